@@ -27,6 +27,7 @@ func main() {
 	engine := sqle.NewDefault()
 	engine.AddDatabase(createTestDatabase())
 	engine.AddDatabase(sql.NewInformationSchemaDatabase(engine.Catalog))
+	engine.AddDatabase(sql.NewSysDatabase(engine.Catalog))
 
 	config := server.Config{
 		Protocol: "tcp",
@@ -1,6 +1,8 @@
 package sqle // import "github.com/mushiyu/go-mysql-server"
 
 import (
+	"context"
+	"io"
 	"time"
 
 	"github.com/go-kit/kit/metrics/discard"
@@ -12,8 +14,17 @@ import (
 	"github.com/mushiyu/go-mysql-server/sql/expression/function"
 	"github.com/mushiyu/go-mysql-server/sql/parse"
 	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"gopkg.in/src-d/go-errors.v1"
 )
 
+// ErrMaxExecutionTimeExceeded is returned when a query is interrupted because
+// it has been running for longer than its maximum execution time.
+var ErrMaxExecutionTimeExceeded = errors.NewKind("Query execution was interrupted, maximum statement execution time exceeded")
+
+// maxExecutionTimeVar is the session variable with the maximum execution
+// time, in milliseconds, of the read-only queries of the session.
+const maxExecutionTimeVar = "max_execution_time"
+
 // Config for the Engine.
 type Config struct {
 	// VersionPostfix to display with the `VERSION()` UDF.
@@ -120,7 +131,11 @@ func (e *Engine) Query(
 	case *plan.CreateIndex:
 		typ = sql.CreateIndexProcess
 		perm = auth.ReadPerm | auth.WritePerm
-	case *plan.InsertInto, *plan.DeleteFrom, *plan.DropIndex, *plan.UnlockTables, *plan.LockTables:
+	case *plan.RebuildIndex:
+		typ = sql.RebuildIndexProcess
+		perm = auth.ReadPerm | auth.WritePerm
+	case *plan.InsertInto, *plan.DeleteFrom, *plan.DropIndex, *plan.UnlockTables, *plan.LockTables,
+		*plan.CreatePolicy, *plan.DropPolicy:
 		perm = auth.ReadPerm | auth.WritePerm
 	}
 
@@ -140,19 +155,100 @@ func (e *Engine) Query(
 		return nil, nil, err
 	}
 
+	// As in MySQL, the maximum execution time only applies to read-only
+	// queries.
+	var cancel context.CancelFunc
+	if perm == auth.ReadPerm {
+		var timeout time.Duration
+		timeout, err = maxExecutionTime(ctx, query)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if timeout > 0 {
+			var newCtx context.Context
+			newCtx, cancel = context.WithTimeout(ctx, timeout)
+			ctx = ctx.WithContext(newCtx)
+			defer func() {
+				if err != nil {
+					cancel()
+				}
+			}()
+		}
+	}
+
 	analyzed, err = e.Analyzer.Analyze(ctx, parsed)
 	if err != nil {
+		err = checkExecutionTime(ctx, err)
 		return nil, nil, err
 	}
 
 	iter, err = analyzed.RowIter(ctx)
 	if err != nil {
+		err = checkExecutionTime(ctx, err)
 		return nil, nil, err
 	}
 
+	if cancel != nil {
+		iter = &timeoutRowIter{iter, ctx, cancel}
+	}
+
 	return analyzed.Schema(), iter, nil
 }
 
+// maxExecutionTime returns the maximum execution time of the given query,
+// which is the one in its MAX_EXECUTION_TIME hint or, if it has none, the
+// one in the max_execution_time session variable. Zero means no limit.
+func maxExecutionTime(ctx *sql.Context, query string) (time.Duration, error) {
+	ms, ok := parse.MaxExecutionTimeHint(query)
+	if !ok {
+		_, v := ctx.Get(maxExecutionTimeVar)
+		if v == nil {
+			return 0, nil
+		}
+
+		v, err := sql.Int64.Convert(v)
+		if err != nil {
+			return 0, err
+		}
+		ms = v.(int64)
+	}
+
+	if ms <= 0 {
+		return 0, nil
+	}
+
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// checkExecutionTime returns ErrMaxExecutionTimeExceeded instead of the
+// given error if the query failed because it ran out of time.
+func checkExecutionTime(ctx *sql.Context, err error) error {
+	if err != nil && err != io.EOF && ctx.Err() == context.DeadlineExceeded {
+		return ErrMaxExecutionTimeExceeded.New()
+	}
+	return err
+}
+
+// timeoutRowIter is the iterator of a query with a maximum execution time.
+// It reports when the query ran out of time and releases the timer when
+// closed.
+type timeoutRowIter struct {
+	sql.RowIter
+	ctx    *sql.Context
+	cancel context.CancelFunc
+}
+
+func (i *timeoutRowIter) Next() (sql.Row, error) {
+	row, err := i.RowIter.Next()
+	return row, checkExecutionTime(i.ctx, err)
+}
+
+func (i *timeoutRowIter) Close() error {
+	defer i.cancel()
+	return i.RowIter.Close()
+}
+
 // AddDatabase adds the given database to the catalog.
 func (e *Engine) AddDatabase(db sql.Database) {
 	e.Catalog.AddDatabase(db)
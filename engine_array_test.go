@@ -0,0 +1,106 @@
+package sqle_test
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function"
+
+	"github.com/stretchr/testify/require"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+func TestArrays(t *testing.T) {
+	e := newEngine(t)
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			"SELECT ARRAY(1, 2, 3), ARRAY('a', NULL), ARRAY()",
+			[]sql.Row{{
+				[]interface{}{int64(1), int64(2), int64(3)},
+				[]interface{}{"a", nil},
+				[]interface{}{},
+			}},
+		},
+		{
+			"SELECT ELEMENT_AT(ARRAY(1, 2, 3), 1), ELEMENT_AT(ARRAY(1, 2, 3), -1), ELEMENT_AT(ARRAY(1, 2, 3), 4)",
+			[]sql.Row{{int64(1), int64(3), nil}},
+		},
+		{
+			"SELECT i, SLICE(SPLIT(s, ' '), 1, 1), ELEMENT_AT(SPLIT(s, ' '), 2) FROM mytable ORDER BY i",
+			[]sql.Row{
+				{int64(1), []interface{}{"first"}, "row"},
+				{int64(2), []interface{}{"second"}, "row"},
+				{int64(3), []interface{}{"third"}, "row"},
+			},
+		},
+		{
+			"SELECT i FROM mytable WHERE ARRAY_CONTAINS(ARRAY('first', 'third'), ELEMENT_AT(SPLIT(s, ' '), 1)) ORDER BY i",
+			[]sql.Row{{int64(1)}, {int64(3)}},
+		},
+		{
+			"SELECT ARRAY_POSITION(ARRAY('a', 'b'), 'b'), ARRAY_POSITION(ARRAY('a', 'b'), 'c'), ARRAY_CONTAINS(ARRAY(1, NULL), 2)",
+			[]sql.Row{{int64(2), int64(0), nil}},
+		},
+		{
+			"SELECT ARRAY_JOIN(ARRAY('a', NULL, 'c'), '-'), ARRAY_JOIN(ARRAY('a', NULL, 'c'), '-', '?')",
+			[]sql.Row{{"a-c", "a-?-c"}},
+		},
+		{
+			"SELECT ELEMENT_AT(JSON_ARRAY(1, 'a'), 2), ARRAY_CONTAINS(JSON_ARRAY(1, 'a'), 1), ARRAY_JOIN(JSON_ARRAY(1, 'a'), ',')",
+			[]sql.Row{{sql.JSONDocument{Val: "a"}, true, "1,a"}},
+		},
+		{
+			"SELECT ARRAY_LENGTH(ARRAY_AGG(i)), ARRAY_CONTAINS(ARRAY_AGG(i), 2), ARRAY_CONTAINS(ARRAY_AGG(i), 4) FROM mytable",
+			[]sql.Row{{int32(3), true, false}},
+		},
+		{
+			"SELECT i, ARRAY_AGG(s) FROM mytable GROUP BY i ORDER BY i",
+			[]sql.Row{
+				{int64(1), []interface{}{"first row"}},
+				{int64(2), []interface{}{"second row"}},
+				{int64(3), []interface{}{"third row"}},
+			},
+		},
+		{
+			"SELECT ARRAY_AGG(i) FROM mytable WHERE i > 5",
+			[]sql.Row{{nil}},
+		},
+		{
+			"SELECT UNNEST(ARRAY(1, 2, 3)) AS n",
+			[]sql.Row{{int64(1)}, {int64(2)}, {int64(3)}},
+		},
+	}
+
+	for _, tt := range testCases {
+		testQuery(t, e, tt.query, tt.expected)
+	}
+}
+
+func TestArrayErrors(t *testing.T) {
+	e := newEngine(t)
+
+	testCases := []struct {
+		query string
+		err   *errors.Kind
+	}{
+		{"SELECT ELEMENT_AT(ARRAY(1, 2), 0)", function.ErrArrayIndexZero},
+		{"SELECT SLICE(ARRAY(1, 2), 1, -1)", function.ErrNegativeSliceLength},
+		{"SELECT ARRAY_CONTAINS('a', 'a')", function.ErrNotArrayArgument},
+		{"SELECT ARRAY_JOIN(JSON_OBJECT('a', 1), ',')", function.ErrNotArrayArgument},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			_, it, err := e.Query(newCtx(), tt.query)
+			if err == nil {
+				_, err = sql.RowIterToRows(it)
+			}
+			require.Error(t, err)
+			require.True(t, tt.err.Is(err), err.Error())
+		})
+	}
+}
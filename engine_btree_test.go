@@ -0,0 +1,656 @@
+package sqle_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/analyzer"
+	"github.com/mushiyu/go-mysql-server/sql/index/btree"
+	"github.com/mushiyu/go-mysql-server/sql/index/ordered"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/mushiyu/go-mysql-server/test"
+
+	"github.com/stretchr/testify/require"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+func TestBTreeIndexes(t *testing.T) {
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(btree.NewDriver())
+	e.AddDatabase(sql.NewSysDatabase(e.Catalog))
+
+	for _, q := range []string{
+		"CREATE INDEX idx_i ON mytable USING btree (i) WITH (async = false)",
+		"CREATE INDEX idx_s ON mytable USING btree (s) WITH (async = false)",
+		"CREATE INDEX idx_is ON mytable USING btree (i, s) WITH (async = false)",
+	} {
+		_, _, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			"SELECT * FROM mytable WHERE i = 2",
+			[]sql.Row{
+				{int64(2), "second row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i > 1",
+			[]sql.Row{
+				{int64(3), "third row"},
+				{int64(2), "second row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i <= 2",
+			[]sql.Row{
+				{int64(2), "second row"},
+				{int64(1), "first row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i = 2 AND s = 'second row'",
+			[]sql.Row{
+				{int64(2), "second row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i BETWEEN 1 AND 2",
+			[]sql.Row{
+				{int64(1), "first row"},
+				{int64(2), "second row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i = 1 OR i = 3",
+			[]sql.Row{
+				{int64(1), "first row"},
+				{int64(3), "third row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE s > 'second row'",
+			[]sql.Row{
+				{int64(3), "third row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i = 1 AND i = 2",
+			[]sql.Row{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			tracer := new(test.MemTracer)
+			ctx := sql.NewContext(context.TODO(), sql.WithTracer(tracer))
+
+			_, it, err := e.Query(ctx, tt.query)
+			require.NoError(err)
+
+			rows, err := sql.RowIterToRows(it)
+			require.NoError(err)
+
+			require.ElementsMatch(tt.expected, rows)
+			require.Equal("plan.ResolvedTable", tracer.Spans[len(tracer.Spans)-1])
+		})
+	}
+}
+
+func TestBTreeIndexesOrderAndCovering(t *testing.T) {
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(btree.NewDriver())
+
+	_, _, err := e.Query(newCtx(), "CREATE INDEX idx_i ON mytable USING btree (i) WITH (async = false)")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			"SELECT * FROM mytable ORDER BY i DESC",
+			[]sql.Row{
+				{int64(3), "third row"},
+				{int64(2), "second row"},
+				{int64(1), "first row"},
+			},
+		},
+		{
+			"SELECT s FROM mytable ORDER BY i LIMIT 2",
+			[]sql.Row{
+				{"first row"},
+				{"second row"},
+			},
+		},
+		{
+			"SELECT i AS x, s FROM mytable WHERE i > 1 ORDER BY x",
+			[]sql.Row{
+				{int64(2), "second row"},
+				{int64(3), "third row"},
+			},
+		},
+		{
+			"SELECT i FROM mytable WHERE i >= 2 ORDER BY i DESC LIMIT 1",
+			[]sql.Row{
+				{int64(3)},
+			},
+		},
+		{
+			"SELECT i FROM mytable ORDER BY i",
+			[]sql.Row{
+				{int64(1)},
+				{int64(2)},
+				{int64(3)},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			tracer := new(test.MemTracer)
+			ctx := sql.NewContext(context.TODO(), sql.WithTracer(tracer))
+
+			_, it, err := e.Query(ctx, tt.query)
+			require.NoError(err)
+
+			rows, err := sql.RowIterToRows(it)
+			require.NoError(err)
+
+			require.Equal(tt.expected, rows)
+			require.NotContains(tracer.Spans, "plan.Sort")
+		})
+	}
+}
+
+func TestBTreeIndexHints(t *testing.T) {
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(btree.NewDriver())
+	e.AddDatabase(sql.NewSysDatabase(e.Catalog))
+
+	for _, q := range []string{
+		"CREATE INDEX idx_i ON mytable USING btree (i) WITH (async = false)",
+		"CREATE INDEX idx_s ON mytable USING btree (s) WITH (async = false)",
+	} {
+		_, _, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+		sorted   bool
+	}{
+		{
+			"SELECT i FROM mytable USE INDEX (idx_i) ORDER BY i",
+			[]sql.Row{{int64(1)}, {int64(2)}, {int64(3)}},
+			false,
+		},
+		{
+			"SELECT i FROM mytable USE INDEX (idx_s) ORDER BY i",
+			[]sql.Row{{int64(1)}, {int64(2)}, {int64(3)}},
+			true,
+		},
+		{
+			"SELECT i FROM mytable IGNORE INDEX (idx_i) ORDER BY i DESC",
+			[]sql.Row{{int64(3)}, {int64(2)}, {int64(1)}},
+			true,
+		},
+		{
+			"SELECT i FROM mytable FORCE INDEX (idx_i) ORDER BY i DESC",
+			[]sql.Row{{int64(3)}, {int64(2)}, {int64(1)}},
+			false,
+		},
+		{
+			"SELECT i FROM mytable FORCE INDEX (idx_s) WHERE s = 'second row' ORDER BY i",
+			[]sql.Row{{int64(2)}},
+			true,
+		},
+		{
+			"SELECT i FROM mytable AS t IGNORE INDEX (idx_s) WHERE t.i > 1 ORDER BY t.i",
+			[]sql.Row{{int64(2)}, {int64(3)}},
+			true,
+		},
+		{
+			"SELECT a.i FROM mytable AS a USE INDEX (idx_i) JOIN mytable AS b IGNORE INDEX (idx_i) ON a.i = b.i WHERE a.i = 2",
+			[]sql.Row{{int64(2)}},
+			false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			tracer := new(test.MemTracer)
+			ctx := sql.NewContext(context.TODO(), sql.WithTracer(tracer))
+
+			_, it, err := e.Query(ctx, tt.query)
+			require.NoError(err)
+
+			rows, err := sql.RowIterToRows(it)
+			require.NoError(err)
+
+			require.Equal(tt.expected, rows)
+			if tt.sorted {
+				require.Contains(tracer.Spans, "plan.Sort")
+			} else {
+				require.NotContains(tracer.Spans, "plan.Sort")
+			}
+		})
+	}
+
+	errorCases := []struct {
+		query string
+		err   *errors.Kind
+	}{
+		{
+			"SELECT i FROM mytable USE INDEX (idx_foo) WHERE i = 1",
+			analyzer.ErrIndexHintNotFound,
+		},
+		{
+			"SELECT i FROM mytable FORCE INDEX (idx_s) WHERE i = 1",
+			analyzer.ErrForcedIndexNotUsable,
+		},
+		{
+			"SELECT i FROM mytable FORCE INDEX (idx_i)",
+			analyzer.ErrForcedIndexNotUsable,
+		},
+		{
+			"SELECT a.i FROM mytable AS a FORCE INDEX (idx_i) JOIN mytable AS b IGNORE INDEX (idx_i) ON a.i = b.i WHERE a.i = 2",
+			analyzer.ErrForcedIndexNotUsable,
+		},
+	}
+
+	for _, tt := range errorCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			_, _, err := e.Query(newCtx(), tt.query)
+			require.Error(err)
+			require.True(tt.err.Is(err), "unexpected error: %s", err)
+		})
+	}
+}
+
+func TestBTreeIndexesMaintenance(t *testing.T) {
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(btree.NewDriver())
+
+	_, _, err := e.Query(newCtx(), "CREATE INDEX idx_i ON mytable USING btree (i) WITH (async = false)")
+	require.NoError(t, err)
+
+	for _, q := range []string{
+		"INSERT INTO mytable (i, s) VALUES (4, 'fourth row'), (5, 'fifth row')",
+		"DELETE FROM mytable WHERE i = 2",
+		"REPLACE INTO mytable (i, s) VALUES (3, 'third row'), (6, 'sixth row')",
+		"DELETE FROM mytable WHERE i >= 5",
+	} {
+		_, iter, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(iter)
+		require.NoError(t, err)
+	}
+
+	idx := e.Catalog.IndexRegistry.Index("mydb", "idx_i")
+	require.NotNil(t, idx)
+	require.True(t, e.Catalog.IndexRegistry.CanUseIndex(idx))
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			"SELECT * FROM mytable WHERE i = 2",
+			[]sql.Row{},
+		},
+		{
+			"SELECT * FROM mytable WHERE i > 1",
+			[]sql.Row{
+				{int64(3), "third row"},
+				{int64(4), "fourth row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i < 5",
+			[]sql.Row{
+				{int64(1), "first row"},
+				{int64(3), "third row"},
+				{int64(4), "fourth row"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			tracer := new(test.MemTracer)
+			ctx := sql.NewContext(context.TODO(), sql.WithTracer(tracer))
+
+			_, it, err := e.Query(ctx, tt.query)
+			require.NoError(err)
+
+			rows, err := sql.RowIterToRows(it)
+			require.NoError(err)
+
+			require.ElementsMatch(tt.expected, rows)
+			require.Equal("plan.ResolvedTable", tracer.Spans[len(tracer.Spans)-1])
+		})
+	}
+}
+
+func TestNonIncrementalIndexesOutdated(t *testing.T) {
+	require := require.New(t)
+
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "ordered-test")
+	require.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(ordered.NewDriver(tmpDir))
+
+	_, _, err = e.Query(newCtx(), "CREATE INDEX idx_i ON mytable USING ordered (i) WITH (async = false)")
+	require.NoError(err)
+
+	idx := e.Catalog.IndexRegistry.Index("mydb", "idx_i")
+	require.NotNil(idx)
+	require.True(e.Catalog.IndexRegistry.CanUseIndex(idx))
+
+	_, iter, err := e.Query(newCtx(), "INSERT INTO mytable (i, s) VALUES (4, 'fourth row')")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(iter)
+	require.NoError(err)
+
+	require.False(e.Catalog.IndexRegistry.CanUseIndex(idx))
+}
+
+func TestBTreeIndexStatistics(t *testing.T) {
+	require := require.New(t)
+
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(btree.NewDriver())
+	e.AddDatabase(sql.NewSysDatabase(e.Catalog))
+
+	for _, q := range []string{
+		"CREATE INDEX idx_i ON mytable USING btree (i) WITH (async = false)",
+		"CREATE INDEX idx_si ON mytable USING btree (s, i) WITH (async = false)",
+		"CREATE TABLE nullables (a INT, b INT NOT NULL)",
+		"CREATE INDEX idx_ab ON nullables USING btree (a, b) WITH (async = false)",
+	} {
+		_, _, err := e.Query(newCtx(), q)
+		require.NoError(err)
+	}
+
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(newCtx(), q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		return rows
+	}
+
+	require.Equal(
+		[]sql.Row{
+			{"idx_i", uint64(1), "i", nil, "", "BTREE", "YES", "btree", "ready"},
+			{"idx_si", uint64(1), "s", nil, "", "BTREE", "YES", "btree", "ready"},
+			{"idx_si", uint64(2), "i", nil, "", "BTREE", "YES", "btree", "ready"},
+		},
+		query(`SELECT index_name, seq_in_index, column_name, expression, nullable,
+			index_type, is_visible, driver, status
+			FROM information_schema.statistics
+			WHERE table_schema = 'mydb' AND table_name = 'mytable'
+			ORDER BY index_name, seq_in_index`),
+	)
+
+	require.Equal(
+		[]sql.Row{
+			{"idx_ab", uint64(1), "a", "YES"},
+			{"idx_ab", uint64(2), "b", ""},
+		},
+		query(`SELECT index_name, seq_in_index, column_name, nullable
+			FROM information_schema.statistics
+			WHERE table_schema = 'mydb' AND table_name = 'nullables'
+			ORDER BY index_name, seq_in_index`),
+	)
+
+	require.Equal(
+		[]sql.Row{
+			{"idx_i", uint64(0), uint64(0), true},
+			{"idx_si", uint64(0), uint64(0), true},
+		},
+		query(`SELECT index_name, lookups, rows_selected, last_used IS NULL
+			FROM information_schema.index_statistics
+			WHERE table_name = 'mytable'
+			ORDER BY index_name`),
+	)
+
+	require.Len(query("SELECT * FROM mytable WHERE i > 1"), 2)
+	require.Len(query("SELECT * FROM mytable WHERE i = 3"), 1)
+	// Only analyzing the query does not count as using the index.
+	query("DESCRIBE FORMAT=TREE SELECT * FROM mytable WHERE i = 3")
+
+	require.Equal(
+		[]sql.Row{
+			{"idx_i", uint64(2), uint64(3), false},
+			{"idx_si", uint64(0), uint64(0), true},
+		},
+		query(`SELECT index_name, lookups, rows_selected, last_used IS NULL
+			FROM information_schema.index_statistics
+			WHERE table_name = 'mytable'
+			ORDER BY index_name`),
+	)
+
+	require.Equal(
+		query(`SELECT * FROM information_schema.index_statistics ORDER BY index_name`),
+		query(`SELECT * FROM sys.schema_index_statistics ORDER BY index_name`),
+	)
+}
+
+func TestRebuildOutdatedIndex(t *testing.T) {
+	require := require.New(t)
+
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "ordered-test")
+	require.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(ordered.NewDriver(tmpDir))
+
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(newCtx(), q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		return rows
+	}
+
+	query("CREATE INDEX idx_i ON mytable USING ordered (i) WITH (async = false)")
+	query("INSERT INTO mytable (i, s) VALUES (4, 'fourth row')")
+
+	status := `SELECT status FROM information_schema.statistics
+		WHERE table_name = 'mytable' AND index_name = 'idx_i'`
+	require.Equal([]sql.Row{{"outdated"}}, query(status))
+
+	query("ALTER INDEX idx_i ON mytable REBUILD WITH (async = false)")
+	require.Equal([]sql.Row{{"ready"}}, query(status))
+
+	tracer := new(test.MemTracer)
+	ctx := sql.NewContext(context.TODO(), sql.WithTracer(tracer))
+	_, iter, err := e.Query(ctx, "SELECT * FROM mytable WHERE i = 4")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(4), "fourth row"}}, rows)
+	require.Equal("plan.ResolvedTable", tracer.Spans[len(tracer.Spans)-1])
+
+	_, _, err = e.Query(newCtx(), "REINDEX idx_foo ON mytable")
+	require.Error(err)
+	require.True(plan.ErrIndexNotFound.Is(err))
+}
+
+// versionedTable is a table whose checksum changes every time a row is
+// inserted.
+type versionedTable struct {
+	*memory.Table
+	version int32
+}
+
+func (t *versionedTable) Checksum() (string, error) {
+	return fmt.Sprint(atomic.LoadInt32(&t.version)), nil
+}
+
+func (t *versionedTable) Insert(ctx *sql.Context, row sql.Row) error {
+	atomic.AddInt32(&t.version, 1)
+	return t.Table.Insert(ctx, row)
+}
+
+func (t *versionedTable) InsertLocated(ctx *sql.Context, row sql.Row) (sql.RowLocationChanges, error) {
+	atomic.AddInt32(&t.version, 1)
+	return t.Table.InsertLocated(ctx, row)
+}
+
+func TestIndexChecksums(t *testing.T) {
+	require := require.New(t)
+
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(btree.NewDriver())
+
+	table := &versionedTable{Table: memory.NewPartitionedTable("versioned", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "versioned"},
+	}, 2)}
+	db, err := e.Catalog.Database("mydb")
+	require.NoError(err)
+	db.(*memory.Database).AddTable("versioned", table)
+
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(newCtx(), q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		return rows
+	}
+
+	status := func() string {
+		rows := query(`SELECT status FROM information_schema.statistics
+			WHERE table_name = 'versioned' AND index_name = 'idx_i'`)
+		require.Len(rows, 1)
+		return rows[0][0].(string)
+	}
+
+	query("INSERT INTO versioned (i) VALUES (1), (2)")
+	query("CREATE INDEX idx_i ON versioned USING btree (i) WITH (async = false)")
+
+	// Rows inserted by the engine are added to the index, so the index is
+	// still up to date with the table.
+	query("INSERT INTO versioned (i) VALUES (3)")
+	require.Equal([]sql.Row{{int64(3)}}, query("SELECT i FROM versioned WHERE i = 3"))
+	require.Equal("ready", status())
+
+	// Rows inserted directly into the table are not.
+	require.NoError(table.Insert(newCtx(), sql.NewRow(int64(4))))
+	require.Equal([]sql.Row{{int64(4)}}, query("SELECT i FROM versioned WHERE i = 4"))
+	require.Equal("outdated", status())
+
+	query("ALTER INDEX idx_i ON versioned REBUILD WITH (async = false)")
+	require.Equal("ready", status())
+
+	e.Catalog.RebuildOutdated = true
+	require.NoError(table.Insert(newCtx(), sql.NewRow(int64(5))))
+	require.Equal([]sql.Row{{int64(5)}}, query("SELECT i FROM versioned WHERE i = 5"))
+
+	for i := 0; i < 100 && status() != "ready"; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal("ready", status())
+
+	lookups := `SELECT lookups FROM information_schema.index_statistics
+		WHERE table_name = 'versioned' AND index_name = 'idx_i'`
+	before := query(lookups)[0][0].(uint64)
+	require.Equal([]sql.Row{{int64(5)}}, query("SELECT i FROM versioned WHERE i = 5"))
+	require.Equal(before+1, query(lookups)[0][0].(uint64))
+}
+
+func TestIndexAggregations(t *testing.T) {
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "ordered-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+		// btree and ordered report whether the aggregations are answered by
+		// the indexes of each driver.
+		btree   bool
+		ordered bool
+	}{
+		{"SELECT COUNT(*) FROM mytable WHERE i = 2", []sql.Row{{int64(1)}}, true, true},
+		{"SELECT COUNT(*) FROM mytable WHERE i > 1 OR s = 'first row'", []sql.Row{{int64(3)}}, true, true},
+		{"SELECT COUNT(*) FROM mytable WHERE i >= 2 AND i < 3", []sql.Row{{int64(1)}}, true, true},
+		{"SELECT COUNT(*) FROM mytable WHERE i IN (1, 3)", []sql.Row{{int64(2)}}, true, true},
+		{"SELECT COUNT(1) FROM mytable WHERE i BETWEEN 2 AND 3", []sql.Row{{int64(2)}}, true, true},
+		{"SELECT COUNT(*) AS c FROM mytable", []sql.Row{{int64(3)}}, true, false},
+		{"SELECT MIN(i), MAX(i), MAX(s) FROM mytable", []sql.Row{{int64(1), int64(3), "third row"}}, true, true},
+		{"SELECT COUNT(*), MAX(i) + 1 FROM mytable", []sql.Row{{int64(3), int64(4)}}, true, false},
+		{"SELECT COUNT(*) FROM mytable WHERE i + 1 = 3", []sql.Row{{int64(1)}}, false, false},
+		{"SELECT COUNT(*) FROM mytable WHERE i = 2.5", []sql.Row{{int64(0)}}, false, false},
+		{"SELECT MAX(i) FROM mytable WHERE i < 3", []sql.Row{{int64(2)}}, false, false},
+		{"SELECT COUNT(s) FROM mytable WHERE i = 2", []sql.Row{{int64(1)}}, false, false},
+		{"SELECT MIN(i) FROM mytable IGNORE INDEX (idx_i)", []sql.Row{{int64(1)}}, false, false},
+	}
+
+	drivers := []sql.IndexDriver{btree.NewDriver(), ordered.NewDriver(tmpDir)}
+	for _, d := range drivers {
+		t.Run(d.ID(), func(t *testing.T) {
+			e := newEngine(t)
+			e.Catalog.RegisterIndexDriver(d)
+
+			for _, q := range []string{
+				"CREATE INDEX idx_i ON mytable USING " + d.ID() + " (i) WITH (async = false)",
+				"CREATE INDEX idx_s ON mytable USING " + d.ID() + " (s) WITH (async = false)",
+			} {
+				_, _, err := e.Query(newCtx(), q)
+				require.NoError(t, err)
+			}
+
+			for _, tt := range testCases {
+				t.Run(tt.query, func(t *testing.T) {
+					tracer := new(test.MemTracer)
+					ctx := sql.NewContext(context.TODO(), sql.WithTracer(tracer))
+					_, iter, err := e.Query(ctx, tt.query)
+					require.NoError(t, err)
+					rows, err := sql.RowIterToRows(iter)
+					require.NoError(t, err)
+					require.Equal(t, tt.expected, rows)
+
+					var answered, read bool
+					for _, s := range tracer.Spans {
+						answered = answered || s == "plan.IndexAggregation"
+						read = read || s == "plan.ResolvedTable"
+					}
+					used := tt.btree
+					if d.ID() == ordered.DriverID {
+						used = tt.ordered
+					}
+					require.Equal(t, used, answered)
+					require.Equal(t, !used, read)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package sqle_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/index/fulltext"
+	"github.com/mushiyu/go-mysql-server/test"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullTextIndexes(t *testing.T) {
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(fulltext.NewDriver())
+
+	for _, q := range []string{
+		"CREATE TABLE articles (id BIGINT, title TEXT, body TEXT)",
+		`INSERT INTO articles VALUES
+			(1, 'MySQL Tutorial', 'DBMS stands for DataBase management system'),
+			(2, 'How To Use MySQL Well', 'After you went through a tutorial'),
+			(3, 'Optimizing MySQL', 'In this tutorial we show how to optimize queries'),
+			(4, '1001 MySQL Tricks', '1. Never run mysqld as root. 2. Show tricks'),
+			(5, 'MySQL vs. YourSQL', 'In the following database comparison'),
+			(6, 'MySQL Security', 'When configured properly, MySQL is secure')`,
+		"CREATE FULLTEXT INDEX idx_ft ON articles (title, body) WITH (async = false)",
+		"INSERT INTO articles VALUES (7, 'Database Design', 'Normalize your database tables')",
+	} {
+		_, it, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(it)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			"SELECT id FROM articles WHERE MATCH(title, body) AGAINST ('database') ORDER BY id",
+			[]sql.Row{{int64(1)}, {int64(5)}, {int64(7)}},
+		},
+		{
+			"SELECT id FROM articles WHERE MATCH(title, body) AGAINST ('database tutorial') ORDER BY MATCH(title, body) AGAINST ('database tutorial') DESC, id",
+			[]sql.Row{{int64(1)}, {int64(7)}, {int64(2)}, {int64(3)}, {int64(5)}},
+		},
+		{
+			"SELECT id FROM articles WHERE MATCH(title, body) AGAINST ('+mysql -tutorial' IN BOOLEAN MODE) ORDER BY id",
+			[]sql.Row{{int64(4)}, {int64(5)}, {int64(6)}},
+		},
+		{
+			"SELECT id FROM articles WHERE MATCH(title, body) AGAINST ('optim*' IN BOOLEAN MODE)",
+			[]sql.Row{{int64(3)}},
+		},
+		{
+			`SELECT id FROM articles WHERE MATCH(title, body) AGAINST ('"database management"' IN BOOLEAN MODE)`,
+			[]sql.Row{{int64(1)}},
+		},
+		{
+			"SELECT id, MATCH(title, body) AGAINST ('secure') > 0 AS found FROM articles WHERE id > 5 ORDER BY id",
+			[]sql.Row{{int64(6), true}, {int64(7), false}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			tracer := new(test.MemTracer)
+			ctx := sql.NewContext(context.TODO(), sql.WithTracer(tracer))
+
+			_, it, err := e.Query(ctx, tt.query)
+			require.NoError(err)
+
+			rows, err := sql.RowIterToRows(it)
+			require.NoError(err)
+
+			require.Equal(tt.expected, rows)
+		})
+	}
+}
+
+func TestFullTextIndexesErrors(t *testing.T) {
+	require := require.New(t)
+
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(fulltext.NewDriver())
+
+	_, _, err := e.Query(newCtx(), "CREATE FULLTEXT INDEX idx_ft ON mytable (s) WITH (async = false)")
+	require.NoError(err)
+
+	_, _, err = e.Query(newCtx(), "SELECT i FROM mytable WHERE MATCH(s, s) AGAINST ('row')")
+	require.Error(err)
+	require.True(expression.ErrNoFullTextIndex.Is(err))
+
+	_, _, err = e.Query(newCtx(), "CREATE FULLTEXT INDEX idx_ft2 ON mytable (i) WITH (async = false)")
+	require.Error(err)
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	sqle "github.com/mushiyu/go-mysql-server"
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/index/pilosa"
 	"github.com/mushiyu/go-mysql-server/test"
@@ -182,6 +183,83 @@ func TestIndexes(t *testing.T) {
 	}
 }
 
+func TestIndexesCollation(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			"SELECT * FROM mytable WHERE s = 'FIRST ROW'",
+			[]sql.Row{
+				{int64(1), "first row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE s = 'Second Row  '",
+			[]sql.Row{
+				{int64(2), "second row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE s > 'SECOND ROW'",
+			[]sql.Row{
+				{int64(3), "third row"},
+			},
+		},
+		{
+			"SELECT * FROM mytable WHERE i = 3 AND s = 'THIRD ROW'",
+			[]sql.Row{
+				{int64(3), "third row"},
+			},
+		},
+	}
+
+	query := func(t *testing.T, e *sqle.Engine, q string) []sql.Row {
+		_, it, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+
+		rows, err := sql.RowIterToRows(it)
+		require.NoError(t, err)
+		return rows
+	}
+
+	e := newEngine(t)
+	for _, tt := range testCases {
+		t.Run("without index "+tt.query, func(t *testing.T) {
+			require.ElementsMatch(t, tt.expected, query(t, e, tt.query))
+		})
+	}
+
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "pilosa-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	e.Catalog.RegisterIndexDriver(pilosa.NewDriver(tmpDir))
+	for _, q := range []string{
+		"CREATE INDEX idx_s ON mytable USING pilosa (s) WITH (async = false)",
+		"CREATE INDEX idx_is ON mytable USING pilosa (i, s) WITH (async = false)",
+	} {
+		_, iter, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(iter)
+		require.NoError(t, err)
+	}
+
+	defer func() {
+		for _, id := range []string{"idx_s", "idx_is"} {
+			done, err := e.Catalog.DeleteIndex("mydb", id, true)
+			require.NoError(t, err)
+			<-done
+		}
+	}()
+
+	for _, tt := range testCases {
+		t.Run("with index "+tt.query, func(t *testing.T) {
+			require.ElementsMatch(t, tt.expected, query(t, e, tt.query))
+		})
+	}
+}
+
 func TestCreateIndex(t *testing.T) {
 	require := require.New(t)
 	e := newEngine(t)
@@ -0,0 +1,152 @@
+package sqle_test
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function"
+	"github.com/mushiyu/go-mysql-server/sql/index/rtree"
+
+	"github.com/stretchr/testify/require"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+func TestSpatial(t *testing.T) {
+	e := newEngine(t)
+	e.Catalog.RegisterIndexDriver(rtree.NewDriver())
+
+	query := func(t *testing.T, q string) []sql.Row {
+		t.Helper()
+		_, it, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(it)
+		require.NoError(t, err)
+		return rows
+	}
+
+	for _, q := range []string{
+		"CREATE TABLE places (id BIGINT, name TEXT, location POINT)",
+		`INSERT INTO places VALUES
+			(1, 'origin', POINT(0, 0)),
+			(2, 'madrid', ST_GeomFromText('POINT(-3.7038 40.4168)')),
+			(3, 'paris', ST_GeomFromText('POINT(2.3522 48.8566)')),
+			(4, 'corner', POINT(10, 10)),
+			(5, 'nowhere', NULL)`,
+		"CREATE TABLE zones (id BIGINT, area GEOMETRY)",
+		`INSERT INTO zones VALUES
+			(1, ST_GeomFromText('POLYGON((-10 30,10 30,10 50,-10 50,-10 30))')),
+			(2, ST_GeomFromText('LINESTRING(0 0,10 10)'))`,
+	} {
+		query(t, q)
+	}
+
+	testCases := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			"SELECT id, ST_AsText(location), ST_X(location), ST_Y(location) FROM places WHERE id < 4 ORDER BY id",
+			[]sql.Row{
+				{int64(1), "POINT(0 0)", float64(0), float64(0)},
+				{int64(2), "POINT(-3.7038 40.4168)", -3.7038, 40.4168},
+				{int64(3), "POINT(2.3522 48.8566)", 2.3522, 48.8566},
+			},
+		},
+		{
+			"SELECT ST_AsText(ST_GeomFromText('linestring(1 2, 3 4)', 4326)), ST_SRID(ST_GeomFromText('POINT(1 2)', 4326))",
+			[]sql.Row{{"LINESTRING(1 2,3 4)", uint32(4326)}},
+		},
+		{
+			"SELECT ST_AsText(ST_GeomFromWKB(ST_AsBinary(POINT(1, 2))))",
+			[]sql.Row{{"POINT(1 2)"}},
+		},
+		{
+			"SELECT ST_Distance(POINT(0, 0), POINT(3, 4)), ST_Distance(POINT(13, 14), ST_GeomFromText('POLYGON((0 0,10 0,10 10,0 10,0 0))'))",
+			[]sql.Row{{float64(5), float64(5)}},
+		},
+		{
+			"SELECT name, ROUND(ST_Distance_Sphere(location, POINT(2.3522, 48.8566)) / 1000) FROM places WHERE id = 2",
+			[]sql.Row{{"madrid", float64(1053)}},
+		},
+		{
+			"SELECT places.name FROM places, zones WHERE zones.id = 1 AND ST_Contains(zones.area, places.location) ORDER BY places.id",
+			[]sql.Row{{"madrid"}, {"paris"}},
+		},
+		{
+			"SELECT name FROM places WHERE ST_Within(location, ST_GeomFromText('POLYGON((-10 30,10 30,10 45,-10 45,-10 30))'))",
+			[]sql.Row{{"madrid"}},
+		},
+		{
+			"SELECT name FROM places WHERE ST_Intersects(location, ST_GeomFromText('LINESTRING(-5 -5,5 5)'))",
+			[]sql.Row{{"origin"}},
+		},
+		{
+			"SELECT name FROM places WHERE ST_Distance(location, POINT(0, 0)) <= 15 ORDER BY id",
+			[]sql.Row{{"origin"}, {"corner"}},
+		},
+		{
+			"SELECT name FROM places WHERE ST_Contains(ST_Buffer(POINT(10, 11), 2), location)",
+			[]sql.Row{{"corner"}},
+		},
+		{
+			"SELECT id FROM zones WHERE MBRIntersects(area, POINT(2, 8)) ORDER BY id",
+			[]sql.Row{{int64(2)}},
+		},
+	}
+
+	query(t, "CREATE SPATIAL INDEX idx_location ON places (location) WITH (async = false)")
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, query(t, tt.query))
+		})
+	}
+
+	// Only the rows whose location is in the bounding box of the other
+	// geometry are read from the table by the queries that use the index.
+	require.Equal(
+		t,
+		[]sql.Row{{"idx_location", uint64(4), uint64(5)}},
+		query(t, `SELECT index_name, lookups, rows_selected
+			FROM information_schema.index_statistics
+			WHERE table_name = 'places'`),
+	)
+}
+
+func TestSpatialErrors(t *testing.T) {
+	e := newEngine(t)
+
+	for _, q := range []string{
+		"CREATE TABLE places (id BIGINT, location POINT)",
+		"INSERT INTO places VALUES (1, POINT(0, 0))",
+	} {
+		_, it, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(it)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query string
+		err   *errors.Kind
+	}{
+		{"INSERT INTO places VALUES (2, ST_GeomFromText('LINESTRING(0 0,1 1)'))", sql.ErrInvalidType},
+		{"SELECT ST_GeomFromText('POINT(0)')", sql.ErrInvalidWKT},
+		{"SELECT ST_Distance(location, ST_GeomFromText('POINT(0 0)', 4326)) FROM places", function.ErrDifferentSRIDs},
+		{"SELECT ST_Buffer(ST_GeomFromText('LINESTRING(0 0,1 1)'), 1)", function.ErrBufferNotSupported},
+		{"CREATE SPATIAL INDEX idx ON places (id) WITH (async = false)", nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			_, it, err := e.Query(newCtx(), tt.query)
+			if err == nil {
+				_, err = sql.RowIterToRows(it)
+			}
+			require.Error(t, err)
+			if tt.err != nil {
+				require.True(t, tt.err.Is(err), err.Error())
+			}
+		})
+	}
+}
@@ -11,12 +11,14 @@ import (
 
 	sqle "github.com/mushiyu/go-mysql-server"
 	"github.com/mushiyu/go-mysql-server/auth"
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
 	"github.com/mushiyu/go-mysql-server/memory"
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/analyzer"
 	"github.com/mushiyu/go-mysql-server/sql/parse"
 	"github.com/mushiyu/go-mysql-server/sql/plan"
 	"github.com/mushiyu/go-mysql-server/test"
+	"github.com/shopspring/decimal"
 
 	"github.com/stretchr/testify/require"
 )
@@ -642,8 +644,8 @@ var queries = []struct {
 	{
 		`SELECT SCHEMA_NAME, DEFAULT_CHARACTER_SET_NAME, DEFAULT_COLLATION_NAME FROM information_schema.SCHEMATA`,
 		[]sql.Row{
-			{"mydb", "utf8mb4", "utf8_bin"},
-			{"foo", "utf8mb4", "utf8_bin"},
+			{"mydb", "utf8mb4", "utf8mb4_general_ci"},
+			{"foo", "utf8mb4", "utf8mb4_general_ci"},
 		},
 	},
 	{
@@ -688,38 +690,38 @@ var queries = []struct {
 		`SHOW FULL COLUMNS FROM mytable`,
 		[]sql.Row{
 			{"i", "INT64", nil, "NO", "", "", "", "", ""},
-			{"s", "TEXT", "utf8_bin", "NO", "", "", "", "", ""},
+			{"s", "TEXT", "utf8mb4_general_ci", "NO", "", "", "", "", ""},
 		},
 	},
 	{
 		`SHOW TABLE STATUS FROM mydb`,
 		[]sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"tabletest", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"typestable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"tabletest", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"bigtable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"floattable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"niltable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"newlinetable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"typestable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
 		},
 	},
 	{
 		`SHOW TABLE STATUS LIKE '%table'`,
 		[]sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"typestable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"bigtable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"floattable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"niltable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"newlinetable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"typestable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
 		},
 	},
 	{
 		`SHOW TABLE STATUS WHERE Name = 'mytable'`,
 		[]sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
 		},
 	},
 	{
@@ -741,7 +743,7 @@ var queries = []struct {
 	{
 		`SELECT AVG(23.222000)`,
 		[]sql.Row{
-			{float64(23.222)},
+			{decimal.RequireFromString("23.2220000000")},
 		},
 	},
 	{
@@ -757,9 +759,17 @@ var queries = []struct {
 			{"time_zone", time.Local.String()},
 			{"system_time_zone", time.Local.String()},
 			{"max_allowed_packet", math.MaxInt32},
+			{"max_execution_time", int64(0)},
 			{"sql_mode", ""},
 			{"gtid_mode", int32(0)},
-			{"collation_database", "utf8_bin"},
+			{"last_insert_id", uint64(0)},
+			{"character_set_client", "utf8mb4"},
+			{"character_set_connection", "utf8mb4"},
+			{"character_set_results", "utf8mb4"},
+			{"character_set_server", "utf8mb4"},
+			{"collation_connection", "utf8mb4_general_ci"},
+			{"collation_database", "utf8mb4_general_ci"},
+			{"collation_server", "utf8mb4_general_ci"},
 			{"ndbinfo_version", ""},
 			{"sql_select_limit", math.MaxInt32},
 			{"transaction_isolation", "READ UNCOMMITTED"},
@@ -788,7 +798,7 @@ var queries = []struct {
 	},
 	{
 		`SELECT JSON_EXTRACT("foo", "$")`,
-		[]sql.Row{{"foo"}},
+		[]sql.Row{{sql.JSONDocument{Val: "foo"}}},
 	},
 	{
 		`SELECT JSON_UNQUOTE('"foo"')`,
@@ -976,7 +986,7 @@ var queries = []struct {
 		`SHOW CREATE DATABASE mydb`,
 		[]sql.Row{{
 			"mydb",
-			"CREATE DATABASE `mydb` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8_bin */",
+			"CREATE DATABASE `mydb` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci */",
 		}},
 	},
 	{
@@ -1000,14 +1010,14 @@ var queries = []struct {
 	{
 		`SHOW TABLE STATUS`,
 		[]sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"tabletest", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
-			{"typestable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8_bin", nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"tabletest", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"bigtable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"floattable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"niltable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"newlinetable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
+			{"typestable", "InnoDB", "10", "Fixed", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), nil, nil, nil, "utf8mb4_general_ci", nil, nil},
 		},
 	},
 	{
@@ -1164,15 +1174,32 @@ var queries = []struct {
 	},
 	{
 		`SHOW COLLATION`,
-		[]sql.Row{{"utf8_bin", "utf8mb4", int64(1), "Yes", "Yes", int64(1)}},
+		[]sql.Row{
+			{"binary", "binary", int64(63), "Yes", "Yes", int64(1), "NO PAD"},
+			{"ascii_general_ci", "ascii", int64(11), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"ascii_bin", "ascii", int64(65), "", "Yes", int64(1), "PAD SPACE"},
+			{"latin1_swedish_ci", "latin1", int64(8), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"latin1_bin", "latin1", int64(47), "", "Yes", int64(1), "PAD SPACE"},
+			{"utf8_general_ci", "utf8", int64(33), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"utf8_bin", "utf8", int64(83), "", "Yes", int64(1), "PAD SPACE"},
+			{"utf8_unicode_ci", "utf8", int64(192), "", "Yes", int64(8), "PAD SPACE"},
+			{"utf8mb4_general_ci", "utf8mb4", int64(45), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"utf8mb4_bin", "utf8mb4", int64(46), "", "Yes", int64(1), "PAD SPACE"},
+			{"utf8mb4_unicode_ci", "utf8mb4", int64(224), "", "Yes", int64(8), "PAD SPACE"},
+			{"utf8mb4_0900_ai_ci", "utf8mb4", int64(255), "", "Yes", int64(0), "NO PAD"},
+			{"utf8mb4_0900_bin", "utf8mb4", int64(309), "", "Yes", int64(1), "NO PAD"},
+		},
 	},
 	{
 		`SHOW COLLATION LIKE 'foo'`,
 		[]sql.Row{},
 	},
 	{
-		`SHOW COLLATION LIKE 'utf8%'`,
-		[]sql.Row{{"utf8_bin", "utf8mb4", int64(1), "Yes", "Yes", int64(1)}},
+		`SHOW COLLATION LIKE 'latin1%'`,
+		[]sql.Row{
+			{"latin1_swedish_ci", "latin1", int64(8), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"latin1_bin", "latin1", int64(47), "", "Yes", int64(1), "PAD SPACE"},
+		},
 	},
 	{
 		`SHOW COLLATION WHERE charset = 'foo'`,
@@ -1180,7 +1207,37 @@ var queries = []struct {
 	},
 	{
 		"SHOW COLLATION WHERE `Default` = 'Yes'",
-		[]sql.Row{{"utf8_bin", "utf8mb4", int64(1), "Yes", "Yes", int64(1)}},
+		[]sql.Row{
+			{"binary", "binary", int64(63), "Yes", "Yes", int64(1), "NO PAD"},
+			{"ascii_general_ci", "ascii", int64(11), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"latin1_swedish_ci", "latin1", int64(8), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"utf8_general_ci", "utf8", int64(33), "Yes", "Yes", int64(1), "PAD SPACE"},
+			{"utf8mb4_general_ci", "utf8mb4", int64(45), "Yes", "Yes", int64(1), "PAD SPACE"},
+		},
+	},
+	{
+		`SHOW CHARACTER SET`,
+		[]sql.Row{
+			{"ascii", "US ASCII", "ascii_general_ci", int64(1)},
+			{"binary", "Binary pseudo charset", "binary", int64(1)},
+			{"latin1", "cp1252 West European", "latin1_swedish_ci", int64(1)},
+			{"utf8", "UTF-8 Unicode", "utf8_general_ci", int64(3)},
+			{"utf8mb4", "UTF-8 Unicode", "utf8mb4_general_ci", int64(4)},
+		},
+	},
+	{
+		`SHOW CHARSET LIKE 'utf8%'`,
+		[]sql.Row{
+			{"utf8", "UTF-8 Unicode", "utf8_general_ci", int64(3)},
+			{"utf8mb4", "UTF-8 Unicode", "utf8mb4_general_ci", int64(4)},
+		},
+	},
+	{
+		"SHOW CHARACTER SET WHERE Maxlen > 1",
+		[]sql.Row{
+			{"utf8", "UTF-8 Unicode", "utf8_general_ci", int64(3)},
+			{"utf8mb4", "UTF-8 Unicode", "utf8mb4_general_ci", int64(4)},
+		},
 	},
 	{
 		"ROLLBACK",
@@ -1312,14 +1369,14 @@ var queries = []struct {
 	},
 	{
 		`SELECT JSON_EXTRACT('[1, 2, 3]', '$.[0]')`,
-		[]sql.Row{{float64(1)}},
+		[]sql.Row{{sql.JSONDocument{Val: int64(1)}}},
 	},
 	{
 		`SELECT ARRAY_LENGTH(JSON_EXTRACT('[1, 2, 3]', '$'))`,
 		[]sql.Row{{int32(3)}},
 	},
 	{
-		`SELECT ARRAY_LENGTH(JSON_EXTRACT('[{"i":0}, {"i":1, "y":"yyy"}, {"i":2, "x":"xxx"}]', '$.i'))`,
+		`SELECT ARRAY_LENGTH(JSON_EXTRACT('[{"i":0}, {"i":1, "y":"yyy"}, {"i":2, "x":"xxx"}]', '$[*].i'))`,
 		[]sql.Row{{int32(3)}},
 	},
 	{
@@ -1602,6 +1659,52 @@ func TestSessionSelectLimit(t *testing.T) {
 	})
 }
 
+func TestMaxExecutionTime(t *testing.T) {
+	e := newEngine(t)
+
+	timeout := []string{
+		"SELECT /*+ MAX_EXECUTION_TIME(10) */ SLEEP(1)",
+		"SELECT /*+ MAX_EXECUTION_TIME(10) */ i FROM mytable WHERE SLEEP(1) = 0",
+	}
+
+	for _, q := range timeout {
+		t.Run(q, func(t *testing.T) {
+			require := require.New(t)
+
+			_, iter, err := e.Query(newCtx(), q)
+			if err == nil {
+				_, err = sql.RowIterToRows(iter)
+			}
+			require.Error(err)
+			require.True(sqle.ErrMaxExecutionTimeExceeded.Is(err))
+		})
+	}
+
+	t.Run("session variable", func(t *testing.T) {
+		require := require.New(t)
+
+		session := newCtx().Session
+		session.Set("max_execution_time", sql.Int64, int64(10))
+		newSessionCtx := func() *sql.Context {
+			return sql.NewContext(
+				context.Background(),
+				sql.WithPid(atomic.AddUint64(&pid, 1)),
+				sql.WithSession(session),
+			)
+		}
+
+		_, iter, err := e.Query(newSessionCtx(), "SELECT SLEEP(1)")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(iter)
+		require.Error(err)
+		require.True(sqle.ErrMaxExecutionTimeExceeded.Is(err))
+
+		// The hint takes precedence over the session variable.
+		testQueryWithContext(newSessionCtx(), t, e, "SELECT /*+ MAX_EXECUTION_TIME(0) */ SLEEP(0.05)", []sql.Row{{int(0)}})
+		testQueryWithContext(newSessionCtx(), t, e, "SELECT i FROM mytable ORDER BY i LIMIT 1", []sql.Row{{int64(1)}})
+	})
+}
+
 func TestSessionDefaults(t *testing.T) {
 	ctx := newCtx()
 	ctx.Session.Set("auto_increment_increment", sql.Int64, 0)
@@ -1896,7 +1999,7 @@ func TestInsertInto(t *testing.T) {
 			0, 0, 0, 0,
 			1.401298464324817070923729583289916131280e-45, 4.940656458412465441765687928682213723651e-324,
 			'0010-04-05 12:51:36', '0101-11-07',
-			'', false, '', ''
+			'', false, '""', ''
 			);`,
 			[]sql.Row{{int64(1)}},
 			"SELECT * FROM typestable WHERE id = 999;",
@@ -1905,7 +2008,7 @@ func TestInsertInto(t *testing.T) {
 				int64(0), int64(0), int64(0), int64(0),
 				float64(math.SmallestNonzeroFloat32), float64(math.SmallestNonzeroFloat64),
 				timeParse(sql.TimestampLayout, "0010-04-05 12:51:36"), timeParse(sql.DateLayout, "0101-11-07"),
-				"", false, ``, "",
+				"", false, `""`, "",
 			}},
 		},
 		{
@@ -1914,7 +2017,7 @@ func TestInsertInto(t *testing.T) {
 			u8 = 0, u16 = 0, u32 = 0, u64 = 0,
 			f32 = 1.401298464324817070923729583289916131280e-45, f64 = 4.940656458412465441765687928682213723651e-324,
 			ti = '0010-04-05 12:51:36', da = '0101-11-07',
-			te = '', bo = false, js = '', bl = ''
+			te = '', bo = false, js = '""', bl = ''
 			;`,
 			[]sql.Row{{int64(1)}},
 			"SELECT * FROM typestable WHERE id = 999;",
@@ -1923,7 +2026,7 @@ func TestInsertInto(t *testing.T) {
 				int64(0), int64(0), int64(0), int64(0),
 				float64(math.SmallestNonzeroFloat32), float64(math.SmallestNonzeroFloat64),
 				timeParse(sql.TimestampLayout, "0010-04-05 12:51:36"), timeParse(sql.DateLayout, "0101-11-07"),
-				"", false, ``, "",
+				"", false, `""`, "",
 			}},
 		},
 		{
@@ -2096,7 +2199,7 @@ func TestReplaceInto(t *testing.T) {
 			0, 0, 0, 0,
 			1.401298464324817070923729583289916131280e-45, 4.940656458412465441765687928682213723651e-324,
 			'0010-04-05 12:51:36', '0101-11-07',
-			'', false, '', ''
+			'', false, '""', ''
 			);`,
 			[]sql.Row{{int64(1)}},
 			"SELECT * FROM typestable WHERE id = 999;",
@@ -2105,7 +2208,7 @@ func TestReplaceInto(t *testing.T) {
 				int64(0), int64(0), int64(0), int64(0),
 				float64(math.SmallestNonzeroFloat32), float64(math.SmallestNonzeroFloat64),
 				timeParse(sql.TimestampLayout, "0010-04-05 12:51:36"), timeParse(sql.DateLayout, "0101-11-07"),
-				"", false, ``, "",
+				"", false, `""`, "",
 			}},
 		},
 		{
@@ -2114,7 +2217,7 @@ func TestReplaceInto(t *testing.T) {
 			u8 = 0, u16 = 0, u32 = 0, u64 = 0,
 			f32 = 1.401298464324817070923729583289916131280e-45, f64 = 4.940656458412465441765687928682213723651e-324,
 			ti = '0010-04-05 12:51:36', da = '0101-11-07',
-			te = '', bo = false, js = '', bl = ''
+			te = '', bo = false, js = '""', bl = ''
 			;`,
 			[]sql.Row{{int64(1)}},
 			"SELECT * FROM typestable WHERE id = 999;",
@@ -2123,7 +2226,7 @@ func TestReplaceInto(t *testing.T) {
 				int64(0), int64(0), int64(0), int64(0),
 				float64(math.SmallestNonzeroFloat32), float64(math.SmallestNonzeroFloat64),
 				timeParse(sql.TimestampLayout, "0010-04-05 12:51:36"), timeParse(sql.DateLayout, "0101-11-07"),
-				"", false, ``, "",
+				"", false, `""`, "",
 			}},
 		},
 		{
@@ -2292,12 +2395,12 @@ func TestDDL(t *testing.T) {
 		{Name: "b", Type: sql.Text, Nullable: true, Source: "t1"},
 		{Name: "c", Type: sql.Date, Nullable: true, Source: "t1"},
 		{Name: "d", Type: sql.Timestamp, Nullable: true, Source: "t1"},
-		{Name: "e", Type: sql.Text, Nullable: true, Source: "t1"},
+		{Name: "e", Type: sql.VarChar(20), Nullable: true, Source: "t1"},
 		{Name: "f", Type: sql.Blob, Source: "t1"},
 		{Name: "b1", Type: sql.Uint8, Nullable: true, Source: "t1"},
 		{Name: "b2", Type: sql.Uint8, Source: "t1"},
 		{Name: "g", Type: sql.Datetime, Nullable: true, Source: "t1"},
-		{Name: "h", Type: sql.Text, Nullable: true, Source: "t1"},
+		{Name: "h", Type: sql.Char(40), Nullable: true, Source: "t1"},
 	}
 
 	require.Equal(s, testTable.Schema())
@@ -3136,6 +3239,33 @@ func TestDeleteFrom(t *testing.T) {
 	}
 }
 
+func TestPolicies(t *testing.T) {
+	require := require.New(t)
+
+	e := newEngine(t)
+	testQuery(t, e, "CREATE POLICY tenant ON mytable USING (s = CONCAT(CURRENT_USER(), ' row'))", []sql.Row{})
+
+	session := sql.NewSession("address", "client", "second", 1)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(session))
+
+	testQueryWithContext(ctx, t, e, "SELECT i FROM mytable", []sql.Row{{int64(2)}})
+	testQueryWithContext(ctx, t, e, "SELECT i FROM mytable WHERE i > 1", []sql.Row{{int64(2)}})
+	testQueryWithContext(ctx, t, e, "SELECT t.i FROM mytable t", []sql.Row{{int64(2)}})
+	testQueryWithContext(ctx, t, e,
+		"SELECT i, i2 FROM mytable INNER JOIN othertable ON i = i2",
+		[]sql.Row{{int64(2), int64(2)}},
+	)
+	testQueryWithContext(ctx, t, e, "DELETE FROM mytable WHERE i > 1", []sql.Row{{int64(1)}})
+	testQuery(t, e, "SELECT i FROM mytable", []sql.Row{})
+
+	testQuery(t, e, "DROP POLICY tenant ON mytable", []sql.Row{})
+	testQuery(t, e, "SELECT i FROM mytable", []sql.Row{{int64(1)}, {int64(3)}})
+
+	_, _, err := e.Query(newCtx(), "CREATE POLICY p ON mytable USING (foo = 1)")
+	require.Error(err)
+	require.True(plan.ErrPolicyColumnNotFound.Is(err))
+}
+
 func TestDeleteFromErrors(t *testing.T) {
 	var expectedFailures = []struct {
 		name  string
@@ -3213,6 +3343,21 @@ var generatorQueries = []struct {
 			{int64(3), "e", "third"},
 		},
 	},
+	{
+		`SELECT a, UNNEST(b) AS x FROM t WHERE a > 1`,
+		[]sql.Row{
+			{int64(2), "c"},
+			{int64(2), "d"},
+			{int64(3), "e"},
+			{int64(3), "f"},
+		},
+	},
+	{
+		`SELECT UNNEST(SLICE(b, 2)) FROM t WHERE ARRAY_CONTAINS(b, 'c')`,
+		[]sql.Row{
+			{"d"},
+		},
+	},
 }
 
 func TestGenerators(t *testing.T) {
@@ -3241,6 +3386,1216 @@ func TestGenerators(t *testing.T) {
 	}
 }
 
+var decimalQueries = []struct {
+	query    string
+	expected [][]string
+}{
+	{
+		"SELECT SUM(price), AVG(price) FROM t",
+		[][]string{{"20.29", "6.763333"}},
+	},
+	{
+		"SELECT id, price * qty, price + 1, price / 3 FROM t ORDER BY id",
+		[][]string{
+			{"1", "0.30", "1.10", "0.033333"},
+			{"2", "0.20", "1.20", "0.066667"},
+			{"3", "39.98", "20.99", "6.663333"},
+		},
+	},
+	{
+		"SELECT ROUND(price, 1), ROUND(price), CEIL(price), FLOOR(price) FROM t WHERE id = 3",
+		[][]string{{"20.0", "20", "20", "19"}},
+	},
+	{
+		"SELECT price / 0, price + 0.5 FROM t WHERE id = 1",
+		[][]string{{"", "0.60"}},
+	},
+	{
+		"SELECT id FROM t WHERE price > 0 AND price <= 0.2 ORDER BY id",
+		[][]string{{"1"}, {"2"}},
+	},
+	{
+		"SELECT id FROM t WHERE price = '19.990' ORDER BY id",
+		[][]string{{"3"}},
+	},
+	{
+		"SELECT price * 1.05, price * 1.05e0 FROM t WHERE id = 3",
+		[][]string{{"20.9895", "20.9895"}},
+	},
+	{
+		"SELECT CAST(price AS DECIMAL), CAST(price AS DECIMAL(5,1)), CAST(price AS DECIMAL(3,2)) FROM t WHERE id = 3",
+		[][]string{{"20", "20.0", "9.99"}},
+	},
+	{
+		"SELECT CAST('1.255' AS DECIMAL(4,2)), CAST('foo' AS DECIMAL(4,2)), CAST(-1000 AS DECIMAL(3))",
+		[][]string{{"1.26", "0.00", "-999"}},
+	},
+	{
+		"SELECT CAST(10.5 AS DECIMAL(4,1)) % 3, -10.5 % 3, 7.25 % 2.5, price % 0, 10 % 3 FROM t WHERE id = 3",
+		[][]string{{"1.5", "-1.5", "2.25", "", "1"}},
+	},
+}
+
+func TestDecimals(t *testing.T) {
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "t"},
+		{Name: "price", Type: sql.Decimal(10, 2), Source: "t"},
+		{Name: "qty", Type: sql.Int64, Source: "t"},
+	}, testNumPartitions)
+
+	db := memory.NewDatabase("db")
+	db.AddTable("t", table)
+
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	_, iter, err := e.Query(newCtx(), "INSERT INTO t VALUES (1, 0.1, 3), (2, '0.2', 1), (3, 19.99, 2)")
+	require.NoError(t, err)
+	_, err = sql.RowIterToRows(iter)
+	require.NoError(t, err)
+
+	for _, q := range decimalQueries {
+		t.Run(q.query, func(t *testing.T) {
+			schema, iter, err := e.Query(newCtx(), q.query)
+			require.NoError(t, err)
+
+			rows, err := sql.RowIterToRows(iter)
+			require.NoError(t, err)
+			require.Equal(t, q.expected, sqlStrings(t, schema, rows))
+		})
+	}
+
+	t.Run("insert", func(t *testing.T) {
+		query := func(ctx *sql.Context, q string) ([][]string, error) {
+			schema, iter, err := e.Query(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+
+			rows, err := sql.RowIterToRows(iter)
+			if err != nil {
+				return nil, err
+			}
+			return sqlStrings(t, schema, rows), nil
+		}
+
+		_, err := query(newCtx(), "INSERT INTO t VALUES (4, 1.005, 1), (5, 3.333, 1), (6, 123456789012.5, 1), (7, 'foo', 1)")
+		require.NoError(t, err)
+
+		rows, err := query(newCtx(), "SELECT id, price FROM t WHERE id > 3 ORDER BY id")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"4", "1.01"}, {"5", "3.33"}, {"6", "99999999.99"}, {"7", "0.00"}}, rows)
+
+		rows, err = query(newCtx(), "SELECT price * 1000 FROM t WHERE id = 5")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"3330.00"}}, rows)
+
+		ctx := newCtx()
+		ctx.Set("sql_mode", sql.Text, "STRICT_ALL_TABLES")
+		_, err = query(ctx, "INSERT INTO t VALUES (8, 123456789012.5, 1)")
+		require.True(t, plan.ErrInsertIntoOutOfRange.Is(err))
+
+		_, err = query(ctx, "INSERT INTO t VALUES (8, 'foo', 1)")
+		require.True(t, plan.ErrInsertIntoIncorrectValue.Is(err))
+	})
+}
+
+// sqlStrings returns the values of the rows as they are sent to clients.
+func sqlStrings(t *testing.T, schema sql.Schema, rows []sql.Row) [][]string {
+	t.Helper()
+
+	var result [][]string
+	for _, row := range rows {
+		var values []string
+		for i, v := range row {
+			val, err := schema[i].Type.SQL(v)
+			require.NoError(t, err)
+			values = append(values, val.ToString())
+		}
+		result = append(result, values)
+	}
+	return result
+}
+
+var timeQueries = []struct {
+	query    string
+	expected [][]string
+}{
+	{
+		"SELECT id, tm, y, dt FROM t ORDER BY id",
+		[][]string{
+			{"1", "10:30:00", "2019", "2019-01-01 10:00:00.123456"},
+			{"2", "-01:00:00", "1970", "2019-01-02 00:00:00.000000"},
+		},
+	},
+	{
+		"SELECT tm + INTERVAL 1 HOUR, tm - INTERVAL '1.000005' SECOND_MICROSECOND FROM t ORDER BY id",
+		[][]string{
+			{"11:30:00", "10:29:58.999995"},
+			{"00:00:00", "-01:00:01.000005"},
+		},
+	},
+	{
+		"SELECT TIMEDIFF(dt, '2019-01-01 00:00:00'), TIMEDIFF(tm, '12:00:00') FROM t ORDER BY id",
+		[][]string{
+			{"10:00:00.123456", "-01:30:00"},
+			{"24:00:00.000000", "-13:00:00"},
+		},
+	},
+	{
+		"SELECT id FROM t WHERE tm > '10:00:00'",
+		[][]string{{"1"}},
+	},
+	{
+		"SELECT id FROM t WHERE y = 70",
+		[][]string{{"2"}},
+	},
+	{
+		"SELECT id, ts FROM t ORDER BY id",
+		[][]string{
+			{"1", "2019-01-01 10:00:00.124"},
+			{"2", "2019-01-02 00:00:01.000"},
+		},
+	},
+	{
+		"SELECT CAST(dt AS DATETIME(3)), CAST(dt AS DATETIME) FROM t WHERE id = 1",
+		[][]string{{"2019-01-01 10:00:00.123", "2019-01-01 10:00:00"}},
+	},
+	{
+		"SELECT id, dt3, tm1, y2 FROM t ORDER BY id",
+		[][]string{
+			{"1", "2019-01-01 10:00:00.123", "10:11:12.6", "2069"},
+			{"2", "2019-01-01 10:00:01.000", "10:11:12.0", "2005"},
+		},
+	},
+	{
+		"SELECT id FROM t ORDER BY dt3 DESC",
+		[][]string{{"2"}, {"1"}},
+	},
+	{
+		"SELECT MAX(dt3), MIN(tm1), MAX(ts) FROM t",
+		[][]string{{"2019-01-01 10:00:01.000", "10:11:12.0", "2019-01-02 00:00:01.000"}},
+	},
+	{
+		"SELECT id FROM t WHERE y2 = 2069",
+		[][]string{{"1"}},
+	},
+	{
+		"SELECT CAST('10:11:12.5' AS TIME), CAST('10:11:12.56' AS TIME(1)), CAST('foo' AS TIME)",
+		[][]string{{"10:11:13", "10:11:12.6", ""}},
+	},
+}
+
+func TestTimes(t *testing.T) {
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "t"},
+		{Name: "tm", Type: sql.Time, Source: "t"},
+		{Name: "y", Type: sql.Year, Source: "t"},
+		{Name: "dt", Type: sql.DatetimeWithPrecision(6), Source: "t"},
+		{Name: "ts", Type: sql.TimestampWithPrecision(3), Source: "t"},
+		{Name: "dt3", Type: sql.DatetimeWithPrecision(3), Source: "t", Nullable: true},
+		{Name: "tm1", Type: sql.TimeWithPrecision(1), Source: "t", Nullable: true},
+		{Name: "y2", Type: sql.Year, Source: "t", Nullable: true},
+	}, testNumPartitions)
+
+	db := memory.NewDatabase("db")
+	db.AddTable("t", table)
+
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	_, iter, err := e.Query(newCtx(), `INSERT INTO t VALUES
+		(1, '10:30:00', 2019, '2019-01-01 10:00:00.123456', '2019-01-01 10:00:00.1235',
+			'2019-01-01 10:00:00.123456', '10:11:12.56', 69),
+		(2, '-01:00:00', 70, '2019-01-02 00:00:00', '2019-01-02 00:00:00.9999',
+			'2019-01-01 10:00:00.9996', '10:11:12.04', '05')`)
+	require.NoError(t, err)
+	_, err = sql.RowIterToRows(iter)
+	require.NoError(t, err)
+
+	for _, q := range timeQueries {
+		t.Run(q.query, func(t *testing.T) {
+			schema, iter, err := e.Query(newCtx(), q.query)
+			require.NoError(t, err)
+
+			rows, err := sql.RowIterToRows(iter)
+			require.NoError(t, err)
+			require.Equal(t, q.expected, sqlStrings(t, schema, rows))
+		})
+	}
+
+	_, _, err = e.Query(newCtx(), "INSERT INTO t (id, tm, y, dt, ts, dt3) VALUES (3, '10:00:00', 2019, NOW(), NOW(), 'foo')")
+	require.True(t, plan.ErrInsertIntoIncorrectValue.Is(err))
+
+	_, _, err = e.Query(newCtx(), "INSERT INTO t (id, tm, y, dt, ts, y2) VALUES (3, '10:00:00', 2019, NOW(), NOW(), 1800)")
+	require.True(t, plan.ErrInsertIntoOutOfRange.Is(err))
+}
+
+var enumQueries = []struct {
+	query    string
+	expected [][]string
+}{
+	{
+		"SELECT id, size, opts FROM t ORDER BY size",
+		[][]string{
+			{"2", "small", ""},
+			{"3", "medium", "b"},
+			{"1", "large", "a,c"},
+		},
+	},
+	{
+		"SELECT id, size + 0, opts + 0 FROM t ORDER BY id",
+		[][]string{
+			{"1", "3", "5"},
+			{"2", "1", "0"},
+			{"3", "2", "2"},
+		},
+	},
+	{
+		"SELECT id FROM t WHERE size = 'medium'",
+		[][]string{{"3"}},
+	},
+	{
+		"SELECT id FROM t WHERE size > 1 AND opts = 'a,c'",
+		[][]string{{"1"}},
+	},
+	{
+		"SELECT id, CONCAT(size, '-', opts), UPPER(size) FROM t ORDER BY id",
+		[][]string{
+			{"1", "large-a,c", "LARGE"},
+			{"2", "small-", "SMALL"},
+			{"3", "medium-b", "MEDIUM"},
+		},
+	},
+	{
+		"SELECT id FROM t WHERE size LIKE 'l%' OR opts LIKE '%b%'",
+		[][]string{{"1"}, {"3"}},
+	},
+	{
+		`SELECT data_type, column_type FROM information_schema.columns
+		WHERE table_name = 't' AND column_name IN ('size', 'opts') ORDER BY column_name`,
+		[][]string{
+			{"SET", "set('a','b','c')"},
+			{"ENUM", "enum('small','medium','large')"},
+		},
+	},
+}
+
+func TestEnumsAndSets(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	catalog.AddDatabase(sql.NewInformationSchemaDatabase(catalog))
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	for _, q := range []string{
+		"CREATE TABLE t (id BIGINT, size ENUM('small', 'medium', 'large'), opts SET('a', 'b', 'c'))",
+		"INSERT INTO t VALUES (1, 'large', 'c,a'), (2, 'small', ''), (3, 'MEDIUM', 2)",
+	} {
+		_, iter, err := e.Query(newCtx(), q)
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(iter)
+		require.NoError(t, err)
+	}
+
+	for _, q := range enumQueries {
+		t.Run(q.query, func(t *testing.T) {
+			schema, iter, err := e.Query(newCtx(), q.query)
+			require.NoError(t, err)
+
+			rows, err := sql.RowIterToRows(iter)
+			require.NoError(t, err)
+			require.Equal(t, q.expected, sqlStrings(t, schema, rows))
+		})
+	}
+
+	t.Run("show create table", func(t *testing.T) {
+		_, iter, err := e.Query(newCtx(), "SHOW CREATE TABLE t")
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(t, err)
+		require.Contains(t, rows[0][1], "`size` enum('small','medium','large'),\n")
+		require.Contains(t, rows[0][1], "`opts` set('a','b','c')\n")
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		ctx := newCtx()
+		_, iter, err := e.Query(ctx, "INSERT INTO t VALUES (4, 'huge', 'a,d')")
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(iter)
+		require.NoError(t, err)
+
+		warnings := ctx.Session.Warnings()
+		require.Len(t, warnings, 2)
+		require.Equal(t, 1265, warnings[0].Code)
+		require.Equal(t, "Data truncated for column 'size' at row 1", warnings[1].Message)
+
+		schema, iter, err := e.Query(ctx, "SELECT size, opts FROM t WHERE id = 4")
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"", ""}}, sqlStrings(t, schema, rows))
+
+		_, iter, err = e.Query(ctx, "SET sql_mode = 'STRICT_TRANS_TABLES'")
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(iter)
+		require.NoError(t, err)
+
+		for _, q := range []string{
+			"INSERT INTO t VALUES (5, 'small', 'd')",
+			"INSERT INTO t VALUES (5, '', 'a')",
+		} {
+			_, iter, err = e.Query(ctx, q)
+			if err == nil {
+				_, err = sql.RowIterToRows(iter)
+			}
+			require.True(t, plan.ErrInsertIntoDataTruncated.Is(err), q)
+		}
+	})
+}
+
+func TestSizedStrings(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	catalog.AddDatabase(sql.NewInformationSchemaDatabase(catalog))
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	query := func(ctx *sql.Context, q string) ([][]string, error) {
+		schema, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := sql.RowIterToRows(iter)
+		if err != nil {
+			return nil, err
+		}
+		return sqlStrings(t, schema, rows), nil
+	}
+
+	ctx := newCtx()
+	for _, q := range []string{
+		"CREATE TABLE s (id BIGINT, a CHAR(3), b VARCHAR(5), c BINARY(2), d VARBINARY(3))",
+		"INSERT INTO s VALUES (1, 'ñañ', 'abc', 'x', 'xyz')",
+		"INSERT INTO s VALUES (2, 'abcd', 'abcdef', 'xyz', 'x')",
+	} {
+		_, err := query(ctx, q)
+		require.NoError(t, err)
+	}
+
+	warnings := ctx.Session.Warnings()
+	require.Len(t, warnings, 3)
+	for _, w := range warnings {
+		require.Equal(t, 1265, w.Code)
+	}
+
+	rows, err := query(ctx, "SELECT a, b, c, d FROM s ORDER BY id")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"ñañ", "abc", "x\x00", "xyz"},
+		{"abc", "abcde", "xy", "x"},
+	}, rows)
+
+	_, err = query(ctx, "SET sql_mode = 'STRICT_ALL_TABLES'")
+	require.NoError(t, err)
+
+	_, err = query(ctx, "INSERT INTO s VALUES (3, 'abc', 'abc', 'xy', 'wxyz')")
+	require.True(t, plan.ErrInsertIntoDataTruncated.Is(err))
+
+	rows, err = query(ctx, `SELECT column_name, column_type, character_maximum_length, character_octet_length
+		FROM information_schema.columns WHERE table_name = 's' AND column_name <> 'id' ORDER BY column_name`)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"a", "char(3)", "3", "12"},
+		{"b", "varchar(5)", "5", "20"},
+		{"c", "binary(2)", "2", "2"},
+		{"d", "varbinary(3)", "3", "3"},
+	}, rows)
+
+	rows, err = query(ctx, "SHOW CREATE TABLE s")
+	require.NoError(t, err)
+	require.Equal(t,
+		"CREATE TABLE `s` (\n"+
+			"  `id` bigint,\n"+
+			"  `a` char(3),\n"+
+			"  `b` varchar(5),\n"+
+			"  `c` binary(2),\n"+
+			"  `d` varbinary(3)\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		rows[0][1],
+	)
+}
+
+func TestCollations(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	catalog.AddDatabase(sql.NewInformationSchemaDatabase(catalog))
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	query := func(q string) ([][]string, error) {
+		schema, iter, err := e.Query(newCtx(), q)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := sql.RowIterToRows(iter)
+		if err != nil {
+			return nil, err
+		}
+		return sqlStrings(t, schema, rows), nil
+	}
+
+	for _, q := range []string{
+		`CREATE TABLE c (
+			id BIGINT,
+			ci VARCHAR(10),
+			cs VARCHAR(10) COLLATE utf8mb4_bin,
+			l TEXT CHARACTER SET latin1
+		)`,
+		"CREATE TABLE d (a VARCHAR(5), b TEXT CHARACTER SET utf8mb4) DEFAULT CHARSET=latin1 COLLATE=latin1_bin",
+		"INSERT INTO c VALUES (1, 'abc', 'abc', 'Été'), (2, 'ABC', 'ABC', 'ete'), (3, 'b', 'b', 'x'), (4, 'abc ', 'abc ', 'y')",
+	} {
+		_, err := query(q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{
+			"SELECT id FROM c WHERE ci = 'ABC' ORDER BY id",
+			[][]string{{"1"}, {"2"}, {"4"}},
+		},
+		{
+			"SELECT id FROM c WHERE cs = 'ABC' ORDER BY id",
+			[][]string{{"2"}},
+		},
+		{
+			"SELECT id FROM c WHERE ci COLLATE utf8mb4_bin = 'ABC' ORDER BY id",
+			[][]string{{"2"}},
+		},
+		{
+			"SELECT id FROM c WHERE cs = 'ABC' COLLATE utf8mb4_general_ci ORDER BY id",
+			[][]string{{"1"}, {"2"}, {"4"}},
+		},
+		{
+			"SELECT id FROM c WHERE l = 'ETE' ORDER BY id",
+			[][]string{{"1"}, {"2"}},
+		},
+		{
+			"SELECT id FROM c WHERE ci LIKE 'a%' ORDER BY id",
+			[][]string{{"1"}, {"2"}, {"4"}},
+		},
+		{
+			"SELECT id FROM c WHERE cs LIKE 'a%' ORDER BY id",
+			[][]string{{"1"}, {"4"}},
+		},
+		{
+			"SELECT id FROM c ORDER BY cs, id",
+			[][]string{{"2"}, {"1"}, {"4"}, {"3"}},
+		},
+		{
+			"SELECT id FROM c ORDER BY ci DESC, id",
+			[][]string{{"3"}, {"1"}, {"2"}, {"4"}},
+		},
+		{
+			"SELECT COUNT(*) FROM c GROUP BY ci ORDER BY 1",
+			[][]string{{"1"}, {"3"}},
+		},
+		{
+			"SELECT COUNT(*) FROM c GROUP BY cs ORDER BY 1",
+			[][]string{{"1"}, {"1"}, {"2"}},
+		},
+		{
+			"SELECT COUNT(DISTINCT ci), COUNT(DISTINCT cs) FROM c",
+			[][]string{{"2", "3"}},
+		},
+		{
+			"SELECT DISTINCT ci FROM c ORDER BY ci",
+			[][]string{{"abc"}, {"b"}},
+		},
+		{
+			"SELECT 'a' = 'A', 'a' COLLATE utf8mb4_bin = 'A', 'a ' = 'a', 'a ' COLLATE utf8mb4_0900_bin = 'a'",
+			[][]string{{"1", "0", "1", "0"}},
+		},
+		{
+			"SHOW FULL COLUMNS FROM c",
+			[][]string{
+				{"id", "INT64", "", "YES", "", "", "", "", ""},
+				{"ci", "VARCHAR(10)", "utf8mb4_general_ci", "YES", "", "", "", "", ""},
+				{"cs", "VARCHAR(10)", "utf8mb4_bin", "YES", "", "", "", "", ""},
+				{"l", "TEXT", "latin1_swedish_ci", "YES", "", "", "", "", ""},
+			},
+		},
+		{
+			`SELECT table_name, column_name, character_set_name, collation_name, character_octet_length
+			FROM information_schema.columns WHERE table_schema = 'db' AND column_name <> 'id'
+			ORDER BY table_name, column_name`,
+			[][]string{
+				{"c", "ci", "utf8mb4", "utf8mb4_general_ci", "40"},
+				{"c", "cs", "utf8mb4", "utf8mb4_bin", "40"},
+				{"c", "l", "latin1", "latin1_swedish_ci", ""},
+				{"d", "a", "latin1", "latin1_bin", "5"},
+				{"d", "b", "utf8mb4", "utf8mb4_general_ci", ""},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			rows, err := query(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+
+	rows, err := query("SHOW CREATE TABLE c")
+	require.NoError(t, err)
+	require.Equal(t,
+		"CREATE TABLE `c` (\n"+
+			"  `id` bigint,\n"+
+			"  `ci` varchar(10),\n"+
+			"  `cs` varchar(10) COLLATE utf8mb4_bin,\n"+
+			"  `l` text CHARACTER SET latin1\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		rows[0][1],
+	)
+
+	rows, err = query("SHOW CREATE TABLE d")
+	require.NoError(t, err)
+	require.Equal(t,
+		"CREATE TABLE `d` (\n"+
+			"  `a` varchar(5),\n"+
+			"  `b` text CHARACTER SET utf8mb4\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_bin",
+		rows[0][1],
+	)
+
+	rows, err = query(`SELECT table_name, table_collation FROM information_schema.tables
+		WHERE table_schema = 'db' ORDER BY table_name`)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"c", "utf8mb4_general_ci"}, {"d", "latin1_bin"}}, rows)
+
+	_, err = query("SELECT * FROM c WHERE cs = l")
+	require.True(t, sql.ErrIllegalCollationMix.Is(err))
+
+	_, err = query("SELECT * FROM c WHERE ci COLLATE latin1_bin = 'a'")
+	require.True(t, sql.ErrCollationCharacterSetMismatch.Is(err))
+
+	_, err = query("CREATE TABLE e (a TEXT COLLATE foo)")
+	require.True(t, sql.ErrUnknownCollation.Is(err))
+
+	_, err = query("CREATE TABLE e (a TEXT CHARACTER SET latin1 COLLATE utf8mb4_bin)")
+	require.True(t, sql.ErrCollationCharacterSetMismatch.Is(err))
+}
+
+func TestZerofill(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	catalog.AddDatabase(sql.NewInformationSchemaDatabase(catalog))
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	query := func(q string) ([][]string, error) {
+		schema, iter, err := e.Query(newCtx(), q)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := sql.RowIterToRows(iter)
+		if err != nil {
+			return nil, err
+		}
+		return sqlStrings(t, schema, rows), nil
+	}
+
+	for _, q := range []string{
+		"CREATE TABLE z (a INT(5) ZEROFILL, b TINYINT ZEROFILL, c INT)",
+		"INSERT INTO z VALUES (3, 7, 3), (123456, 255, 123456)",
+	} {
+		_, err := query(q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{
+			"SELECT a, b, c FROM z ORDER BY a",
+			[][]string{{"00003", "007", "3"}, {"123456", "255", "123456"}},
+		},
+		{
+			"SELECT column_name, column_type FROM information_schema.columns WHERE table_name = 'z' ORDER BY ordinal_position",
+			[][]string{
+				{"a", "int(5) unsigned zerofill"},
+				{"b", "tinyint(3) unsigned zerofill"},
+				{"c", "int"},
+			},
+		},
+		{
+			"SHOW CREATE TABLE z",
+			[][]string{{"z", "CREATE TABLE `z` (\n" +
+				"  `a` int(5) unsigned zerofill,\n" +
+				"  `b` tinyint(3) unsigned zerofill,\n" +
+				"  `c` int\n" +
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			rows, err := query(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestColumnDefaults(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	catalog.AddDatabase(sql.NewInformationSchemaDatabase(catalog))
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	session := sql.NewSession("address", "client", "user", 1)
+	query := func(q string) ([][]string, error) {
+		ctx := sql.NewContext(
+			context.Background(),
+			sql.WithPid(atomic.AddUint64(&pid, 1)),
+			sql.WithSession(session),
+		)
+
+		schema, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := sql.RowIterToRows(iter)
+		if err != nil {
+			return nil, err
+		}
+		return sqlStrings(t, schema, rows), nil
+	}
+
+	for _, q := range []string{
+		`CREATE TABLE t (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			name VARCHAR(10) NOT NULL DEFAULT 'none',
+			n INT DEFAULT -1,
+			m INT DEFAULT (n * 2),
+			created DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		"CREATE TABLE u (id INT AUTO_INCREMENT, v TEXT)",
+		"INSERT INTO t (name) VALUES ('a')",
+		"INSERT INTO t (name, n) VALUES ('b', 5), ('c', DEFAULT)",
+		"INSERT INTO t VALUES (10, DEFAULT, 1, DEFAULT, DEFAULT)",
+		"INSERT INTO t (id, n) VALUES (NULL, 2), (0, 3)",
+	} {
+		_, err := query(q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{
+			"SELECT id, name, n, m FROM t ORDER BY id",
+			[][]string{
+				{"1", "a", "-1", "-2"},
+				{"2", "b", "5", "10"},
+				{"3", "c", "-1", "-2"},
+				{"10", "none", "1", "2"},
+				{"11", "none", "2", "4"},
+				{"12", "none", "3", "6"},
+			},
+		},
+		{
+			"SELECT COUNT(*) FROM t WHERE created IS NOT NULL AND created <= NOW()",
+			[][]string{{"6"}},
+		},
+		{
+			"SELECT LAST_INSERT_ID()",
+			[][]string{{"11"}},
+		},
+		{
+			"SHOW COLUMNS FROM t",
+			[][]string{
+				{"id", "INT64", "NO", "", "", "auto_increment"},
+				{"name", "VARCHAR(10)", "NO", "", "none", ""},
+				{"n", "INT32", "YES", "", "-1", ""},
+				{"m", "INT32", "YES", "", "n * 2", "DEFAULT_GENERATED"},
+				{"created", "DATETIME", "YES", "", "NOW()", "DEFAULT_GENERATED"},
+			},
+		},
+		{
+			`SELECT column_name, column_default, extra FROM information_schema.columns
+			WHERE table_name = 't' ORDER BY ordinal_position`,
+			[][]string{
+				{"id", "", "auto_increment"},
+				{"name", "none", ""},
+				{"n", "-1", ""},
+				{"m", "n * 2", "DEFAULT_GENERATED"},
+				{"created", "NOW()", "DEFAULT_GENERATED"},
+			},
+		},
+		{
+			"INSERT INTO u (v) VALUES ('x'), ('y')",
+			[][]string{{"2"}},
+		},
+		{
+			"SELECT LAST_INSERT_ID()",
+			[][]string{{"1"}},
+		},
+		{
+			"INSERT INTO u VALUES (5, 'z')",
+			[][]string{{"1"}},
+		},
+		{
+			"SELECT LAST_INSERT_ID(), LAST_INSERT_ID(42)",
+			[][]string{{"1", "42"}},
+		},
+		{
+			"SELECT LAST_INSERT_ID()",
+			[][]string{{"42"}},
+		},
+		{
+			"INSERT INTO u (v) VALUES ('w')",
+			[][]string{{"1"}},
+		},
+		{
+			"SELECT id, v FROM u ORDER BY id",
+			[][]string{{"1", "x"}, {"2", "y"}, {"5", "z"}, {"6", "w"}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			rows, err := query(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+
+	rows, err := query("SHOW CREATE TABLE t")
+	require.NoError(t, err)
+	require.Equal(t,
+		"CREATE TABLE `t` (\n"+
+			"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+			"  `name` varchar(10) NOT NULL DEFAULT 'none',\n"+
+			"  `n` int DEFAULT '-1',\n"+
+			"  `m` int DEFAULT (n * 2),\n"+
+			"  `created` datetime DEFAULT CURRENT_TIMESTAMP\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		rows[0][1],
+	)
+
+	// The statement creates the same table again.
+	_, err = query(strings.Replace(rows[0][1], "`t`", "`t2`", 1))
+	require.NoError(t, err)
+	rows2, err := query("SHOW CREATE TABLE t2")
+	require.NoError(t, err)
+	require.Equal(t, strings.Replace(rows[0][1], "`t`", "`t2`", 1), rows2[0][1])
+
+	_, err = query("INSERT INTO t (n) VALUES (1), (NULL)")
+	require.NoError(t, err)
+
+	_, err = query("CREATE TABLE e (a TEXT NOT NULL DEFAULT NULL)")
+	require.True(t, parse.ErrInvalidDefaultValue.Is(err))
+
+	_, err = query("CREATE TABLE e (a INT DEFAULT 'abc')")
+	require.True(t, parse.ErrInvalidDefaultValue.Is(err))
+
+	_, err = query("CREATE TABLE e (a TEXT AUTO_INCREMENT)")
+	require.True(t, parse.ErrInvalidAutoIncrement.Is(err))
+
+	_, err = query("CREATE TABLE e (a INT AUTO_INCREMENT, b INT AUTO_INCREMENT)")
+	require.True(t, parse.ErrMultipleAutoIncrement.Is(err))
+
+	_, err = query("CREATE TABLE e (a INT DEFAULT (b + 1))")
+	require.True(t, parse.ErrUnknownColumn.Is(err))
+}
+
+func TestGeneratedColumns(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	catalog.AddDatabase(sql.NewInformationSchemaDatabase(catalog))
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	query := func(q string) ([][]string, error) {
+		schema, iter, err := e.Query(newCtx(), q)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := sql.RowIterToRows(iter)
+		if err != nil {
+			return nil, err
+		}
+		return sqlStrings(t, schema, rows), nil
+	}
+
+	for _, q := range []string{
+		`CREATE TABLE g (
+			a INT,
+			b INT,
+			total INT AS (a + b),
+			name VARCHAR(20) GENERATED ALWAYS AS (CONCAT('n', a)) STORED,
+			doubled INT AS (total * 2) VIRTUAL
+		)`,
+		"INSERT INTO g (a, b) VALUES (1, 2), (3, 4)",
+		"INSERT INTO g VALUES (5, 6, DEFAULT, DEFAULT, DEFAULT)",
+		"INSERT INTO g (a) VALUES (7)",
+	} {
+		_, err := query(q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{
+			"SELECT a, total, name, doubled FROM g ORDER BY a",
+			[][]string{
+				{"1", "3", "n1", "6"},
+				{"3", "7", "n3", "14"},
+				{"5", "11", "n5", "22"},
+				{"7", "", "n7", ""},
+			},
+		},
+		{
+			"SELECT a FROM g WHERE doubled > 10 ORDER BY a",
+			[][]string{{"3"}, {"5"}},
+		},
+		{
+			"SELECT doubled FROM g WHERE a = 1",
+			[][]string{{"6"}},
+		},
+		{
+			"DELETE FROM g WHERE doubled = 14",
+			[][]string{{"1"}},
+		},
+		{
+			"SELECT a FROM g ORDER BY a",
+			[][]string{{"1"}, {"5"}, {"7"}},
+		},
+		{
+			"SHOW COLUMNS FROM g",
+			[][]string{
+				{"a", "INT32", "YES", "", "", ""},
+				{"b", "INT32", "YES", "", "", ""},
+				{"total", "INT32", "YES", "", "", "VIRTUAL GENERATED"},
+				{"name", "VARCHAR(20)", "YES", "", "", "STORED GENERATED"},
+				{"doubled", "INT32", "YES", "", "", "VIRTUAL GENERATED"},
+			},
+		},
+		{
+			`SELECT column_name, generation_expression FROM information_schema.columns
+			WHERE table_name = 'g' ORDER BY ordinal_position`,
+			[][]string{
+				{"a", ""},
+				{"b", ""},
+				{"total", "a + b"},
+				{"name", "concat(\"n\", a)"},
+				{"doubled", "total * 2"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			rows, err := query(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+
+	_, err := query("INSERT INTO g VALUES (1, 2, 3, DEFAULT, DEFAULT)")
+	require.True(t, plan.ErrInsertIntoGeneratedColumn.Is(err))
+
+	_, err = query("CREATE TABLE e (a INT AS (b), b INT AS (1))")
+	require.True(t, parse.ErrInvalidColumnReference.Is(err))
+
+	_, err = query("CREATE TABLE e (a INT AS (a + 1))")
+	require.True(t, parse.ErrInvalidColumnReference.Is(err))
+
+	_, err = query("CREATE TABLE e (a INT AS (c))")
+	require.True(t, parse.ErrUnknownColumn.Is(err))
+}
+
+func TestJSON(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	query := func(q string) ([][]string, error) {
+		schema, iter, err := e.Query(newCtx(), q)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := sql.RowIterToRows(iter)
+		if err != nil {
+			return nil, err
+		}
+		return sqlStrings(t, schema, rows), nil
+	}
+
+	for _, q := range []string{
+		"CREATE TABLE j (id INT, name TEXT, doc JSON)",
+		`INSERT INTO j VALUES
+			(1, 'a', '{"n": 1, "tags": ["x", "y"], "o": {"k": "v"}}'),
+			(2, 'b', '{"n": 2.5, "tags": [], "o": {"k": "w"}}'),
+			(3, 'c', '[1, 2, 3]'),
+			(4, 'd', NULL)`,
+	} {
+		_, err := query(q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{
+			"SELECT id, doc FROM j ORDER BY id",
+			[][]string{
+				{"1", `{"n": 1, "o": {"k": "v"}, "tags": ["x", "y"]}`},
+				{"2", `{"n": 2.5, "o": {"k": "w"}, "tags": []}`},
+				{"3", `[1, 2, 3]`},
+				{"4", ""},
+			},
+		},
+		{
+			"SELECT id, doc->'$.o.k', doc->>'$.o.k' FROM j ORDER BY id",
+			[][]string{
+				{"1", `"v"`, "v"},
+				{"2", `"w"`, "w"},
+				{"3", "", ""},
+				{"4", "", ""},
+			},
+		},
+		{
+			"SELECT id FROM j WHERE doc->'$.n' = 1",
+			[][]string{{"1"}},
+		},
+		{
+			"SELECT id FROM j WHERE doc->'$.n' > 2 ORDER BY id",
+			[][]string{{"2"}},
+		},
+		{
+			"SELECT id FROM j WHERE doc->>'$.o.k' = 'w'",
+			[][]string{{"2"}},
+		},
+		{
+			"SELECT id FROM j WHERE JSON_CONTAINS(doc, '\"x\"', '$.tags')",
+			[][]string{{"1"}},
+		},
+		{
+			"SELECT id, JSON_TYPE(doc), JSON_LENGTH(doc), JSON_KEYS(doc) FROM j ORDER BY id",
+			[][]string{
+				{"1", "OBJECT", "3", `["n", "o", "tags"]`},
+				{"2", "OBJECT", "3", `["n", "o", "tags"]`},
+				{"3", "ARRAY", "3", ""},
+				{"4", "", "", ""},
+			},
+		},
+		{
+			"SELECT JSON_EXTRACT(doc, '$[last]', '$[0 to 1]') FROM j WHERE id = 3",
+			[][]string{{"[3, 1, 2]"}},
+		},
+		{
+			"SELECT JSON_EXTRACT(doc, '$**.k') FROM j WHERE id = 1",
+			[][]string{{`["v"]`}},
+		},
+		{
+			"SELECT JSON_SET(doc, '$.n', 10, '$.new', JSON_ARRAY(1, 'a')) FROM j WHERE id = 1",
+			[][]string{{`{"n": 10, "o": {"k": "v"}, "new": [1, "a"], "tags": ["x", "y"]}`}},
+		},
+		{
+			"SELECT JSON_INSERT(doc, '$[1]', 5, '$[5]', 6), JSON_REPLACE(doc, '$[1]', 5, '$[5]', 6), JSON_REMOVE(doc, '$[0]') FROM j WHERE id = 3",
+			[][]string{{"[1, 2, 3, 6]", "[1, 5, 3]", "[2, 3]"}},
+		},
+		{
+			"SELECT JSON_OBJECT('id', id, 'name', name) FROM j WHERE id < 3 ORDER BY id",
+			[][]string{{`{"id": 1, "name": "a"}`}, {`{"id": 2, "name": "b"}`}},
+		},
+		{
+			"SELECT JSON_CONTAINS_PATH(doc, 'one', '$.x', '$.o'), JSON_MERGE_PATCH(doc, '{\"o\": null}') FROM j WHERE id = 2",
+			[][]string{{"1", `{"n": 2.5, "tags": []}`}},
+		},
+		{
+			"SELECT JSON_VALID(doc), JSON_VALID('{'), JSON_VALID(name) FROM j WHERE id = 1",
+			[][]string{{"1", "0", "0"}},
+		},
+		{
+			"SELECT JSON_ARRAYAGG(id), JSON_OBJECTAGG(name, doc->'$.n') FROM j",
+			[][]string{{"[1, 2, 3, 4]", `{"a": 1, "b": 2.5, "c": null, "d": null}`}},
+		},
+		{
+			"SELECT CAST('[1, 2]' AS JSON) = JSON_ARRAY(1, 2), JSON_EXTRACT('[1.0]', '$[0]') = 1, JSON_ARRAY('1') = JSON_ARRAY(1)",
+			[][]string{{"1", "1", "0"}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			rows, err := query(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+
+	_, err := query("SELECT JSON_EXTRACT(doc, '$.') FROM j")
+	require.True(t, jsonpath.ErrInvalidPath.Is(err))
+
+	_, err = query("SELECT JSON_OBJECT(NULL, 1)")
+	require.True(t, sql.ErrJSONNullKey.Is(err))
+
+	_, err = query(`INSERT INTO j VALUES (5, 'e', '{bad')`)
+	require.True(t, plan.ErrInsertIntoInvalidJSON.Is(err))
+	require.Contains(t, err.Error(), "for column 'doc' at row 1")
+
+	rows, err := query("SELECT COUNT(*) FROM j WHERE id = 5")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"0"}}, rows)
+
+	_, err = query(`INSERT INTO j VALUES (5, 'e', '"a string"')`)
+	require.NoError(t, err)
+
+	rows, err = query("SELECT JSON_TYPE(doc), doc->>'$' FROM j WHERE id = 5")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"STRING", "a string"}}, rows)
+}
+
+func TestJSONTable(t *testing.T) {
+	db := memory.NewDatabase("db")
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	e := sqle.New(catalog, analyzer.NewDefault(catalog), new(sqle.Config))
+
+	query := func(q string) ([][]string, error) {
+		schema, iter, err := e.Query(newCtx(), q)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := sql.RowIterToRows(iter)
+		if err != nil {
+			return nil, err
+		}
+		return sqlStrings(t, schema, rows), nil
+	}
+
+	for _, q := range []string{
+		"CREATE TABLE orders (id INT, doc JSON)",
+		`INSERT INTO orders VALUES
+			(1, '{"items": [{"sku": "a", "qty": 2, "tags": ["x", "y"]}, {"sku": "b", "qty": "bad"}]}'),
+			(2, '{"items": [{"sku": "c"}]}'),
+			(3, '{"items": []}')`,
+	} {
+		_, err := query(q)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected [][]string
+	}{
+		{
+			`SELECT * FROM JSON_TABLE('[1, "2", {"a": 3}]', '$[*]' COLUMNS (
+				n FOR ORDINALITY,
+				v INT PATH '$',
+				j JSON PATH '$',
+				a INT EXISTS PATH '$.a'
+			)) AS t`,
+			[][]string{
+				{"1", "1", "1", "0"},
+				{"2", "2", `"2"`, "0"},
+				{"3", "", `{"a": 3}`, "1"},
+			},
+		},
+		{
+			`SELECT o.id, t.* FROM orders o, JSON_TABLE(o.doc, '$.items[*]' COLUMNS (
+				n FOR ORDINALITY,
+				sku VARCHAR(10) PATH '$.sku',
+				qty INT PATH '$.qty' DEFAULT '0' ON EMPTY DEFAULT '-1' ON ERROR
+			)) AS t ORDER BY o.id, t.n`,
+			[][]string{
+				{"1", "1", "a", "2"},
+				{"1", "2", "b", "-1"},
+				{"2", "1", "c", "0"},
+			},
+		},
+		{
+			`SELECT id, sku, tn, tag FROM orders, JSON_TABLE(doc, '$.items[*]' COLUMNS (
+				sku TEXT PATH '$.sku',
+				NESTED PATH '$.tags[*]' COLUMNS (tn FOR ORDINALITY, tag TEXT PATH '$')
+			)) t ORDER BY id, sku, tn`,
+			[][]string{
+				{"1", "a", "1", "x"},
+				{"1", "a", "2", "y"},
+				{"1", "b", "", ""},
+				{"2", "c", "", ""},
+			},
+		},
+		{
+			`SELECT id, sku FROM orders JOIN JSON_TABLE(orders.doc, '$.items[*]' COLUMNS (
+				sku TEXT PATH '$.sku'
+			)) t ON t.sku <> 'a' ORDER BY id`,
+			[][]string{{"1", "b"}, {"2", "c"}},
+		},
+		{
+			`SELECT sku FROM orders, JSON_TABLE(doc, '$.items[*]' COLUMNS (
+				sku TEXT PATH '$.sku'
+			)) t WHERE id = 1 AND sku > 'a'`,
+			[][]string{{"b"}},
+		},
+		{
+			`SELECT SUM(v) FROM (SELECT * FROM JSON_TABLE('[1, 2, 3]', '$[*]' COLUMNS (
+				v INT PATH '$'
+			)) AS t) AS s`,
+			[][]string{{"6"}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			rows, err := query(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+
+	_, err := query(`SELECT * FROM orders, JSON_TABLE(doc, '$.items[*]' COLUMNS (
+		qty INT PATH '$.qty' ERROR ON ERROR
+	)) t`)
+	require.True(t, plan.ErrJSONTableInvalidValue.Is(err))
+
+	_, err = query(`SELECT * FROM orders, JSON_TABLE(doc, '$.items[*]' COLUMNS (
+		qty INT PATH '$.qty' ERROR ON EMPTY
+	)) t WHERE id = 2`)
+	require.True(t, plan.ErrJSONTableMissingValue.Is(err))
+
+	_, err = query(`SELECT * FROM JSON_TABLE('[]', '$[*]' COLUMNS (v INT PATH '$'))`)
+	require.True(t, parse.ErrJSONTableAlias.Is(err))
+
+	_, err = query(`SELECT * FROM orders LEFT JOIN JSON_TABLE(doc, '$.items[*]' COLUMNS (
+		sku TEXT PATH '$.sku'
+	)) t ON 1 = 1`)
+	require.True(t, parse.ErrUnsupportedFeature.Is(err))
+}
+
 func insertRows(t *testing.T, table sql.Inserter, rows ...sql.Row) {
 	t.Helper()
 
@@ -12,11 +12,11 @@ require (
 	github.com/hashicorp/golang-lru v0.5.3
 	github.com/mitchellh/hashstructure v1.0.0
 	github.com/mushiyu/vitess v1.0.0
-	github.com/oliveagle/jsonpath v0.0.0-20180606110733-2e52cf6e6852
 	github.com/opentracing/opentracing-go v1.0.2
 	github.com/pilosa/pilosa v1.3.0
 	github.com/sanity-io/litter v1.1.0
 	github.com/shirou/w32 v0.0.0-20160930032740-bb4de0191aa4 // indirect
+	github.com/shopspring/decimal v1.2.0
 	github.com/sirupsen/logrus v1.3.0
 	github.com/spf13/cast v1.3.0
 	github.com/src-d/go-oniguruma v1.0.0
@@ -28,3 +28,7 @@ require (
 )
 
 go 1.13
+
+// The vitess packages are a copy of the fork's without the code that sent
+// queries and their results to an external service.
+replace github.com/mushiyu/vitess => ./third_party/vitess
@@ -0,0 +1,544 @@
+// Package jsonpath implements the MySQL JSON path language, which is used to
+// find and modify values in JSON documents.
+//
+// A path starts with the document itself, $, followed by any number of legs:
+// object members (.key, ."quoted key" or .* for all of them), array elements
+// ([n], [last], [last-n], [m to n] or [*] for all of them) and ** for all
+// the values nested at any depth. Documents are the values decoded by
+// sql.ParseJSON.
+package jsonpath
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrInvalidPath is returned when a path is not a valid JSON path.
+	ErrInvalidPath = errors.NewKind("invalid JSON path expression %q: error around character %d")
+
+	// ErrWildcardPath is returned when a path that may match more than one
+	// value is used where only one value is allowed.
+	ErrWildcardPath = errors.NewKind("in this situation, path expressions may not contain the * and ** tokens or an array range: %s")
+
+	// ErrRootPath is returned when the root of the document is used where
+	// only a value inside the document is allowed.
+	ErrRootPath = errors.NewKind("the path expression '$' is not allowed in this context")
+)
+
+type legKind byte
+
+const (
+	memberLeg legKind = iota
+	memberWildcardLeg
+	elementLeg
+	elementWildcardLeg
+	rangeLeg
+	descendantsLeg
+)
+
+// index is the position of an element in an array, which is counted from
+// its end if fromEnd is set.
+type index struct {
+	n       int
+	fromEnd bool
+}
+
+// in returns the position of the index in an array of the given length,
+// which may be out of its bounds.
+func (i index) in(length int) int {
+	if i.fromEnd {
+		return length - 1 - i.n
+	}
+	return i.n
+}
+
+type leg struct {
+	kind     legKind
+	key      string
+	from, to index
+}
+
+// Path is a parsed JSON path.
+type Path struct {
+	text string
+	legs []leg
+}
+
+// Parse parses a JSON path.
+func Parse(s string) (*Path, error) {
+	p := &parser{s: s}
+	legs, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &Path{s, legs}, nil
+}
+
+// String returns the text of the path.
+func (p *Path) String() string { return p.text }
+
+// IsRoot reports whether the path is the whole document.
+func (p *Path) IsRoot() bool { return len(p.legs) == 0 }
+
+// HasWildcard reports whether the path may match more than one value, which
+// is when it has wildcards, ranges or **.
+func (p *Path) HasWildcard() bool {
+	for _, l := range p.legs {
+		if l.kind != memberLeg && l.kind != elementLeg {
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns all the values matched by the path in the document. An
+// element of an array of any value but an array is the value itself if its
+// position is 0 or last, as if the value was wrapped in an array.
+func (p *Path) Find(doc interface{}) []interface{} {
+	var result []interface{}
+	find(doc, p.legs, func(v interface{}) {
+		result = append(result, v)
+	})
+	return result
+}
+
+func find(v interface{}, legs []leg, fn func(interface{})) {
+	if len(legs) == 0 {
+		fn(v)
+		return
+	}
+
+	l, rest := legs[0], legs[1:]
+	switch l.kind {
+	case memberLeg:
+		if o, ok := v.(map[string]interface{}); ok {
+			if e, ok := o[l.key]; ok {
+				find(e, rest, fn)
+			}
+		}
+	case memberWildcardLeg:
+		if o, ok := v.(map[string]interface{}); ok {
+			for _, k := range sql.JSONObjectKeys(o) {
+				find(o[k], rest, fn)
+			}
+		}
+	case elementLeg:
+		a := wrap(v)
+		if i := l.from.in(len(a)); i >= 0 && i < len(a) {
+			find(a[i], rest, fn)
+		}
+	case elementWildcardLeg:
+		if a, ok := v.([]interface{}); ok {
+			for _, e := range a {
+				find(e, rest, fn)
+			}
+		}
+	case rangeLeg:
+		a := wrap(v)
+		from, to := l.from.in(len(a)), l.to.in(len(a))
+		if from < 0 {
+			from = 0
+		}
+		for i := from; i <= to && i < len(a); i++ {
+			find(a[i], rest, fn)
+		}
+	case descendantsLeg:
+		find(v, rest, fn)
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for _, k := range sql.JSONObjectKeys(v) {
+				find(v[k], legs, fn)
+			}
+		case []interface{}:
+			for _, e := range v {
+				find(e, legs, fn)
+			}
+		}
+	}
+}
+
+func wrap(v interface{}) []interface{} {
+	if a, ok := v.([]interface{}); ok {
+		return a
+	}
+	return []interface{}{v}
+}
+
+// Mode tells which values are changed when a value is set.
+type Mode byte
+
+const (
+	// Insert only adds values that do not exist.
+	Insert Mode = 1 << iota
+	// Replace only changes values that exist.
+	Replace
+	// Set adds values that do not exist and changes those that do.
+	Set = Insert | Replace
+)
+
+// Set sets the value matched by the path in the document and returns the
+// new document, leaving the given one untouched. A member is added to an
+// object if it doesn't exist, and an element past the end of an array is
+// appended to it; a value that is not an array is wrapped in one before
+// appending an element to it. Nothing is changed if the parent of the value
+// doesn't exist. The path can't have wildcards.
+func (p *Path) Set(doc, value interface{}, mode Mode) (interface{}, error) {
+	if p.HasWildcard() {
+		return nil, ErrWildcardPath.New(p.text)
+	}
+	return set(doc, p.legs, value, mode), nil
+}
+
+func set(v interface{}, legs []leg, value interface{}, mode Mode) interface{} {
+	if len(legs) == 0 {
+		if mode&Replace != 0 {
+			return value
+		}
+		return v
+	}
+
+	l, rest := legs[0], legs[1:]
+	switch l.kind {
+	case memberLeg:
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return v
+		}
+
+		e, ok := o[l.key]
+		switch {
+		case ok:
+			return withMember(o, l.key, set(e, rest, value, mode))
+		case len(rest) == 0 && mode&Insert != 0:
+			return withMember(o, l.key, value)
+		default:
+			return v
+		}
+	case elementLeg:
+		a, isArray := v.([]interface{})
+		if !isArray {
+			a = []interface{}{v}
+		}
+
+		i := l.from.in(len(a))
+		switch {
+		case i >= 0 && i < len(a):
+			e := set(a[i], rest, value, mode)
+			if !isArray {
+				return e
+			}
+			return withElement(a, i, e)
+		case i >= len(a) && len(rest) == 0 && mode&Insert != 0:
+			result := make([]interface{}, len(a), len(a)+1)
+			copy(result, a)
+			return append(result, value)
+		default:
+			return v
+		}
+	default:
+		return v
+	}
+}
+
+// Remove removes the value matched by the path from the document and
+// returns the new document, leaving the given one untouched. The path can't
+// be the whole document nor have wildcards.
+func (p *Path) Remove(doc interface{}) (interface{}, error) {
+	if p.IsRoot() {
+		return nil, ErrRootPath.New()
+	}
+
+	if p.HasWildcard() {
+		return nil, ErrWildcardPath.New(p.text)
+	}
+
+	return remove(doc, p.legs), nil
+}
+
+func remove(v interface{}, legs []leg) interface{} {
+	l, rest := legs[0], legs[1:]
+	switch l.kind {
+	case memberLeg:
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return v
+		}
+
+		e, ok := o[l.key]
+		if !ok {
+			return v
+		}
+
+		if len(rest) > 0 {
+			return withMember(o, l.key, remove(e, rest))
+		}
+
+		result := make(map[string]interface{}, len(o))
+		for k, e := range o {
+			if k != l.key {
+				result[k] = e
+			}
+		}
+		return result
+	case elementLeg:
+		a, ok := v.([]interface{})
+		if !ok {
+			if len(rest) > 0 && l.from.in(1) == 0 {
+				return remove(v, rest)
+			}
+			return v
+		}
+
+		i := l.from.in(len(a))
+		if i < 0 || i >= len(a) {
+			return v
+		}
+
+		if len(rest) > 0 {
+			return withElement(a, i, remove(a[i], rest))
+		}
+
+		result := make([]interface{}, 0, len(a)-1)
+		result = append(result, a[:i]...)
+		return append(result, a[i+1:]...)
+	default:
+		return v
+	}
+}
+
+func withMember(o map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(o)+1)
+	for k, e := range o {
+		result[k] = e
+	}
+	result[key] = value
+	return result
+}
+
+func withElement(a []interface{}, i int, value interface{}) []interface{} {
+	result := make([]interface{}, len(a))
+	copy(result, a)
+	result[i] = value
+	return result
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) parse() ([]leg, error) {
+	p.skipSpaces()
+	if !p.consume("$") {
+		return nil, p.invalid()
+	}
+
+	var legs []leg
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.s) {
+			break
+		}
+
+		var l leg
+		var err error
+		switch {
+		case p.consume("**"):
+			l.kind = descendantsLeg
+		case p.consume("."):
+			p.skipSpaces()
+			// A dot before an array element is allowed, as in $.[0].
+			if p.peek() == '[' {
+				continue
+			}
+			l, err = p.member()
+		case p.consume("["):
+			l, err = p.element()
+		default:
+			err = p.invalid()
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		legs = append(legs, l)
+	}
+
+	// A path can't end with ** nor have two of them in a row.
+	for i, l := range legs {
+		if l.kind == descendantsLeg && (i == len(legs)-1 || legs[i+1].kind == descendantsLeg) {
+			return nil, ErrInvalidPath.New(p.s, len(p.s))
+		}
+	}
+
+	return legs, nil
+}
+
+func (p *parser) member() (leg, error) {
+	if p.consume("*") {
+		return leg{kind: memberWildcardLeg}, nil
+	}
+
+	if p.peek() == '"' {
+		start := p.pos
+		for p.pos++; p.pos < len(p.s) && p.s[p.pos] != '"'; p.pos++ {
+			if p.s[p.pos] == '\\' {
+				p.pos++
+			}
+		}
+
+		if p.pos >= len(p.s) {
+			return leg{}, p.invalid()
+		}
+		p.pos++
+
+		var key string
+		if err := json.Unmarshal([]byte(p.s[start:p.pos]), &key); err != nil {
+			return leg{}, ErrInvalidPath.New(p.s, start)
+		}
+		return leg{kind: memberLeg, key: key}, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) {
+		r, size := utf8.DecodeRuneInString(p.s[p.pos:])
+		if !isIdentifierRune(r, p.pos == start) {
+			break
+		}
+		p.pos += size
+	}
+
+	if p.pos == start {
+		return leg{}, p.invalid()
+	}
+
+	return leg{kind: memberLeg, key: p.s[start:p.pos]}, nil
+}
+
+func isIdentifierRune(r rune, first bool) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || (!first && unicode.IsDigit(r))
+}
+
+func (p *parser) element() (leg, error) {
+	p.skipSpaces()
+	if p.consume("*") {
+		p.skipSpaces()
+		if !p.consume("]") {
+			return leg{}, p.invalid()
+		}
+		return leg{kind: elementWildcardLeg}, nil
+	}
+
+	from, err := p.index()
+	if err != nil {
+		return leg{}, err
+	}
+
+	l := leg{kind: elementLeg, from: from}
+
+	p.skipSpaces()
+	if p.consumeWord("to") {
+		p.skipSpaces()
+		to, err := p.index()
+		if err != nil {
+			return leg{}, err
+		}
+
+		if from.fromEnd == to.fromEnd &&
+			(!from.fromEnd && from.n > to.n || from.fromEnd && from.n < to.n) {
+			return leg{}, p.invalid()
+		}
+
+		l = leg{kind: rangeLeg, from: from, to: to}
+		p.skipSpaces()
+	}
+
+	if !p.consume("]") {
+		return leg{}, p.invalid()
+	}
+
+	return l, nil
+}
+
+func (p *parser) index() (index, error) {
+	if p.consumeWord("last") {
+		p.skipSpaces()
+		if !p.consume("-") {
+			return index{fromEnd: true}, nil
+		}
+
+		p.skipSpaces()
+		n, err := p.number()
+		if err != nil {
+			return index{}, err
+		}
+		return index{n: n, fromEnd: true}, nil
+	}
+
+	n, err := p.number()
+	if err != nil {
+		return index{}, err
+	}
+	return index{n: n}, nil
+}
+
+func (p *parser) number() (int, error) {
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+
+	n, err := strconv.Atoi(p.s[start:p.pos])
+	if err != nil {
+		return 0, ErrInvalidPath.New(p.s, start)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() byte {
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+func (p *parser) consume(s string) bool {
+	if strings.HasPrefix(p.s[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+// consumeWord consumes the given word if it's not followed by the rest of
+// an identifier.
+func (p *parser) consumeWord(w string) bool {
+	if !strings.HasPrefix(p.s[p.pos:], w) {
+		return false
+	}
+
+	if r, _ := utf8.DecodeRuneInString(p.s[p.pos+len(w):]); isIdentifierRune(r, false) {
+		return false
+	}
+
+	p.pos += len(w)
+	return true
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) invalid() error {
+	return ErrInvalidPath.New(p.s, p.pos)
+}
@@ -0,0 +1,200 @@
+package jsonpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+func mustParseJSON(s string) interface{} {
+	v, err := sql.ParseJSON(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestFind(t *testing.T) {
+	doc := mustParseJSON(`{
+		"a": [1, 2, [3, 4], {"b": 5}],
+		"b": {"c": "x", "d": {"b": 6}},
+		"e f": true,
+		"g": 7
+	}`)
+
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{`$`, `[{"a": [1, 2, [3, 4], {"b": 5}], "b": {"c": "x", "d": {"b": 6}}, "g": 7, "e f": true}]`},
+		{`$.g`, `[7]`},
+		{`$ . g`, `[7]`},
+		{`$.b.c`, `["x"]`},
+		{`$."e f"`, `[true]`},
+		{`$.nope`, `[]`},
+		{`$.g.nope`, `[]`},
+		{`$.a[0]`, `[1]`},
+		{`$.a.[1]`, `[2]`},
+		{`$.a[2][1]`, `[4]`},
+		{`$.a[last]`, `[{"b": 5}]`},
+		{`$.a[last - 1][0]`, `[3]`},
+		{`$.a[10]`, `[]`},
+		{`$.g[0]`, `[7]`},
+		{`$.g[last]`, `[7]`},
+		{`$.g[1]`, `[]`},
+		{`$.a[*]`, `[1, 2, [3, 4], {"b": 5}]`},
+		{`$.g[*]`, `[]`},
+		{`$.a[1 to 2]`, `[2, [3, 4]]`},
+		{`$.a[2 to last]`, `[[3, 4], {"b": 5}]`},
+		{`$.a[last-1 to last]`, `[[3, 4], {"b": 5}]`},
+		{`$.a[1 to 10]`, `[2, [3, 4], {"b": 5}]`},
+		{`$.b.*`, `["x", {"b": 6}]`},
+		{`$.*.c`, `["x"]`},
+		{`$**.b`, `[{"c": "x", "d": {"b": 6}}, 5, 6]`},
+		{`$**[1]`, `[2, 4]`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, sql.JSONDocument{Val: append([]interface{}{}, p.Find(doc)...)}.String())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, path := range []string{
+		``,
+		`a`,
+		`$.`,
+		`$.1a`,
+		`$a`,
+		`$[`,
+		`$[a]`,
+		`$[-1]`,
+		`$[2 to 1]`,
+		`$[last to last-1]`,
+		`$."a`,
+		`$**`,
+		`$***.a`,
+		`$.a[0] b`,
+	} {
+		t.Run(path, func(t *testing.T) {
+			_, err := Parse(path)
+			require.Error(t, err)
+			require.True(t, ErrInvalidPath.Is(err))
+		})
+	}
+}
+
+func TestHasWildcard(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected bool
+	}{
+		{`$`, false},
+		{`$.a[0][last-1]."b"`, false},
+		{`$.*`, true},
+		{`$[*]`, true},
+		{`$[0 to 1]`, true},
+		{`$**.a`, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, p.HasWildcard())
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	doc := `{"a": 1, "b": [2, 3], "c": {"d": 4}}`
+
+	testCases := []struct {
+		path     string
+		mode     Mode
+		value    interface{}
+		expected string
+	}{
+		{`$.a`, Set, int64(10), `{"a": 10, "b": [2, 3], "c": {"d": 4}}`},
+		{`$.a`, Insert, int64(10), `{"a": 1, "b": [2, 3], "c": {"d": 4}}`},
+		{`$.a`, Replace, int64(10), `{"a": 10, "b": [2, 3], "c": {"d": 4}}`},
+		{`$.e`, Set, "x", `{"a": 1, "b": [2, 3], "c": {"d": 4}, "e": "x"}`},
+		{`$.e`, Insert, "x", `{"a": 1, "b": [2, 3], "c": {"d": 4}, "e": "x"}`},
+		{`$.e`, Replace, "x", `{"a": 1, "b": [2, 3], "c": {"d": 4}}`},
+		{`$.e.f`, Set, "x", `{"a": 1, "b": [2, 3], "c": {"d": 4}}`},
+		{`$.c.e`, Set, true, `{"a": 1, "b": [2, 3], "c": {"d": 4, "e": true}}`},
+		{`$.b[0]`, Set, nil, `{"a": 1, "b": [null, 3], "c": {"d": 4}}`},
+		{`$.b[last]`, Replace, int64(5), `{"a": 1, "b": [2, 5], "c": {"d": 4}}`},
+		{`$.b[5]`, Set, int64(5), `{"a": 1, "b": [2, 3, 5], "c": {"d": 4}}`},
+		{`$.b[5]`, Replace, int64(5), `{"a": 1, "b": [2, 3], "c": {"d": 4}}`},
+		{`$.a[0]`, Replace, int64(5), `{"a": 5, "b": [2, 3], "c": {"d": 4}}`},
+		{`$.a[1]`, Insert, int64(5), `{"a": [1, 5], "b": [2, 3], "c": {"d": 4}}`},
+		{`$.c[0].d`, Set, int64(5), `{"a": 1, "b": [2, 3], "c": {"d": 5}}`},
+		{`$`, Set, int64(5), `5`},
+		{`$`, Insert, int64(5), doc},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			require.NoError(t, err)
+
+			original := mustParseJSON(doc)
+			result, err := p.Set(original, tt.value, tt.mode)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, sql.JSONDocument{Val: result}.String())
+			require.Equal(t, doc, sql.JSONDocument{Val: original}.String())
+		})
+	}
+
+	p, err := Parse(`$[*]`)
+	require.NoError(t, err)
+	_, err = p.Set(mustParseJSON(doc), nil, Set)
+	require.True(t, ErrWildcardPath.Is(err))
+}
+
+func TestRemove(t *testing.T) {
+	doc := `{"a": 1, "b": [2, 3, [4, 5]], "c": {"d": 4}}`
+
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{`$.a`, `{"b": [2, 3, [4, 5]], "c": {"d": 4}}`},
+		{`$.e`, doc},
+		{`$.c.d`, `{"a": 1, "b": [2, 3, [4, 5]], "c": {}}`},
+		{`$.b[0]`, `{"a": 1, "b": [3, [4, 5]], "c": {"d": 4}}`},
+		{`$.b[last][0]`, `{"a": 1, "b": [2, 3, [5]], "c": {"d": 4}}`},
+		{`$.b[5]`, doc},
+		{`$.a[0]`, doc},
+		{`$.c[0].d`, `{"a": 1, "b": [2, 3, [4, 5]], "c": {}}`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			require.NoError(t, err)
+
+			original := mustParseJSON(doc)
+			result, err := p.Remove(original)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, sql.JSONDocument{Val: result}.String())
+			require.Equal(t, doc, sql.JSONDocument{Val: original}.String())
+		})
+	}
+
+	p, err := Parse(`$`)
+	require.NoError(t, err)
+	_, err = p.Remove(mustParseJSON(doc))
+	require.True(t, ErrRootPath.Is(err))
+
+	p, err = Parse(`$**.a`)
+	require.NoError(t, err)
+	_, err = p.Remove(mustParseJSON(doc))
+	require.True(t, ErrWildcardPath.Is(err))
+}
@@ -35,11 +35,19 @@ func (d *Database) AddTable(name string, t sql.Table) {
 
 // Create creates a table with the given name and schema
 func (d *Database) Create(name string, schema sql.Schema) error {
+	return d.CreateWithCollation(name, schema, sql.DefaultCollation)
+}
+
+// CreateWithCollation creates a table with the given name, schema and
+// default collation.
+func (d *Database) CreateWithCollation(name string, schema sql.Schema, collation *sql.Collation) error {
 	_, ok := d.tables[name]
 	if ok {
 		return sql.ErrTableAlreadyExists.New(name)
 	}
 
-	d.tables[name] = NewTable(name, schema)
+	table := NewTable(name, schema)
+	table.collation = collation
+	d.tables[name] = table
 	return nil
 }
@@ -32,3 +32,22 @@ func TestDatabase_AddTable(t *testing.T) {
 	err = altDb.Create("test_table", nil)
 	require.Error(err)
 }
+
+func TestDatabase_CreateWithCollation(t *testing.T) {
+	require := require.New(t)
+	db := NewDatabase("test")
+
+	collation, err := sql.LookupCollation("latin1_bin")
+	require.NoError(err)
+
+	var altDb sql.CollatedAlterable = db
+	require.NoError(altDb.CreateWithCollation("a", nil, collation))
+	require.NoError(altDb.Create("b", nil))
+
+	tables := db.Tables()
+	require.Equal(collation, sql.TableCollation(tables["a"]))
+	require.Equal(sql.DefaultCollation, sql.TableCollation(tables["b"]))
+
+	err = altDb.CreateWithCollation("a", nil, collation)
+	require.True(sql.ErrTableAlreadyExists.Is(err))
+}
@@ -20,6 +20,13 @@ type Table struct {
 	keys       [][]byte
 
 	insert int
+	// autoIncrement is the last value of the AUTO_INCREMENT column.
+	autoIncrement int64
+	// virtual are the expressions of the virtual generated columns of the
+	// table by their position in the schema.
+	virtual map[int]sql.Expression
+	// collation is the default collation of the table.
+	collation *sql.Collation
 
 	filters    []sql.Expression
 	projection []string
@@ -32,6 +39,8 @@ var _ sql.Inserter = (*Table)(nil)
 var _ sql.FilteredTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.IndexableTable = (*Table)(nil)
+var _ sql.AutoIncrementer = (*Table)(nil)
+var _ sql.CollatedTable = (*Table)(nil)
 
 // NewTable creates a new Table with the given name and schema.
 func NewTable(name string, schema sql.Schema) *Table {
@@ -53,11 +62,19 @@ func NewPartitionedTable(name string, schema sql.Schema, numPartitions int) *Tab
 		partitions[key] = []sql.Row{}
 	}
 
+	virtual := make(map[int]sql.Expression)
+	for i, col := range schema {
+		if col.Generated != nil && col.Virtual {
+			virtual[i] = col.Generated
+		}
+	}
+
 	return &Table{
 		name:       name,
 		schema:     schema,
 		partitions: partitions,
 		keys:       keys,
+		virtual:    virtual,
 	}
 }
 
@@ -71,6 +88,14 @@ func (t *Table) Schema() sql.Schema {
 	return t.schema
 }
 
+// Collation implements the sql.CollatedTable interface.
+func (t *Table) Collation() *sql.Collation {
+	if t.collation == nil {
+		return sql.DefaultCollation
+	}
+	return t.collation
+}
+
 // Partitions implements the sql.Table interface.
 func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 	var keys [][]byte
@@ -106,7 +131,9 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 	}
 
 	return &tableIter{
+		ctx:         ctx,
 		rows:        rows,
+		virtual:     t.virtual,
 		columns:     t.columns,
 		filters:     t.filters,
 		indexValues: values,
@@ -137,6 +164,8 @@ func (p *partitionIter) Next() (sql.Partition, error) {
 func (p *partitionIter) Close() error { return nil }
 
 type tableIter struct {
+	ctx     *sql.Context
+	virtual map[int]sql.Expression
 	columns []int
 	filters []sql.Expression
 
@@ -153,13 +182,18 @@ func (i *tableIter) Next() (sql.Row, error) {
 		return nil, err
 	}
 
+	row, err = computeVirtual(i.ctx, i.virtual, row)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, f := range i.filters {
-		result, err := f.Eval(sql.NewEmptyContext(), row)
+		ok, err := sql.EvaluateCondition(sql.NewEmptyContext(), f, row)
 		if err != nil {
 			return nil, err
 		}
 
-		if result != true {
+		if !ok {
 			return i.Next()
 		}
 	}
@@ -189,6 +223,31 @@ func (i *tableIter) getRow() (sql.Row, error) {
 	return row, nil
 }
 
+// computeVirtual returns a copy of the row with the values of the virtual
+// generated columns, which are not stored.
+func computeVirtual(ctx *sql.Context, virtual map[int]sql.Expression, row sql.Row) (sql.Row, error) {
+	if len(virtual) == 0 {
+		return row, nil
+	}
+
+	row = row.Copy()
+	for i := range row {
+		e, ok := virtual[i]
+		if !ok {
+			continue
+		}
+
+		v, err := e.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+
+		row[i] = v
+	}
+
+	return row, nil
+}
+
 func projectOnRow(columns []int, row sql.Row) sql.Row {
 	if len(columns) < 1 {
 		return row
@@ -243,8 +302,37 @@ func encodeIndexValue(value *indexValue) ([]byte, error) {
 
 // Insert a new row into the table.
 func (t *Table) Insert(ctx *sql.Context, row sql.Row) error {
+	_, _, err := t.insertRow(row)
+	return err
+}
+
+// InsertLocated implements the sql.LocatedInserter interface.
+func (t *Table) InsertLocated(ctx *sql.Context, row sql.Row) (sql.RowLocationChanges, error) {
+	key, pos, err := t.insertRow(row)
+	if err != nil {
+		return sql.RowLocationChanges{}, err
+	}
+
+	location, err := t.rowLocation(key, pos)
+	if err != nil {
+		return sql.RowLocationChanges{}, err
+	}
+
+	return sql.RowLocationChanges{Added: []sql.RowLocation{location}}, nil
+}
+
+// insertRow adds the row to the table and returns the key of the partition
+// and the position where it was inserted.
+func (t *Table) insertRow(row sql.Row) (string, int, error) {
 	if err := checkRow(t.schema, row); err != nil {
-		return err
+		return "", 0, err
+	}
+
+	if len(t.virtual) > 0 {
+		row = row.Copy()
+		for i := range t.virtual {
+			row[i] = nil
+		}
 	}
 
 	key := string(t.keys[t.insert])
@@ -254,40 +342,124 @@ func (t *Table) Insert(ctx *sql.Context, row sql.Row) error {
 	}
 
 	t.partitions[key] = append(t.partitions[key], row)
+	return key, len(t.partitions[key]) - 1, nil
+}
+
+// NextAutoIncrement implements the sql.AutoIncrementer interface.
+func (t *Table) NextAutoIncrement(ctx *sql.Context) (int64, error) {
+	t.autoIncrement++
+	return t.autoIncrement, nil
+}
+
+// UpdateAutoIncrement implements the sql.AutoIncrementer interface.
+func (t *Table) UpdateAutoIncrement(ctx *sql.Context, v int64) error {
+	if v > t.autoIncrement {
+		t.autoIncrement = v
+	}
 	return nil
 }
 
 // Delete the given row from the table.
 func (t *Table) Delete(ctx *sql.Context, row sql.Row) error {
+	_, _, err := t.deleteRow(row)
+	return err
+}
+
+// DeleteLocated implements the sql.LocatedDeleter interface. Since the rows
+// after the deleted one are moved back in its partition, all of them change
+// their location.
+func (t *Table) DeleteLocated(ctx *sql.Context, row sql.Row) (sql.RowLocationChanges, error) {
+	key, pos, err := t.deleteRow(row)
+	if err != nil {
+		return sql.RowLocationChanges{}, err
+	}
+
+	var changes sql.RowLocationChanges
+	location, err := t.rowLocation(key, pos)
+	if err != nil {
+		return sql.RowLocationChanges{}, err
+	}
+	location.Row = row
+	changes.Removed = append(changes.Removed, location)
+
+	for i := pos; i < len(t.partitions[key]); i++ {
+		removed, err := t.rowLocation(key, i+1)
+		if err != nil {
+			return sql.RowLocationChanges{}, err
+		}
+		removed.Row, err = computeVirtual(ctx, t.virtual, t.partitions[key][i])
+		if err != nil {
+			return sql.RowLocationChanges{}, err
+		}
+
+		added, err := t.rowLocation(key, i)
+		if err != nil {
+			return sql.RowLocationChanges{}, err
+		}
+
+		changes.Removed = append(changes.Removed, removed)
+		changes.Added = append(changes.Added, added)
+	}
+
+	return changes, nil
+}
+
+// deleteRow removes the row from the table and returns the key of the partition
+// and the position where it was.
+func (t *Table) deleteRow(row sql.Row) (string, int, error) {
 	if err := checkRow(t.schema, row); err != nil {
-		return err
+		return "", 0, err
 	}
 
-	matches := false
-	for partitionIndex, partition := range t.partitions {
-		for partitionRowIndex, partitionRow := range partition {
-			matches = true
+	for key, partition := range t.partitions {
+		for pos, partitionRow := range partition {
+			matches := true
 			for rIndex, val := range row {
+				if _, ok := t.virtual[rIndex]; ok {
+					continue
+				}
+
 				if val != partitionRow[rIndex] {
 					matches = false
 					break
 				}
 			}
+
 			if matches {
-				t.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
-				break
+				// The rows are copied, so the iterators of the partition
+				// still see the rows it had when they were created.
+				rows := make([]sql.Row, 0, len(partition)-1)
+				rows = append(rows, partition[:pos]...)
+				t.partitions[key] = append(rows, partition[pos+1:]...)
+				return key, pos, nil
 			}
 		}
-		if matches {
-			break
-		}
 	}
 
-	if !matches {
-		return sql.ErrDeleteRowNotFound
+	return "", 0, sql.ErrDeleteRowNotFound
+}
+
+// rowLocation returns the location of the row in the given position of the
+// partition with the given key.
+func (t *Table) rowLocation(key string, pos int) (sql.RowLocation, error) {
+	location, err := encodeIndexValue(&indexValue{Key: key, Pos: pos})
+	if err != nil {
+		return sql.RowLocation{}, err
 	}
 
-	return nil
+	var row sql.Row
+	if pos < len(t.partitions[key]) {
+		row, err = computeVirtual(sql.NewEmptyContext(), t.virtual, t.partitions[key][pos])
+		if err != nil {
+			return sql.RowLocation{}, err
+		}
+	}
+
+	return sql.RowLocation{
+		Partition: &partition{key: []byte(key)},
+		Location:  location,
+		Row:       row,
+	}, nil
 }
 
 func checkRow(schema sql.Schema, row sql.Row) error {
@@ -221,6 +221,38 @@ func TestTable(t *testing.T) {
 	}
 }
 
+func TestTableDeleteWhileIterating(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := NewTable("t", sql.Schema{{Name: "i", Type: sql.Int64, Source: "t"}})
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i)))
+	}
+
+	p, err := table.Partitions(ctx)
+	require.NoError(err)
+	partition, err := p.Next()
+	require.NoError(err)
+	iter, err := table.PartitionRows(ctx, partition)
+	require.NoError(err)
+
+	var rows []sql.Row
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		require.NoError(table.Delete(ctx, row))
+		rows = append(rows, row)
+	}
+	require.NoError(iter.Close())
+
+	require.Equal([]sql.Row{{int64(1)}, {int64(2)}, {int64(3)}}, rows)
+	require.Len(table.partitions[string(partition.Key())], 0)
+}
+
 func TestFiltered(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -401,3 +433,120 @@ func TestTableIndexKeyValueIter(t *testing.T) {
 		})
 	}
 }
+
+func TestTableLocated(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := NewPartitionedTable("test", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "test"},
+	}, 1)
+
+	location := func(l sql.RowLocation) *indexValue {
+		v, err := decodeIndexValue(l.Location)
+		require.NoError(err)
+		return v
+	}
+
+	for i := int64(0); i < 3; i++ {
+		changes, err := table.InsertLocated(ctx, sql.NewRow(i))
+		require.NoError(err)
+		require.Len(changes.Removed, 0)
+		require.Len(changes.Added, 1)
+		require.Equal(sql.NewRow(i), changes.Added[0].Row)
+		require.Equal(int(i), location(changes.Added[0]).Pos)
+	}
+
+	changes, err := table.DeleteLocated(ctx, sql.NewRow(int64(1)))
+	require.NoError(err)
+	require.Len(changes.Removed, 2)
+	require.Len(changes.Added, 1)
+
+	require.Equal(sql.NewRow(int64(1)), changes.Removed[0].Row)
+	require.Equal(1, location(changes.Removed[0]).Pos)
+	require.Equal(sql.NewRow(int64(2)), changes.Removed[1].Row)
+	require.Equal(2, location(changes.Removed[1]).Pos)
+	require.Equal(sql.NewRow(int64(2)), changes.Added[0].Row)
+	require.Equal(1, location(changes.Added[0]).Pos)
+
+	_, err = table.DeleteLocated(ctx, sql.NewRow(int64(1)))
+	require.Equal(sql.ErrDeleteRowNotFound, err)
+}
+
+func TestTableVirtualColumns(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := NewPartitionedTable("test", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "test"},
+		{
+			Name:   "b",
+			Type:   sql.Int64,
+			Source: "test",
+			Generated: expression.NewMult(
+				expression.NewGetField(0, sql.Int64, "a", false),
+				expression.NewLiteral(int64(2), sql.Int64),
+			),
+			Virtual:  true,
+			Nullable: true,
+		},
+	}, 2)
+
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i, i*2)))
+	}
+
+	for _, rows := range table.partitions {
+		for _, row := range rows {
+			require.Nil(row[1])
+		}
+	}
+
+	rows := testFlatRows(t, table)
+	require.ElementsMatch([]sql.Row{
+		sql.NewRow(int64(1), int64(2)),
+		sql.NewRow(int64(2), int64(4)),
+		sql.NewRow(int64(3), int64(6)),
+	}, rows)
+
+	filtered := table.WithFilters([]sql.Expression{
+		expression.NewGreaterThan(
+			expression.NewGetFieldWithTable(1, sql.Int64, "test", "b", true),
+			expression.NewLiteral(int64(3), sql.Int64),
+		),
+	})
+	require.ElementsMatch([]sql.Row{
+		sql.NewRow(int64(2), int64(4)),
+		sql.NewRow(int64(3), int64(6)),
+	}, testFlatRows(t, filtered))
+
+	require.NoError(table.Delete(ctx, sql.NewRow(int64(2), int64(4))))
+	require.ElementsMatch([]sql.Row{
+		sql.NewRow(int64(1), int64(2)),
+		sql.NewRow(int64(3), int64(6)),
+	}, testFlatRows(t, table))
+}
+
+func TestTableAutoIncrement(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := NewTable("test", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "test", AutoIncrement: true},
+	})
+
+	next := func() int64 {
+		n, err := table.NextAutoIncrement(ctx)
+		require.NoError(err)
+		return n
+	}
+
+	require.Equal(int64(1), next())
+	require.Equal(int64(2), next())
+
+	require.NoError(table.UpdateAutoIncrement(ctx, 10))
+	require.Equal(int64(11), next())
+
+	require.NoError(table.UpdateAutoIncrement(ctx, 5))
+	require.Equal(int64(12), next())
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/mushiyu/go-mysql-server/auth"
 	"github.com/mushiyu/go-mysql-server/internal/sockstate"
 	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/parse"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/sirupsen/logrus"
@@ -32,6 +34,21 @@ var ErrRowTimeout = errors.NewKind("row read wait bigger than connection timeout
 // ErrConnectionWasClosed will be returned if we try to use a previously closed connection
 var ErrConnectionWasClosed = errors.NewKind("connection was closed")
 
+// ErrMultiStatementsDisabled will be returned if a query with several statements is received
+// from a client without the CLIENT_MULTI_STATEMENTS capability
+var ErrMultiStatementsDisabled = errors.NewKind("multiple statements are not enabled for this connection")
+
+// ErrStatementFailed will be returned if one of the statements of a multi-statement query fails
+var ErrStatementFailed = errors.NewKind("statement %d of %d failed (%s): %s")
+
+// ErrResultSetNotLast will be returned if a statement of a multi-statement query that is not the
+// last one returns rows, because only a result set can be sent to the client for each query
+var ErrResultSetNotLast = errors.NewKind("only the last statement of a query can return rows")
+
+// erQueryTimeout is the MySQL error code returned when a query is interrupted
+// because it exceeded its maximum execution time.
+const erQueryTimeout = 3024
+
 // TODO parametrize
 const rowsBatch = 100
 const tcpCheckerSleepTime = 1
@@ -49,16 +66,41 @@ type Handler struct {
 	c           map[uint32]conntainer
 	readTimeout time.Duration
 	lc          []*net.Conn
+	conns       *connLimiter
+	queries     *queryPool
+
+	// inflight is the number of queries being executed, closing is set once
+	// the handler starts shutting down and drained is closed when, after
+	// that, there are no queries left being executed.
+	inflight int
+	closing  bool
+	drained  chan struct{}
 }
 
 // NewHandler creates a new Handler given a SQLe engine.
 func NewHandler(e *sqle.Engine, sm *SessionManager, rt time.Duration) *Handler {
-	return &Handler{
+	h := &Handler{
 		e:           e,
 		sm:          sm,
 		c:           make(map[uint32]conntainer),
 		readTimeout: rt,
+		conns:       newConnLimiter(0, 0),
+		queries:     newQueryPool(0, 0, 0),
 	}
+
+	e.Catalog.RegisterStatusVariable("Threads_connected", func() interface{} {
+		return h.conns.count()
+	})
+	e.Catalog.RegisterStatusVariable("Threads_running", func() interface{} {
+		running, _ := h.queries.stats()
+		return running
+	})
+	e.Catalog.RegisterStatusVariable("Queries_queued", func() interface{} {
+		_, queued := h.queries.stats()
+		return queued
+	})
+
+	return h
 }
 
 // AddNetConnection is used to add the net.Conn to the Handler when available (usually on the
@@ -96,6 +138,13 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 	delete(h.c, c.ConnectionID)
 	h.mu.Unlock()
 
+	// Only the connections that logged in successfully hold a slot of the
+	// connection limiter, which is released here even if the handshake
+	// failed after the login.
+	if slot, ok := c.UserData.(*connSlot); ok {
+		slot.release()
+	}
+
 	// If connection was closed, kill only its associated queries.
 	h.e.Catalog.ProcessList.KillOnlyQueries(c.ConnectionID)
 
@@ -106,12 +155,150 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 	logrus.Infof("ConnectionClosed: client %v", c.ConnectionID)
 }
 
-// ComQuery executes a SQL query on the SQLe engine.
+// ComQuery executes a SQL query on the SQLe engine. If the query contains
+// several statements and the client has the CLIENT_MULTI_STATEMENTS
+// capability, all of them are executed in order. Only the last of them can
+// return rows.
 func (h *Handler) ComQuery(
 	c *mysql.Conn,
 	query string,
 	callback func(*sqltypes.Result) error,
+) error {
+	if err := h.startQuery(); err != nil {
+		return err
+	}
+	defer h.endQuery()
+
+	stmts := parse.SplitStatements(query)
+	if len(stmts) <= 1 {
+		return h.doQuery(c, query, true, callback)
+	}
+
+	return h.multiQuery(c, stmts, callback)
+}
+
+// multiQuery executes all the given statements in order, in the same
+// session. The connection can only send a single result set for each query,
+// so only the last statement can return rows, and the results of the rest
+// of them, which are just the number of changed rows, are not sent. If a
+// statement fails, or returns rows without being the last one, the rest of
+// them are not executed and the returned error reports which statement
+// failed.
+func (h *Handler) multiQuery(
+	c *mysql.Conn,
+	stmts []string,
+	callback func(*sqltypes.Result) error,
+) error {
+	if c.Capabilities&mysql.CapabilityClientMultiStatements == 0 {
+		return ErrMultiStatementsDisabled.New()
+	}
+
+	for i, stmt := range stmts {
+		last := i == len(stmts)-1
+		err := h.doQuery(c, stmt, last, func(r *sqltypes.Result) error {
+			if !last {
+				return nil
+			}
+			return callback(r)
+		})
+		if err != nil {
+			// Keep the error code of MySQL errors, so clients can still
+			// handle them.
+			if se, ok := err.(*mysql.SQLError); ok {
+				msg := ErrStatementFailed.New(i+1, len(stmts), stmt, se.Message)
+				return mysql.NewSQLError(se.Num, se.State, "%s", msg)
+			}
+			return ErrStatementFailed.New(i+1, len(stmts), stmt, err)
+		}
+	}
+
+	return nil
+}
+
+// startQuery registers a new query being executed, unless the handler is
+// shutting down.
+func (h *Handler) startQuery() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closing {
+		return mysql.NewSQLError(mysql.ERServerShutdown, mysql.SSServerShutdown, "Server shutdown in progress")
+	}
+
+	h.inflight++
+	return nil
+}
+
+// endQuery unregisters a query once it has been executed.
+func (h *Handler) endQuery() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.inflight--
+	if h.closing && h.inflight == 0 {
+		close(h.drained)
+	}
+}
+
+// Shutdown stops executing new queries and waits until the ones being
+// executed finish or the given context is done, whatever happens first.
+// Then, the queries still running are killed, the table locks released and
+// all the connections and their sessions closed. If the context is done
+// before the queries finish, its error is returned.
+func (h *Handler) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	if !h.closing {
+		h.closing = true
+		h.drained = make(chan struct{})
+		if h.inflight == 0 {
+			close(h.drained)
+		}
+	}
+	drained := h.drained
+	h.mu.Unlock()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	h.mu.Lock()
+	var conns = make([]*mysql.Conn, 0, len(h.c))
+	for _, c := range h.c {
+		conns = append(conns, c.MysqlConn)
+	}
+	h.mu.Unlock()
+
+	for _, c := range conns {
+		h.e.Catalog.ProcessList.Kill(c.ConnectionID)
+
+		if err := h.e.Catalog.UnlockTables(nil, c.ConnectionID); err != nil {
+			logrus.Errorf("unable to unlock tables on shutdown: %s", err)
+		}
+
+		h.sm.CloseConn(c)
+		c.Close()
+	}
+
+	return err
+}
+
+// doQuery executes a single statement. If rowsAllowed is false, the
+// statement fails without reading any row when it returns a result set.
+func (h *Handler) doQuery(
+	c *mysql.Conn,
+	query string,
+	rowsAllowed bool,
+	callback func(*sqltypes.Result) error,
 ) (err error) {
+	defer func() {
+		if sqle.ErrMaxExecutionTimeExceeded.Is(err) {
+			err = mysql.NewSQLError(erQueryTimeout, mysql.SSUnknownSQLState, "%s", err)
+		}
+	}()
+
 	ctx := h.sm.NewContextWithQuery(c, query)
 	newCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -126,6 +313,11 @@ func (h *Handler) ComQuery(
 		return callback(&sqltypes.Result{})
 	}
 
+	if err := h.queries.acquire(ctx); err != nil {
+		return err
+	}
+	defer h.queries.release()
+
 	start := time.Now()
 	schema, rows, err := h.e.Query(ctx, query)
 	defer func() {
@@ -137,6 +329,13 @@ func (h *Handler) ComQuery(
 		return err
 	}
 
+	if !rowsAllowed && len(schema) > 0 && !schema.Equals(plan.OkResultSchema) {
+		if err := rows.Close(); err != nil {
+			return err
+		}
+		return ErrResultSetNotLast.New()
+	}
+
 	nc, ok := h.c[c.ConnectionID]
 	if !ok {
 		return ErrConnectionWasClosed.New()
@@ -287,9 +486,32 @@ rowLoop:
 		return nil
 	}
 
+	if schema.Equals(plan.OkResultSchema) {
+		r, err = okResult(ctx, r)
+		if err != nil {
+			return err
+		}
+	}
+
 	return callback(r)
 }
 
+// okResult turns the result of a statement that changes rows into an OK
+// packet with the number of changed rows and the first AUTO_INCREMENT value
+// generated by the statement, if any.
+func okResult(ctx *sql.Context, r *sqltypes.Result) (*sqltypes.Result, error) {
+	var affected uint64
+	if len(r.Rows) == 1 && len(r.Rows[0]) == 1 {
+		var err error
+		affected, err = strconv.ParseUint(r.Rows[0][0].ToString(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &sqltypes.Result{RowsAffected: affected, InsertID: ctx.InsertID()}, nil
+}
+
 // WarningCount is called at the end of each query to obtain
 // the value to be returned to the client in the EOF packet.
 // Note that this will be called either in the context of the
@@ -366,8 +588,12 @@ func schemaToFields(s sql.Schema) []*query.Field {
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
 		var charset uint32 = mysql.CharacterSetUtf8
-		if c.Type == sql.Blob {
+		if c.Type == sql.Blob || sql.IsBinary(c.Type) {
 			charset = mysql.CharacterSetBinary
+		} else if sql.HasCollation(c.Type) {
+			// The character set of a field is the ID of its collation.
+			collation, _ := sql.CollationOf(c.Type)
+			charset = uint32(collation.ID)
 		}
 
 		fields[i] = &query.Field{
@@ -375,6 +601,15 @@ func schemaToFields(s sql.Schema) []*query.Field {
 			Type:    c.Type.Type(),
 			Charset: charset,
 		}
+
+		if sql.IsFixedPoint(c.Type) {
+			_, scale, _ := sql.DecimalPrecision(c.Type)
+			fields[i].Decimals = uint32(scale)
+		}
+
+		if fsp := sql.TimePrecision(c.Type); fsp > 0 {
+			fields[i].Decimals = uint32(fsp)
+		}
 	}
 
 	return fields
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"testing"
@@ -193,6 +194,291 @@ func assertNoConnProcesses(t *testing.T, e *sqle.Engine, conn uint32) {
 	}
 }
 
+func TestHandlerMultiStatements(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+
+	query := "SET autocommit = 0; SELECT @@autocommit; SELECT c1 FROM test WHERE c1 < 2"
+	err := handler.ComQuery(conn, query, func(res *sqltypes.Result) error {
+		return nil
+	})
+	require.Error(err)
+	require.True(ErrMultiStatementsDisabled.Is(err))
+
+	conn.Capabilities |= mysql.CapabilityClientMultiStatements
+
+	query = "SET autocommit = 0; SELECT c1 FROM test WHERE c1 < 2"
+	var results []*sqltypes.Result
+	err = handler.ComQuery(conn, query, func(res *sqltypes.Result) error {
+		results = append(results, res)
+		return nil
+	})
+	require.NoError(err)
+	require.Len(results, 1)
+	require.Len(results[0].Rows, 2)
+
+	autocommit := func() string {
+		var value string
+		err := handler.ComQuery(conn, "SELECT @@autocommit", func(res *sqltypes.Result) error {
+			value = res.Rows[0][0].ToString()
+			return nil
+		})
+		require.NoError(err)
+		return value
+	}
+	require.Equal("0", autocommit())
+
+	// The results of statements that are not the last one can't be sent,
+	// so they can't return rows.
+	var calls int
+	query = "SET autocommit = 1; SELECT @@autocommit; SELECT 2"
+	err = handler.ComQuery(conn, query, func(res *sqltypes.Result) error {
+		calls++
+		return nil
+	})
+	require.Error(err)
+	require.True(ErrStatementFailed.Is(err))
+	require.Contains(err.Error(), "statement 2 of 3 failed (SELECT @@autocommit)")
+	require.Contains(err.Error(), ErrResultSetNotLast.New().Error())
+	require.Equal(0, calls)
+	require.Equal("1", autocommit())
+
+	// Multiple result sets are not supported, so only the last statement of
+	// a query can return rows, even if the previous ones only set variables.
+	query = "SET @a = 1; SELECT @a; SELECT 2"
+	err = handler.ComQuery(conn, query, func(res *sqltypes.Result) error {
+		calls++
+		return nil
+	})
+	require.Error(err)
+	require.True(ErrStatementFailed.Is(err))
+	require.Contains(err.Error(), "statement 2 of 3 failed (SELECT @a)")
+	require.Contains(err.Error(), ErrResultSetNotLast.New().Error())
+	require.Equal(0, calls)
+
+	results = nil
+	err = handler.ComQuery(conn, "SET @a = 1; SELECT @a", func(res *sqltypes.Result) error {
+		results = append(results, res)
+		return nil
+	})
+	require.NoError(err)
+	require.Len(results, 1)
+	require.Equal("1", results[0].Rows[0][0].ToString())
+
+	err = handler.ComQuery(conn, "SET autocommit = 0; SELECT * FROM nope; SELECT 2", func(res *sqltypes.Result) error {
+		calls++
+		return nil
+	})
+	require.Error(err)
+	require.True(ErrStatementFailed.Is(err))
+	require.Contains(err.Error(), "statement 2 of 3 failed (SELECT * FROM nope)")
+	require.Equal(0, calls)
+}
+
+func TestHandlerInsertID(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+
+	var results []*sqltypes.Result
+	callback := func(res *sqltypes.Result) error {
+		results = append(results, res)
+		return nil
+	}
+
+	err := handler.ComQuery(conn, "CREATE TABLE ai (id BIGINT AUTO_INCREMENT, v TEXT)", callback)
+	require.NoError(err)
+
+	results = nil
+	err = handler.ComQuery(conn, "INSERT INTO ai (v) VALUES ('a'), ('b')", callback)
+	require.NoError(err)
+	require.Len(results, 1)
+	require.Empty(results[0].Fields)
+	require.Empty(results[0].Rows)
+	require.Equal(uint64(2), results[0].RowsAffected)
+	require.Equal(uint64(1), results[0].InsertID)
+
+	results = nil
+	err = handler.ComQuery(conn, "INSERT INTO ai (v) VALUES ('c')", callback)
+	require.NoError(err)
+	require.Equal(uint64(1), results[0].RowsAffected)
+	require.Equal(uint64(3), results[0].InsertID)
+
+	results = nil
+	err = handler.ComQuery(conn, "DELETE FROM ai WHERE id = 1", callback)
+	require.NoError(err)
+	require.Len(results, 1)
+	require.Equal(uint64(1), results[0].RowsAffected)
+	require.Equal(uint64(0), results[0].InsertID)
+
+	results = nil
+	err = handler.ComQuery(conn, "SELECT LAST_INSERT_ID()", callback)
+	require.NoError(err)
+	require.Len(results, 1)
+	require.Equal("3", results[0].Rows[0][0].ToString())
+}
+
+func TestHandlerShutdown(t *testing.T) {
+	require := require.New(t)
+
+	newHandler := func() *Handler {
+		return NewHandler(
+			setupMemDB(require),
+			NewSessionManager(
+				testSessionBuilder,
+				opentracing.NoopTracer{},
+				sql.NewMemoryManager(nil),
+				"foo",
+			),
+			0,
+		)
+	}
+
+	// blockQuery executes a query in the background that does not finish
+	// until release is closed.
+	blockQuery := func(h *Handler, c *mysql.Conn, release chan struct{}) chan error {
+		started := make(chan struct{})
+		done := make(chan error, 1)
+		go func() {
+			done <- h.ComQuery(c, "SELECT 1", func(*sqltypes.Result) error {
+				close(started)
+				<-release
+				return nil
+			})
+		}()
+		<-started
+		return done
+	}
+
+	isClosing := func(h *Handler) bool {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		return h.closing
+	}
+
+	t.Run("drain", func(t *testing.T) {
+		handler := newHandler()
+		conn1, conn2 := newConn(1), newConn(2)
+		handler.NewConnection(conn1)
+		handler.NewConnection(conn2)
+
+		release := make(chan struct{})
+		done := blockQuery(handler, conn1, release)
+
+		shutdown := make(chan error)
+		go func() {
+			shutdown <- handler.Shutdown(context.Background())
+		}()
+
+		for !isClosing(handler) {
+			time.Sleep(time.Millisecond)
+		}
+
+		err := handler.ComQuery(conn2, "SELECT 2", func(*sqltypes.Result) error {
+			return nil
+		})
+		require.Error(err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(ok)
+		require.Equal(mysql.ERServerShutdown, sqlErr.Number())
+
+		close(release)
+		require.NoError(<-done)
+		require.NoError(<-shutdown)
+
+		require.True(conn1.IsClosed())
+		require.True(conn2.IsClosed())
+		require.Len(handler.sm.sessions, 0)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		handler := newHandler()
+		conn := newConn(1)
+		handler.NewConnection(conn)
+
+		release := make(chan struct{})
+		defer close(release)
+		blockQuery(handler, conn, release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := handler.Shutdown(ctx)
+		require.Equal(context.DeadlineExceeded, err)
+
+		require.True(conn.IsClosed())
+		require.Len(handler.sm.sessions, 0)
+		assertNoConnProcesses(t, handler.e, conn.ConnectionID)
+	})
+}
+
+func TestHandlerMaxExecutionTime(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+
+	err := handler.ComQuery(conn, "SELECT /*+ MAX_EXECUTION_TIME(10) */ SLEEP(1)", func(*sqltypes.Result) error {
+		return nil
+	})
+	require.Error(err)
+	sqlErr, ok := err.(*mysql.SQLError)
+	require.True(ok)
+	require.Equal(erQueryTimeout, sqlErr.Number())
+
+	err = handler.ComQuery(conn, "SET max_execution_time = 10", func(*sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(err)
+
+	err = handler.ComQuery(conn, "SELECT SLEEP(1)", func(*sqltypes.Result) error {
+		return nil
+	})
+	require.Error(err)
+	sqlErr, ok = err.(*mysql.SQLError)
+	require.True(ok)
+	require.Equal(erQueryTimeout, sqlErr.Number())
+}
+
 func TestSchemaToFields(t *testing.T) {
 	require := require.New(t)
 
@@ -291,3 +577,27 @@ func TestOkClosedConnection(t *testing.T) {
 	})
 	require.NoError(err)
 }
+
+func TestRowToSQL(t *testing.T) {
+	require := require.New(t)
+
+	schema := sql.Schema{
+		{Name: "a", Type: sql.Array(sql.Text)},
+		{Name: "b", Type: sql.Array(sql.Datetime)},
+		{Name: "c", Type: sql.Array(sql.Int64)},
+	}
+
+	row := sql.NewRow(
+		sql.NewArrayGenerator([]interface{}{"<a>", nil, []byte("b")}),
+		[]interface{}{time.Date(2019, 12, 31, 10, 20, 30, 0, time.UTC)},
+		nil,
+	)
+
+	values, err := rowToSQL(schema, row)
+	require.NoError(err)
+	require.Equal([]sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`["<a>", null, "b"]`)),
+		sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`["2019-12-31 10:20:30"]`)),
+		sqltypes.NULL,
+	}, values)
+}
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/mushiyu/vitess/go/mysql"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrTooManyConnections will be returned if a client tries to log in when the server already
+// has the maximum number of connections
+var ErrTooManyConnections = errors.NewKind("Too many connections")
+
+// ErrTooManyUserConnections will be returned if a client tries to log in with a user that
+// already has the maximum number of connections
+var ErrTooManyUserConnections = errors.NewKind("User %s already has more than 'max_user_connections' active connections")
+
+// ErrQueryQueueFull will be returned if a query can't be executed because the maximum number of
+// queries are running and the queue of waiting queries is full
+var ErrQueryQueueFull = errors.NewKind("too many queries waiting to be executed")
+
+// ErrQueryQueueTimeout will be returned if a query waits for its turn to be executed longer
+// than the queue timeout
+var ErrQueryQueueTimeout = errors.NewKind("query waited more than %s to be executed")
+
+// sqlStateConCount is the SQL state for the ERConCount error.
+const sqlStateConCount = "08004"
+
+// connLimiter keeps track of the logged in connections, in total and by
+// user, and rejects new ones over the configured limits. A limit of zero
+// means no limit.
+type connLimiter struct {
+	mu           sync.Mutex
+	maxConns     int
+	maxUserConns int
+	conns        int
+	users        map[string]int
+}
+
+func newConnLimiter(maxConns, maxUserConns int) *connLimiter {
+	return &connLimiter{
+		maxConns:     maxConns,
+		maxUserConns: maxUserConns,
+		users:        make(map[string]int),
+	}
+}
+
+// acquire registers a new connection of the given user. It returns a MySQL
+// error with the ERConCount code if any of the limits has been reached.
+func (l *connLimiter) acquire(user string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.maxConns > 0 && l.conns >= l.maxConns {
+		return mysql.NewSQLError(mysql.ERConCount, sqlStateConCount, "%s", ErrTooManyConnections.New())
+	}
+
+	if l.maxUserConns > 0 && l.users[user] >= l.maxUserConns {
+		return mysql.NewSQLError(mysql.ERConCount, sqlStateConCount, "%s", ErrTooManyUserConnections.New(user))
+	}
+
+	l.conns++
+	l.users[user]++
+	return nil
+}
+
+// release unregisters a connection of the given user.
+func (l *connLimiter) release(user string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n, ok := l.users[user]
+	if !ok {
+		return
+	}
+
+	l.conns--
+	if n <= 1 {
+		delete(l.users, user)
+	} else {
+		l.users[user]--
+	}
+}
+
+// count returns the number of logged in connections.
+func (l *connLimiter) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.conns
+}
+
+// limitedAuthServer wraps mysql.AuthServer to reject the logins over the
+// limits of a connLimiter.
+type limitedAuthServer struct {
+	mysql.AuthServer
+	limiter *connLimiter
+}
+
+// ValidateHash implements the mysql.AuthServer interface.
+func (s *limitedAuthServer) ValidateHash(
+	salt []byte,
+	user string,
+	resp []byte,
+	addr net.Addr,
+) (mysql.Getter, error) {
+	getter, err := s.AuthServer.ValidateHash(salt, user, resp, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.acquire(getter, user)
+}
+
+// Negotiate implements the mysql.AuthServer interface.
+func (s *limitedAuthServer) Negotiate(
+	c *mysql.Conn,
+	user string,
+	addr net.Addr,
+) (mysql.Getter, error) {
+	getter, err := s.AuthServer.Negotiate(c, user, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.acquire(getter, user)
+}
+
+// acquire takes a slot of the limiter for the given user, which is kept in
+// the user data of the connection, so it's released once the connection is
+// closed, no matter whether the handshake fails after the login or not.
+func (s *limitedAuthServer) acquire(getter mysql.Getter, user string) (mysql.Getter, error) {
+	if err := s.limiter.acquire(user); err != nil {
+		return nil, err
+	}
+
+	return &connSlot{Getter: getter, user: user, limiter: s.limiter}, nil
+}
+
+// connSlot is the user data of the connections logged in through a
+// limitedAuthServer, which holds their slot of the connLimiter.
+type connSlot struct {
+	mysql.Getter
+	user    string
+	limiter *connLimiter
+	once    sync.Once
+}
+
+// release gives the slot back to the limiter. Only the first call releases
+// it, so it's safe to call it more than once.
+func (s *connSlot) release() {
+	s.once.Do(func() {
+		s.limiter.release(s.user)
+	})
+}
+
+// queryPool limits the number of queries executed at the same time. Queries
+// over the limit wait in a queue, in order of arrival, until a running query
+// finishes. A limit of zero means no limit.
+type queryPool struct {
+	mu         sync.Mutex
+	maxRunning int
+	maxQueued  int
+	timeout    time.Duration
+	running    int
+	queue      []chan struct{}
+}
+
+func newQueryPool(maxRunning, maxQueued int, timeout time.Duration) *queryPool {
+	return &queryPool{
+		maxRunning: maxRunning,
+		maxQueued:  maxQueued,
+		timeout:    timeout,
+	}
+}
+
+// acquire waits until the query can be executed. It fails if the queue is
+// full, if the query waits longer than the queue timeout or if the context
+// is cancelled while waiting. Every successful call must be followed by a
+// call to release once the query has finished.
+func (p *queryPool) acquire(ctx context.Context) error {
+	p.mu.Lock()
+	if p.maxRunning <= 0 || (p.running < p.maxRunning && len(p.queue) == 0) {
+		p.running++
+		p.mu.Unlock()
+		return nil
+	}
+
+	if p.maxQueued > 0 && len(p.queue) >= p.maxQueued {
+		p.mu.Unlock()
+		return ErrQueryQueueFull.New()
+	}
+
+	ready := make(chan struct{})
+	p.queue = append(p.queue, ready)
+	p.mu.Unlock()
+
+	var timeout <-chan time.Time
+	if p.timeout > 0 {
+		timer := time.NewTimer(p.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case <-ready:
+		return nil
+	case <-timeout:
+		err = ErrQueryQueueTimeout.New(p.timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, ch := range p.queue {
+		if ch == ready {
+			p.queue = append(p.queue[:i], p.queue[i+1:]...)
+			return err
+		}
+	}
+
+	// The query was given its turn right when it stopped waiting, so it has
+	// to be passed on.
+	p.releaseLocked()
+	return err
+}
+
+// release marks a query as finished, giving its turn to the first query in
+// the queue, if any.
+func (p *queryPool) release() {
+	p.mu.Lock()
+	p.releaseLocked()
+	p.mu.Unlock()
+}
+
+func (p *queryPool) releaseLocked() {
+	if len(p.queue) > 0 {
+		close(p.queue[0])
+		p.queue = p.queue[1:]
+		return
+	}
+
+	p.running--
+}
+
+// stats returns the number of running and queued queries.
+func (p *queryPool) stats() (running, queued int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.running, len(p.queue)
+}
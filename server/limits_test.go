@@ -0,0 +1,250 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/vitess/go/mysql"
+	"github.com/mushiyu/vitess/go/sqltypes"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnLimiter(t *testing.T) {
+	require := require.New(t)
+
+	l := newConnLimiter(3, 2)
+	require.NoError(l.acquire("foo"))
+	require.NoError(l.acquire("foo"))
+
+	err := l.acquire("foo")
+	require.Error(err)
+	sqlErr, ok := err.(*mysql.SQLError)
+	require.True(ok)
+	require.Equal(mysql.ERConCount, sqlErr.Number())
+	require.Contains(sqlErr.Message, "max_user_connections")
+
+	require.NoError(l.acquire("bar"))
+	require.Equal(3, l.count())
+
+	err = l.acquire("baz")
+	require.Error(err)
+	sqlErr, ok = err.(*mysql.SQLError)
+	require.True(ok)
+	require.Equal(mysql.ERConCount, sqlErr.Number())
+	require.Equal("Too many connections", sqlErr.Message)
+
+	l.release("foo")
+	require.NoError(l.acquire("baz"))
+
+	// Releasing connections never acquired does nothing.
+	l.release("qux")
+	require.Equal(3, l.count())
+
+	l = newConnLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		require.NoError(l.acquire("foo"))
+	}
+	require.Equal(100, l.count())
+}
+
+func TestLimitedAuthServer(t *testing.T) {
+	require := require.New(t)
+
+	l := newConnLimiter(1, 0)
+	s := &limitedAuthServer{AuthServer: new(mysql.AuthServerNone), limiter: l}
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}
+
+	getter, err := s.ValidateHash(nil, "foo", nil, addr)
+	require.NoError(err)
+	require.NotNil(getter)
+
+	_, err = s.ValidateHash(nil, "bar", nil, addr)
+	require.Error(err)
+
+	l.release("foo")
+	_, err = s.ValidateHash(nil, "bar", nil, addr)
+	require.NoError(err)
+}
+
+func TestLimitedAuthServerHandshakeError(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+	handler.conns = newConnLimiter(1, 0)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+
+	a := &limitedAuthServer{AuthServer: new(mysql.AuthServerNone), limiter: handler.conns}
+	listener, err := mysql.NewFromListener(failingWritesListener{l}, a, handler, 0, 0)
+	require.NoError(err)
+	defer listener.Close()
+	go listener.Accept()
+
+	params := &mysql.ConnParams{
+		Host:  "127.0.0.1",
+		Port:  l.Addr().(*net.TCPAddr).Port,
+		Uname: "foo",
+	}
+
+	// The OK packet of the handshake can't be written, so the handshake
+	// fails after the login, and the slot taken by the login must be
+	// released, or the second connection would be over the limit.
+	for i := 0; i < 2; i++ {
+		_, err = mysql.Connect(context.Background(), params)
+		require.Error(err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(ok)
+		require.NotEqual(mysql.ERConCount, sqlErr.Number())
+		require.Equal(0, handler.conns.count())
+	}
+}
+
+// failingWritesListener accepts connections that can't be written once
+// something has been read from them, so the handshake of the connections
+// fails right after the login.
+type failingWritesListener struct {
+	net.Listener
+}
+
+func (l failingWritesListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return &failingWritesConn{Conn: conn}, nil
+}
+
+type failingWritesConn struct {
+	net.Conn
+	read bool
+}
+
+func (c *failingWritesConn) Read(b []byte) (int, error) {
+	c.read = true
+	return c.Conn.Read(b)
+}
+
+func (c *failingWritesConn) Write(b []byte) (int, error) {
+	if c.read {
+		return 0, io.ErrClosedPipe
+	}
+	return c.Conn.Write(b)
+}
+
+func TestQueryPool(t *testing.T) {
+	require := require.New(t)
+
+	p := newQueryPool(1, 1, 0)
+	require.NoError(p.acquire(context.Background()))
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- p.acquire(context.Background())
+	}()
+
+	for {
+		if _, queued := p.stats(); queued == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	err := p.acquire(context.Background())
+	require.Error(err)
+	require.True(ErrQueryQueueFull.Is(err))
+
+	p.release()
+	require.NoError(<-acquired)
+
+	running, queued := p.stats()
+	require.Equal(1, running)
+	require.Equal(0, queued)
+
+	p.release()
+	running, _ = p.stats()
+	require.Equal(0, running)
+}
+
+func TestQueryPoolTimeout(t *testing.T) {
+	require := require.New(t)
+
+	p := newQueryPool(1, 0, 10*time.Millisecond)
+	require.NoError(p.acquire(context.Background()))
+
+	err := p.acquire(context.Background())
+	require.Error(err)
+	require.True(ErrQueryQueueTimeout.Is(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = newQueryPool(1, 0, 0).acquire(ctx)
+	require.NoError(err)
+
+	p = newQueryPool(1, 0, 0)
+	require.NoError(p.acquire(context.Background()))
+	require.Equal(context.Canceled, p.acquire(ctx))
+
+	running, queued := p.stats()
+	require.Equal(1, running)
+	require.Equal(0, queued)
+}
+
+func TestHandlerQueryQueue(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+	handler.queries = newQueryPool(1, 0, 10*time.Millisecond)
+
+	conn1, conn2 := newConn(1), newConn(2)
+	handler.NewConnection(conn1)
+	handler.NewConnection(conn2)
+
+	// The second query can't be executed while the first one is running,
+	// since the first one is blocked in the callback.
+	err := handler.ComQuery(conn1, "SELECT 1", func(*sqltypes.Result) error {
+		err := handler.ComQuery(conn2, "SELECT 2", func(*sqltypes.Result) error {
+			return nil
+		})
+		require.Error(err)
+		require.True(ErrQueryQueueTimeout.Is(err))
+		return nil
+	})
+	require.NoError(err)
+
+	var rows [][]sqltypes.Value
+	err = handler.ComQuery(conn2, "SHOW STATUS LIKE 'threads_running'", func(res *sqltypes.Result) error {
+		rows = append(rows, res.Rows...)
+		return nil
+	})
+	require.NoError(err)
+	require.Len(rows, 1)
+	require.Equal("Threads_running", rows[0][0].ToString())
+	require.Equal("1", rows[0][1].ToString())
+}
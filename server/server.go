@@ -1,6 +1,7 @@
 package server // import "github.com/mushiyu/go-mysql-server/server"
 
 import (
+	"context"
 	"time"
 
 	"github.com/opentracing/opentracing-go"
@@ -30,6 +31,22 @@ type Config struct {
 
 	ConnReadTimeout  time.Duration
 	ConnWriteTimeout time.Duration
+
+	// MaxConnections is the maximum number of clients that can be connected
+	// at the same time. Zero means no limit.
+	MaxConnections int
+	// MaxUserConnections is the maximum number of clients that can be
+	// connected at the same time with the same user. Zero means no limit.
+	MaxUserConnections int
+	// MaxRunningQueries is the maximum number of queries executed at the same
+	// time. Queries over the limit wait in a queue. Zero means no limit.
+	MaxRunningQueries int
+	// MaxQueuedQueries is the maximum number of queries waiting to be
+	// executed. Queries over the limit fail. Zero means no limit.
+	MaxQueuedQueries int
+	// QueryQueueTimeout is the maximum time a query can wait to be executed.
+	// Zero means no timeout.
+	QueryQueueTimeout time.Duration
 }
 
 // NewDefaultServer creates a Server with the default session builder.
@@ -61,7 +78,10 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder) (*Server, error) {
 			e.Catalog.MemoryManager,
 			cfg.Address),
 		cfg.ConnReadTimeout)
-	a := cfg.Auth.Mysql()
+	handler.conns = newConnLimiter(cfg.MaxConnections, cfg.MaxUserConnections)
+	handler.queries = newQueryPool(cfg.MaxRunningQueries, cfg.MaxQueuedQueries, cfg.QueryQueueTimeout)
+
+	a := &limitedAuthServer{AuthServer: cfg.Auth.Mysql(), limiter: handler.conns}
 	l, err := NewListener(cfg.Protocol, cfg.Address, handler)
 	if err != nil {
 		return nil, err
@@ -85,3 +105,13 @@ func (s *Server) Close() error {
 	s.Listener.Close()
 	return nil
 }
+
+// Shutdown gracefully shuts down the server. It stops accepting new
+// connections and queries and waits for the queries being executed to
+// finish, until the given context is done. Then, the remaining queries are
+// killed and all the connections closed. If the context is done before the
+// queries finish, its error is returned.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.Listener.Shutdown()
+	return s.h.Shutdown(ctx)
+}
@@ -0,0 +1,102 @@
+package analyzer
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+// applyPolicies wraps every table with row-level security policies in a
+// filter with the predicates of all its policies, so only the rows allowed
+// by them can be read, deleted or used as the source of an insert. The
+// filter is added before the tables are resolved and will end up right above
+// the resolved table, where it can be pushed down like any other filter.
+func applyPolicies(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+	span, _ := ctx.Span("apply_policies")
+	defer span.Finish()
+
+	if !a.Catalog.HasPolicies() {
+		return n, nil
+	}
+
+	a.Log("apply policies, node of type: %T", n)
+	return applyPoliciesToNode(a, n)
+}
+
+func applyPoliciesToNode(a *Analyzer, n sql.Node) (sql.Node, error) {
+	switch n := n.(type) {
+	case *plan.UnresolvedTable:
+		return wrapTableWithPolicies(a, n, n, n.Name())
+	case *plan.TableAlias:
+		// Aliased tables are expected to be the direct child of the alias,
+		// so the filter goes above the alias instead.
+		if t, ok := n.Child.(*plan.UnresolvedTable); ok {
+			return wrapTableWithPolicies(a, n, t, n.Name())
+		}
+	case *plan.InsertInto:
+		// The destination table is only written, so the policies apply
+		// just to the rows read from the source.
+		src, err := applyPoliciesToNode(a, n.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		return n.WithChildren(n.Left, src)
+	case *plan.CreateIndex, *plan.DropIndex, *plan.RebuildIndex, *plan.CreatePolicy, *plan.DropPolicy,
+		*plan.ShowColumns, *plan.Describe, *plan.LockTables:
+		return n, nil
+	}
+
+	children := n.Children()
+	if len(children) == 0 {
+		return n, nil
+	}
+
+	var newChildren = make([]sql.Node, len(children))
+	for i, c := range children {
+		var err error
+		newChildren[i], err = applyPoliciesToNode(a, c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return n.WithChildren(newChildren...)
+}
+
+func wrapTableWithPolicies(
+	a *Analyzer,
+	n sql.Node,
+	t *plan.UnresolvedTable,
+	name string,
+) (sql.Node, error) {
+	db := t.Database
+	if db == "" {
+		db = a.Catalog.CurrentDatabase()
+	}
+
+	policies := a.Catalog.PoliciesByTable(db, t.Name())
+	if len(policies) == 0 {
+		return n, nil
+	}
+
+	var predicates = make([]sql.Expression, len(policies))
+	for i, p := range policies {
+		// Columns in the predicate are qualified with the table name (or its
+		// alias) so they can't be ambiguous in queries joining several tables.
+		predicate, err := expression.TransformUp(p.Predicate, func(e sql.Expression) (sql.Expression, error) {
+			if col, ok := e.(*expression.UnresolvedColumn); ok && col.Table() == "" {
+				return expression.NewUnresolvedQualifiedColumn(name, col.Name()), nil
+			}
+			return e, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		a.Log("applying policy %q to table %q", p.Name, t.Name())
+		predicates[i] = predicate
+	}
+
+	return plan.NewFilter(expression.JoinAnd(predicates...), n), nil
+}
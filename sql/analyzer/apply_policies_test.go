@@ -0,0 +1,88 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPolicies(t *testing.T) {
+	require := require.New(t)
+	f := getRule("apply_policies")
+
+	table := memory.NewTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+		{Name: "tenant", Type: sql.Text, Source: "mytable"},
+	})
+	db := memory.NewDatabase("mydb")
+	db.AddTable("mytable", table)
+
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+	a := NewDefault(catalog)
+
+	node := plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("i")},
+		plan.NewUnresolvedTable("mytable", ""),
+	)
+
+	result, err := f.Apply(sql.NewEmptyContext(), a, node)
+	require.NoError(err)
+	require.Equal(node, result)
+
+	require.NoError(catalog.AddPolicy(&sql.Policy{
+		Name:     "p",
+		Database: "mydb",
+		Table:    "mytable",
+		Predicate: expression.NewEquals(
+			expression.NewUnresolvedColumn("tenant"),
+			expression.NewUnresolvedFunction("current_user", false),
+		),
+	}))
+
+	result, err = f.Apply(sql.NewEmptyContext(), a, node)
+	require.NoError(err)
+	require.Equal(plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("i")},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewUnresolvedQualifiedColumn("mytable", "tenant"),
+				expression.NewUnresolvedFunction("current_user", false),
+			),
+			plan.NewUnresolvedTable("mytable", ""),
+		),
+	), result)
+
+	node = plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("i")},
+		plan.NewTableAlias("t", plan.NewUnresolvedTable("mytable", "")),
+	)
+
+	result, err = f.Apply(sql.NewEmptyContext(), a, node)
+	require.NoError(err)
+	require.Equal(plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("i")},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewUnresolvedQualifiedColumn("t", "tenant"),
+				expression.NewUnresolvedFunction("current_user", false),
+			),
+			plan.NewTableAlias("t", plan.NewUnresolvedTable("mytable", "")),
+		),
+	), result)
+
+	insert := plan.NewInsertInto(
+		plan.NewUnresolvedTable("mytable", ""),
+		plan.NewValues(nil),
+		false,
+		nil,
+	)
+
+	result, err = f.Apply(sql.NewEmptyContext(), a, insert)
+	require.NoError(err)
+	require.Equal(insert, result)
+}
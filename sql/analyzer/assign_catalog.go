@@ -26,10 +26,39 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error)
 			nc.Catalog = a.Catalog
 			nc.CurrentDatabase = a.Catalog.CurrentDatabase()
 			return &nc, nil
+		case *plan.RebuildIndex:
+			nc := *node
+			nc.Catalog = a.Catalog
+			nc.CurrentDatabase = a.Catalog.CurrentDatabase()
+			return &nc, nil
+		case *plan.CreatePolicy:
+			nc := *node
+			nc.Catalog = a.Catalog
+			nc.CurrentDatabase = a.Catalog.CurrentDatabase()
+			return &nc, nil
+		case *plan.DropPolicy:
+			nc := *node
+			nc.Catalog = a.Catalog
+			nc.CurrentDatabase = a.Catalog.CurrentDatabase()
+			return &nc, nil
 		case *plan.ShowIndexes:
 			nc := *node
 			nc.Registry = a.Catalog.IndexRegistry
 			return &nc, nil
+		case *plan.InsertInto:
+			nc := *node
+			nc.Registry = a.Catalog.IndexRegistry
+			nc.CurrentDatabase = a.Catalog.CurrentDatabase()
+			return &nc, nil
+		case *plan.DeleteFrom:
+			nc := *node
+			nc.Registry = a.Catalog.IndexRegistry
+			nc.CurrentDatabase = a.Catalog.CurrentDatabase()
+			return &nc, nil
+		case *plan.ShowStatus:
+			nc := *node
+			nc.Registry = a.Catalog.StatusRegistry
+			return &nc, nil
 		case *plan.ShowDatabases:
 			nc := *node
 			nc.Catalog = a.Catalog
@@ -40,6 +40,24 @@ func TestAssignCatalog(t *testing.T) {
 	require.Equal(c, di.Catalog)
 	require.Equal("foo", di.CurrentDatabase)
 
+	node, err = f.Apply(sql.NewEmptyContext(), a,
+		plan.NewInsertInto(plan.NewResolvedTable(tbl), plan.NewValues(nil), false, nil))
+	require.NoError(err)
+
+	ii, ok := node.(*plan.InsertInto)
+	require.True(ok)
+	require.Equal(c.IndexRegistry, ii.Registry)
+	require.Equal("foo", ii.CurrentDatabase)
+
+	node, err = f.Apply(sql.NewEmptyContext(), a,
+		plan.NewDeleteFrom(plan.NewResolvedTable(tbl)))
+	require.NoError(err)
+
+	df, ok := node.(*plan.DeleteFrom)
+	require.True(ok)
+	require.Equal(c.IndexRegistry, df.Registry)
+	require.Equal("foo", df.CurrentDatabase)
+
 	node, err = f.Apply(sql.NewEmptyContext(), a, plan.NewShowIndexes(db, "table-test", nil))
 	require.NoError(err)
 
@@ -62,6 +80,12 @@ func TestAssignCatalog(t *testing.T) {
 	require.True(ok)
 	require.Equal(c, sd.Catalog)
 
+	node, err = f.Apply(sql.NewEmptyContext(), a, plan.NewShowStatus(""))
+	require.NoError(err)
+	ss, ok := node.(*plan.ShowStatus)
+	require.True(ok)
+	require.Equal(c.StatusRegistry, ss.Registry)
+
 	node, err = f.Apply(sql.NewEmptyContext(), a, plan.NewLockTables(nil))
 	require.NoError(err)
 	lt, ok := node.(*plan.LockTables)
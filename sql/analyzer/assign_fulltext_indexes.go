@@ -0,0 +1,101 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+// assignFullTextIndexes assigns to each MATCH expression the full-text index
+// on its columns, which is needed to evaluate it.
+func assignFullTextIndexes(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+	span, _ := ctx.Span("assign_fulltext_indexes")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	// Columns of aliased tables need to refer to the actual tables to find
+	// their indexes.
+	var tables = make(map[string]string)
+	plan.Inspect(n, func(node sql.Node) bool {
+		if alias, ok := node.(*plan.TableAlias); ok {
+			if t, ok := alias.Child.(*plan.ResolvedTable); ok {
+				tables[alias.Name()] = t.Name()
+			}
+		}
+		return true
+	})
+
+	return plan.TransformExpressionsUp(n, func(e sql.Expression) (sql.Expression, error) {
+		m, ok := e.(*expression.Match)
+		if !ok || m.Index != nil {
+			return e, nil
+		}
+
+		columns, ok := matchColumns(m, tables)
+		if !ok {
+			return nil, expression.ErrNoFullTextIndex.New(columnList(m.Columns))
+		}
+
+		idx := fullTextIndex(a, nil, columns)
+		if idx == nil {
+			return nil, expression.ErrNoFullTextIndex.New(columnList(m.Columns))
+		}
+
+		// The index is not retained during the execution of the query, as
+		// it is not deleted while it's still being used.
+		a.Catalog.ReleaseIndex(idx)
+		a.Log("MATCH expression %s will use index %q", m, idx.ID())
+
+		return m.WithIndex(idx), nil
+	})
+}
+
+// matchColumns returns the columns of the MATCH expression referring to the
+// actual tables instead of their aliases. It returns false if some of them
+// is not a column.
+func matchColumns(m *expression.Match, tables map[string]string) ([]sql.Expression, bool) {
+	var columns = make([]sql.Expression, len(m.Columns))
+	for i, c := range m.Columns {
+		gf, ok := c.(*expression.GetField)
+		if !ok {
+			return nil, false
+		}
+
+		if table, ok := tables[gf.Table()]; ok {
+			gf = expression.NewGetFieldWithTable(gf.Index(), gf.Type(), table, gf.Name(), gf.IsNullable())
+		}
+		columns[i] = gf
+	}
+	return columns, true
+}
+
+// fullTextIndex returns the full-text index on exactly the given columns that
+// can be used according to the hints, or nil if there is none.
+func fullTextIndex(a *Analyzer, hints indexHints, columns []sql.Expression) sql.FullTextIndex {
+	idx := a.Catalog.FilteredIndexByExpression(
+		a.Catalog.CurrentDatabase(),
+		func(idx sql.Index) bool {
+			_, ok := idx.(sql.FullTextIndex)
+			return ok && len(idx.Expressions()) == len(columns) && hints.canUse(idx)
+		},
+		columns...,
+	)
+	if idx == nil {
+		return nil
+	}
+
+	return idx.(sql.FullTextIndex)
+}
+
+func columnList(columns []sql.Expression) string {
+	var names = make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.String()
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,67 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/index/fulltext"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignFullTextIndexes(t *testing.T) {
+	require := require.New(t)
+
+	catalog := sql.NewCatalog()
+	idx, err := fulltext.NewDriver().Create("", "t1", "idx_ft", []sql.Expression{
+		expression.NewGetFieldWithTable(1, sql.Text, "t1", "bar", false),
+	}, nil)
+	require.NoError(err)
+
+	done, ready, err := catalog.AddIndex(idx)
+	require.NoError(err)
+	close(done)
+	<-ready
+
+	a := NewDefault(catalog)
+
+	t1 := memory.NewTable("t1", sql.Schema{
+		{Name: "foo", Type: sql.Int64, Source: "t1"},
+		{Name: "bar", Type: sql.Text, Source: "t1"},
+	})
+
+	match := func(column sql.Expression) *expression.Match {
+		return expression.NewMatch(
+			[]sql.Expression{column},
+			expression.NewLiteral("foo", sql.Text),
+			sql.NaturalLanguageMode,
+		)
+	}
+
+	node := plan.NewProject(
+		[]sql.Expression{
+			match(expression.NewGetFieldWithTable(1, sql.Text, "t", "bar", false)),
+		},
+		plan.NewTableAlias("t", plan.NewResolvedTable(t1)),
+	)
+
+	result, err := assignFullTextIndexes(sql.NewEmptyContext(), a, node)
+	require.NoError(err)
+
+	m := result.(*plan.Project).Projections[0].(*expression.Match)
+	require.Equal(idx, m.Index)
+	require.Equal("t", m.Columns[0].(*expression.GetField).Table())
+
+	node = plan.NewProject(
+		[]sql.Expression{
+			match(expression.NewGetFieldWithTable(0, sql.Int64, "t1", "foo", false)),
+		},
+		plan.NewResolvedTable(t1),
+	)
+
+	_, err = assignFullTextIndexes(sql.NewEmptyContext(), a, node)
+	require.Error(err)
+	require.True(expression.ErrNoFullTextIndex.Is(err))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function"
 	"github.com/mushiyu/go-mysql-server/sql/plan"
 	errors "gopkg.in/src-d/go-errors.v1"
 )
@@ -18,7 +19,7 @@ type indexLookup struct {
 	indexes []sql.Index
 }
 
-func assignIndexes(a *Analyzer, node sql.Node) (map[string]*indexLookup, error) {
+func assignIndexes(a *Analyzer, node sql.Node, hints indexHints) (map[string]*indexLookup, error) {
 	a.Log("assigning indexes, node of type: %T", node)
 
 	var indexes map[string]*indexLookup
@@ -70,7 +71,7 @@ func assignIndexes(a *Analyzer, node sql.Node) (map[string]*indexLookup, error)
 		fn(filter.Child)
 
 		var result map[string]*indexLookup
-		result, err = getIndexes(filter.Expression, aliases, a)
+		result, err = getIndexes(filter.Expression, aliases, a, hints)
 		if err != nil {
 			return false
 		}
@@ -87,16 +88,21 @@ func assignIndexes(a *Analyzer, node sql.Node) (map[string]*indexLookup, error)
 	return indexes, err
 }
 
-func getIndexes(e sql.Expression, aliases map[string]sql.Expression, a *Analyzer) (map[string]*indexLookup, error) {
+func getIndexes(
+	e sql.Expression,
+	aliases map[string]sql.Expression,
+	a *Analyzer,
+	hints indexHints,
+) (map[string]*indexLookup, error) {
 	var result = make(map[string]*indexLookup)
 	switch e := e.(type) {
 	case *expression.Or:
-		leftIndexes, err := getIndexes(e.Left, aliases, a)
+		leftIndexes, err := getIndexes(e.Left, aliases, a, hints)
 		if err != nil {
 			return nil, err
 		}
 
-		rightIndexes, err := getIndexes(e.Right, aliases, a)
+		rightIndexes, err := getIndexes(e.Right, aliases, a, hints)
 		if err != nil {
 			return nil, err
 		}
@@ -128,7 +134,7 @@ func getIndexes(e sql.Expression, aliases map[string]sql.Expression, a *Analyzer
 		// the right branch is evaluable and the indexlookup supports set
 		// operations.
 		if !isEvaluable(c.Left()) && isEvaluable(c.Right()) {
-			idx := a.Catalog.IndexByExpression(a.Catalog.CurrentDatabase(), unifyExpressions(aliases, c.Left())...)
+			idx := indexByExpression(a, hints, unifyExpressions(aliases, c.Left())...)
 			if idx != nil {
 				var nidx sql.NegateIndex
 				if negate {
@@ -205,7 +211,31 @@ func getIndexes(e sql.Expression, aliases map[string]sql.Expression, a *Analyzer
 		*expression.GreaterThan,
 		*expression.LessThanOrEqual,
 		*expression.GreaterThanOrEqual:
-		idx, lookup, err := getComparisonIndex(a, e.(expression.Comparer), aliases)
+		idx, lookup, err := getComparisonIndex(a, hints, e.(expression.Comparer), aliases)
+		if err == nil && lookup == nil {
+			idx, lookup, err = getDistanceIndex(a, hints, e.(expression.Comparer), aliases)
+		}
+
+		if err != nil || lookup == nil {
+			return result, err
+		}
+
+		result[idx.Table()] = &indexLookup{
+			indexes: []sql.Index{idx},
+			lookup:  lookup,
+		}
+	case *expression.Match:
+		idx, lookup, err := getMatchIndex(a, hints, e)
+		if err != nil || lookup == nil {
+			return result, err
+		}
+
+		result[idx.Table()] = &indexLookup{
+			indexes: []sql.Index{idx},
+			lookup:  lookup,
+		}
+	case *function.SpatialRelation:
+		idx, lookup, err := getSpatialIndex(a, hints, e.Left, e.Right, 0, aliases)
 		if err != nil || lookup == nil {
 			return result, err
 		}
@@ -215,7 +245,7 @@ func getIndexes(e sql.Expression, aliases map[string]sql.Expression, a *Analyzer
 			lookup:  lookup,
 		}
 	case *expression.Not:
-		r, err := getNegatedIndexes(a, e, aliases)
+		r, err := getNegatedIndexes(a, hints, e, aliases)
 		if err != nil {
 			return nil, err
 		}
@@ -225,7 +255,7 @@ func getIndexes(e sql.Expression, aliases map[string]sql.Expression, a *Analyzer
 		}
 	case *expression.Between:
 		if !isEvaluable(e.Val) && isEvaluable(e.Upper) && isEvaluable(e.Lower) {
-			idx := a.Catalog.IndexByExpression(a.Catalog.CurrentDatabase(), unifyExpressions(aliases, e.Val)...)
+			idx := indexByExpression(a, hints, unifyExpressions(aliases, e.Val)...)
 			if idx != nil {
 				// release the index if it was not used
 				defer func() {
@@ -265,7 +295,7 @@ func getIndexes(e sql.Expression, aliases map[string]sql.Expression, a *Analyzer
 		exprs := splitExpression(e)
 		used := make(map[sql.Expression]struct{})
 
-		result, err := getMultiColumnIndexes(exprs, a, used, aliases)
+		result, err := getMultiColumnIndexes(exprs, a, hints, used, aliases)
 		if err != nil {
 			return nil, err
 		}
@@ -275,7 +305,7 @@ func getIndexes(e sql.Expression, aliases map[string]sql.Expression, a *Analyzer
 				continue
 			}
 
-			indexes, err := getIndexes(e, aliases, a)
+			indexes, err := getIndexes(e, aliases, a, hints)
 			if err != nil {
 				return nil, err
 			}
@@ -341,6 +371,7 @@ func betweenIndexLookup(index sql.Index, upper, lower []interface{}) (sql.IndexL
 // can handle inclusiveness on both sides.
 func getComparisonIndex(
 	a *Analyzer,
+	hints indexHints,
 	e expression.Comparer,
 	aliases map[string]sql.Expression,
 ) (sql.Index, sql.IndexLookup, error) {
@@ -351,7 +382,7 @@ func getComparisonIndex(
 	}
 
 	if !isEvaluable(left) && isEvaluable(right) {
-		idx := a.Catalog.IndexByExpression(a.Catalog.CurrentDatabase(), unifyExpressions(aliases, left)...)
+		idx := indexByExpression(a, hints, unifyExpressions(aliases, left)...)
 		if idx != nil {
 			value, err := right.Eval(sql.NewEmptyContext(), nil)
 			if err != nil {
@@ -372,6 +403,128 @@ func getComparisonIndex(
 	return nil, nil, nil
 }
 
+// getMatchIndex returns the full-text index on the columns of the MATCH
+// expression and a lookup of the rows that match its query.
+func getMatchIndex(
+	a *Analyzer,
+	hints indexHints,
+	m *expression.Match,
+) (sql.Index, sql.IndexLookup, error) {
+	if !isEvaluable(m.Query) {
+		return nil, nil, nil
+	}
+
+	idx := fullTextIndex(a, hints, m.Columns)
+	if idx == nil {
+		return nil, nil, nil
+	}
+
+	query, err := m.QueryString(sql.NewEmptyContext(), nil)
+	if err != nil {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil, err
+	}
+
+	lookup, err := idx.Match(query, m.Mode)
+	if err != nil {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil, err
+	}
+
+	return idx, lookup, nil
+}
+
+// getSpatialIndex returns the spatial index on one of the given expressions
+// and a lookup of the rows whose geometry in it may be at most at the given
+// distance of the geometry that is the value of the other one, which must be
+// evaluable. All the rows whose geometry is in a spatial relation with the
+// other geometry are in the lookup.
+func getSpatialIndex(
+	a *Analyzer,
+	hints indexHints,
+	left, right sql.Expression,
+	distance float64,
+	aliases map[string]sql.Expression,
+) (sql.Index, sql.IndexLookup, error) {
+	if !isEvaluable(right) {
+		left, right = right, left
+	}
+
+	if isEvaluable(left) || !isEvaluable(right) {
+		return nil, nil, nil
+	}
+
+	idx := a.Catalog.FilteredIndexByExpression(
+		a.Catalog.CurrentDatabase(),
+		func(idx sql.Index) bool {
+			_, ok := idx.(sql.SpatialIndex)
+			return ok && hints.canUse(idx)
+		},
+		unifyExpressions(aliases, left)...,
+	)
+	if idx == nil {
+		return nil, nil, nil
+	}
+
+	value, err := right.Eval(sql.NewEmptyContext(), nil)
+	if err != nil || value == nil {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil, err
+	}
+
+	g, err := sql.Geometry.Convert(value)
+	if err != nil {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil, err
+	}
+
+	bounds := g.(sql.GeometryValue).Bounds().Expand(distance)
+	lookup, err := idx.(sql.SpatialIndex).Intersecting(bounds)
+	if err != nil {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil, err
+	}
+
+	return idx, lookup, nil
+}
+
+// getDistanceIndex returns the spatial index and the lookup for comparisons
+// such as ST_DISTANCE(geometry, other) < distance, which can only be true
+// for the rows whose geometry is at most at the distance of the other one.
+func getDistanceIndex(
+	a *Analyzer,
+	hints indexHints,
+	e expression.Comparer,
+	aliases map[string]sql.Expression,
+) (sql.Index, sql.IndexLookup, error) {
+	var d *function.Distance
+	var limit sql.Expression
+	switch e.(type) {
+	case *expression.LessThan, *expression.LessThanOrEqual:
+		d, _ = e.Left().(*function.Distance)
+		limit = e.Right()
+	case *expression.GreaterThan, *expression.GreaterThanOrEqual:
+		d, _ = e.Right().(*function.Distance)
+		limit = e.Left()
+	}
+
+	if d == nil || !isEvaluable(limit) {
+		return nil, nil, nil
+	}
+
+	value, err := limit.Eval(sql.NewEmptyContext(), nil)
+	if err != nil || value == nil {
+		return nil, nil, err
+	}
+
+	distance, err := sql.Float64.Convert(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getSpatialIndex(a, hints, d.Left, d.Right, distance.(float64), aliases)
+}
+
 func comparisonIndexLookup(
 	c expression.Comparer,
 	idx sql.Index,
@@ -413,10 +566,15 @@ func comparisonIndexLookup(
 	return nil, nil
 }
 
-func getNegatedIndexes(a *Analyzer, not *expression.Not, aliases map[string]sql.Expression) (map[string]*indexLookup, error) {
+func getNegatedIndexes(
+	a *Analyzer,
+	hints indexHints,
+	not *expression.Not,
+	aliases map[string]sql.Expression,
+) (map[string]*indexLookup, error) {
 	switch e := not.Child.(type) {
 	case *expression.Not:
-		return getIndexes(e.Child, aliases, a)
+		return getIndexes(e.Child, aliases, a, hints)
 	case *expression.Equals:
 		left, right := e.Left(), e.Right()
 		// if the form is SOMETHING OP {INDEXABLE EXPR}, swap it, so it's {INDEXABLE EXPR} OP SOMETHING
@@ -428,7 +586,7 @@ func getNegatedIndexes(a *Analyzer, not *expression.Not, aliases map[string]sql.
 			return nil, nil
 		}
 
-		idx := a.Catalog.IndexByExpression(a.Catalog.CurrentDatabase(), unifyExpressions(aliases, left)...)
+		idx := indexByExpression(a, hints, unifyExpressions(aliases, left)...)
 		if idx == nil {
 			return nil, nil
 		}
@@ -460,37 +618,37 @@ func getNegatedIndexes(a *Analyzer, not *expression.Not, aliases map[string]sql.
 		return result, nil
 	case *expression.GreaterThan:
 		lte := expression.NewLessThanOrEqual(e.Left(), e.Right())
-		return getIndexes(lte, aliases, a)
+		return getIndexes(lte, aliases, a, hints)
 	case *expression.GreaterThanOrEqual:
 		lt := expression.NewLessThan(e.Left(), e.Right())
-		return getIndexes(lt, aliases, a)
+		return getIndexes(lt, aliases, a, hints)
 	case *expression.LessThan:
 		gte := expression.NewGreaterThanOrEqual(e.Left(), e.Right())
-		return getIndexes(gte, aliases, a)
+		return getIndexes(gte, aliases, a, hints)
 	case *expression.LessThanOrEqual:
 		gt := expression.NewGreaterThan(e.Left(), e.Right())
-		return getIndexes(gt, aliases, a)
+		return getIndexes(gt, aliases, a, hints)
 	case *expression.Between:
 		or := expression.NewOr(
 			expression.NewLessThan(e.Val, e.Lower),
 			expression.NewGreaterThan(e.Val, e.Upper),
 		)
 
-		return getIndexes(or, aliases, a)
+		return getIndexes(or, aliases, a, hints)
 	case *expression.Or:
 		and := expression.NewAnd(
 			expression.NewNot(e.Left),
 			expression.NewNot(e.Right),
 		)
 
-		return getIndexes(and, aliases, a)
+		return getIndexes(and, aliases, a, hints)
 	case *expression.And:
 		or := expression.NewOr(
 			expression.NewNot(e.Left),
 			expression.NewNot(e.Right),
 		)
 
-		return getIndexes(or, aliases, a)
+		return getIndexes(or, aliases, a, hints)
 	default:
 		return nil, nil
 
@@ -530,6 +688,7 @@ func indexesIntersection(
 func getMultiColumnIndexes(
 	exprs []sql.Expression,
 	a *Analyzer,
+	hints indexHints,
 	used map[sql.Expression]struct{},
 	aliases map[string]sql.Expression,
 ) (map[string]*indexLookup, error) {
@@ -543,7 +702,7 @@ func getMultiColumnIndexes(
 				cols[i] = e.col
 			}
 
-			exprList := a.Catalog.ExpressionsWithIndexes(a.Catalog.CurrentDatabase(), cols...)
+			exprList := a.Catalog.FilteredExpressionsWithIndexes(a.Catalog.CurrentDatabase(), hints.canLookup, cols...)
 
 			var selected []sql.Expression
 			for _, l := range exprList {
@@ -553,7 +712,7 @@ func getMultiColumnIndexes(
 			}
 
 			if len(selected) > 0 {
-				index, lookup, err := getMultiColumnIndexForExpressions(a, selected, exps, used, aliases)
+				index, lookup, err := getMultiColumnIndexForExpressions(a, hints, selected, exps, used, aliases)
 				if err != nil || lookup == nil {
 					if index != nil {
 						a.Catalog.ReleaseIndex(index)
@@ -582,12 +741,13 @@ func getMultiColumnIndexes(
 
 func getMultiColumnIndexForExpressions(
 	a *Analyzer,
+	hints indexHints,
 	selected []sql.Expression,
 	exprs []columnExpr,
 	used map[sql.Expression]struct{},
 	aliases map[string]sql.Expression,
 ) (index sql.Index, lookup sql.IndexLookup, err error) {
-	index = a.Catalog.IndexByExpression(a.Catalog.CurrentDatabase(), unifyExpressions(aliases, selected...)...)
+	index = indexByExpression(a, hints, unifyExpressions(aliases, selected...)...)
 	if index != nil {
 		var first sql.Expression
 		for _, e := range exprs {
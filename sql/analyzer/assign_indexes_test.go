@@ -46,7 +46,7 @@ func TestNegateIndex(t *testing.T) {
 		),
 	)
 
-	result, err := assignIndexes(a, node)
+	result, err := assignIndexes(a, node, nil)
 	require.NoError(err)
 
 	lookupIdxs, ok := result["t1"]
@@ -119,7 +119,7 @@ func TestAssignIndexes(t *testing.T) {
 		),
 	)
 
-	result, err := assignIndexes(a, node)
+	result, err := assignIndexes(a, node, nil)
 	require.NoError(err)
 
 	lookupIdxs, ok := result["t1"]
@@ -682,7 +682,7 @@ func TestGetIndexes(t *testing.T) {
 		t.Run(tt.expr.String(), func(t *testing.T) {
 			require := require.New(t)
 
-			result, err := getIndexes(tt.expr, nil, a)
+			result, err := getIndexes(tt.expr, nil, a, nil)
 			if tt.ok {
 				require.NoError(err)
 				require.Equal(tt.expected, result)
@@ -779,7 +779,7 @@ func TestGetMultiColumnIndexes(t *testing.T) {
 			lit(6),
 		),
 	}
-	result, err := getMultiColumnIndexes(exprs, a, used, nil)
+	result, err := getMultiColumnIndexes(exprs, a, nil, used, nil)
 	require.NoError(err)
 
 	expected := map[string]*indexLookup{
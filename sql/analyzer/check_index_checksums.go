@@ -0,0 +1,65 @@
+package analyzer
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+// checkIndexChecksums compares the checksums of the tables in the node with
+// the ones of their indexes, so the indexes of the tables that changed since
+// they were created are marked as outdated and not used by the rules that
+// assign indexes. If the registry is configured to do so, these indexes are
+// rebuilt in the background.
+func checkIndexChecksums(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	// Index statements check the status of the index on their own.
+	switch n.(type) {
+	case *plan.CreateIndex, *plan.DropIndex, *plan.RebuildIndex:
+		return n, nil
+	}
+
+	span, _ := ctx.Span("check_index_checksums")
+	defer span.Finish()
+
+	db := a.Catalog.CurrentDatabase()
+	var err error
+	plan.Inspect(n, func(node sql.Node) bool {
+		if err != nil {
+			return false
+		}
+
+		t, ok := node.(*plan.ResolvedTable)
+		if !ok {
+			return true
+		}
+
+		var outdated []sql.Index
+		outdated, err = a.Catalog.CheckIndexChecksums(db, t.Table)
+		if err != nil || !a.Catalog.RebuildOutdated {
+			return err == nil
+		}
+
+		for _, idx := range outdated {
+			a.Log("rebuilding outdated index %q", idx.ID())
+
+			// The rebuild outlives the query, so it can't use its context.
+			rctx := sql.NewContext(context.Background(), sql.WithSession(ctx.Session))
+			if err := plan.StartIndexRebuild(rctx, a.Catalog, idx, t.Table); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"id":  idx.ID(),
+					"err": err,
+				}).Warn("unable to rebuild outdated index")
+			}
+		}
+
+		return true
+	})
+
+	return n, err
+}
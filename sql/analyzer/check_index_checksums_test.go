@@ -0,0 +1,59 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+type checksumTable struct {
+	sql.Table
+	checksum string
+}
+
+func (t *checksumTable) Checksum() (string, error) { return t.checksum, nil }
+
+func TestCheckIndexChecksums(t *testing.T) {
+	require := require.New(t)
+
+	catalog := sql.NewCatalog()
+	idx := &dummyIndex{
+		"t1",
+		[]sql.Expression{
+			expression.NewGetFieldWithTable(0, sql.Int64, "t1", "foo", false),
+		},
+	}
+	done, ready, err := catalog.AddIndex(idx)
+	require.NoError(err)
+	close(done)
+	<-ready
+
+	a := NewDefault(catalog)
+	table := &checksumTable{
+		memory.NewTable("t1", sql.Schema{
+			{Name: "foo", Type: sql.Int64, Source: "t1"},
+		}),
+		"1",
+	}
+
+	// The index has no checksum, so any change in the table makes it
+	// outdated, but index statements are not checked.
+	node := plan.NewDropIndex("idx", plan.NewResolvedTable(table))
+	result, err := checkIndexChecksums(sql.NewEmptyContext(), a, node)
+	require.NoError(err)
+	require.Equal(node, result)
+	require.Equal(sql.IndexReady, catalog.IndexStatus(idx))
+
+	node2 := plan.NewProject(
+		[]sql.Expression{expression.NewGetFieldWithTable(0, sql.Int64, "t1", "foo", false)},
+		plan.NewResolvedTable(table),
+	)
+	result, err = checkIndexChecksums(sql.NewEmptyContext(), a, node2)
+	require.NoError(err)
+	require.Equal(node2, result)
+	require.Equal(sql.IndexOutdated, catalog.IndexStatus(idx))
+}
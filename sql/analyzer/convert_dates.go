@@ -25,6 +25,12 @@ func convertDates(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
 			return n, nil
 		}
 
+		// Column defaults and generated columns are converted to the type
+		// of their column when a row is inserted.
+		if _, ok := n.(*plan.CreateTable); ok {
+			return n, nil
+		}
+
 		// nodeReplacements are all the replacements found in the current node.
 		// These replacements are not applied to the current node, only to
 		// parent nodes.
@@ -158,11 +164,11 @@ func addDateConvert(
 			}
 		}
 
-		switch e.Type() {
-		case sql.Date:
+		switch t := e.Type(); {
+		case t == sql.Date:
 			result = expression.NewConvert(e, expression.ConvertToDate)
-		case sql.Timestamp:
-			result = expression.NewConvert(e, expression.ConvertToDatetime)
+		case sql.IsTimestamp(t):
+			result = expression.NewDatetimeConvert(e, sql.TimePrecision(t))
 		default:
 			result = e
 		}
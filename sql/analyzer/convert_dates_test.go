@@ -65,6 +65,18 @@ func TestConvertDates(t *testing.T) {
 				expression.ConvertToDatetime,
 			),
 		},
+		{
+			"timestamp with precision",
+			aggregation.NewMin(
+				expression.NewGetField(0, sql.TimestampWithPrecision(3), "foo", false),
+			),
+			aggregation.NewMin(
+				expression.NewDatetimeConvert(
+					expression.NewGetField(0, sql.TimestampWithPrecision(3), "foo", false),
+					3,
+				),
+			),
+		},
 		{
 			"min aggregation",
 			aggregation.NewMin(
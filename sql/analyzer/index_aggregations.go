@@ -0,0 +1,350 @@
+package analyzer
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+// indexAggregations replaces the aggregations without grouping of a single
+// table that can be answered by its indexes with an IndexAggregation node,
+// so the rows of the table are not read. COUNT(*) can be answered if the
+// table has no filter or its filter can be resolved exactly by an index
+// lookup, and MIN and MAX of a column if there is no filter and the column
+// has an index that knows its bounds.
+func indexAggregations(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+	span, _ := ctx.Span("index_aggregations")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	switch n.(type) {
+	case *plan.InsertInto, *plan.DeleteFrom, *plan.CreateIndex, *plan.RebuildIndex:
+		return n, nil
+	}
+
+	// The tables of the rewritten aggregations are not in the node anymore
+	// when the pushdown validates the hints, so they are validated here.
+	hints := findIndexHints(n)
+	if err := validateIndexHints(a, hints); err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(node sql.Node) (sql.Node, error) {
+		gb, ok := node.(*plan.GroupBy)
+		if !ok || len(gb.Grouping) > 0 {
+			return node, nil
+		}
+
+		return indexAggregation(a, gb, hints)
+	})
+}
+
+// indexAggregation returns the IndexAggregation node that answers the
+// aggregations of the given GroupBy, or the GroupBy itself if they can't be
+// answered by indexes. The indexes are released once the node is executed.
+func indexAggregation(a *Analyzer, gb *plan.GroupBy, hints indexHints) (sql.Node, error) {
+	var filter sql.Expression
+	child := gb.Child
+	if f, ok := child.(*plan.Filter); ok {
+		filter = f.Expression
+		child = f.Child
+	}
+
+	table, ok := child.(*plan.ResolvedTable)
+	if !ok {
+		return gb, nil
+	}
+
+	if _, ok := table.Table.(sql.IndexableTable); !ok {
+		return gb, nil
+	}
+
+	var indexes []sql.Index
+	release := func() {
+		for _, idx := range indexes {
+			a.Catalog.ReleaseIndex(idx)
+		}
+	}
+
+	var count bool
+	var aggregates = make([]plan.IndexAggregate, len(gb.Aggregate))
+	for i, e := range gb.Aggregate {
+		aggregates[i].Expression = e
+		if alias, ok := e.(*expression.Alias); ok {
+			e = alias.Child
+		}
+
+		if c, ok := e.(*aggregation.Count); ok && isCountOfRows(c) {
+			count = true
+			continue
+		}
+
+		col, max, ok := boundedColumn(e)
+		if !ok || filter != nil || col.Table() != table.Name() {
+			release()
+			return gb, nil
+		}
+
+		idx := boundedIndex(a, hints, col)
+		if idx == nil {
+			release()
+			return gb, nil
+		}
+
+		indexes = append(indexes, idx)
+		aggregates[i].Index = idx
+		aggregates[i].Max = max
+	}
+
+	var lookup sql.CountableIndexLookup
+	if count {
+		var l *indexLookup
+		var err error
+		if filter != nil {
+			l, err = exactIndexLookup(a, hints, table.Name(), filter)
+		} else {
+			l, err = allIndexLookup(a, hints, table.Name())
+		}
+
+		if err != nil || l == nil {
+			release()
+			return gb, err
+		}
+
+		indexes = append(indexes, l.indexes...)
+		lookup, ok = l.lookup.(sql.CountableIndexLookup)
+		if !ok {
+			release()
+			return gb, nil
+		}
+	}
+
+	a.Log("aggregations of table %q will be answered by indexes", table.Name())
+
+	node := plan.NewIndexAggregation(
+		aggregates,
+		table.Table,
+		lookup,
+		uniqueIndexes(indexes),
+		a.Catalog.IndexRegistry,
+	)
+	return &releaser{node, release}, nil
+}
+
+// isCountOfRows reports whether the COUNT aggregation counts all the rows,
+// which is the case of COUNT(*) and COUNT of a constant that is not NULL.
+func isCountOfRows(c *aggregation.Count) bool {
+	switch e := c.Child.(type) {
+	case *expression.Star:
+		return true
+	case *expression.Literal:
+		return e.Value() != nil
+	default:
+		return false
+	}
+}
+
+// boundedColumn returns the column of a MIN or MAX aggregation, and whether
+// it is a MAX.
+func boundedColumn(e sql.Expression) (col *expression.GetField, max bool, ok bool) {
+	switch e := e.(type) {
+	case *aggregation.Min:
+		col, ok = e.Child.(*expression.GetField)
+	case *aggregation.Max:
+		col, ok = e.Child.(*expression.GetField)
+		max = true
+	}
+	return col, max, ok
+}
+
+// boundedIndex returns the bounded index on the given column that can be
+// used according to the hints, or nil if there is none.
+func boundedIndex(a *Analyzer, hints indexHints, col *expression.GetField) sql.BoundedIndex {
+	idx := a.Catalog.FilteredIndexByExpression(
+		a.Catalog.CurrentDatabase(),
+		func(idx sql.Index) bool {
+			_, ok := idx.(sql.BoundedIndex)
+			return ok && hints.canLookup(idx)
+		},
+		col,
+	)
+	if idx == nil {
+		return nil
+	}
+
+	return idx.(sql.BoundedIndex)
+}
+
+// allIndexLookup returns a lookup with all the rows of the table from one
+// of its sorted indexes that can be used according to the hints, or nil if
+// there is none.
+func allIndexLookup(a *Analyzer, hints indexHints, table string) (*indexLookup, error) {
+	var result *indexLookup
+	for _, idx := range a.Catalog.IndexesByTable(a.Catalog.CurrentDatabase(), table) {
+		si, ok := idx.(sql.SortedIndex)
+		if result != nil || !ok || !a.Catalog.CanUseIndex(idx) || !hints.canLookup(idx) {
+			a.Catalog.ReleaseIndex(idx)
+			continue
+		}
+
+		lookup, err := si.All()
+		if err != nil {
+			a.Catalog.ReleaseIndex(idx)
+			if result != nil {
+				a.Catalog.ReleaseIndex(result.indexes[0])
+			}
+			return nil, err
+		}
+
+		result = &indexLookup{lookup, []sql.Index{idx}}
+	}
+
+	return result, nil
+}
+
+// exactIndexLookup returns a lookup with exactly the rows of the table that
+// satisfy the filter, or nil if there is no such lookup. Unlike the lookups
+// used by the pushdown, which may have more rows than the ones satisfying
+// the filter, it's only built from conjunctions and disjunctions of
+// comparisons between columns and values of their own type.
+func exactIndexLookup(
+	a *Analyzer,
+	hints indexHints,
+	table string,
+	filter sql.Expression,
+) (*indexLookup, error) {
+	switch e := filter.(type) {
+	case *expression.And, *expression.Or:
+		var left, right sql.Expression
+		if and, ok := e.(*expression.And); ok {
+			left, right = and.Left, and.Right
+		} else {
+			or := e.(*expression.Or)
+			left, right = or.Left, or.Right
+		}
+
+		l, err := exactIndexLookup(a, hints, table, left)
+		if err != nil || l == nil {
+			return nil, err
+		}
+
+		r, err := exactIndexLookup(a, hints, table, right)
+		if err != nil || r == nil || !canMergeIndexes(l.lookup, r.lookup) {
+			for _, idx := range l.indexes {
+				a.Catalog.ReleaseIndex(idx)
+			}
+			if r != nil {
+				for _, idx := range r.indexes {
+					a.Catalog.ReleaseIndex(idx)
+				}
+			}
+			return nil, err
+		}
+
+		if _, ok := e.(*expression.And); ok {
+			l.lookup = l.lookup.(sql.SetOperations).Intersection(r.lookup)
+		} else {
+			l.lookup = l.lookup.(sql.SetOperations).Union(r.lookup)
+		}
+		l.indexes = append(l.indexes, r.indexes...)
+		return l, nil
+	}
+
+	if !isExactIndexFilter(table, filter) {
+		return nil, nil
+	}
+
+	result, err := getIndexes(filter, nil, a, hints)
+	if err != nil {
+		return nil, err
+	}
+
+	return result[table], nil
+}
+
+// isExactIndexFilter reports whether the expression is a comparison, IN or
+// BETWEEN of a column of the table on the left side with values of its own
+// type, which are the ones whose index lookups have exactly the rows that
+// satisfy them.
+func isExactIndexFilter(table string, e sql.Expression) bool {
+	var left sql.Expression
+	var values []sql.Expression
+	switch e := e.(type) {
+	case *expression.In:
+		tuple, ok := e.Right().(expression.Tuple)
+		if !ok || len(tuple) < 2 {
+			return false
+		}
+		left, values = e.Left(), tuple
+	case *expression.Between:
+		left, values = e.Val, []sql.Expression{e.Lower, e.Upper}
+	case *expression.Equals,
+		*expression.LessThan,
+		*expression.GreaterThan,
+		*expression.LessThanOrEqual,
+		*expression.GreaterThanOrEqual:
+		c := e.(expression.Comparer)
+		left, values = c.Left(), []sql.Expression{c.Right()}
+	default:
+		return false
+	}
+
+	col, ok := left.(*expression.GetField)
+	if !ok || col.Table() != table {
+		return false
+	}
+
+	for _, v := range values {
+		lit, ok := v.(*expression.Literal)
+		if !ok || lit.Value() == nil || !isSameValueType(col.Type(), lit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSameValueType reports whether the literal is compared with values of the
+// given type as the index compares it with its keys, which are converted to
+// that type. Integers are only if the conversion doesn't change them.
+func isSameValueType(t sql.Type, lit *expression.Literal) bool {
+	switch {
+	case t == lit.Type():
+		return true
+	case sql.IsText(t) && sql.IsText(lit.Type()):
+		return true
+	case sql.IsInteger(t) && sql.IsInteger(lit.Type()):
+		v, err := t.Convert(lit.Value())
+		if err != nil {
+			return false
+		}
+
+		cmp, err := lit.Type().Compare(lit.Value(), v)
+		return err == nil && cmp == 0
+	default:
+		return false
+	}
+}
+
+// uniqueIndexes returns the given indexes without the repeated ones.
+func uniqueIndexes(indexes []sql.Index) []sql.Index {
+	var result []sql.Index
+	for _, idx := range indexes {
+		var found bool
+		for _, i := range result {
+			if isSameIndex(i, idx) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, idx)
+		}
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexAggregations(t *testing.T) {
+	catalog, table := sortIndexesCatalog(t)
+	a := NewDefault(catalog)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	s := expression.NewGetFieldWithTable(1, sql.Text, "mytable", "s", true)
+	count := aggregation.NewCount(expression.NewStar())
+
+	groupBy := func(filter sql.Expression, aggregate ...sql.Expression) *plan.GroupBy {
+		var child sql.Node = plan.NewResolvedTable(table)
+		if filter != nil {
+			child = plan.NewFilter(filter, child)
+		}
+		return plan.NewGroupBy(aggregate, nil, child)
+	}
+
+	testCases := []struct {
+		name     string
+		node     sql.Node
+		expected []sql.Row
+	}{
+		{
+			"count with filter",
+			groupBy(or(gt(i, lit(2)), eq(i, lit(0))), count),
+			[]sql.Row{{int64(2)}},
+		},
+		{
+			"count with filter on text column",
+			groupBy(eq(s, expression.NewLiteral("foo", sql.Text)), count),
+			[]sql.Row{{int64(0)}},
+		},
+		{
+			"count without filter",
+			groupBy(nil, expression.NewAlias(count, "c")),
+			[]sql.Row{{int64(4)}},
+		},
+		{
+			"min and max",
+			groupBy(nil, aggregation.NewMin(s), aggregation.NewMax(i), aggregation.NewMin(i)),
+			[]sql.Row{{nil, int64(3), int64(0)}},
+		},
+		{
+			"count with inexact filter",
+			groupBy(eq(expression.NewArithmetic(i, lit(1), "+"), lit(2)), count),
+			nil,
+		},
+		{
+			"count with filter of another type",
+			groupBy(eq(i, expression.NewLiteral(1.5, sql.Float64)), count),
+			nil,
+		},
+		{
+			"max with filter",
+			groupBy(eq(i, lit(1)), aggregation.NewMax(i)),
+			nil,
+		},
+		{
+			"count of column",
+			groupBy(nil, aggregation.NewCount(s)),
+			nil,
+		},
+		{
+			"grouping",
+			plan.NewGroupBy([]sql.Expression{count}, []sql.Expression{s}, plan.NewResolvedTable(table)),
+			nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			result, err := indexAggregations(ctx, a, tt.node)
+			require.NoError(t, err)
+
+			if tt.expected == nil {
+				require.Equal(t, tt.node, result)
+				return
+			}
+
+			r, ok := result.(*releaser)
+			require.True(t, ok)
+			require.IsType(t, (*plan.IndexAggregation)(nil), r.Child)
+			require.Equal(t, tt.node.Schema(), result.Schema())
+
+			iter, err := result.RowIter(ctx)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(iter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrIndexHintNotFound is returned when an index hint mentions an index
+	// that does not exist on the table.
+	ErrIndexHintNotFound = errors.NewKind("index %q does not exist on table %q")
+
+	// ErrForcedIndexNotUsable is returned when none of the indexes forced
+	// with a FORCE INDEX hint can be used to resolve the query.
+	ErrForcedIndexNotUsable = errors.NewKind("forced index %s can't be used to resolve the query on table %q")
+)
+
+// tableHint is the index hint given in a reference to a table.
+type tableHint struct {
+	table string
+	hint  *plan.IndexHint
+}
+
+// indexHints contains the index hints of the tables of a query by table
+// reference, which is the alias of the table or its name if it has no alias.
+type indexHints map[string]tableHint
+
+// findIndexHints returns the index hints of all the table references in the
+// node.
+func findIndexHints(n sql.Node) indexHints {
+	var hints = make(indexHints)
+	plan.Inspect(n, func(node sql.Node) bool {
+		switch node := node.(type) {
+		case *plan.TableAlias:
+			if t, ok := node.Child.(*plan.ResolvedTable); ok {
+				if t.IndexHint != nil {
+					hints[node.Name()] = tableHint{t.Name(), t.IndexHint}
+				}
+				return false
+			}
+		case *plan.ResolvedTable:
+			if node.IndexHint != nil {
+				hints[node.Name()] = tableHint{node.Name(), node.IndexHint}
+			}
+		}
+		return true
+	})
+	return hints
+}
+
+// canUse reports whether the index can be used according to the hints of
+// the references to its table. Columns and index lookups refer to tables and
+// not to their references, so a lookup is used for all the references to its
+// table, and the index must be allowed by all of their hints.
+func (h indexHints) canUse(idx sql.Index) bool {
+	for _, th := range h {
+		if th.table == idx.Table() && !th.hint.Allows(idx.ID()) {
+			return false
+		}
+	}
+	return true
+}
+
+// canLookup reports whether the index can be used to look up values of its
+// expressions according to the hint of its table. Full-text indexes can only
+// be used by MATCH expressions, and spatial indexes by spatial functions.
+func (h indexHints) canLookup(idx sql.Index) bool {
+	switch idx.(type) {
+	case sql.FullTextIndex, sql.SpatialIndex:
+		return false
+	}
+	return h.canUse(idx)
+}
+
+// indexByExpression returns the index for the given expressions in the
+// current database that can be used to look up their values according to the
+// hints, or nil if there is none.
+func indexByExpression(a *Analyzer, hints indexHints, exprs ...sql.Expression) sql.Index {
+	return a.Catalog.FilteredIndexByExpression(a.Catalog.CurrentDatabase(), hints.canLookup, exprs...)
+}
+
+// validateIndexHints checks that all the indexes mentioned in the hints exist
+// on their tables.
+func validateIndexHints(a *Analyzer, hints indexHints) error {
+	db := a.Catalog.CurrentDatabase()
+	for _, th := range hints {
+		indexes := a.Catalog.IndexesByTable(db, th.table)
+		for _, idx := range indexes {
+			a.Catalog.ReleaseIndex(idx)
+		}
+
+	Hints:
+		for _, name := range th.hint.Indexes {
+			for _, idx := range indexes {
+				if strings.EqualFold(idx.ID(), name) {
+					continue Hints
+				}
+			}
+
+			return ErrIndexHintNotFound.New(name, th.table)
+		}
+	}
+
+	return nil
+}
+
+// checkForcedIndexes checks that all the tables with a FORCE INDEX hint have
+// an index lookup, which can only be of the forced indexes.
+func checkForcedIndexes(hints indexHints, indexes map[string]*indexLookup) error {
+	for _, th := range hints {
+		if th.hint.Type != plan.ForceIndex {
+			continue
+		}
+
+		if _, ok := indexes[th.table]; !ok {
+			return ErrForcedIndexNotUsable.New(strings.Join(th.hint.Indexes, ", "), th.table)
+		}
+	}
+
+	return nil
+}
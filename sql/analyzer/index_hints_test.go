@@ -0,0 +1,132 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignIndexesWithHints(t *testing.T) {
+	catalog := sql.NewCatalog()
+	idx := &dummyIndex{
+		"t1",
+		[]sql.Expression{
+			expression.NewGetFieldWithTable(0, sql.Int64, "t1", "foo", false),
+		},
+	}
+	done, ready, err := catalog.AddIndex(idx)
+	require.NoError(t, err)
+	close(done)
+	<-ready
+
+	a := NewDefault(catalog)
+
+	t1 := memory.NewTable("t1", sql.Schema{
+		{Name: "foo", Type: sql.Int64, Source: "t1"},
+	})
+
+	testCases := []struct {
+		name     string
+		hint     *plan.IndexHint
+		hasIndex bool
+	}{
+		{"no hint", nil, true},
+		{"use index", plan.NewIndexHint(plan.UseIndex, "t1.foo"), true},
+		{"use no index", plan.NewIndexHint(plan.UseIndex), false},
+		{"force index", plan.NewIndexHint(plan.ForceIndex, "T1.FOO"), true},
+		{"ignore index", plan.NewIndexHint(plan.IgnoreIndex, "t1.foo"), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			table := plan.NewResolvedTable(t1)
+			table.IndexHint = tt.hint
+
+			node := plan.NewProject(
+				[]sql.Expression{},
+				plan.NewFilter(
+					expression.NewEquals(
+						expression.NewGetFieldWithTable(0, sql.Int64, "t1", "foo", false),
+						expression.NewLiteral(int64(1), sql.Int64),
+					),
+					table,
+				),
+			)
+
+			hints := findIndexHints(node)
+			require.NoError(t, validateIndexHints(a, hints))
+
+			result, err := assignIndexes(a, node, hints)
+			require.NoError(t, err)
+
+			_, ok := result["t1"]
+			require.Equal(t, tt.hasIndex, ok)
+			require.NoError(t, checkForcedIndexes(hints, result))
+		})
+	}
+}
+
+func TestIndexHintsErrors(t *testing.T) {
+	require := require.New(t)
+
+	catalog := sql.NewCatalog()
+	a := NewDefault(catalog)
+
+	hints := indexHints{"t1": {"t1", plan.NewIndexHint(plan.UseIndex, "idx_foo")}}
+	err := validateIndexHints(a, hints)
+	require.Error(err)
+	require.True(ErrIndexHintNotFound.Is(err))
+
+	hints = indexHints{"t": {"t1", plan.NewIndexHint(plan.ForceIndex, "idx_foo")}}
+	err = checkForcedIndexes(hints, nil)
+	require.Error(err)
+	require.True(ErrForcedIndexNotUsable.Is(err))
+
+	hints = indexHints{"t1": {"t1", plan.NewIndexHint(plan.IgnoreIndex, "idx_foo")}}
+	require.NoError(checkForcedIndexes(hints, nil))
+}
+
+func TestIndexHintsSelfJoin(t *testing.T) {
+	require := require.New(t)
+
+	idx := &dummyIndex{
+		"t1",
+		[]sql.Expression{
+			expression.NewGetFieldWithTable(0, sql.Int64, "t1", "foo", false),
+		},
+	}
+
+	t1 := memory.NewTable("t1", sql.Schema{
+		{Name: "foo", Type: sql.Int64, Source: "t1"},
+	})
+
+	join := func(a, b *plan.IndexHint) sql.Node {
+		ta := plan.NewResolvedTable(t1)
+		ta.IndexHint = a
+		tb := plan.NewResolvedTable(t1)
+		tb.IndexHint = b
+		return plan.NewCrossJoin(
+			plan.NewTableAlias("a", ta),
+			plan.NewTableAlias("b", tb),
+		)
+	}
+
+	hints := findIndexHints(join(
+		plan.NewIndexHint(plan.UseIndex, "t1.foo"),
+		plan.NewIndexHint(plan.IgnoreIndex, "t1.foo"),
+	))
+	require.Len(hints, 2)
+	require.Equal("t1", hints["a"].table)
+	require.Equal(plan.UseIndex, hints["a"].hint.Type)
+	require.Equal("t1", hints["b"].table)
+	require.Equal(plan.IgnoreIndex, hints["b"].hint.Type)
+	require.False(hints.canUse(idx))
+
+	hints = findIndexHints(join(plan.NewIndexHint(plan.UseIndex, "t1.foo"), nil))
+	require.Len(hints, 1)
+	require.True(hints.canUse(idx))
+}
@@ -383,6 +383,11 @@ func evalFilter(ctx *sql.Context, a *Analyzer, node sql.Node) (sql.Node, error)
 				return e, nil
 			case *expression.Literal, expression.Tuple:
 				return e, nil
+			case *expression.Collate:
+				// A collation given with COLLATE takes precedence over the
+				// collations of columns, so it must not be turned into a
+				// literal.
+				return e, nil
 			default:
 				if !isEvaluable(e) {
 					return e, nil
@@ -17,7 +17,7 @@ var (
 func shouldParallelize(node sql.Node) bool {
 	// Do not try to parallelize index operations.
 	switch node.(type) {
-	case *plan.CreateIndex, *plan.DropIndex, *plan.Describe:
+	case *plan.CreateIndex, *plan.DropIndex, *plan.RebuildIndex, *plan.Describe:
 		return false
 	default:
 		return true
@@ -65,9 +65,11 @@ func trackProcess(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
 		return nil, err
 	}
 
-	// Don't wrap CreateIndex in a QueryProcess, as it is a CreateIndexProcess.
-	// CreateIndex will take care of marking the process as done on its own.
-	if _, ok := n.(*plan.CreateIndex); ok {
+	// Don't wrap CreateIndex or RebuildIndex in a QueryProcess, as they are
+	// index processes. They will take care of marking the process as done on
+	// their own.
+	switch n.(type) {
+	case *plan.CreateIndex, *plan.RebuildIndex:
 		return n, nil
 	}
 
@@ -20,7 +20,7 @@ func pushdown(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
 
 	// don't do pushdown on certain queries
 	switch n.(type) {
-	case *plan.InsertInto, *plan.DeleteFrom, *plan.CreateIndex:
+	case *plan.InsertInto, *plan.DeleteFrom, *plan.CreateIndex, *plan.RebuildIndex:
 		return n, nil
 	}
 
@@ -37,16 +37,37 @@ func pushdown(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
 	a.Log("finding filters in node")
 	filters := findFilters(ctx, n)
 
+	hints := findIndexHints(n)
+	if err := validateIndexHints(a, hints); err != nil {
+		return nil, err
+	}
+
 	indexSpan, _ := ctx.Span("assign_indexes")
-	indexes, err := assignIndexes(a, n)
+	indexes, err := assignIndexes(a, n, hints)
 	if err != nil {
 		return nil, err
 	}
 	indexSpan.Finish()
 
+	sortSpan, _ := ctx.Span("assign_sort_indexes")
+	n, indexes, orders, err := assignSortIndexes(a, n, indexes, hints)
+	if err != nil {
+		return nil, err
+	}
+	sortSpan.Finish()
+
+	if err := checkForcedIndexes(hints, indexes); err != nil {
+		for _, lookup := range indexes {
+			for _, idx := range lookup.indexes {
+				a.Catalog.ReleaseIndex(idx)
+			}
+		}
+		return nil, err
+	}
+
 	a.Log("transforming nodes with pushdown of filters, projections and indexes")
 
-	return transformPushdown(a, n, filters, indexes, fieldsByTable)
+	return transformPushdown(a, n, filters, indexes, orders, fieldsByTable)
 }
 
 // fixFieldIndexesOnExpressions executes fixFieldIndexes on a list of exprs.
@@ -126,6 +147,7 @@ func transformPushdown(
 	n sql.Node,
 	filters filters,
 	indexes map[string]*indexLookup,
+	orders map[string][]plan.SortField,
 	fieldsByTable map[string][]string,
 ) (sql.Node, error) {
 	// Now all nodes can be transformed. Since traversal of the tree is done
@@ -148,6 +170,7 @@ func transformPushdown(
 				&queryIndexes,
 				fieldsByTable,
 				indexes,
+				orders,
 			)
 		default:
 			return transformExpressioners(node)
@@ -242,10 +265,20 @@ func pushdownTable(
 	queryIndexes *[]sql.Index,
 	fieldsByTable map[string][]string,
 	indexes map[string]*indexLookup,
+	orders map[string][]plan.SortField,
 ) (sql.Node, error) {
 	var table = node.Table
 
-	if ft, ok := table.(sql.FilteredTable); ok {
+	indexLookup, hasLookup := indexes[node.Name()]
+	_, indexable := table.(sql.IndexableTable)
+	hasLookup = hasLookup && indexable
+
+	// If the lookup can return all the columns needed, the rows are read
+	// from it instead of the table, so filters are not pushed down to the
+	// table.
+	covering := hasLookup && isCoveringLookup(node.Name(), indexLookup, fieldsByTable[node.Name()])
+
+	if ft, ok := table.(sql.FilteredTable); ok && !covering {
 		tableFilters := filters[node.Name()]
 		handled := ft.HandledFilters(tableFilters)
 		*handledFilters = append(*handledFilters, handled...)
@@ -269,18 +302,64 @@ func pushdownTable(
 		a.Log("table %q transformed with pushdown of projection", node.Name())
 	}
 
-	if it, ok := table.(sql.IndexableTable); ok {
-		indexLookup, ok := indexes[node.Name()]
-		if ok {
-			*queryIndexes = append(*queryIndexes, indexLookup.indexes...)
+	if hasLookup {
+		*queryIndexes = append(*queryIndexes, indexLookup.indexes...)
+		if covering {
+			table = plan.NewCoveringTable(
+				table,
+				indexLookup.indexes[0],
+				indexLookup.lookup.(sql.CoveringIndexLookup),
+			)
+			a.Log("table %q transformed with covering index", node.Name())
+		} else if it, ok := table.(sql.IndexableTable); ok {
 			table = it.WithIndexLookup(indexLookup.lookup)
 			a.Log("table %q transformed with pushdown of index", node.Name())
 		}
 	}
 
+	if fields, ok := orders[node.Name()]; ok && hasLookup {
+		var sortFields = make([]plan.SortField, len(fields))
+		for i, f := range fields {
+			col, err := fixFieldIndexes(table.Schema(), f.Column)
+			if err != nil {
+				return nil, err
+			}
+
+			sortFields[i] = plan.SortField{
+				Column:       col,
+				Order:        f.Order,
+				NullOrdering: f.NullOrdering,
+			}
+		}
+
+		table = plan.NewSortedTable(table, sortFields)
+		a.Log("table %q transformed with sorted index lookup", node.Name())
+	}
+
+	if hasLookup {
+		table = plan.NewIndexUsageTable(table, a.Catalog.IndexRegistry, indexLookup.indexes)
+	}
+
 	return plan.NewResolvedTable(table), nil
 }
 
+// isCoveringLookup reports whether the lookup can return the values of all
+// the given columns of the table.
+func isCoveringLookup(table string, lookup *indexLookup, columns []string) bool {
+	if _, ok := lookup.lookup.(sql.CoveringIndexLookup); !ok {
+		return false
+	}
+
+	exprs := lookup.indexes[0].Expressions()
+	for _, c := range columns {
+		if !stringContains(exprs, table+"."+c) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func pushdownFilter(
 	a *Analyzer,
 	node *plan.Filter,
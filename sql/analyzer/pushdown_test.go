@@ -177,7 +177,7 @@ func TestPushdownIndexable(t *testing.T) {
 				expression.NewGetFieldWithTable(0, sql.Int32, "mytable", "i", false),
 			},
 			plan.NewCrossJoin(
-				plan.NewResolvedTable(
+				plan.NewResolvedTable(plan.NewIndexUsageTable(
 					table.WithFilters([]sql.Expression{
 						expression.NewEquals(
 							expression.NewGetFieldWithTable(1, sql.Float64, "mytable", "f", false),
@@ -190,8 +190,10 @@ func TestPushdownIndexable(t *testing.T) {
 					}).(*memory.Table).
 						WithProjection([]string{"i", "f"}).(*memory.Table).
 						WithIndexLookup(&mergeableIndexLookup{id: "3.14"}),
-				),
-				plan.NewResolvedTable(
+					catalog.IndexRegistry,
+					[]sql.Index{idx2},
+				)),
+				plan.NewResolvedTable(plan.NewIndexUsageTable(
 					table2.WithFilters([]sql.Expression{
 						expression.NewNot(
 							expression.NewEquals(
@@ -202,7 +204,9 @@ func TestPushdownIndexable(t *testing.T) {
 					}).(*memory.Table).
 						WithProjection([]string{"i2"}).(*memory.Table).
 						WithIndexLookup(&negateIndexLookup{value: "2"}),
-				),
+					catalog.IndexRegistry,
+					[]sql.Index{idx3},
+				)),
 			),
 		),
 		nil,
@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+// resolveColumnDefaults replaces the DEFAULT keyword given as the value of a
+// column in the rows of an INSERT with the default value of the column.
+func resolveColumnDefaults(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+	span, _ := ctx.Span("resolve_column_defaults")
+	defer span.Finish()
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		insert, ok := n.(*plan.InsertInto)
+		if !ok || !insert.Left.Resolved() {
+			return n, nil
+		}
+
+		values, ok := insert.Right.(*plan.Values)
+		if !ok {
+			return n, nil
+		}
+
+		schema := insert.Left.Schema()
+		columns := insert.Columns
+		if len(columns) == 0 {
+			columns = make([]string, len(schema))
+			for i, col := range schema {
+				columns[i] = col.Name
+			}
+		}
+
+		var changed bool
+		tuples := make([][]sql.Expression, len(values.ExpressionTuples))
+		for i, tuple := range values.ExpressionTuples {
+			tuples[i] = make([]sql.Expression, len(tuple))
+			for j, e := range tuple {
+				tuples[i][j] = e
+				if _, ok := e.(*expression.DefaultColumn); !ok || j >= len(columns) {
+					continue
+				}
+
+				col := schemaColumn(schema, columns[j])
+				if col == nil {
+					continue
+				}
+
+				a.Log("resolved DEFAULT value of column %q", col.Name)
+				tuples[i][j] = expression.NewColumnDefault(col)
+				changed = true
+			}
+		}
+
+		if !changed {
+			return n, nil
+		}
+
+		return insert.WithChildren(insert.Left, plan.NewValues(tuples))
+	})
+}
+
+func schemaColumn(schema sql.Schema, name string) *sql.Column {
+	for _, col := range schema {
+		if strings.EqualFold(col.Name, name) {
+			return col
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+func TestResolveColumnDefaults(t *testing.T) {
+	require := require.New(t)
+
+	f := getRule("resolve_column_defaults")
+
+	schema := sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "mytable"},
+		{Name: "b", Type: sql.Text, Source: "mytable", Default: "foo"},
+	}
+	table := plan.NewResolvedTable(memory.NewTable("mytable", schema))
+
+	values := func(tuples ...[]sql.Expression) sql.Node {
+		return plan.NewValues(tuples)
+	}
+	one := expression.NewLiteral(int64(1), sql.Int64)
+
+	testCases := []struct {
+		name     string
+		node     sql.Node
+		expected sql.Node
+	}{
+		{
+			"all columns",
+			plan.NewInsertInto(table, values(
+				[]sql.Expression{one, expression.NewDefaultColumn("")},
+			), false, nil),
+			plan.NewInsertInto(table, values(
+				[]sql.Expression{one, expression.NewColumnDefault(schema[1])},
+			), false, nil),
+		},
+		{
+			"given columns",
+			plan.NewInsertInto(table, values(
+				[]sql.Expression{expression.NewDefaultColumn(""), one},
+			), false, []string{"B", "a"}),
+			plan.NewInsertInto(table, values(
+				[]sql.Expression{expression.NewColumnDefault(schema[1]), one},
+			), false, []string{"B", "a"}),
+		},
+		{
+			"no defaults",
+			plan.NewInsertInto(table, values(
+				[]sql.Expression{one, one},
+			), false, nil),
+			plan.NewInsertInto(table, values(
+				[]sql.Expression{one, one},
+			), false, nil),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Apply(sql.NewEmptyContext(), NewDefault(nil), tt.node)
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
@@ -244,6 +244,10 @@ func getColumnsInNodes(nodes []sql.Node, columns map[string][]string) {
 			for _, col := range n.Schema() {
 				indexCol(col.Source, col.Name)
 			}
+		case *plan.JSONTable:
+			for _, col := range n.Schema() {
+				indexCol(col.Source, col.Name)
+			}
 		case *plan.Project:
 			indexExpressions(n.Projections)
 		case *plan.GroupBy:
@@ -266,6 +270,10 @@ func getNodesAvailableTables(tables map[string]string, nodes ...sql.Node) {
 		case *plan.SubqueryAlias, *plan.ResolvedTable:
 			name := strings.ToLower(n.(sql.Nameable).Name())
 			tables[name] = name
+		case *plan.JSONTable:
+			name := strings.ToLower(n.Name())
+			tables[name] = name
+			getNodesAvailableTables(tables, n.Children()...)
 		case *plan.TableAlias:
 			switch t := n.Child.(type) {
 			case *plan.ResolvedTable, *plan.UnresolvedTable:
@@ -325,6 +333,10 @@ func resolveColumns(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error)
 				return resolveGlobalOrSessionColumn(ctx, uc)
 			}
 
+			if isUserVariableColumn(uc) {
+				return resolveUserVariableColumn(ctx, uc)
+			}
+
 			return resolveColumnExpression(ctx, uc, columns)
 		})
 	})
@@ -358,6 +370,14 @@ func resolveGlobalOrSessionColumn(ctx *sql.Context, col column) (sql.Expression,
 	return expression.NewGetSessionField(name, typ, value), nil
 }
 
+// resolveUserVariableColumn returns the value of the user variable of the
+// column, which is NULL if it has not been set.
+func resolveUserVariableColumn(ctx *sql.Context, col column) (sql.Expression, error) {
+	name := strings.ToLower(col.Name())
+	typ, value := ctx.Get(name)
+	return expression.NewGetSessionField(name, typ, value), nil
+}
+
 func resolveColumnExpression(
 	ctx *sql.Context,
 	e column,
@@ -567,3 +587,7 @@ func dedupStrings(in []string) []string {
 func isGlobalOrSessionColumn(col column) bool {
 	return strings.HasPrefix(col.Name(), "@@") || strings.HasPrefix(col.Table(), "@@")
 }
+
+func isUserVariableColumn(col column) bool {
+	return col.Table() == "" && strings.HasPrefix(col.Name(), "@")
+}
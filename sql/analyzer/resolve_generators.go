@@ -9,8 +9,8 @@ import (
 )
 
 var (
-	errMultipleGenerators = errors.NewKind("there can't be more than 1 instance of EXPLODE in a SELECT")
-	errExplodeNotArray    = errors.NewKind("argument of type %q given to EXPLODE, expecting array")
+	errMultipleGenerators = errors.NewKind("there can't be more than 1 instance of EXPLODE or UNNEST in a SELECT")
+	errExplodeNotArray    = errors.NewKind("argument of type %q given to EXPLODE or UNNEST, expecting array")
 )
 
 func resolveGenerators(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
@@ -66,12 +66,12 @@ func findGenerator(exprs []sql.Expression) (*generator, error) {
 		switch e := e.(type) {
 		case *function.Explode:
 			found = true
-			g.expr = function.NewGenerate(e.Child)
+			g.expr = e.Generate()
 		case *expression.Alias:
 			if exp, ok := e.Child.(*function.Explode); ok {
 				found = true
 				g.expr = expression.NewAlias(
-					function.NewGenerate(exp.Child),
+					exp.Generate(),
 					e.Name(),
 				)
 			}
@@ -50,6 +50,8 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error)
 
 		a.Log("table resolved: %q", t.Name())
 
-		return plan.NewResolvedTable(rt), nil
+		table := plan.NewResolvedTable(rt)
+		table.IndexHint = t.IndexHint
+		return table, nil
 	})
 }
@@ -27,7 +27,9 @@ var DefaultRules = []Rule{
 // DefaultRules.
 var OnceBeforeDefault = []Rule{
 	{"resolve_subqueries", resolveSubqueries},
+	{"apply_policies", applyPolicies},
 	{"resolve_tables", resolveTables},
+	{"resolve_column_defaults", resolveColumnDefaults},
 	{"check_aliases", checkAliases},
 }
 
@@ -39,6 +41,9 @@ var OnceAfterDefault = []Rule{
 	{"assign_catalog", assignCatalog},
 	{"prune_columns", pruneColumns},
 	{"convert_dates", convertDates},
+	{"check_index_checksums", checkIndexChecksums},
+	{"assign_fulltext_indexes", assignFullTextIndexes},
+	{"index_aggregations", indexAggregations},
 	{"pushdown", pushdown},
 	{"erase_projection", eraseProjection},
 }
@@ -0,0 +1,215 @@
+package analyzer
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+// assignSortIndexes removes the Sort nodes that sort the rows of a single
+// table when a sorted index lookup of that table can return them already
+// sorted. The lookups are added to the given indexes, and the returned map
+// contains the fields by which the rows of each of those tables are sorted.
+func assignSortIndexes(
+	a *Analyzer,
+	n sql.Node,
+	indexes map[string]*indexLookup,
+	hints indexHints,
+) (sql.Node, map[string]*indexLookup, map[string][]plan.SortField, error) {
+	a.Log("assigning sort indexes, node of type: %T", n)
+
+	var orders = make(map[string][]plan.SortField)
+	node, err := plan.TransformUp(n, func(node sql.Node) (sql.Node, error) {
+		sort, ok := node.(*plan.Sort)
+		if !ok {
+			return node, nil
+		}
+
+		table, aliases := sortedTable(sort.Child)
+		if table == nil {
+			return node, nil
+		}
+
+		if _, ok := table.Table.(sql.IndexableTable); !ok {
+			return node, nil
+		}
+
+		if _, ok := orders[table.Name()]; ok {
+			return node, nil
+		}
+
+		fields := tableSortFields(table.Name(), sort.SortFields, aliases)
+		if fields == nil {
+			return node, nil
+		}
+
+		lookup, err := sortedIndexLookup(a, hints, fields, indexes[table.Name()])
+		if err != nil || lookup == nil {
+			return node, err
+		}
+
+		if indexes == nil {
+			indexes = make(map[string]*indexLookup)
+		}
+
+		indexes[table.Name()] = lookup
+		orders[table.Name()] = fields
+		a.Log("sort of table %q will be done by index %q", table.Name(), lookup.indexes[0].ID())
+
+		return sort.Child, nil
+	})
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return node, indexes, orders, nil
+}
+
+// sortedTable returns the table whose rows are the ones of the given node,
+// in the same order, and the aliases defined in between.
+func sortedTable(node sql.Node) (*plan.ResolvedTable, map[string]sql.Expression) {
+	aliases := make(map[string]sql.Expression)
+	for {
+		switch n := node.(type) {
+		case *plan.Project:
+			for _, e := range n.Projections {
+				if alias, ok := e.(*expression.Alias); ok {
+					if _, ok := aliases[alias.Name()]; !ok {
+						aliases[alias.Name()] = alias.Child
+					}
+				}
+			}
+			node = n.Child
+		case *plan.Filter:
+			node = n.Child
+		case *plan.ResolvedTable:
+			return n, aliases
+		default:
+			return nil, nil
+		}
+	}
+}
+
+// tableSortFields returns the given sort fields as columns of the given
+// table. It returns nil if some of them is not a column of the table or
+// if they are not all sorted in the same order.
+func tableSortFields(
+	table string,
+	sortFields []plan.SortField,
+	aliases map[string]sql.Expression,
+) []plan.SortField {
+	var fields = make([]plan.SortField, len(sortFields))
+	for i, f := range sortFields {
+		col, ok := unifyExpressions(aliases, f.Column)[0].(*expression.GetField)
+		if !ok || col.Table() != table || f.Order != sortFields[0].Order {
+			return nil
+		}
+
+		fields[i] = plan.SortField{
+			Column:       col,
+			Order:        f.Order,
+			NullOrdering: f.NullOrdering,
+		}
+	}
+
+	return fields
+}
+
+// sortedIndexLookup returns a lookup that returns the rows of each partition
+// sorted by the given fields, or nil if there is no index to do it. If the
+// table already has a lookup, it must be a lookup of that index.
+func sortedIndexLookup(
+	a *Analyzer,
+	hints indexHints,
+	fields []plan.SortField,
+	lookup *indexLookup,
+) (*indexLookup, error) {
+	var cols = make([]sql.Expression, len(fields))
+	for i, f := range fields {
+		cols[i] = f.Column
+	}
+
+	idx := indexByExpression(a, hints, cols...)
+	if idx == nil {
+		return nil, nil
+	}
+
+	// The index must be sorted by the same columns in the same order.
+	exprs := idx.Expressions()
+	if len(exprs) != len(cols) {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil
+	}
+
+	for i, e := range exprs {
+		if e != cols[i].String() {
+			a.Catalog.ReleaseIndex(idx)
+			return nil, nil
+		}
+	}
+
+	descending := fields[0].Order == plan.Descending
+
+	if lookup != nil {
+		// The index is already retained by the lookup.
+		a.Catalog.ReleaseIndex(idx)
+
+		sorted, ok := lookup.lookup.(sql.SortedIndexLookup)
+		if !ok || !isSameIndex(lookup.indexes[0], idx) {
+			return nil, nil
+		}
+
+		// Lookups never contain NULL values for the indexes they are
+		// created from.
+		for _, i := range lookup.indexes {
+			if !isSameIndex(i, idx) && !nullsSortedByIndex(fields) {
+				return nil, nil
+			}
+		}
+
+		return &indexLookup{sorted.Sorted(descending), lookup.indexes}, nil
+	}
+
+	si, ok := idx.(sql.SortedIndex)
+	if !ok || !nullsSortedByIndex(fields) {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil
+	}
+
+	all, err := si.All()
+	if err != nil {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, err
+	}
+
+	sorted, ok := all.(sql.SortedIndexLookup)
+	if !ok {
+		a.Catalog.ReleaseIndex(idx)
+		return nil, nil
+	}
+
+	return &indexLookup{sorted.Sorted(descending), []sql.Index{idx}}, nil
+}
+
+// nullsSortedByIndex reports whether the NULL values of the fields are
+// sorted as a sorted index lookup does, which returns them first in
+// ascending order and last in descending order.
+func nullsSortedByIndex(fields []plan.SortField) bool {
+	for _, f := range fields {
+		if !f.Column.IsNullable() {
+			continue
+		}
+
+		if f.Order == plan.Ascending && f.NullOrdering != plan.NullsFirst ||
+			f.Order == plan.Descending && f.NullOrdering != plan.NullsLast {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSameIndex(a, b sql.Index) bool {
+	return a.Database() == b.Database() && a.ID() == b.ID()
+}
@@ -0,0 +1,178 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/index/btree"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func sortIndexesCatalog(t *testing.T) (*sql.Catalog, *memory.Table) {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+		{Name: "s", Type: sql.Text, Source: "mytable", Nullable: true},
+	}, 2)
+	for i := int64(0); i < 4; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i, nil)))
+	}
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("mytable", table)
+
+	catalog := sql.NewCatalog()
+	catalog.AddDatabase(db)
+
+	d := btree.NewDriver()
+	for i, col := range table.Schema() {
+		idx, err := d.Create("mydb", "mytable", "idx_"+col.Name, []sql.Expression{
+			expression.NewGetFieldWithTable(i, col.Type, "mytable", col.Name, col.Nullable),
+		}, nil)
+		require.NoError(err)
+
+		iter, err := table.IndexKeyValues(ctx, []string{col.Name})
+		require.NoError(err)
+		require.NoError(d.Save(ctx, idx, iter))
+
+		done, ready, err := catalog.AddIndex(idx)
+		require.NoError(err)
+		close(done)
+		<-ready
+	}
+
+	return catalog, table
+}
+
+func TestAssignSortIndexes(t *testing.T) {
+	require := require.New(t)
+	catalog, table := sortIndexesCatalog(t)
+	a := NewDefault(catalog)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	s := expression.NewGetFieldWithTable(1, sql.Text, "mytable", "s", true)
+
+	project := plan.NewProject(
+		[]sql.Expression{expression.NewAlias(i, "x"), s},
+		plan.NewResolvedTable(table),
+	)
+	node := plan.NewLimit(1, plan.NewSort(
+		[]plan.SortField{{
+			Column: expression.NewGetField(0, sql.Int64, "x", false),
+			Order:  plan.Descending,
+		}},
+		project,
+	))
+
+	result, indexes, orders, err := assignSortIndexes(a, node, nil, nil)
+	require.NoError(err)
+	require.Equal(plan.NewLimit(1, project), result)
+	require.Equal(map[string][]plan.SortField{
+		"mytable": {{Column: i, Order: plan.Descending}},
+	}, orders)
+	require.Len(indexes, 1)
+	require.Equal("idx_i", indexes["mytable"].indexes[0].ID())
+	catalog.ReleaseIndex(indexes["mytable"].indexes[0])
+}
+
+func TestAssignSortIndexesUnsupported(t *testing.T) {
+	catalog, table := sortIndexesCatalog(t)
+	a := NewDefault(catalog)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	s := expression.NewGetFieldWithTable(1, sql.Text, "mytable", "s", true)
+
+	testCases := []struct {
+		name   string
+		fields []plan.SortField
+	}{
+		{
+			"nullable column in descending order",
+			[]plan.SortField{{Column: s, Order: plan.Descending}},
+		},
+		{
+			"columns without index",
+			[]plan.SortField{{Column: i}, {Column: s}},
+		},
+		{
+			"expression",
+			[]plan.SortField{{Column: expression.NewNot(i)}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			node := plan.NewSort(tt.fields, plan.NewResolvedTable(table))
+
+			result, indexes, orders, err := assignSortIndexes(a, node, nil, nil)
+			require.NoError(err)
+			require.Equal(node, result)
+			require.Len(indexes, 0)
+			require.Len(orders, 0)
+		})
+	}
+}
+
+func TestPushdownSortedCoveringIndex(t *testing.T) {
+	require := require.New(t)
+	catalog, table := sortIndexesCatalog(t)
+	a := withoutProcessTracking(NewDefault(catalog))
+	catalog.SetCurrentDatabase("mydb")
+
+	node := plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedQualifiedColumn("mytable", "i")},
+		plan.NewSort(
+			[]plan.SortField{{
+				Column: expression.NewUnresolvedQualifiedColumn("mytable", "i"),
+				Order:  plan.Ascending,
+			}},
+			plan.NewFilter(
+				expression.NewGreaterThan(
+					expression.NewUnresolvedQualifiedColumn("mytable", "i"),
+					expression.NewLiteral(int64(0), sql.Int64),
+				),
+				plan.NewResolvedTable(table),
+			),
+		),
+	)
+
+	result, err := a.Analyze(sql.NewEmptyContext(), node)
+	require.NoError(err)
+
+	var tables []sql.Table
+	plan.Inspect(result, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.Sort:
+			require.Fail("unexpected sort node")
+		case *plan.ResolvedTable:
+			for t := n.Table; t != nil; {
+				tables = append(tables, t)
+				w, ok := t.(sql.TableWrapper)
+				if !ok {
+					break
+				}
+				t = w.Underlying()
+			}
+		}
+		return true
+	})
+
+	require.Len(tables, 4)
+	require.IsType((*plan.IndexUsageTable)(nil), tables[0])
+	require.IsType((*plan.SortedTable)(nil), tables[1])
+	require.IsType((*plan.CoveringTable)(nil), tables[2])
+	require.IsType((*memory.Table)(nil), tables[3])
+
+	iter, err := result.RowIter(sql.NewEmptyContext())
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}, {int64(2)}, {int64(3)}}, rows)
+}
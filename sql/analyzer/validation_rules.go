@@ -55,7 +55,7 @@ var (
 	// ErrExplodeInvalidUse is returned when an EXPLODE function is used
 	// outside a Project node.
 	ErrExplodeInvalidUse = errors.NewKind(
-		"using EXPLODE is not supported outside a Project node",
+		"using EXPLODE or UNNEST is not supported outside a Project node",
 	)
 )
 
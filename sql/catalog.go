@@ -17,6 +17,8 @@ var ErrDatabaseNotFound = errors.NewKind("database not found: %s")
 type Catalog struct {
 	FunctionRegistry
 	*IndexRegistry
+	*PolicyRegistry
+	*StatusRegistry
 	*ProcessList
 	*MemoryManager
 
@@ -37,6 +39,8 @@ func NewCatalog() *Catalog {
 	return &Catalog{
 		FunctionRegistry: NewFunctionRegistry(),
 		IndexRegistry:    NewIndexRegistry(),
+		PolicyRegistry:   NewPolicyRegistry(),
+		StatusRegistry:   NewStatusRegistry(),
 		MemoryManager:    NewMemoryManager(ProcessMemory),
 		ProcessList:      NewProcessList(),
 		locks:            make(sessionLocks),
@@ -0,0 +1,237 @@
+package sql
+
+import (
+	"strings"
+	"unicode"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrUnknownCharacterSet is returned when a character set does not exist.
+	ErrUnknownCharacterSet = errors.NewKind("unknown character set: %s")
+
+	// ErrUnknownCollation is returned when a collation does not exist.
+	ErrUnknownCollation = errors.NewKind("unknown collation: %s")
+
+	// ErrCollationCharacterSetMismatch is returned when a collation is used
+	// with a character set it does not belong to.
+	ErrCollationCharacterSetMismatch = errors.NewKind("COLLATION '%s' is not valid for CHARACTER SET '%s'")
+
+	// ErrIllegalCollationMix is returned when two strings with different
+	// collations are compared and none of them takes precedence.
+	ErrIllegalCollationMix = errors.NewKind("Illegal mix of collations (%s) and (%s) for operation '%s'")
+)
+
+// CharacterSet is a set of characters along with their encoding.
+type CharacterSet struct {
+	// Name of the character set.
+	Name string
+	// Description of the character set.
+	Description string
+	// DefaultCollation is the name of the collation used for strings of the
+	// character set when no collation is given.
+	DefaultCollation string
+	// MaxLength is the maximum number of bytes of a character.
+	MaxLength int64
+}
+
+// Collation is a set of rules to compare the strings of a character set.
+type Collation struct {
+	// Name of the collation.
+	Name string
+	// CharacterSet is the name of the character set of the collation.
+	CharacterSet string
+	// ID of the collation.
+	ID int64
+	// Sortlen is the amount of memory needed to sort a character.
+	Sortlen int64
+	// PadSpace reports whether trailing spaces are ignored when comparing
+	// strings. Otherwise, they are significant, as any other character.
+	PadSpace bool
+	// weight returns the character all the characters that are equal to the
+	// given one are compared as, or nil if characters are compared by their
+	// code.
+	weight func(rune) rune
+}
+
+// IsDefault reports whether the collation is the default collation of its
+// character set.
+func (c *Collation) IsDefault() bool {
+	cs, err := LookupCharacterSet(c.CharacterSet)
+	return err == nil && cs.DefaultCollation == c.Name
+}
+
+// IsCaseSensitive reports whether the collation tells apart uppercase and
+// lowercase characters.
+func (c *Collation) IsCaseSensitive() bool {
+	return c.weight == nil
+}
+
+func (c *Collation) String() string { return c.Name }
+
+// Key returns the string that is compared instead of the given one. Two
+// strings are equal with the collation if and only if their keys are equal,
+// and their keys have the same order as the strings.
+func (c *Collation) Key(s string) string {
+	if c.PadSpace {
+		s = strings.TrimRight(s, " ")
+	}
+	return c.Fold(s)
+}
+
+// Fold replaces every character of the given string with the character it
+// is compared as. Unlike Key, trailing spaces are kept, so the result can be
+// matched with patterns.
+func (c *Collation) Fold(s string) string {
+	if c.weight == nil {
+		return s
+	}
+	return strings.Map(c.weight, s)
+}
+
+// Compare compares two strings with the collation.
+func (c *Collation) Compare(a, b string) int {
+	return strings.Compare(c.Key(a), c.Key(b))
+}
+
+// generalWeight is the weight of the characters in the general_ci
+// collations, which don't tell apart uppercase and lowercase characters, nor
+// latin characters with and without accents.
+func generalWeight(r rune) rune {
+	if r >= latinWeightsStart && int(r-latinWeightsStart) < len(latinWeights) {
+		return latinWeights[r-latinWeightsStart]
+	}
+	return unicode.ToUpper(r)
+}
+
+// latinWeights are the weights of the characters of the Latin-1 Supplement
+// and Latin Extended-A blocks starting at latinWeightsStart, which are their
+// uppercase letter without accents.
+var latinWeights = []rune("AAAAAAÆCEEEEIIIIÐNOOOOO×ØUUUUYÞS" +
+	"AAAAAAÆCEEEEIIIIÐNOOOOO÷ØUUUUYÞY" +
+	"AAAAAACCCCCCCCDDĐĐEEEEEEEEEEGGGG" +
+	"GGGGHHĦĦIIIIIIIIIIĲĲJJKKĸLLLLLLĿ" +
+	"ĿŁŁNNNNNNŉŊŊOOOOOOŒŒRRRRRRSSSSSS" +
+	"SSTTTTŦŦUUUUUUUUUUUUWWYYYZZZZZZS")
+
+const latinWeightsStart = 0xC0
+
+var (
+	// BinaryCollation is the collation of binary strings, which are compared
+	// byte by byte.
+	BinaryCollation = &Collation{Name: "binary", CharacterSet: "binary", ID: 63, Sortlen: 1}
+
+	// DefaultCollation is the collation of strings when no collation is
+	// given.
+	DefaultCollation = &Collation{Name: "utf8mb4_general_ci", CharacterSet: "utf8mb4", ID: 45, Sortlen: 1, PadSpace: true, weight: generalWeight}
+)
+
+// Collations are all the supported collations.
+var Collations = []*Collation{
+	BinaryCollation,
+	{Name: "ascii_general_ci", CharacterSet: "ascii", ID: 11, Sortlen: 1, PadSpace: true, weight: generalWeight},
+	{Name: "ascii_bin", CharacterSet: "ascii", ID: 65, Sortlen: 1, PadSpace: true},
+	{Name: "latin1_swedish_ci", CharacterSet: "latin1", ID: 8, Sortlen: 1, PadSpace: true, weight: generalWeight},
+	{Name: "latin1_bin", CharacterSet: "latin1", ID: 47, Sortlen: 1, PadSpace: true},
+	{Name: "utf8_general_ci", CharacterSet: "utf8", ID: 33, Sortlen: 1, PadSpace: true, weight: generalWeight},
+	{Name: "utf8_bin", CharacterSet: "utf8", ID: 83, Sortlen: 1, PadSpace: true},
+	{Name: "utf8_unicode_ci", CharacterSet: "utf8", ID: 192, Sortlen: 8, PadSpace: true, weight: generalWeight},
+	DefaultCollation,
+	{Name: "utf8mb4_bin", CharacterSet: "utf8mb4", ID: 46, Sortlen: 1, PadSpace: true},
+	{Name: "utf8mb4_unicode_ci", CharacterSet: "utf8mb4", ID: 224, Sortlen: 8, PadSpace: true, weight: generalWeight},
+	{Name: "utf8mb4_0900_ai_ci", CharacterSet: "utf8mb4", ID: 255, Sortlen: 0, weight: generalWeight},
+	{Name: "utf8mb4_0900_bin", CharacterSet: "utf8mb4", ID: 309, Sortlen: 1},
+}
+
+// CharacterSets are all the supported character sets.
+var CharacterSets = []*CharacterSet{
+	{Name: "ascii", Description: "US ASCII", DefaultCollation: "ascii_general_ci", MaxLength: 1},
+	{Name: "binary", Description: "Binary pseudo charset", DefaultCollation: "binary", MaxLength: 1},
+	{Name: "latin1", Description: "cp1252 West European", DefaultCollation: "latin1_swedish_ci", MaxLength: 1},
+	{Name: "utf8", Description: "UTF-8 Unicode", DefaultCollation: "utf8_general_ci", MaxLength: 3},
+	{Name: "utf8mb4", Description: "UTF-8 Unicode", DefaultCollation: "utf8mb4_general_ci", MaxLength: 4},
+}
+
+// characterSetAliases are other names of the character sets.
+var characterSetAliases = map[string]string{
+	"utf8mb3": "utf8",
+}
+
+// characterMaxLength returns the maximum number of bytes of a character of
+// the character set of a collation.
+func characterMaxLength(c *Collation) int64 {
+	cs, err := LookupCharacterSet(c.CharacterSet)
+	if err != nil {
+		return 1
+	}
+	return cs.MaxLength
+}
+
+// LookupCharacterSet returns the character set with the given name, which
+// is case insensitive.
+func LookupCharacterSet(name string) (*CharacterSet, error) {
+	name = strings.ToLower(name)
+	if alias, ok := characterSetAliases[name]; ok {
+		name = alias
+	}
+
+	for _, cs := range CharacterSets {
+		if cs.Name == name {
+			return cs, nil
+		}
+	}
+	return nil, ErrUnknownCharacterSet.New(name)
+}
+
+// LookupCollation returns the collation with the given name, which is case
+// insensitive.
+func LookupCollation(name string) (*Collation, error) {
+	name = strings.ToLower(name)
+	if strings.HasPrefix(name, "utf8mb3_") {
+		name = "utf8_" + strings.TrimPrefix(name, "utf8mb3_")
+	}
+
+	for _, c := range Collations {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, ErrUnknownCollation.New(name)
+}
+
+// ResolveCollation returns the collation of a string with the given
+// character set and collation, any of which may be empty. If both are
+// empty, the collation is the default one. If only the character set is
+// given, the collation is the default collation of the character set.
+func ResolveCollation(charset, collation string) (*Collation, error) {
+	if collation != "" {
+		c, err := LookupCollation(collation)
+		if err != nil {
+			return nil, err
+		}
+
+		if charset != "" {
+			cs, err := LookupCharacterSet(charset)
+			if err != nil {
+				return nil, err
+			}
+
+			if cs.Name != c.CharacterSet {
+				return nil, ErrCollationCharacterSetMismatch.New(c.Name, cs.Name)
+			}
+		}
+
+		return c, nil
+	}
+
+	if charset != "" {
+		cs, err := LookupCharacterSet(charset)
+		if err != nil {
+			return nil, err
+		}
+		return LookupCollation(cs.DefaultCollation)
+	}
+
+	return DefaultCollation, nil
+}
@@ -0,0 +1,108 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollationCompare(t *testing.T) {
+	testCases := []struct {
+		collation string
+		a, b      string
+		expected  int
+	}{
+		{"utf8mb4_general_ci", "a", "A", 0},
+		{"utf8mb4_general_ci", "a", "B", -1},
+		{"utf8mb4_general_ci", "b", "A", 1},
+		{"utf8mb4_general_ci", "été", "ETE", 0},
+		{"utf8mb4_general_ci", "straße", "STRASSE", -1},
+		{"utf8mb4_general_ci", "a ", "a", 0},
+		{"utf8mb4_bin", "a", "A", 1},
+		{"utf8mb4_bin", "a ", "a", 0},
+		{"utf8mb4_0900_ai_ci", "A", "a", 0},
+		{"utf8mb4_0900_ai_ci", "a ", "a", 1},
+		{"utf8mb4_0900_bin", "a ", "a", 1},
+		{"latin1_swedish_ci", "Ñ", "n", 0},
+		{"binary", "a", "A", 1},
+		{"binary", "a ", "a", 1},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.collation+" "+tt.a+" "+tt.b, func(t *testing.T) {
+			c, err := LookupCollation(tt.collation)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, c.Compare(tt.a, tt.b))
+		})
+	}
+}
+
+func TestCollationKey(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("ABC", DefaultCollation.Key("abc  "))
+	require.Equal("ABC  ", DefaultCollation.Fold("abc  "))
+	require.Equal("abc  ", BinaryCollation.Key("abc  "))
+}
+
+func TestLookupCollation(t *testing.T) {
+	require := require.New(t)
+
+	c, err := LookupCollation("UTF8MB4_BIN")
+	require.NoError(err)
+	require.Equal("utf8mb4_bin", c.Name)
+	require.False(c.IsDefault())
+	require.True(c.IsCaseSensitive())
+
+	c, err = LookupCollation("utf8mb3_general_ci")
+	require.NoError(err)
+	require.Equal("utf8_general_ci", c.Name)
+	require.True(c.IsDefault())
+	require.False(c.IsCaseSensitive())
+
+	_, err = LookupCollation("foo")
+	require.True(ErrUnknownCollation.Is(err))
+
+	cs, err := LookupCharacterSet("utf8mb3")
+	require.NoError(err)
+	require.Equal("utf8", cs.Name)
+
+	_, err = LookupCharacterSet("foo")
+	require.True(ErrUnknownCharacterSet.Is(err))
+
+	for _, c := range Collations {
+		_, err := LookupCharacterSet(c.CharacterSet)
+		require.NoError(err, c.Name)
+	}
+}
+
+func TestResolveCollation(t *testing.T) {
+	testCases := []struct {
+		charset   string
+		collation string
+		expected  string
+		err       bool
+	}{
+		{"", "", "utf8mb4_general_ci", false},
+		{"latin1", "", "latin1_swedish_ci", false},
+		{"", "latin1_bin", "latin1_bin", false},
+		{"utf8mb4", "utf8mb4_bin", "utf8mb4_bin", false},
+		{"binary", "", "binary", false},
+		{"latin1", "utf8mb4_bin", "", true},
+		{"foo", "", "", true},
+		{"", "foo", "", true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.charset+" "+tt.collation, func(t *testing.T) {
+			c, err := ResolveCollation(tt.charset, tt.collation)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, c.Name)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package sql // import "github.com/mushiyu/go-mysql-server/sql"
 import (
 	"fmt"
 	"io"
-	"math"
 	"strconv"
 	"time"
 
@@ -205,6 +204,17 @@ type Inserter interface {
 	Insert(*Context, Row) error
 }
 
+// AutoIncrementer is a table that keeps the counter its AUTO_INCREMENT
+// column takes its values from.
+type AutoIncrementer interface {
+	// NextAutoIncrement returns the next value of the counter and
+	// increments it.
+	NextAutoIncrement(*Context) (int64, error)
+	// UpdateAutoIncrement makes the next values of the counter greater than
+	// the given one, which was inserted in the AUTO_INCREMENT column.
+	UpdateAutoIncrement(*Context, int64) error
+}
+
 // Deleter allow rows to be deleted from tables.
 type Deleter interface {
 	// Delete the given row. Returns ErrDeleteRowNotFound if the row was not found.
@@ -229,6 +239,32 @@ type Alterable interface {
 	Create(name string, schema Schema) error
 }
 
+// CollatedAlterable should be implemented by databases that can create
+// tables with a default collation other than DefaultCollation.
+type CollatedAlterable interface {
+	Alterable
+	// CreateWithCollation creates a table whose default collation is the
+	// given one.
+	CreateWithCollation(name string, schema Schema, collation *Collation) error
+}
+
+// CollatedTable is a table with a default character set and collation,
+// which are the ones of the string columns created without any.
+type CollatedTable interface {
+	Table
+	// Collation returns the default collation of the table.
+	Collation() *Collation
+}
+
+// TableCollation returns the default collation of the table, which is
+// DefaultCollation unless the table is a CollatedTable.
+func TableCollation(t Table) *Collation {
+	if ct, ok := t.(CollatedTable); ok {
+		return ct.Collation()
+	}
+	return DefaultCollation
+}
+
 // Lockable should be implemented by tables that can be locked and unlocked.
 type Lockable interface {
 	Nameable
@@ -281,9 +317,9 @@ func EvaluateCondition(ctx *Context, cond Expression, row Row) (bool, error) {
 	case time.Time:
 		return b.UnixNano() != 0, nil
 	case float64:
-		return int(math.Round(v.(float64))) != 0, nil
+		return b != 0, nil
 	case float32:
-		return int(math.Round(float64(v.(float32)))) != 0, nil
+		return b != 0, nil
 	case string:
 		parsed, err := strconv.ParseFloat(v.(string), 64)
 		return err == nil && int(parsed) != 0, nil
@@ -27,6 +27,8 @@ var conditions = []struct {
 	{false, float64(0), sql.Float64},
 	{true, float32(0.5), sql.Float32},
 	{true, float64(0.5), sql.Float64},
+	{true, float32(0.25), sql.Float32},
+	{true, float64(0.25), sql.Float64},
 	{true, "1", sql.Text},
 	{false, "0", sql.Text},
 	{false, "foo", sql.Text},
@@ -3,9 +3,11 @@ package expression
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/mushiyu/vitess/go/vt/sqlparser"
+	"github.com/shopspring/decimal"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/mushiyu/go-mysql-server/sql"
@@ -19,6 +21,15 @@ var (
 	errUnableToEval = errors.NewKind("Unable to evaluate an expression: %v %s %v")
 )
 
+// exactDecimal is a Decimal type that holds any integer or decimal value
+// without changing it.
+var exactDecimal = sql.Decimal(sql.MaxDecimalPrecision, sql.MaxDecimalScale)
+
+// divPrecisionIncrement is the number of digits that the scale of the result
+// of a division of exact values has besides the ones of the dividend, as
+// the div_precision_increment variable of MySQL does.
+const divPrecisionIncrement = 4
+
 // Arithmetic expressions (+, -, *, /, ...)
 type Arithmetic struct {
 	BinaryExpression
@@ -91,7 +102,13 @@ func (a *Arithmetic) String() string {
 
 // IsNullable implements the sql.Expression interface.
 func (a *Arithmetic) IsNullable() bool {
-	if a.Type() == sql.Timestamp {
+	// Results of operations with intervals out of range are NULL.
+	if isInterval(a.Left) || isInterval(a.Right) {
+		return true
+	}
+
+	// Division by zero of exact values is NULL.
+	if (a.Op == sqlparser.DivStr || a.Op == sqlparser.ModStr) && sql.IsFixedPoint(a.Type()) {
 		return true
 	}
 
@@ -100,30 +117,45 @@ func (a *Arithmetic) IsNullable() bool {
 
 // Type returns the greatest type for given operation.
 func (a *Arithmetic) Type() sql.Type {
+	left, right := numericType(a.Left.Type()), numericType(a.Right.Type())
 	switch a.Op {
 	case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr, sqlparser.DivStr:
 		if isInterval(a.Left) || isInterval(a.Right) {
-			return sql.Timestamp
+			return a.intervalType()
 		}
 
-		if sql.IsTime(a.Left.Type()) && sql.IsTime(a.Right.Type()) {
+		if sql.IsTime(left) && sql.IsTime(right) {
 			return sql.Int64
 		}
 
-		if sql.IsInteger(a.Left.Type()) && sql.IsInteger(a.Right.Type()) {
-			if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
+		if sql.IsInteger(left) && sql.IsInteger(right) {
+			if sql.IsUnsigned(left) && sql.IsUnsigned(right) {
 				return sql.Uint64
 			}
 			return sql.Int64
 		}
 
+		if typ, ok := decimalType(a.Op, left, right); ok {
+			return typ
+		}
+
 		return sql.Float64
 
 	case sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr:
 		return sql.Uint64
 
-	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.IntDivStr, sqlparser.ModStr:
-		if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
+	case sqlparser.ModStr:
+		if typ, ok := decimalType(a.Op, left, right); ok {
+			return typ
+		}
+
+		if sql.IsUnsigned(left) && sql.IsUnsigned(right) {
+			return sql.Uint64
+		}
+		return sql.Int64
+
+	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.IntDivStr:
+		if sql.IsUnsigned(left) && sql.IsUnsigned(right) {
 			return sql.Uint64
 		}
 		return sql.Int64
@@ -132,6 +164,78 @@ func (a *Arithmetic) Type() sql.Type {
 	return sql.Float64
 }
 
+// numericType returns the type of the values of the given type when they
+// are used as numbers, which is the type itself except for enums and sets,
+// whose values are used as their ordinals and bitmasks.
+func numericType(t sql.Type) sql.Type {
+	if sql.IsEnum(t) || sql.IsSet(t) {
+		return sql.Uint64
+	}
+	return t
+}
+
+// decimalType returns the type of the result of an operation with exact
+// values, which are the ones of operations between a decimal and a decimal
+// or an integer. Its precision and scale are the ones MySQL uses for the
+// result of the operation.
+func decimalType(op string, left, right sql.Type) (sql.Type, bool) {
+	if !sql.IsFixedPoint(left) && !sql.IsFixedPoint(right) {
+		return nil, false
+	}
+
+	lp, ls, ok := sql.DecimalPrecision(left)
+	if !ok {
+		return nil, false
+	}
+
+	rp, rs, ok := sql.DecimalPrecision(right)
+	if !ok {
+		return nil, false
+	}
+
+	switch op {
+	case sqlparser.PlusStr, sqlparser.MinusStr:
+		scale := max(ls, rs)
+		return sql.Decimal(max(lp-ls, rp-rs)+scale+1, scale), true
+	case sqlparser.MultStr:
+		return sql.Decimal(lp+rp, ls+rs), true
+	case sqlparser.ModStr:
+		scale := max(ls, rs)
+		return sql.Decimal(max(lp-ls, rp-rs)+scale, scale), true
+	default:
+		scale := ls + divPrecisionIncrement
+		return sql.Decimal(lp-ls+rs+scale, scale), true
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// intervalType returns the type of the result of an operation with an
+// interval, which is a Time if the other operand is a Time and a Timestamp
+// otherwise. Its fractional seconds precision is the one of the other
+// operand, or the maximum one if the interval has microseconds.
+func (a *Arithmetic) intervalType() sql.Type {
+	interval, operand := a.Left, a.Right
+	if !isInterval(interval) {
+		interval, operand = a.Right, a.Left
+	}
+
+	fsp := sql.TimePrecision(operand.Type())
+	if strings.Contains(interval.(*Interval).Unit, "MICROSECOND") {
+		fsp = sql.MaxTimePrecision
+	}
+
+	if sql.IsDuration(operand.Type()) {
+		return sql.TimeWithPrecision(fsp)
+	}
+	return sql.TimestampWithPrecision(fsp)
+}
+
 func isInterval(expr sql.Expression) bool {
 	_, ok := expr.(*Interval)
 	return ok
@@ -156,11 +260,24 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	// Members of enums and sets are converted to their ordinals and bitmasks.
+	if lval, err = sql.MemberNumber(a.Left.Type(), lval); err != nil {
+		return nil, err
+	}
+
+	if rval, err = sql.MemberNumber(a.Right.Type(), rval); err != nil {
+		return nil, err
+	}
+
 	lval, rval, err = a.convertLeftRight(lval, rval)
 	if err != nil {
 		return nil, err
 	}
 
+	if typ := a.Type(); sql.IsFixedPoint(typ) {
+		return decimalArithmetic(typ, a.Op, lval.(decimal.Decimal), rval.(decimal.Decimal))
+	}
+
 	switch a.Op {
 	case sqlparser.PlusStr:
 		return plus(lval, rval)
@@ -224,6 +341,12 @@ func (a *Arithmetic) convertLeftRight(left interface{}, right interface{}) (inte
 	var err error
 	typ := a.Type()
 
+	// Operands of exact operations are not rounded to the scale of the
+	// result, only the result is.
+	if sql.IsFixedPoint(typ) {
+		typ = exactDecimal
+	}
+
 	if i, ok := left.(*TimeDelta); ok {
 		left = i
 	} else {
@@ -245,6 +368,37 @@ func (a *Arithmetic) convertLeftRight(left interface{}, right interface{}) (inte
 	return left, right, nil
 }
 
+// decimalArithmetic returns the result of the operation between the exact
+// values as a value of the given Decimal type.
+func decimalArithmetic(typ sql.Type, op string, l, r decimal.Decimal) (interface{}, error) {
+	var result decimal.Decimal
+	switch op {
+	case sqlparser.PlusStr:
+		result = l.Add(r)
+	case sqlparser.MinusStr:
+		result = l.Sub(r)
+	case sqlparser.MultStr:
+		result = l.Mul(r)
+	case sqlparser.DivStr:
+		if r.IsZero() {
+			return nil, nil
+		}
+
+		_, scale, _ := sql.DecimalPrecision(typ)
+		result = l.DivRound(r, int32(scale))
+	case sqlparser.ModStr:
+		if r.IsZero() {
+			return nil, nil
+		}
+
+		result = l.Mod(r)
+	default:
+		return nil, errUnableToEval.New(l, op, r)
+	}
+
+	return typ.Convert(result)
+}
+
 func plus(lval, rval interface{}) (interface{}, error) {
 	switch l := lval.(type) {
 	case uint64:
@@ -271,10 +425,17 @@ func plus(lval, rval interface{}) (interface{}, error) {
 		case time.Time:
 			return l.Unix() + r.Unix(), nil
 		}
+	case time.Duration:
+		switch r := rval.(type) {
+		case *TimeDelta:
+			return addDuration(l, r, 1), nil
+		}
 	case *TimeDelta:
 		switch r := rval.(type) {
 		case time.Time:
 			return sql.ValidateTime(l.Add(r)), nil
+		case time.Duration:
+			return addDuration(r, l, 1), nil
 		}
 	}
 
@@ -307,11 +468,28 @@ func minus(lval, rval interface{}) (interface{}, error) {
 		case time.Time:
 			return l.Unix() - r.Unix(), nil
 		}
+	case time.Duration:
+		switch r := rval.(type) {
+		case *TimeDelta:
+			return addDuration(l, r, -1), nil
+		}
 	}
 
 	return nil, errUnableToCast.New(lval, rval)
 }
 
+// addDuration returns the duration plus the time delta multiplied by the
+// sign, or nil if the delta has years or months or the result is out of the
+// range of the Time type.
+func addDuration(d time.Duration, td *TimeDelta, sign time.Duration) interface{} {
+	delta, ok := td.Duration()
+	if !ok {
+		return nil
+	}
+
+	return sql.ValidateDuration(d + delta*sign)
+}
+
 func mult(lval, rval interface{}) (interface{}, error) {
 	switch l := lval.(type) {
 	case uint64:
@@ -502,7 +680,16 @@ func (e *UnaryMinus) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
+	if sql.IsFixedPoint(e.Child.Type()) {
+		child, err = e.Child.Type().Convert(child)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	switch n := child.(type) {
+	case decimal.Decimal:
+		return n.Neg(), nil
 	case float64:
 		return -n, nil
 	case float32:
@@ -527,11 +714,11 @@ func (e *UnaryMinus) Type() sql.Type {
 		return sql.Float64
 	}
 
-	if typ == sql.Uint32 {
+	if typ.Type() == sql.Uint32.Type() {
 		return sql.Int32
 	}
 
-	if typ == sql.Uint64 {
+	if typ.Type() == sql.Uint64.Type() {
 		return sql.Int64
 	}
 
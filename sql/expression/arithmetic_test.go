@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/mushiyu/go-mysql-server/sql"
 )
@@ -107,6 +108,97 @@ func TestMinusInterval(t *testing.T) {
 	require.Equal(expected, result)
 }
 
+func TestTimeInterval(t *testing.T) {
+	hour := NewInterval(NewLiteral(int64(1), sql.Int64), "HOUR")
+	testCases := []struct {
+		name     string
+		op       *Arithmetic
+		typ      sql.Type
+		expected interface{}
+	}{
+		{
+			"time plus interval",
+			NewPlus(NewLiteral("10:00:00", sql.Time), hour),
+			sql.Time,
+			11 * time.Hour,
+		},
+		{
+			"interval plus time",
+			NewPlus(hour, NewLiteral("-10:00:00", sql.Time)),
+			sql.Time,
+			-9 * time.Hour,
+		},
+		{
+			"time minus interval with microseconds",
+			NewMinus(
+				NewLiteral("00:00:00", sql.Time),
+				NewInterval(NewLiteral("1.5", sql.Text), "SECOND_MICROSECOND"),
+			),
+			sql.TimeWithPrecision(6),
+			-(time.Second + 5*time.Microsecond),
+		},
+		{
+			"time out of range",
+			NewPlus(NewLiteral("838:30:00", sql.Time), hour),
+			sql.Time,
+			nil,
+		},
+		{
+			"time plus interval of months",
+			NewPlus(
+				NewLiteral("10:00:00", sql.Time),
+				NewInterval(NewLiteral(int64(1), sql.Int64), "MONTH"),
+			),
+			sql.Time,
+			nil,
+		},
+		{
+			"datetime with precision plus interval",
+			NewPlus(
+				NewLiteral(time.Date(2018, time.May, 1, 0, 0, 0, 5000, time.UTC), sql.DatetimeWithPrecision(6)),
+				hour,
+			),
+			sql.TimestampWithPrecision(6),
+			time.Date(2018, time.May, 1, 1, 0, 0, 5000, time.UTC),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.typ, tt.op.Type())
+			require.True(t, tt.op.IsNullable())
+
+			result, err := tt.op.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEnumArithmetic(t *testing.T) {
+	require := require.New(t)
+
+	op := NewPlus(
+		NewLiteral("b", sql.Enum("a", "b")),
+		NewLiteral(int64(0), sql.Int64),
+	)
+	require.Equal(sql.Int64, op.Type())
+
+	result, err := op.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.Equal(int64(2), result)
+
+	op = NewPlus(
+		NewLiteral("x,z", sql.Set("x", "y", "z")),
+		NewLiteral(uint64(1), sql.Uint64),
+	)
+	require.Equal(sql.Uint64, op.Type())
+
+	result, err = op.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.Equal(uint64(6), result)
+}
+
 func TestMult(t *testing.T) {
 	var testCases = []struct {
 		name        string
@@ -426,3 +518,49 @@ func TestUnaryMinus(t *testing.T) {
 		})
 	}
 }
+
+func TestDecimalArithmetic(t *testing.T) {
+	price := NewLiteral(decimal.New(1999, -2), sql.Decimal(10, 2))
+	rate := NewLiteral(decimal.New(5, -3), sql.Decimal(4, 3))
+	two := NewLiteral(int64(2), sql.Int64)
+	zero := NewLiteral(int64(0), sql.Int64)
+
+	testCases := []struct {
+		name     string
+		expr     *Arithmetic
+		typ      sql.Type
+		expected interface{}
+	}{
+		{"decimal + int", NewPlus(price, two), sql.Decimal(22, 2), "21.99"},
+		{"decimal - decimal", NewMinus(price, rate), sql.Decimal(12, 3), "19.985"},
+		{"decimal * decimal", NewMult(price, rate), sql.Decimal(14, 5), "0.09995"},
+		{"int * decimal", NewMult(two, price), sql.Decimal(29, 2), "39.98"},
+		{"decimal / int", NewDiv(price, NewLiteral(int64(3), sql.Int64)), sql.Decimal(14, 6), "6.663333"},
+		{"int / decimal", NewDiv(two, rate), sql.Decimal(26, 4), "400"},
+		{"division by zero", NewDiv(price, zero), sql.Decimal(14, 6), nil},
+		{"decimal % int", NewMod(price, NewLiteral(int64(3), sql.Int64)), sql.Decimal(21, 2), "1.99"},
+		{"decimal % decimal", NewMod(price, NewLiteral(decimal.New(3, -1), sql.Decimal(2, 1))), sql.Decimal(10, 2), "0.19"},
+		{"modulo by zero", NewMod(price, zero), sql.Decimal(21, 2), nil},
+		{"decimal + float", NewPlus(price, NewLiteral(0.01, sql.Float64)), sql.Float64, 20.0},
+		{"decimal + text", NewPlus(price, NewLiteral("1", sql.Text)), sql.Float64, 20.99},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.typ, tt.expr.Type())
+
+			result, err := tt.expr.Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			if d, ok := result.(decimal.Decimal); ok {
+				require.Equal(tt.expected, d.String())
+			} else {
+				require.Equal(tt.expected, result)
+			}
+		})
+	}
+
+	result, err := NewUnaryMinus(price).Eval(sql.NewEmptyContext(), sql.NewRow())
+	require.NoError(t, err)
+	require.Equal(t, "-19.99", result.(decimal.Decimal).String())
+}
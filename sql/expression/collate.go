@@ -0,0 +1,104 @@
+package expression
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// Collate is an expression that changes the collation of a string.
+type Collate struct {
+	UnaryExpression
+	Collation *sql.Collation
+}
+
+// NewCollate creates a new Collate expression.
+func NewCollate(child sql.Expression, collation *sql.Collation) *Collate {
+	return &Collate{UnaryExpression{child}, collation}
+}
+
+// Type implements the Expression interface.
+func (c *Collate) Type() sql.Type {
+	t := c.Child.Type()
+	if _, ok := sql.CollationOf(t); !ok || sql.IsBinary(t) || t == sql.Blob {
+		t = sql.Text
+	}
+	return sql.WithCollation(t, c.Collation)
+}
+
+// Eval implements the Expression interface.
+func (c *Collate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if cc, ok := sql.CollationOf(c.Child.Type()); ok && cc.CharacterSet != c.Collation.CharacterSet {
+		return nil, sql.ErrCollationCharacterSetMismatch.New(c.Collation.Name, cc.CharacterSet)
+	}
+
+	v, err := c.Child.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	return sql.Text.Convert(v)
+}
+
+func (c *Collate) String() string {
+	return fmt.Sprintf("%s COLLATE %s", c.Child, c.Collation)
+}
+
+// WithChildren implements the Expression interface.
+func (c *Collate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewCollate(children[0], c.Collation), nil
+}
+
+// comparisonCollation returns the collation the strings of two expressions
+// are compared with in the given operation. A collation given with COLLATE
+// takes precedence over the collation of the type of an expression, which
+// takes precedence over the default collation. It's an error to compare
+// strings with two different collations that have the same precedence.
+func comparisonCollation(left, right sql.Expression, op string) (*sql.Collation, error) {
+	lc, lexplicit := explicitCollation(left)
+	rc, rexplicit := explicitCollation(right)
+	switch {
+	case lexplicit && rexplicit:
+		if lc != rc {
+			return nil, sql.ErrIllegalCollationMix.New(lc.Name+",EXPLICIT", rc.Name+",EXPLICIT", op)
+		}
+		return lc, nil
+	case lexplicit:
+		return lc, nil
+	case rexplicit:
+		return rc, nil
+	}
+
+	lt, rt := left.Type(), right.Type()
+	lc, _ = sql.CollationOf(lt)
+	rc, _ = sql.CollationOf(rt)
+	switch {
+	case sql.HasCollation(lt) && sql.HasCollation(rt):
+		if lc != rc {
+			return nil, sql.ErrIllegalCollationMix.New(lc.Name+",IMPLICIT", rc.Name+",IMPLICIT", op)
+		}
+		return lc, nil
+	case sql.HasCollation(lt):
+		return lc, nil
+	case sql.HasCollation(rt):
+		return rc, nil
+	default:
+		return sql.DefaultCollation, nil
+	}
+}
+
+// explicitCollation returns the collation of an expression and whether it
+// was given with COLLATE.
+func explicitCollation(e sql.Expression) (*sql.Collation, bool) {
+	switch e := e.(type) {
+	case *Collate:
+		return e.Collation, true
+	case *Alias:
+		return explicitCollation(e.Child)
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,92 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollate(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	bin, err := sql.LookupCollation("utf8mb4_bin")
+	require.NoError(err)
+
+	e := NewCollate(NewLiteral("a", sql.Text), bin)
+	require.Equal(sql.WithCollation(sql.Text, bin), e.Type())
+	require.Equal(`"a" COLLATE utf8mb4_bin`, e.String())
+
+	v, err := e.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("a", v)
+
+	e = NewCollate(NewLiteral(int64(1), sql.Int64), bin)
+	require.Equal(sql.WithCollation(sql.Text, bin), e.Type())
+
+	v, err = e.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("1", v)
+
+	e = NewCollate(NewLiteral(nil, sql.Null), bin)
+	v, err = e.Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(v)
+
+	e = NewCollate(NewGetField(0, sql.VarChar(3), "a", false), bin)
+	require.Equal(sql.WithCollation(sql.VarChar(3), bin), e.Type())
+
+	latin1, err := sql.LookupCollation("latin1_bin")
+	require.NoError(err)
+
+	_, err = NewCollate(NewLiteral("a", sql.Text), latin1).Eval(ctx, nil)
+	require.True(sql.ErrCollationCharacterSetMismatch.Is(err))
+}
+
+func TestCollationComparison(t *testing.T) {
+	bin, err := sql.LookupCollation("utf8mb4_bin")
+	require.NoError(t, err)
+	ci, err := sql.LookupCollation("utf8mb4_unicode_ci")
+	require.NoError(t, err)
+
+	text := NewLiteral("a", sql.Text)
+	upper := NewLiteral("A", sql.Text)
+	binColumn := NewGetField(0, sql.WithCollation(sql.VarChar(3), bin), "a", false)
+	ciColumn := NewGetField(1, sql.WithCollation(sql.Text, ci), "b", false)
+	row := sql.NewRow("a", "a")
+
+	testCases := []struct {
+		name     string
+		cmp      sql.Expression
+		expected interface{}
+		err      bool
+	}{
+		{"default collation", NewEquals(text, upper), true, false},
+		{"collation of the column", NewEquals(binColumn, upper), false, false},
+		{"collation of the right column", NewEquals(upper, binColumn), false, false},
+		{"explicit collation", NewEquals(text, NewCollate(upper, bin)), false, false},
+		{"explicit collation over column", NewEquals(binColumn, NewCollate(upper, ci)), true, false},
+		{"same explicit collations", NewEquals(NewCollate(text, bin), NewCollate(upper, bin)), false, false},
+		{"different explicit collations", NewEquals(NewCollate(text, bin), NewCollate(upper, ci)), nil, true},
+		{"columns with different collations", NewEquals(binColumn, ciColumn), nil, true},
+		{"less than", NewLessThan(NewCollate(upper, bin), NewLiteral("a", sql.Text)), true, false},
+		{"like", NewLike(text, NewLiteral("A%", sql.Text)), true, false},
+		{"like with accents", NewLike(NewLiteral("Été", sql.Text), NewLiteral("e_e", sql.Text)), true, false},
+		{"like with column collation", NewLike(binColumn, NewLiteral("A%", sql.Text)), false, false},
+		{"like with explicit collation", NewLike(text, NewCollate(NewLiteral("A%", sql.Text), bin)), false, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.cmp.Eval(sql.NewEmptyContext(), row)
+			if tt.err {
+				require.True(t, sql.ErrIllegalCollationMix.Is(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
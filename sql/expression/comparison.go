@@ -69,9 +69,73 @@ func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{
 }
 
 func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, interface{}, error) {
+	left, err := memberValue(c.Left().Type(), left, sql.IsNumber(c.Right().Type()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	right, err = memberValue(c.Right().Type(), right, sql.IsNumber(c.Left().Type()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// JSON values are compared with any other value as JSON values.
+	if c.Left().Type() == sql.JSON || c.Right().Type() == sql.JSON {
+		l, err := sql.ToJSONValue(c.Left().Type(), left)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r, err := sql.ToJSONValue(c.Right().Type(), right)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		c.compareType = sql.JSON
+		return sql.JSONDocument{Val: l}, sql.JSONDocument{Val: r}, nil
+	}
+
+	// Times are compared with durations as durations, and with other times
+	// with the greatest precision, so no fractional seconds are lost.
+	if sql.IsDuration(c.Left().Type()) || sql.IsDuration(c.Right().Type()) {
+		c.compareType = sql.TimeWithPrecision(sql.MaxTimePrecision)
+		return left, right, nil
+	}
+
+	if sql.IsTime(c.Left().Type()) && sql.IsTime(c.Right().Type()) {
+		typ := sql.TimestampWithPrecision(sql.MaxTimePrecision)
+		l, err := typ.Convert(left)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r, err := typ.Convert(right)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		c.compareType = typ
+		return l, r, nil
+	}
+
+	if (sql.IsYear(c.Left().Type()) || sql.IsYear(c.Right().Type())) &&
+		(sql.IsNumber(c.Left().Type()) || sql.IsNumber(c.Right().Type())) {
+		c.compareType = sql.Year
+		return left, right, nil
+	}
+
 	if sql.IsNumber(c.Left().Type()) || sql.IsNumber(c.Right().Type()) {
-		if sql.IsDecimal(c.Left().Type()) || sql.IsDecimal(c.Right().Type()) {
-			l, r, err := convertLeftAndRight(left, right, ConvertToDecimal)
+		// Exact values are compared exactly with each other, and as floats
+		// with anything else.
+		if isExactNumber(c.Left().Type()) && isExactNumber(c.Right().Type()) &&
+			(sql.IsFixedPoint(c.Left().Type()) || sql.IsFixedPoint(c.Right().Type())) {
+			c.compareType = exactDecimal
+			return left, right, nil
+		}
+
+		if sql.IsDecimal(c.Left().Type()) || sql.IsDecimal(c.Right().Type()) ||
+			sql.IsFixedPoint(c.Left().Type()) || sql.IsFixedPoint(c.Right().Type()) {
+			l, r, err := convertLeftAndRight(left, right, convertToDouble)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -99,15 +163,38 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return l, r, nil
 	}
 
-	left, right, err := convertLeftAndRight(left, right, ConvertToChar)
+	left, right, err = convertLeftAndRight(left, right, ConvertToChar)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	c.compareType = sql.Text
+	collation, err := comparisonCollation(c.Left(), c.Right(), "comparison")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.compareType = sql.WithCollation(sql.Text, collation)
 	return left, right, nil
 }
 
+// memberValue returns the value of an enum or set as it's compared with
+// values of other types, which is its ordinal or bitmask if they are numbers
+// and its members otherwise. Values of other types are returned as they are.
+func memberValue(t sql.Type, v interface{}, numeric bool) (interface{}, error) {
+	if !sql.IsEnum(t) && !sql.IsSet(t) {
+		return v, nil
+	}
+
+	if numeric {
+		return sql.MemberNumber(t, v)
+	}
+	return t.Convert(v)
+}
+
+func isExactNumber(t sql.Type) bool {
+	return sql.IsInteger(t) || sql.IsFixedPoint(t)
+}
+
 func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
 	l, err := convertValue(left, convertTo)
 	if err != nil {
@@ -2,6 +2,7 @@ package expression
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mushiyu/go-mysql-server/internal/regex"
 	"github.com/mushiyu/go-mysql-server/sql"
@@ -386,3 +387,141 @@ func TestNotIn(t *testing.T) {
 		})
 	}
 }
+
+func TestDecimalComparison(t *testing.T) {
+	dec := func(v string, typ sql.Type) sql.Expression {
+		d, err := typ.Convert(v)
+		require.NoError(t, err)
+		return NewLiteral(d, typ)
+	}
+
+	testCases := []struct {
+		name        string
+		left, right sql.Expression
+		expected    interface{}
+	}{
+		{
+			"decimals of different types",
+			dec("0.10", sql.Decimal(10, 2)),
+			dec("0.1", sql.Decimal(5, 1)),
+			true,
+		},
+		{
+			"decimal and integer",
+			dec("3.00", sql.Decimal(10, 2)),
+			NewLiteral(int64(3), sql.Int64),
+			true,
+		},
+		{
+			"decimal and unsigned integer",
+			dec("18446744073709551615", sql.Decimal(20, 0)),
+			NewLiteral(uint64(18446744073709551614), sql.Uint64),
+			false,
+		},
+		{
+			"decimal and float",
+			dec("0.30", sql.Decimal(10, 2)),
+			NewLiteral(0.3, sql.Float64),
+			true,
+		},
+		{
+			"decimal and text",
+			dec("1.50", sql.Decimal(10, 2)),
+			NewLiteral("1.5", sql.Text),
+			true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewEquals(tt.left, tt.right).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTimeComparison(t *testing.T) {
+	testCases := []struct {
+		name        string
+		left, right sql.Expression
+		expected    interface{}
+	}{
+		{
+			"time and text",
+			NewLiteral(90*time.Minute, sql.Time),
+			NewLiteral("01:30:00", sql.Text),
+			true,
+		},
+		{
+			"times of different precision",
+			NewLiteral(time.Second+time.Millisecond, sql.TimeWithPrecision(3)),
+			NewLiteral(time.Second, sql.Time),
+			false,
+		},
+		{
+			"datetimes of different precision",
+			NewLiteral(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), sql.Datetime),
+			NewLiteral("2019-01-01 00:00:00.000000", sql.DatetimeWithPrecision(6)),
+			true,
+		},
+		{
+			"year and integer",
+			NewLiteral(int16(2019), sql.Year),
+			NewLiteral(int64(19), sql.Int64),
+			true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewEquals(tt.left, tt.right).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEnumComparison(t *testing.T) {
+	enum := sql.Enum("a", "b")
+	set := sql.Set("x", "y")
+	testCases := []struct {
+		name     string
+		cmp      sql.Expression
+		expected interface{}
+	}{
+		{
+			"enum and text",
+			NewEquals(NewLiteral(uint16(2), enum), NewLiteral("b", sql.Text)),
+			true,
+		},
+		{
+			"enum and number",
+			NewLessThan(NewLiteral("a", enum), NewLiteral(int64(2), sql.Int64)),
+			true,
+		},
+		{
+			"set and text",
+			NewEquals(NewLiteral("y,x", set), NewLiteral("x,y", sql.Text)),
+			true,
+		},
+		{
+			"set and number",
+			NewGreaterThan(NewLiteral("y", set), NewLiteral(int64(2), sql.Int64)),
+			false,
+		},
+		{
+			"enums of different types",
+			NewEquals(NewLiteral(uint16(1), enum), NewLiteral(uint16(1), sql.Enum("b", "a"))),
+			false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.cmp.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -1,12 +1,12 @@
 package expression
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/spf13/cast"
 	"github.com/mushiyu/go-mysql-server/sql"
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -32,8 +32,23 @@ const (
 	ConvertToJSON = "json"
 	// ConvertToSigned is a conversion to signed.
 	ConvertToSigned = "signed"
+	// ConvertToTime is a conversion to time.
+	ConvertToTime = "time"
 	// ConvertToUnsigned is a conversion to unsigned.
 	ConvertToUnsigned = "unsigned"
+
+	// convertToDouble is a conversion to double, which is used to compare
+	// numbers of different types.
+	convertToDouble = "double"
+)
+
+const (
+	// defaultDecimalPrecision is the precision of a conversion to decimal
+	// without a precision.
+	defaultDecimalPrecision = 10
+	// defaultDecimalScale is the scale of a conversion to decimal without a
+	// scale.
+	defaultDecimalScale = 0
 )
 
 // Convert represent a CAST(x AS T) or CONVERT(x, T) operation that casts x expression to type T.
@@ -41,20 +56,56 @@ type Convert struct {
 	UnaryExpression
 	// Type to cast
 	castToType string
+	// precision and scale of a conversion to decimal.
+	precision int
+	scale     int
+	// fsp is the fractional seconds precision of a conversion to datetime
+	// or time.
+	fsp int
 }
 
-// NewConvert creates a new Convert expression.
+// NewConvert creates a new Convert expression. Conversions to decimal have
+// a precision of 10 and a scale of 0, as in MySQL.
 func NewConvert(expr sql.Expression, castToType string) *Convert {
 	return &Convert{
 		UnaryExpression: UnaryExpression{Child: expr},
 		castToType:      castToType,
+		precision:       defaultDecimalPrecision,
+		scale:           defaultDecimalScale,
+	}
+}
+
+// NewDecimalConvert creates a new Convert expression to a decimal with the
+// given precision and scale.
+func NewDecimalConvert(expr sql.Expression, precision, scale int) *Convert {
+	return &Convert{
+		UnaryExpression: UnaryExpression{Child: expr},
+		castToType:      ConvertToDecimal,
+		precision:       precision,
+		scale:           scale,
 	}
 }
 
+// NewDatetimeConvert creates a new Convert expression to a datetime with
+// the given fractional seconds precision.
+func NewDatetimeConvert(expr sql.Expression, fsp int) *Convert {
+	c := NewConvert(expr, ConvertToDatetime)
+	c.fsp = fsp
+	return c
+}
+
+// NewTimeConvert creates a new Convert expression to a time with the given
+// fractional seconds precision.
+func NewTimeConvert(expr sql.Expression, fsp int) *Convert {
+	c := NewConvert(expr, ConvertToTime)
+	c.fsp = fsp
+	return c
+}
+
 // IsNullable implements the Expression interface.
 func (c *Convert) IsNullable() bool {
 	switch c.castToType {
-	case ConvertToDate, ConvertToDatetime:
+	case ConvertToDate, ConvertToDatetime, ConvertToTime:
 		return true
 	default:
 		return c.Child.IsNullable()
@@ -71,13 +122,15 @@ func (c *Convert) Type() sql.Type {
 	case ConvertToDate:
 		return sql.Date
 	case ConvertToDatetime:
-		return sql.Timestamp
+		return sql.TimestampWithPrecision(c.fsp)
 	case ConvertToDecimal:
-		return sql.Float64
+		return sql.Decimal(c.precision, c.scale)
 	case ConvertToJSON:
 		return sql.JSON
 	case ConvertToSigned:
 		return sql.Int64
+	case ConvertToTime:
+		return sql.TimeWithPrecision(c.fsp)
 	case ConvertToUnsigned:
 		return sql.Uint64
 	default:
@@ -87,6 +140,12 @@ func (c *Convert) Type() sql.Type {
 
 // Name implements the Expression interface.
 func (c *Convert) String() string {
+	switch {
+	case c.castToType == ConvertToDecimal:
+		return fmt.Sprintf("convert(%v, %v(%d,%d))", c.Child, c.castToType, c.precision, c.scale)
+	case (c.castToType == ConvertToDatetime || c.castToType == ConvertToTime) && c.fsp > 0:
+		return fmt.Sprintf("convert(%v, %v(%d))", c.Child, c.castToType, c.fsp)
+	}
 	return fmt.Sprintf("convert(%v, %v)", c.Child, c.castToType)
 }
 
@@ -95,7 +154,9 @@ func (c *Convert) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
 	}
-	return NewConvert(children[0], c.castToType), nil
+	nc := *c
+	nc.Child = children[0]
+	return &nc, nil
 }
 
 // Eval implements the Expression interface.
@@ -109,7 +170,18 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	casted, err := convertValue(val, c.castToType)
+	var casted interface{}
+	switch c.castToType {
+	case ConvertToDecimal:
+		casted, err = convertToDecimal(val, c.Type())
+	case ConvertToDatetime:
+		casted, err = convertToTime(val, c.Type())
+	case ConvertToTime:
+		casted, err = convertToDuration(val, c.Type())
+	default:
+		casted, err = convertValue(val, c.castToType)
+	}
+
 	if err != nil {
 		return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
 	}
@@ -138,23 +210,16 @@ func convertValue(val interface{}, castTo string) (interface{}, error) {
 		}
 
 		return s, nil
-	case ConvertToDate, ConvertToDatetime:
-		_, isTime := val.(time.Time)
-		_, isString := val.(string)
-		if !(isTime || isString) {
-			return nil, nil
-		}
-
-		d, err := sql.Timestamp.Convert(val)
-		if err != nil {
-			d, err = sql.Date.Convert(val)
-			if err != nil {
-				return nil, nil
-			}
+	case ConvertToDate:
+		return convertToTime(val, sql.TimestampWithPrecision(sql.MaxTimePrecision))
+	case ConvertToDatetime:
+		return convertToTime(val, sql.Timestamp)
+	case convertToDouble:
+		if d, ok := val.(decimal.Decimal); ok {
+			f, _ := d.Float64()
+			return f, nil
 		}
 
-		return sql.ValidateTime(d.(time.Time)), nil
-	case ConvertToDecimal:
 		d, err := cast.ToFloat64E(val)
 		if err != nil {
 			return float64(0), nil
@@ -167,13 +232,12 @@ func convertValue(val interface{}, castTo string) (interface{}, error) {
 			return nil, err
 		}
 
-		var jsn interface{}
-		err = json.Unmarshal([]byte(s), &jsn)
+		jsn, err := sql.ParseJSON(s)
 		if err != nil {
 			return nil, err
 		}
 
-		return []byte(s), nil
+		return sql.JSONDocument{Val: jsn}, nil
 	case ConvertToSigned:
 		num, err := sql.Int64.Convert(val)
 		if err != nil {
@@ -181,6 +245,8 @@ func convertValue(val interface{}, castTo string) (interface{}, error) {
 		}
 
 		return num, nil
+	case ConvertToTime:
+		return convertToDuration(val, sql.Time)
 	case ConvertToUnsigned:
 		num, err := sql.Uint64.Convert(val)
 		if err != nil {
@@ -193,6 +259,45 @@ func convertValue(val interface{}, castTo string) (interface{}, error) {
 	}
 }
 
+// convertToTime converts a time or a string to the given timestamp type.
+// Other values, and strings that are not times or dates, are converted to
+// NULL.
+func convertToTime(val interface{}, typ sql.Type) (interface{}, error) {
+	_, isTime := val.(time.Time)
+	_, isString := val.(string)
+	if !(isTime || isString) {
+		return nil, nil
+	}
+
+	d, err := typ.Convert(val)
+	if err != nil {
+		d, err = sql.Date.Convert(val)
+		if err != nil {
+			return nil, nil
+		}
+	}
+
+	return sql.ValidateTime(d.(time.Time)), nil
+}
+
+// convertToDuration converts the value to the given Time type. Values that
+// are not times are converted to NULL, as in MySQL.
+func convertToDuration(val interface{}, typ sql.Type) (interface{}, error) {
+	d, err := typ.Convert(val)
+	if err != nil {
+		return nil, nil
+	}
+
+	return d, nil
+}
+
+// convertToDecimal converts the value to the given Decimal type. Values
+// that are not numbers are converted to 0, and numbers out of the range of
+// the type to its maximum or minimum value, as in MySQL.
+func convertToDecimal(val interface{}, typ sql.Type) (interface{}, error) {
+	return sql.TruncateValue(typ, val)
+}
+
 func handleUnsignedErrors(err error, val interface{}) uint64 {
 	if err.Error() == "unable to cast negative value" {
 		return castSignedToUnsigned(val)
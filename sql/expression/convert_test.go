@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
 )
 
 func TestConvert(t *testing.T) {
@@ -118,7 +119,7 @@ func TestConvert(t *testing.T) {
 			row:         nil,
 			castTo:      ConvertToJSON,
 			expression:  NewLiteral(`{"a":2}`, sql.Text),
-			expected:    []byte(`{"a":2}`),
+			expected:    sql.JSONDocument{Val: map[string]interface{}{"a": int64(2)}},
 			expectedErr: false,
 		},
 		{
@@ -126,7 +127,7 @@ func TestConvert(t *testing.T) {
 			row:         nil,
 			castTo:      ConvertToJSON,
 			expression:  NewLiteral(2, sql.Int32),
-			expected:    []byte("2"),
+			expected:    sql.JSONDocument{Val: int64(2)},
 			expectedErr: false,
 		},
 		{
@@ -170,3 +171,94 @@ func TestConvert(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		convert  *Convert
+		typ      sql.Type
+		expected interface{}
+	}{
+		{
+			"default precision",
+			NewConvert(NewLiteral("10:11:12.5", sql.Text), ConvertToTime),
+			sql.Time,
+			10*time.Hour + 11*time.Minute + 13*time.Second,
+		},
+		{
+			"rounded to precision",
+			NewTimeConvert(NewLiteral("10:11:12.56", sql.Text), 1),
+			sql.TimeWithPrecision(1),
+			10*time.Hour + 11*time.Minute + 12*time.Second + 600*time.Millisecond,
+		},
+		{
+			"not a time",
+			NewTimeConvert(NewLiteral("foo", sql.Text), 1),
+			sql.TimeWithPrecision(1),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.typ, tt.convert.Type())
+
+			val, err := tt.convert.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, val)
+		})
+	}
+}
+
+func TestDecimalConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		convert  *Convert
+		typ      sql.Type
+		expected string
+	}{
+		{
+			"default precision",
+			NewConvert(NewLiteral(float64(2.5), sql.Float64), ConvertToDecimal),
+			sql.Decimal(10, 0),
+			"3",
+		},
+		{
+			"rounded to scale",
+			NewDecimalConvert(NewLiteral("1.255", sql.Text), 4, 2),
+			sql.Decimal(4, 2),
+			"1.26",
+		},
+		{
+			"not a number",
+			NewDecimalConvert(NewLiteral("foo", sql.Text), 4, 2),
+			sql.Decimal(4, 2),
+			"0",
+		},
+		{
+			"out of range",
+			NewDecimalConvert(NewLiteral(int64(1000), sql.Int64), 4, 2),
+			sql.Decimal(4, 2),
+			"99.99",
+		},
+		{
+			"negative out of range",
+			NewDecimalConvert(NewLiteral("-1e10", sql.Text), 3, 0),
+			sql.Decimal(3, 0),
+			"-999",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.typ, tt.convert.Type())
+
+			val, err := tt.convert.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.IsType(decimal.Decimal{}, val)
+			require.Equal(tt.expected, val.(decimal.Decimal).String())
+		})
+	}
+}
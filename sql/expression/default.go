@@ -60,3 +60,47 @@ func (c *DefaultColumn) WithChildren(children ...sql.Expression) (sql.Expression
 	}
 	return c, nil
 }
+
+// ColumnDefault is the DEFAULT keyword given as the value of a column in an
+// INSERT, once the column is known. It evaluates to DefaultValue, which is
+// replaced with the default value of the column when the row is inserted.
+type ColumnDefault struct {
+	column *sql.Column
+}
+
+// DefaultValue is the value of a ColumnDefault expression.
+var DefaultValue = defaultValue{}
+
+type defaultValue struct{}
+
+// NewColumnDefault creates a new ColumnDefault expression.
+func NewColumnDefault(column *sql.Column) *ColumnDefault {
+	return &ColumnDefault{column}
+}
+
+// Children implements the sql.Expression interface.
+func (*ColumnDefault) Children() []sql.Expression { return nil }
+
+// Resolved implements the sql.Expression interface.
+func (*ColumnDefault) Resolved() bool { return true }
+
+// IsNullable implements the sql.Expression interface.
+func (c *ColumnDefault) IsNullable() bool { return c.column.Nullable }
+
+// Type implements the sql.Expression interface.
+func (c *ColumnDefault) Type() sql.Type { return c.column.Type }
+
+func (*ColumnDefault) String() string { return "DEFAULT" }
+
+// Eval implements the sql.Expression interface.
+func (*ColumnDefault) Eval(ctx *sql.Context, r sql.Row) (interface{}, error) {
+	return DefaultValue, nil
+}
+
+// WithChildren implements the Expression interface.
+func (c *ColumnDefault) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
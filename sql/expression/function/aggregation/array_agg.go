@@ -0,0 +1,78 @@
+package aggregation
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// ArrayAgg aggregation returns an array with all the values in the selected
+// column, including NULLs.
+// It implements the Aggregation interface.
+type ArrayAgg struct {
+	expression.UnaryExpression
+}
+
+// NewArrayAgg returns a new ArrayAgg node.
+func NewArrayAgg(e sql.Expression) *ArrayAgg {
+	return &ArrayAgg{expression.UnaryExpression{Child: e}}
+}
+
+// Type returns the resultant type of the aggregation.
+func (a *ArrayAgg) Type() sql.Type {
+	return sql.Array(a.Child.Type())
+}
+
+// IsNullable returns whether the return value can be null.
+func (a *ArrayAgg) IsNullable() bool {
+	return true
+}
+
+func (a *ArrayAgg) String() string {
+	return fmt.Sprintf("ARRAY_AGG(%s)", a.Child)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (a *ArrayAgg) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewArrayAgg(children[0]), nil
+}
+
+// NewBuffer creates a new buffer to compute the result.
+func (a *ArrayAgg) NewBuffer() sql.Row {
+	return sql.NewRow(nil)
+}
+
+// Update implements the Aggregation interface.
+func (a *ArrayAgg) Update(ctx *sql.Context, buffer, row sql.Row) error {
+	v, err := a.Child.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	values, _ := buffer[0].([]interface{})
+	buffer[0] = append(values, v)
+
+	return nil
+}
+
+// Merge implements the Aggregation interface.
+func (a *ArrayAgg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
+	if partial[0] == nil {
+		return nil
+	}
+
+	values, _ := buffer[0].([]interface{})
+	buffer[0] = append(values, partial[0].([]interface{})...)
+
+	return nil
+}
+
+// Eval implements the Aggregation interface. NULL is returned if there are
+// no rows.
+func (a *ArrayAgg) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	return buffer[0], nil
+}
@@ -0,0 +1,65 @@
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestArrayAgg(t *testing.T) {
+	testCases := []struct {
+		name     string
+		typ      sql.Type
+		rows     []sql.Row
+		expected interface{}
+	}{
+		{"no rows", sql.Int64, nil, nil},
+		{"numbers", sql.Int64, []sql.Row{{int64(1)}, {nil}, {int64(3)}}, []interface{}{int64(1), nil, int64(3)}},
+		{"strings", sql.Text, []sql.Row{{"a"}, {"b"}}, []interface{}{"a", "b"}},
+		{"only nulls", sql.Text, []sql.Row{{nil}}, []interface{}{nil}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			agg := NewArrayAgg(expression.NewGetField(0, tt.typ, "", true))
+			require.Equal(t, sql.Array(tt.typ), agg.Type())
+
+			result := aggregate(t, agg, tt.rows...)
+			if tt.expected == nil {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArrayAggMerge(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	agg := NewArrayAgg(expression.NewGetField(0, sql.Int64, "", true))
+	require.Equal("ARRAY_AGG(field)", NewArrayAgg(expression.NewGetField(0, sql.Int64, "field", true)).String())
+
+	b1 := agg.NewBuffer()
+	require.NoError(agg.Update(ctx, b1, sql.Row{int64(1)}))
+	b2 := agg.NewBuffer()
+	require.NoError(agg.Update(ctx, b2, sql.Row{int64(2)}))
+	require.NoError(agg.Update(ctx, b2, sql.Row{nil}))
+	b3 := agg.NewBuffer()
+
+	require.NoError(agg.Merge(ctx, b1, b2))
+	require.NoError(agg.Merge(ctx, b1, b3))
+
+	result, err := agg.Eval(ctx, b1)
+	require.NoError(err)
+	require.Equal([]interface{}{int64(1), int64(2), nil}, result)
+
+	b4 := agg.NewBuffer()
+	require.NoError(agg.Merge(ctx, b4, b3))
+	result, err = agg.Eval(ctx, b4)
+	require.NoError(err)
+	require.Nil(result)
+}
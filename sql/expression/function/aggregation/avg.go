@@ -5,6 +5,7 @@ import (
 
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/shopspring/decimal"
 )
 
 // Avg node to calculate the average from numeric column
@@ -26,8 +27,19 @@ func (a *Avg) Resolved() bool {
 	return true
 }
 
+// avgPrecisionIncrement is the number of digits that the precision and the
+// scale of the average of decimals have besides the ones of the decimals, as
+// in MySQL.
+const avgPrecisionIncrement = 4
+
 // Type implements AggregationExpression interface. (AggregationExpression[Expression]])
+// The average of decimals is a decimal, and the one of any other values is a
+// double.
 func (a *Avg) Type() sql.Type {
+	if precision, scale, ok := decimalPrecision(a.Child.Type()); ok {
+		return sql.Decimal(precision+avgPrecisionIncrement, scale+avgPrecisionIncrement)
+	}
+
 	return sql.Float64
 }
 
@@ -43,9 +55,18 @@ func (a *Avg) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	sum := buffer[0].(float64)
 	rows := buffer[1].(int64)
+	if sum, ok := buffer[0].(decimal.Decimal); ok {
+		typ := a.Type()
+		if rows == 0 {
+			return typ.Convert(decimal.Zero)
+		}
+
+		_, scale, _ := sql.DecimalPrecision(typ)
+		return typ.Convert(sum.DivRound(decimal.New(rows, 0), int32(scale)))
+	}
 
+	sum := buffer[0].(float64)
 	if rows == 0 {
 		return float64(0), nil
 	}
@@ -64,12 +85,15 @@ func (a *Avg) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 // NewBuffer implements AggregationExpression interface. (AggregationExpression)
 func (a *Avg) NewBuffer() sql.Row {
 	const (
-		sum   = float64(0)
 		rows  = int64(0)
 		nulls = false
 	)
 
-	return sql.NewRow(sum, rows, nulls)
+	if sql.IsFixedPoint(a.Child.Type()) {
+		return sql.NewRow(decimal.Zero, rows, nulls)
+	}
+
+	return sql.NewRow(float64(0), rows, nulls)
 }
 
 // Update implements AggregationExpression interface. (AggregationExpression)
@@ -89,6 +113,17 @@ func (a *Avg) Update(ctx *sql.Context, buffer, row sql.Row) error {
 		return nil
 	}
 
+	if sum, ok := buffer[0].(decimal.Decimal); ok {
+		v, err = a.Child.Type().Convert(v)
+		if err != nil {
+			return err
+		}
+
+		buffer[0] = sum.Add(v.(decimal.Decimal))
+		buffer[1] = buffer[1].(int64) + 1
+		return nil
+	}
+
 	v, err = sql.Float64.Convert(v)
 	if err != nil {
 		v = float64(0)
@@ -102,15 +137,17 @@ func (a *Avg) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements AggregationExpression interface. (AggregationExpression)
 func (a *Avg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
-	bsum := buffer[0].(float64)
 	brows := buffer[1].(int64)
 	bnulls := buffer[2].(bool)
 
-	psum := partial[0].(float64)
 	prows := partial[1].(int64)
 	pnulls := buffer[2].(bool)
 
-	buffer[0] = bsum + psum
+	if bsum, ok := buffer[0].(decimal.Decimal); ok {
+		buffer[0] = bsum.Add(partial[0].(decimal.Decimal))
+	} else {
+		buffer[0] = buffer[0].(float64) + partial[0].(float64)
+	}
 	buffer[1] = brows + prows
 	buffer[2] = bnulls || pnulls
 
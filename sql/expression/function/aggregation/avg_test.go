@@ -3,6 +3,7 @@ package aggregation
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
@@ -105,6 +106,25 @@ func TestAvg_Merge(t *testing.T) {
 	require.Equal(float64(5.2), eval(t, avgNode, buffer1))
 }
 
+func TestAvg_Decimal(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	avgNode := NewAvg(expression.NewGetField(0, sql.Decimal(10, 2), "col1", true))
+	require.Equal(sql.Decimal(14, 6), avgNode.Type())
+
+	buffer1 := avgNode.NewBuffer()
+	require.Equal("0", eval(t, avgNode, buffer1).(decimal.Decimal).String())
+	require.NoError(avgNode.Update(ctx, buffer1, sql.NewRow(decimal.New(10, -2))))
+	require.NoError(avgNode.Update(ctx, buffer1, sql.NewRow("0.20")))
+
+	buffer2 := avgNode.NewBuffer()
+	require.NoError(avgNode.Update(ctx, buffer2, sql.NewRow(0.4)))
+
+	require.NoError(avgNode.Merge(ctx, buffer1, buffer2))
+	require.Equal("0.233333", eval(t, avgNode, buffer1).(decimal.Decimal).String())
+}
+
 func TestAvg_NULL(t *testing.T) {
 	require := require.New(t)
 	ctx := sql.NewEmptyContext()
@@ -151,7 +151,8 @@ func (c *CountDistinct) Update(ctx *sql.Context, buffer, row sql.Row) error {
 			return err
 		}
 
-		value = v
+		// Strings that are equal in their collation are counted once.
+		value = sql.CollationKey(c.Child.Type(), v)
 	}
 
 	hash, err := hashstructure.Hash(value, nil)
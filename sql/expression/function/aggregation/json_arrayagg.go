@@ -0,0 +1,87 @@
+package aggregation
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// JSONArrayAgg aggregation returns a JSON array with all the values in the
+// selected column, including NULLs.
+// It implements the Aggregation interface.
+type JSONArrayAgg struct {
+	expression.UnaryExpression
+}
+
+// NewJSONArrayAgg returns a new JSONArrayAgg node.
+func NewJSONArrayAgg(e sql.Expression) *JSONArrayAgg {
+	return &JSONArrayAgg{expression.UnaryExpression{Child: e}}
+}
+
+// Type returns the resultant type of the aggregation.
+func (a *JSONArrayAgg) Type() sql.Type {
+	return sql.JSON
+}
+
+// IsNullable returns whether the return value can be null.
+func (a *JSONArrayAgg) IsNullable() bool {
+	return true
+}
+
+func (a *JSONArrayAgg) String() string {
+	return fmt.Sprintf("JSON_ARRAYAGG(%s)", a.Child)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (a *JSONArrayAgg) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewJSONArrayAgg(children[0]), nil
+}
+
+// NewBuffer creates a new buffer to compute the result.
+func (a *JSONArrayAgg) NewBuffer() sql.Row {
+	return sql.NewRow(nil)
+}
+
+// Update implements the Aggregation interface.
+func (a *JSONArrayAgg) Update(ctx *sql.Context, buffer, row sql.Row) error {
+	v, err := a.Child.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	v, err = sql.ToJSONValue(a.Child.Type(), v)
+	if err != nil {
+		return err
+	}
+
+	values, _ := buffer[0].([]interface{})
+	buffer[0] = append(values, v)
+
+	return nil
+}
+
+// Merge implements the Aggregation interface.
+func (a *JSONArrayAgg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
+	if partial[0] == nil {
+		return nil
+	}
+
+	values, _ := buffer[0].([]interface{})
+	buffer[0] = append(values, partial[0].([]interface{})...)
+
+	return nil
+}
+
+// Eval implements the Aggregation interface. NULL is returned if there are
+// no rows.
+func (a *JSONArrayAgg) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	if buffer[0] == nil {
+		return nil, nil
+	}
+
+	return sql.JSONDocument{Val: buffer[0]}, nil
+}
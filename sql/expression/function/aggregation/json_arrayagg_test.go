@@ -0,0 +1,56 @@
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONArrayAgg(t *testing.T) {
+	testCases := []struct {
+		name     string
+		typ      sql.Type
+		rows     []sql.Row
+		expected interface{}
+	}{
+		{"no rows", sql.Int64, nil, nil},
+		{"numbers", sql.Int64, []sql.Row{{int64(1)}, {nil}, {int64(3)}}, `[1, null, 3]`},
+		{"strings", sql.Text, []sql.Row{{"a"}, {`{"b": 1}`}}, `["a", "{\"b\": 1}"]`},
+		{"json", sql.JSON, []sql.Row{{`{"b": 1}`}, {`[2]`}}, `[{"b": 1}, [2]]`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			agg := NewJSONArrayAgg(expression.NewGetField(0, tt.typ, "", true))
+			result := aggregate(t, agg, tt.rows...)
+			if tt.expected == nil {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, tt.expected, result.(sql.JSONDocument).String())
+			}
+		})
+	}
+}
+
+func TestJSONArrayAggMerge(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	agg := NewJSONArrayAgg(expression.NewGetField(0, sql.Int64, "", true))
+	require.Equal("JSON_ARRAYAGG(field)", NewJSONArrayAgg(expression.NewGetField(0, sql.Int64, "field", true)).String())
+
+	b1 := agg.NewBuffer()
+	require.NoError(agg.Update(ctx, b1, sql.Row{int64(1)}))
+	b2 := agg.NewBuffer()
+	require.NoError(agg.Update(ctx, b2, sql.Row{int64(2)}))
+	b3 := agg.NewBuffer()
+
+	require.NoError(agg.Merge(ctx, b1, b2))
+	require.NoError(agg.Merge(ctx, b1, b3))
+
+	result, err := agg.Eval(ctx, b1)
+	require.NoError(err)
+	require.Equal(sql.JSONDocument{Val: []interface{}{int64(1), int64(2)}}, result)
+}
@@ -0,0 +1,114 @@
+package aggregation
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// JSONObjectAgg aggregation returns a JSON object with the keys in a column
+// and the values in another one. If a key is found more than once, the last
+// value found for it is kept.
+// It implements the Aggregation interface.
+type JSONObjectAgg struct {
+	expression.BinaryExpression
+}
+
+// NewJSONObjectAgg returns a new JSONObjectAgg node.
+func NewJSONObjectAgg(key, value sql.Expression) sql.Expression {
+	return &JSONObjectAgg{expression.BinaryExpression{Left: key, Right: value}}
+}
+
+// Type returns the resultant type of the aggregation.
+func (a *JSONObjectAgg) Type() sql.Type {
+	return sql.JSON
+}
+
+// IsNullable returns whether the return value can be null.
+func (a *JSONObjectAgg) IsNullable() bool {
+	return true
+}
+
+func (a *JSONObjectAgg) String() string {
+	return fmt.Sprintf("JSON_OBJECTAGG(%s, %s)", a.Left, a.Right)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (a *JSONObjectAgg) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 2)
+	}
+	return NewJSONObjectAgg(children[0], children[1]), nil
+}
+
+// NewBuffer creates a new buffer to compute the result.
+func (a *JSONObjectAgg) NewBuffer() sql.Row {
+	return sql.NewRow(nil)
+}
+
+// Update implements the Aggregation interface.
+func (a *JSONObjectAgg) Update(ctx *sql.Context, buffer, row sql.Row) error {
+	k, err := a.Left.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if k == nil {
+		return sql.ErrJSONNullKey.New()
+	}
+
+	k, err = sql.Text.Convert(k)
+	if err != nil {
+		return err
+	}
+
+	v, err := a.Right.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	v, err = sql.ToJSONValue(a.Right.Type(), v)
+	if err != nil {
+		return err
+	}
+
+	o, ok := buffer[0].(map[string]interface{})
+	if !ok {
+		o = make(map[string]interface{})
+		buffer[0] = o
+	}
+	o[k.(string)] = v
+
+	return nil
+}
+
+// Merge implements the Aggregation interface.
+func (a *JSONObjectAgg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
+	p, ok := partial[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	o, ok := buffer[0].(map[string]interface{})
+	if !ok {
+		o = make(map[string]interface{}, len(p))
+		buffer[0] = o
+	}
+
+	for k, v := range p {
+		o[k] = v
+	}
+
+	return nil
+}
+
+// Eval implements the Aggregation interface. NULL is returned if there are
+// no rows.
+func (a *JSONObjectAgg) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	if buffer[0] == nil {
+		return nil, nil
+	}
+
+	return sql.JSONDocument{Val: buffer[0]}, nil
+}
@@ -0,0 +1,51 @@
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONObjectAgg(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rows     []sql.Row
+		expected interface{}
+	}{
+		{"no rows", nil, nil},
+		{"values", []sql.Row{{"a", `1`}, {"b", `[2]`}, {"c", nil}}, `{"a": 1, "b": [2], "c": null}`},
+		{"duplicated keys", []sql.Row{{"a", `1`}, {"a", `2`}}, `{"a": 2}`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			agg := NewJSONObjectAgg(
+				expression.NewGetField(0, sql.Text, "k", true),
+				expression.NewGetField(1, sql.JSON, "v", true),
+			).(sql.Aggregation)
+			result := aggregate(t, agg, tt.rows...)
+			if tt.expected == nil {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, tt.expected, result.(sql.JSONDocument).String())
+			}
+		})
+	}
+}
+
+func TestJSONObjectAggErrors(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	agg := NewJSONObjectAgg(
+		expression.NewGetField(0, sql.Text, "k", true),
+		expression.NewGetField(1, sql.Int64, "v", true),
+	).(sql.Aggregation)
+	require.Equal("JSON_OBJECTAGG(k, v)", agg.String())
+
+	err := agg.Update(ctx, agg.NewBuffer(), sql.Row{nil, int64(1)})
+	require.Error(err)
+	require.True(sql.ErrJSONNullKey.Is(err))
+}
@@ -36,7 +36,10 @@ func TestMin_Eval_Text(t *testing.T) {
 	assert := require.New(t)
 	ctx := sql.NewEmptyContext()
 
-	m := NewMin(expression.NewGetField(0, sql.Text, "field", true))
+	bin, err := sql.LookupCollation("utf8mb4_bin")
+	assert.NoError(err)
+
+	m := NewMin(expression.NewGetField(0, sql.WithCollation(sql.Text, bin), "field", true))
 	b := m.NewBuffer()
 
 	m.Update(ctx, b, sql.NewRow("a"))
@@ -46,6 +49,19 @@ func TestMin_Eval_Text(t *testing.T) {
 	v, err := m.Eval(ctx, b)
 	assert.NoError(err)
 	assert.Equal("A", v)
+
+	// With the default collation, "a" and "A" are equal, so the first one
+	// is kept.
+	m = NewMin(expression.NewGetField(0, sql.Text, "field", true))
+	b = m.NewBuffer()
+
+	m.Update(ctx, b, sql.NewRow("a"))
+	m.Update(ctx, b, sql.NewRow("A"))
+	m.Update(ctx, b, sql.NewRow("b"))
+
+	v, err = m.Eval(ctx, b)
+	assert.NoError(err)
+	assert.Equal("a", v)
 }
 
 func TestMin_Eval_Timestamp(t *testing.T) {
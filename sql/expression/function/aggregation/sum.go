@@ -5,6 +5,7 @@ import (
 
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/shopspring/decimal"
 )
 
 // Sum agregation returns the sum of all values in the selected column.
@@ -18,8 +19,17 @@ func NewSum(e sql.Expression) *Sum {
 	return &Sum{expression.UnaryExpression{Child: e}}
 }
 
-// Type returns the resultant type of the aggregation.
+// sumPrecisionIncrement is the number of digits that the precision of the
+// sum of decimals has besides the ones of the decimals, as in MySQL.
+const sumPrecisionIncrement = 22
+
+// Type returns the resultant type of the aggregation, which is a decimal
+// for the sum of decimals and a double for any other values.
 func (m *Sum) Type() sql.Type {
+	if precision, scale, ok := decimalPrecision(m.Child.Type()); ok {
+		return sql.Decimal(precision+sumPrecisionIncrement, scale)
+	}
+
 	return sql.Float64
 }
 
@@ -51,6 +61,20 @@ func (m *Sum) Update(ctx *sql.Context, buffer, row sql.Row) error {
 		return nil
 	}
 
+	if typ := m.Type(); sql.IsFixedPoint(typ) {
+		val, err := typ.Convert(v)
+		if err != nil {
+			return err
+		}
+
+		if buffer[0] == nil {
+			buffer[0] = decimal.Zero
+		}
+
+		buffer[0] = buffer[0].(decimal.Decimal).Add(val.(decimal.Decimal))
+		return nil
+	}
+
 	val, err := sql.Float64.Convert(v)
 	if err != nil {
 		val = float64(0)
@@ -76,3 +100,13 @@ func (m *Sum) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 
 	return sum, nil
 }
+
+// decimalPrecision returns the precision and scale of the given type if
+// it's a Decimal type.
+func decimalPrecision(t sql.Type) (precision, scale int, ok bool) {
+	if !sql.IsFixedPoint(t) {
+		return 0, 0, false
+	}
+
+	return sql.DecimalPrecision(t)
+}
@@ -3,6 +3,7 @@ package aggregation
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
@@ -73,3 +74,20 @@ func TestSum(t *testing.T) {
 		})
 	}
 }
+
+func TestSumDecimal(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	sum := NewSum(expression.NewGetField(0, sql.Decimal(10, 2), "", true))
+	require.Equal(sql.Decimal(32, 2), sum.Type())
+
+	buf := sum.NewBuffer()
+	for _, v := range []interface{}{0.1, "0.2", nil, decimal.New(1999, -2)} {
+		require.NoError(sum.Update(ctx, buf, sql.NewRow(v)))
+	}
+
+	result, err := sum.Eval(ctx, buf)
+	require.NoError(err)
+	require.Equal("20.29", result.(decimal.Decimal).String())
+}
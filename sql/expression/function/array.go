@@ -0,0 +1,116 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrNotArrayArgument is returned when an array function is given a value
+// that is not an array.
+var ErrNotArrayArgument = errors.NewKind("argument of type %q given to %s, expecting array")
+
+// elementType returns the type of the elements of the arrays returned by the
+// expression, which are either arrays or JSON arrays.
+func elementType(e sql.Expression) sql.Type {
+	if t := e.Type(); sql.IsArray(t) {
+		return sql.UnderlyingType(t)
+	}
+	return sql.JSON
+}
+
+// evalArray returns the elements of the array that is the value of the
+// expression, or nil if it's NULL. The expression may also return JSON
+// arrays, whose elements are returned as JSON documents, except for JSON
+// nulls, which are returned as NULL.
+func evalArray(ctx *sql.Context, name string, e sql.Expression, row sql.Row) ([]interface{}, error) {
+	t := e.Type()
+	if !sql.IsArray(t) && t != sql.JSON {
+		return nil, ErrNotArrayArgument.New(t, name)
+	}
+
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	if t == sql.JSON {
+		doc, err := sql.JSON.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+
+		array, ok := doc.(sql.JSONDocument).Val.([]interface{})
+		if !ok {
+			return nil, ErrNotArrayArgument.New(t, name)
+		}
+
+		result := make([]interface{}, len(array))
+		for i, e := range array {
+			if e != nil {
+				result[i] = sql.JSONDocument{Val: e}
+			}
+		}
+		return result, nil
+	}
+
+	v, err = t.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]interface{}), nil
+}
+
+// Array returns an array with the given values.
+type Array struct {
+	args []sql.Expression
+}
+
+// NewArray creates a new Array UDF.
+func NewArray(args ...sql.Expression) (sql.Expression, error) {
+	return &Array{args}, nil
+}
+
+// Type implements the Expression interface. The elements of the array have
+// the type of the first argument that is not a NULL literal.
+func (f *Array) Type() sql.Type {
+	for _, arg := range f.args {
+		if t := arg.Type(); t != sql.Null {
+			return sql.Array(t)
+		}
+	}
+	return sql.Array(sql.Null)
+}
+
+// IsNullable implements the Expression interface.
+func (f *Array) IsNullable() bool { return false }
+
+// Resolved implements the Expression interface.
+func (f *Array) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *Array) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *Array) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewArray(children...)
+}
+
+func (f *Array) String() string { return jsonFuncString("ARRAY", f.args) }
+
+// Eval implements the Expression interface.
+func (f *Array) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.Array")
+	defer span.Finish()
+
+	a := make([]interface{}, len(f.args))
+	for i, arg := range f.args {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		a[i] = v
+	}
+
+	return f.Type().Convert(a)
+}
@@ -0,0 +1,195 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrArrayIndexZero is returned when an array function is given 0 as
+	// the index of an element.
+	ErrArrayIndexZero = errors.NewKind("array indexes start at 1 in %s, 0 given")
+
+	// ErrNegativeSliceLength is returned when SLICE is given a negative
+	// length.
+	ErrNegativeSliceLength = errors.NewKind("negative length given to SLICE: %d")
+)
+
+// evalArrayIndex returns the value of the expression as an array index,
+// which can't be 0. The returned boolean is false if it's NULL.
+func evalArrayIndex(ctx *sql.Context, name string, e sql.Expression, row sql.Row) (int64, bool, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return 0, false, err
+	}
+
+	v, err = sql.Int64.Convert(v)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if v.(int64) == 0 {
+		return 0, false, ErrArrayIndexZero.New(name)
+	}
+
+	return v.(int64), true, nil
+}
+
+// ElementAt returns the element of an array at the given index. Indexes
+// start at 1, and negative indexes are counted from the end of the array.
+type ElementAt struct {
+	expression.BinaryExpression
+}
+
+// NewElementAt creates a new ElementAt UDF.
+func NewElementAt(array, index sql.Expression) sql.Expression {
+	return &ElementAt{expression.BinaryExpression{Left: array, Right: index}}
+}
+
+// Type implements the Expression interface.
+func (f *ElementAt) Type() sql.Type { return elementType(f.Left) }
+
+// IsNullable implements the Expression interface.
+func (f *ElementAt) IsNullable() bool { return true }
+
+func (f *ElementAt) String() string {
+	return fmt.Sprintf("ELEMENT_AT(%s, %s)", f.Left, f.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (f *ElementAt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+	return NewElementAt(children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface. NULL is returned if the index is
+// out of the bounds of the array.
+func (f *ElementAt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.ElementAt")
+	defer span.Finish()
+
+	array, err := evalArray(ctx, "ELEMENT_AT", f.Left, row)
+	if err != nil || array == nil {
+		return nil, err
+	}
+
+	index, ok, err := evalArrayIndex(ctx, "ELEMENT_AT", f.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if index < 0 {
+		index += int64(len(array)) + 1
+	}
+
+	if index < 1 || index > int64(len(array)) {
+		return nil, nil
+	}
+
+	return array[index-1], nil
+}
+
+// Slice returns the part of an array that starts at the given index and has
+// the given length, or goes until the end of the array if there is no
+// length. Indexes start at 1, and negative indexes are counted from the end
+// of the array.
+type Slice struct {
+	args []sql.Expression
+}
+
+// NewSlice creates a new Slice UDF.
+func NewSlice(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("SLICE", "2 or 3", len(args))
+	}
+	return &Slice{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *Slice) Type() sql.Type { return f.args[0].Type() }
+
+// IsNullable implements the Expression interface.
+func (f *Slice) IsNullable() bool { return jsonArgsNullable(f.args) }
+
+// Resolved implements the Expression interface.
+func (f *Slice) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *Slice) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *Slice) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewSlice(children...)
+}
+
+func (f *Slice) String() string { return jsonFuncString("SLICE", f.args) }
+
+// Eval implements the Expression interface.
+func (f *Slice) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.Slice")
+	defer span.Finish()
+
+	array, err := evalArray(ctx, "SLICE", f.args[0], row)
+	if err != nil || array == nil {
+		return nil, err
+	}
+
+	start, ok, err := evalArrayIndex(ctx, "SLICE", f.args[1], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if start < 0 {
+		start += int64(len(array)) + 1
+	}
+
+	end := int64(len(array))
+	if len(f.args) == 3 {
+		v, err := f.args[2].Eval(ctx, row)
+		if err != nil || v == nil {
+			return nil, err
+		}
+
+		v, err = sql.Int64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+
+		length := v.(int64)
+		if length < 0 {
+			return nil, ErrNegativeSliceLength.New(length)
+		}
+
+		if length < end-start+1 {
+			end = start + length - 1
+		}
+	}
+
+	var result = []interface{}{}
+	if start >= 1 && start <= end {
+		result = append(result, array[start-1:end]...)
+	}
+
+	if f.Type() == sql.JSON {
+		return jsonArray(result), nil
+	}
+
+	return result, nil
+}
+
+// jsonArray returns a JSON array with the given elements, which are the ones
+// returned by evalArray for a JSON array.
+func jsonArray(elements []interface{}) sql.JSONDocument {
+	array := make([]interface{}, len(elements))
+	for i, e := range elements {
+		if doc, ok := e.(sql.JSONDocument); ok {
+			array[i] = doc.Val
+		}
+	}
+	return sql.JSONDocument{Val: array}
+}
@@ -0,0 +1,129 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+func TestElementAt(t *testing.T) {
+	f := NewElementAt(
+		expression.NewGetField(0, sql.Array(sql.Text), "", true),
+		expression.NewGetField(1, sql.Int64, "", true),
+	)
+	require.Equal(t, sql.Text, f.Type())
+
+	array := []interface{}{"a", "b", "c"}
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+		err      *errors.Kind
+	}{
+		{"first", sql.NewRow(array, int64(1)), "a", nil},
+		{"last", sql.NewRow(array, int64(3)), "c", nil},
+		{"negative", sql.NewRow(array, int64(-1)), "c", nil},
+		{"negative first", sql.NewRow(array, int64(-3)), "a", nil},
+		{"out of bounds", sql.NewRow(array, int64(4)), nil, nil},
+		{"negative out of bounds", sql.NewRow(array, int64(-4)), nil, nil},
+		{"index as string", sql.NewRow(array, "2"), "b", nil},
+		{"zero", sql.NewRow(array, int64(0)), nil, ErrArrayIndexZero},
+		{"null array", sql.NewRow(nil, int64(1)), nil, nil},
+		{"null index", sql.NewRow(array, nil), nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.True(t, tt.err.Is(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestElementAtJSON(t *testing.T) {
+	f := NewElementAt(
+		expression.NewLiteral(`[1, {"a": 2}, null]`, sql.JSON),
+		expression.NewGetField(0, sql.Int64, "", true),
+	)
+	require.Equal(t, sql.JSON, f.Type())
+
+	ctx := sql.NewEmptyContext()
+	result, err := f.Eval(ctx, sql.NewRow(int64(2)))
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 2}`, result.(sql.JSONDocument).String())
+
+	result, err = f.Eval(ctx, sql.NewRow(int64(3)))
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestSlice(t *testing.T) {
+	array := []interface{}{int64(1), int64(2), int64(3), int64(4)}
+	testCases := []struct {
+		name     string
+		args     []interface{}
+		expected interface{}
+		err      *errors.Kind
+	}{
+		{"until the end", []interface{}{array, int64(2)}, []interface{}{int64(2), int64(3), int64(4)}, nil},
+		{"with length", []interface{}{array, int64(2), int64(2)}, []interface{}{int64(2), int64(3)}, nil},
+		{"length past the end", []interface{}{array, int64(3), int64(10)}, []interface{}{int64(3), int64(4)}, nil},
+		{"zero length", []interface{}{array, int64(1), int64(0)}, []interface{}{}, nil},
+		{"negative start", []interface{}{array, int64(-2), int64(1)}, []interface{}{int64(3)}, nil},
+		{"start past the end", []interface{}{array, int64(5)}, []interface{}{}, nil},
+		{"negative start past the beginning", []interface{}{array, int64(-5), int64(2)}, []interface{}{}, nil},
+		{"zero start", []interface{}{array, int64(0)}, nil, ErrArrayIndexZero},
+		{"negative length", []interface{}{array, int64(1), int64(-1)}, nil, ErrNegativeSliceLength},
+		{"null array", []interface{}{nil, int64(1)}, nil, nil},
+		{"null start", []interface{}{array, nil}, nil, nil},
+		{"null length", []interface{}{array, int64(1), nil}, nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			args := []sql.Expression{expression.NewGetField(0, sql.Array(sql.Int64), "", true)}
+			for i := 1; i < len(tt.args); i++ {
+				args = append(args, expression.NewGetField(i, sql.Int64, "", true))
+			}
+
+			f, err := NewSlice(args...)
+			require.NoError(t, err)
+
+			result, err := f.Eval(sql.NewEmptyContext(), sql.NewRow(tt.args...))
+			if tt.err != nil {
+				require.Error(t, err)
+				require.True(t, tt.err.Is(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+		})
+	}
+
+	_, err := NewSlice(expression.NewLiteral(nil, sql.Null))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+}
+
+func TestSliceJSON(t *testing.T) {
+	f, err := NewSlice(
+		expression.NewLiteral(`[1, null, "a", [2]]`, sql.JSON),
+		expression.NewLiteral(int64(2), sql.Int64),
+		expression.NewLiteral(int64(2), sql.Int64),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sql.JSON, f.Type())
+	require.Equal(t, `SLICE("[1, null, \"a\", [2]]", 2, 2)`, f.String())
+
+	result, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(t, err)
+	require.Equal(t, `[null, "a"]`, result.(sql.JSONDocument).String())
+}
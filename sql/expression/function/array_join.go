@@ -0,0 +1,109 @@
+package function
+
+import (
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// ArrayJoin returns the elements of an array joined with a delimiter. NULL
+// elements are skipped, unless there is a third argument to replace them.
+type ArrayJoin struct {
+	args []sql.Expression
+}
+
+// NewArrayJoin creates a new ArrayJoin UDF.
+func NewArrayJoin(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ARRAY_JOIN", "2 or 3", len(args))
+	}
+	return &ArrayJoin{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *ArrayJoin) Type() sql.Type { return sql.Text }
+
+// IsNullable implements the Expression interface.
+func (f *ArrayJoin) IsNullable() bool { return jsonArgsNullable(f.args[:2]) }
+
+// Resolved implements the Expression interface.
+func (f *ArrayJoin) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *ArrayJoin) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *ArrayJoin) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewArrayJoin(children...)
+}
+
+func (f *ArrayJoin) String() string { return jsonFuncString("ARRAY_JOIN", f.args) }
+
+// Eval implements the Expression interface.
+func (f *ArrayJoin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.ArrayJoin")
+	defer span.Finish()
+
+	array, err := evalArray(ctx, "ARRAY_JOIN", f.args[0], row)
+	if err != nil || array == nil {
+		return nil, err
+	}
+
+	delimiter, err := evalString(ctx, f.args[1], row)
+	if err != nil || delimiter == nil {
+		return nil, err
+	}
+
+	var replacement interface{}
+	if len(f.args) == 3 {
+		if replacement, err = evalString(ctx, f.args[2], row); err != nil {
+			return nil, err
+		}
+	}
+
+	typ := elementType(f.args[0])
+	parts := make([]string, 0, len(array))
+	for _, e := range array {
+		if e == nil {
+			if replacement != nil {
+				parts = append(parts, replacement.(string))
+			}
+			continue
+		}
+
+		s, err := arrayElementString(typ, e)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, s)
+	}
+
+	return strings.Join(parts, delimiter.(string)), nil
+}
+
+// evalString returns the value of the expression as a string, or nil if it's
+// NULL.
+func evalString(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return sql.Text.Convert(v)
+}
+
+// arrayElementString returns the text of an element of an array of the given
+// type, as it's sent to clients. Strings in JSON arrays are not quoted.
+func arrayElementString(t sql.Type, v interface{}) (string, error) {
+	if doc, ok := v.(sql.JSONDocument); ok {
+		if s, ok := doc.Val.(string); ok {
+			return s, nil
+		}
+		return doc.String(), nil
+	}
+
+	val, err := t.SQL(v)
+	if err != nil {
+		return "", err
+	}
+	return val.ToString(), nil
+}
@@ -0,0 +1,60 @@
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestArrayJoin(t *testing.T) {
+	testCases := []struct {
+		name        string
+		typ         sql.Type
+		array       interface{}
+		delimiter   interface{}
+		replacement interface{}
+		expected    interface{}
+	}{
+		{"strings", sql.Array(sql.Text), []interface{}{"a", []byte("b"), "c"}, ", ", nil, "a, b, c"},
+		{"skips nulls", sql.Array(sql.Text), []interface{}{"a", nil, "c"}, "-", nil, "a-c"},
+		{"replaces nulls", sql.Array(sql.Text), []interface{}{"a", nil, "c"}, "-", "?", "a-?-c"},
+		{"numbers", sql.Array(sql.Float64), []interface{}{1.5, float64(2)}, ",", nil, "1.5,2"},
+		{
+			"dates",
+			sql.Array(sql.Date),
+			[]interface{}{time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)},
+			",",
+			nil,
+			"2019-12-31",
+		},
+		{"empty", sql.Array(sql.Text), []interface{}{}, ",", nil, ""},
+		{"json", sql.JSON, `["a", 1, null, {"b": 2}]`, "|", nil, `a|1|{"b": 2}`},
+		{"null array", sql.Array(sql.Text), nil, ",", nil, nil},
+		{"null delimiter", sql.Array(sql.Text), []interface{}{"a"}, nil, nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			args := []sql.Expression{
+				expression.NewGetField(0, tt.typ, "", true),
+				expression.NewGetField(1, sql.Text, "", true),
+			}
+			if tt.replacement != nil {
+				args = append(args, expression.NewGetField(2, sql.Text, "", true))
+			}
+
+			f, err := NewArrayJoin(args...)
+			require.NoError(t, err)
+
+			result, err := f.Eval(sql.NewEmptyContext(), sql.NewRow(tt.array, tt.delimiter, tt.replacement))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	_, err := NewArrayJoin(expression.NewLiteral(nil, sql.Null))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+}
@@ -0,0 +1,147 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// arraySearch is the result of searching a value in an array.
+type arraySearch struct {
+	// null is true if the array or the value are NULL.
+	null bool
+	// position is the index of the first element equal to the value,
+	// starting at 1, or 0 if there is none.
+	position int64
+	// hasNulls is true if the array has some NULL element.
+	hasNulls bool
+}
+
+// searchArray searches the value of an expression in the array that is the
+// value of another one. Elements of JSON arrays are compared with the JSON
+// value of the searched value.
+func searchArray(ctx *sql.Context, name string, array, value sql.Expression, row sql.Row) (arraySearch, error) {
+	elements, err := evalArray(ctx, name, array, row)
+	if err != nil || elements == nil {
+		return arraySearch{null: true}, err
+	}
+
+	v, err := value.Eval(ctx, row)
+	if err != nil || v == nil {
+		return arraySearch{null: true}, err
+	}
+
+	typ := elementType(array)
+	if typ == sql.JSON {
+		if v, err = sql.ToJSONValue(value.Type(), v); err != nil {
+			return arraySearch{}, err
+		}
+		v = sql.JSONDocument{Val: v}
+	}
+
+	var result arraySearch
+	for i, e := range elements {
+		if e == nil {
+			result.hasNulls = true
+			continue
+		}
+
+		cmp, err := typ.Compare(e, v)
+		if err != nil {
+			return arraySearch{}, err
+		}
+
+		if cmp == 0 {
+			result.position = int64(i + 1)
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// ArrayContains returns whether an array contains a value. If it doesn't,
+// but the array has NULL elements, the result is NULL, as it is for IN.
+type ArrayContains struct {
+	expression.BinaryExpression
+}
+
+// NewArrayContains creates a new ArrayContains UDF.
+func NewArrayContains(array, value sql.Expression) sql.Expression {
+	return &ArrayContains{expression.BinaryExpression{Left: array, Right: value}}
+}
+
+// Type implements the Expression interface.
+func (f *ArrayContains) Type() sql.Type { return sql.Boolean }
+
+// IsNullable implements the Expression interface.
+func (f *ArrayContains) IsNullable() bool { return true }
+
+func (f *ArrayContains) String() string {
+	return fmt.Sprintf("ARRAY_CONTAINS(%s, %s)", f.Left, f.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (f *ArrayContains) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+	return NewArrayContains(children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface.
+func (f *ArrayContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.ArrayContains")
+	defer span.Finish()
+
+	s, err := searchArray(ctx, "ARRAY_CONTAINS", f.Left, f.Right, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.null || (s.position == 0 && s.hasNulls) {
+		return nil, nil
+	}
+
+	return s.position > 0, nil
+}
+
+// ArrayPosition returns the index of the first element of an array that is
+// equal to a value, starting at 1, or 0 if there is none.
+type ArrayPosition struct {
+	expression.BinaryExpression
+}
+
+// NewArrayPosition creates a new ArrayPosition UDF.
+func NewArrayPosition(array, value sql.Expression) sql.Expression {
+	return &ArrayPosition{expression.BinaryExpression{Left: array, Right: value}}
+}
+
+// Type implements the Expression interface.
+func (f *ArrayPosition) Type() sql.Type { return sql.Int64 }
+
+func (f *ArrayPosition) String() string {
+	return fmt.Sprintf("ARRAY_POSITION(%s, %s)", f.Left, f.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (f *ArrayPosition) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+	return NewArrayPosition(children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface.
+func (f *ArrayPosition) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.ArrayPosition")
+	defer span.Finish()
+
+	s, err := searchArray(ctx, "ARRAY_POSITION", f.Left, f.Right, row)
+	if err != nil || s.null {
+		return nil, err
+	}
+
+	return s.position, nil
+}
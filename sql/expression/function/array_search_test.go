@@ -0,0 +1,64 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestArrayContainsAndPosition(t *testing.T) {
+	testCases := []struct {
+		name     string
+		typ      sql.Type
+		array    interface{}
+		value    interface{}
+		contains interface{}
+		position interface{}
+	}{
+		{"found", sql.Array(sql.Int64), []interface{}{int64(1), int64(2), int64(2)}, int64(2), true, int64(2)},
+		{"not found", sql.Array(sql.Int64), []interface{}{int64(1), int64(2)}, int64(3), false, int64(0)},
+		{"converted value", sql.Array(sql.Int64), []interface{}{int64(1), int64(2)}, "2", true, int64(2)},
+		{"not found with nulls", sql.Array(sql.Text), []interface{}{"a", nil}, "b", nil, int64(0)},
+		{"found with nulls", sql.Array(sql.Text), []interface{}{nil, "b"}, "b", true, int64(2)},
+		{"empty", sql.Array(sql.Text), []interface{}{}, "b", false, int64(0)},
+		{"null array", sql.Array(sql.Text), nil, "b", nil, nil},
+		{"null value", sql.Array(sql.Text), []interface{}{"a", nil}, nil, nil, nil},
+		{"json number", sql.JSON, `[1, "2", null]`, int64(1), true, int64(1)},
+		{"json string", sql.JSON, `[1, "2", null]`, "2", true, int64(2)},
+		{"json not found", sql.JSON, `[1, "2"]`, int64(2), false, int64(0)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			array := expression.NewGetField(0, tt.typ, "", true)
+			value := expression.NewGetField(1, sql.Text, "", true)
+			if _, ok := tt.value.(int64); ok {
+				value = expression.NewGetField(1, sql.Int64, "", true)
+			}
+			row := sql.NewRow(tt.array, tt.value)
+			ctx := sql.NewEmptyContext()
+
+			result, err := NewArrayContains(array, value).Eval(ctx, row)
+			require.NoError(t, err)
+			require.Equal(t, tt.contains, result)
+
+			result, err = NewArrayPosition(array, value).Eval(ctx, row)
+			require.NoError(t, err)
+			require.Equal(t, tt.position, result)
+		})
+	}
+}
+
+func TestArraySearchNotArray(t *testing.T) {
+	f := NewArrayContains(
+		expression.NewLiteral("a", sql.Text),
+		expression.NewLiteral("a", sql.Text),
+	)
+	require.Equal(t, `ARRAY_CONTAINS("a", "a")`, f.String())
+
+	_, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.Error(t, err)
+	require.True(t, ErrNotArrayArgument.Is(err))
+}
@@ -0,0 +1,87 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestArray(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []sql.Expression
+		typ      sql.Type
+		expected interface{}
+	}{
+		{"empty", nil, sql.Array(sql.Null), []interface{}{}},
+		{
+			"numbers",
+			[]sql.Expression{
+				expression.NewLiteral(int64(1), sql.Int64),
+				expression.NewLiteral(nil, sql.Null),
+				expression.NewLiteral("3", sql.Text),
+			},
+			sql.Array(sql.Int64),
+			[]interface{}{int64(1), nil, int64(3)},
+		},
+		{
+			"first argument is null",
+			[]sql.Expression{
+				expression.NewLiteral(nil, sql.Null),
+				expression.NewLiteral("a", sql.Text),
+			},
+			sql.Array(sql.Text),
+			[]interface{}{nil, "a"},
+		},
+		{
+			"nested",
+			[]sql.Expression{
+				expression.NewLiteral([]interface{}{int64(1)}, sql.Array(sql.Int64)),
+			},
+			sql.Array(sql.Array(sql.Int64)),
+			[]interface{}{[]interface{}{int64(1)}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewArray(tt.args...)
+			require.NoError(t, err)
+			require.Equal(t, tt.typ, f.Type())
+
+			result, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	f, err := NewArray(
+		expression.NewLiteral(int64(1), sql.Int64),
+		expression.NewLiteral("a", sql.Text),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "ARRAY(1, \"a\")", f.String())
+
+	_, err = f.Eval(sql.NewEmptyContext(), nil)
+	require.Error(t, err)
+}
+
+func TestEvalArray(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	elements, err := evalArray(ctx, "F", expression.NewLiteral(`[1, null, "a"]`, sql.JSON), nil)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{sql.JSONDocument{Val: int64(1)}, nil, sql.JSONDocument{Val: "a"}}, elements)
+
+	elements, err = evalArray(ctx, "F", expression.NewLiteral(nil, sql.Array(sql.Int64)), nil)
+	require.NoError(t, err)
+	require.Nil(t, elements)
+
+	_, err = evalArray(ctx, "F", expression.NewLiteral(`{"a": 1}`, sql.JSON), nil)
+	require.True(t, ErrNotArrayArgument.Is(err))
+
+	_, err = evalArray(ctx, "F", expression.NewLiteral("a", sql.Text), nil)
+	require.True(t, ErrNotArrayArgument.Is(err))
+}
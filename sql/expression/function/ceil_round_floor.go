@@ -7,6 +7,7 @@ import (
 
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/shopspring/decimal"
 )
 
 // Ceil returns the smallest integer value not less than X.
@@ -22,6 +23,10 @@ func NewCeil(num sql.Expression) sql.Expression {
 // Type implements the Expression interface.
 func (c *Ceil) Type() sql.Type {
 	childType := c.Child.Type()
+	if sql.IsFixedPoint(childType) {
+		return integralDecimal(childType)
+	}
+
 	if sql.IsNumber(childType) {
 		return childType
 	}
@@ -61,6 +66,15 @@ func (c *Ceil) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Ceil(child.(float64))), nil
 	}
 
+	if sql.IsFixedPoint(c.Child.Type()) {
+		child, err = c.Child.Type().Convert(child)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.Type().Convert(child.(decimal.Decimal).Ceil())
+	}
+
 	if !sql.IsDecimal(c.Child.Type()) {
 		return child, err
 	}
@@ -75,6 +89,17 @@ func (c *Ceil) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// integralDecimal returns the Decimal type of the integral values of the
+// given Decimal type.
+func integralDecimal(t sql.Type) sql.Type {
+	precision, scale, _ := sql.DecimalPrecision(t)
+	if scale == 0 {
+		return t
+	}
+
+	return sql.Decimal(precision-scale+1, 0)
+}
+
 // Floor returns the biggest integer value not less than X.
 type Floor struct {
 	expression.UnaryExpression
@@ -88,6 +113,10 @@ func NewFloor(num sql.Expression) sql.Expression {
 // Type implements the Expression interface.
 func (f *Floor) Type() sql.Type {
 	childType := f.Child.Type()
+	if sql.IsFixedPoint(childType) {
+		return integralDecimal(childType)
+	}
+
 	if sql.IsNumber(childType) {
 		return childType
 	}
@@ -127,6 +156,15 @@ func (f *Floor) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Floor(child.(float64))), nil
 	}
 
+	if sql.IsFixedPoint(f.Child.Type()) {
+		child, err = f.Child.Type().Convert(child)
+		if err != nil {
+			return nil, err
+		}
+
+		return f.Type().Convert(child.(decimal.Decimal).Floor())
+	}
+
 	if !sql.IsDecimal(f.Child.Type()) {
 		return child, err
 	}
@@ -224,7 +262,16 @@ func (r *Round) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Round(xNum*math.Pow(10.0, dVal)) / math.Pow(10.0, dVal)), nil
 	}
 
+	if sql.IsFixedPoint(r.Left.Type()) {
+		xVal, err = r.Left.Type().Convert(xVal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	switch xNum := xVal.(type) {
+	case decimal.Decimal:
+		return r.Type().Convert(xNum.Round(int32(dVal)))
 	case float64:
 		return math.Round(xNum*math.Pow(10.0, dVal)) / math.Pow(10.0, dVal), nil
 	case float32:
@@ -261,12 +308,43 @@ func (r *Round) Resolved() bool {
 // Type implements the Expression interface.
 func (r *Round) Type() sql.Type {
 	leftChildType := r.Left.Type()
+	if sql.IsFixedPoint(leftChildType) {
+		return r.decimalType()
+	}
+
 	if sql.IsNumber(leftChildType) {
 		return leftChildType
 	}
 	return sql.Int32
 }
 
+// decimalType returns the type of the rounded values of a decimal. If the
+// number of decimal places is a constant lower than the scale of the
+// decimal, it's the scale of the type.
+func (r *Round) decimalType() sql.Type {
+	precision, scale, _ := sql.DecimalPrecision(r.Left.Type())
+
+	d := scale
+	if r.Right == nil {
+		d = 0
+	} else if lit, ok := r.Right.(*expression.Literal); ok {
+		v, err := sql.Int64.Convert(lit.Value())
+		if err == nil && lit.Value() != nil {
+			d = int(v.(int64))
+		}
+	}
+
+	if d < 0 {
+		d = 0
+	}
+
+	if d >= scale {
+		return r.Left.Type()
+	}
+
+	return sql.Decimal(precision-scale+d+1, d)
+}
+
 // WithChildren implements the Expression interface.
 func (r *Round) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	return NewRound(children...)
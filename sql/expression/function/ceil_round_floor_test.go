@@ -3,6 +3,7 @@ package function
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
@@ -222,3 +223,52 @@ func TestRound(t *testing.T) {
 	req.NoError(err)
 	req.Equal(int32(0), result)
 }
+
+func TestDecimalRounding(t *testing.T) {
+	typ := sql.Decimal(6, 3)
+	x := expression.NewGetField(0, typ, "x", false)
+	lit := func(v interface{}) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+	round := func(args ...sql.Expression) sql.Expression {
+		r, err := NewRound(args...)
+		require.NoError(t, err)
+		return r
+	}
+
+	testCases := []struct {
+		name     string
+		expr     sql.Expression
+		value    interface{}
+		typ      sql.Type
+		expected string
+	}{
+		{"ceil", NewCeil(x), "-1.5", sql.Decimal(4, 0), "-1"},
+		{"ceil of float value", NewCeil(x), 1.001, sql.Decimal(4, 0), "2"},
+		{"floor", NewFloor(x), "-1.5", sql.Decimal(4, 0), "-2"},
+		{"round", round(x), "2.5", sql.Decimal(4, 0), "3"},
+		{"round negative", round(x), "-2.5", sql.Decimal(4, 0), "-3"},
+		{"round with decimals", round(x, lit(int64(2))), "1.005", sql.Decimal(6, 2), "1.01"},
+		{"round with more decimals", round(x, lit(int64(5))), "1.005", typ, "1.005"},
+		{"round with negative decimals", round(x, lit(int64(-1))), "15.5", sql.Decimal(4, 0), "20"},
+		{"round with carry", round(x, lit(int64(1))), "999.95", sql.Decimal(5, 1), "1000"},
+		{
+			"round with column decimals",
+			round(x, expression.NewGetField(1, sql.Int64, "d", false)),
+			"1.234",
+			typ,
+			"1.2",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.typ, tt.expr.Type())
+
+			result, err := tt.expr.Eval(sql.NewEmptyContext(), sql.NewRow(tt.value, int64(1)))
+			require.NoError(err)
+			require.Equal(tt.expected, result.(decimal.Decimal).String())
+		})
+	}
+}
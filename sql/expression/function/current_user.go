@@ -0,0 +1,40 @@
+package function
+
+import "github.com/mushiyu/go-mysql-server/sql"
+
+// CurrentUser returns the user of the current session. As there are no
+// host-based accounts, only the user name is returned.
+type CurrentUser struct{}
+
+// NewCurrentUser creates a new CurrentUser UDF node.
+func NewCurrentUser() sql.Expression {
+	return CurrentUser{}
+}
+
+// Children implements the sql.Expression interface.
+func (CurrentUser) Children() []sql.Expression { return nil }
+
+// Type implements the sql.Expression interface.
+func (CurrentUser) Type() sql.Type { return sql.Text }
+
+// Resolved implements the sql.Expression interface.
+func (CurrentUser) Resolved() bool { return true }
+
+// WithChildren implements the Expression interface.
+func (c CurrentUser) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (CurrentUser) IsNullable() bool { return false }
+
+// String implements the fmt.Stringer interface.
+func (CurrentUser) String() string { return "current_user()" }
+
+// Eval implements the sql.Expression interface.
+func (CurrentUser) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
+	return ctx.Client().User, nil
+}
@@ -0,0 +1,21 @@
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentUser(t *testing.T) {
+	require := require.New(t)
+
+	session := sql.NewSession("", "127.0.0.1", "tenant1", 2)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(session))
+
+	f := NewCurrentUser()
+	result, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("tenant1", result)
+}
@@ -62,7 +62,7 @@ func (d *DateAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	date, err = sql.Timestamp.Convert(date)
+	date, err = sql.TimestampWithPrecision(sql.MaxTimePrecision).Convert(date)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +137,7 @@ func (d *DateSub) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	date, err = sql.Timestamp.Convert(date)
+	date, err = sql.TimestampWithPrecision(sql.MaxTimePrecision).Convert(date)
 	if err != nil {
 		return nil, err
 	}
@@ -157,3 +157,96 @@ func (d *DateSub) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func (d *DateSub) String() string {
 	return fmt.Sprintf("DATE_SUB(%s, %s)", d.Date, d.Interval)
 }
+
+// TimeDiff returns the difference between two times or two datetimes as a
+// time.
+type TimeDiff struct {
+	expression.BinaryExpression
+}
+
+// NewTimeDiff creates a new TIMEDIFF function.
+func NewTimeDiff(left, right sql.Expression) sql.Expression {
+	return &TimeDiff{expression.BinaryExpression{Left: left, Right: right}}
+}
+
+// IsNullable implements the sql.Expression interface.
+func (td *TimeDiff) IsNullable() bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (td *TimeDiff) Type() sql.Type {
+	fsp := sql.TimePrecision(td.Left.Type())
+	if p := sql.TimePrecision(td.Right.Type()); p > fsp {
+		fsp = p
+	}
+	return sql.TimeWithPrecision(fsp)
+}
+
+// WithChildren implements the Expression interface.
+func (td *TimeDiff) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(td, len(children), 2)
+	}
+	return NewTimeDiff(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface. The result is NULL if the
+// arguments are not both times or both datetimes, or if the difference is
+// out of the range of the TIME type.
+func (td *TimeDiff) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	left, err := timeDiffArg(ctx, td.Left, row)
+	if err != nil || left == nil {
+		return nil, err
+	}
+
+	right, err := timeDiffArg(ctx, td.Right, row)
+	if err != nil || right == nil {
+		return nil, err
+	}
+
+	switch l := left.(type) {
+	case time.Time:
+		if r, ok := right.(time.Time); ok {
+			return sql.ValidateDuration(l.Sub(r)), nil
+		}
+	case time.Duration:
+		if r, ok := right.(time.Duration); ok {
+			return sql.ValidateDuration(l - r), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// timeDiffArg evaluates an argument of TIMEDIFF, returning a time.Time for
+// datetimes and a time.Duration for times. Values of other types are
+// datetimes if they can be converted to one, times if they can be converted
+// to one and NULL otherwise.
+func timeDiffArg(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	switch {
+	case sql.IsDuration(e.Type()):
+		return sql.TimeWithPrecision(sql.MaxTimePrecision).Convert(v)
+	case sql.IsTime(e.Type()):
+		return sql.TimestampWithPrecision(sql.MaxTimePrecision).Convert(v)
+	}
+
+	if t, err := sql.TimestampWithPrecision(sql.MaxTimePrecision).Convert(v); err == nil {
+		return t, nil
+	}
+
+	if d, err := sql.TimeWithPrecision(sql.MaxTimePrecision).Convert(v); err == nil {
+		return d, nil
+	}
+
+	return nil, nil
+}
+
+func (td *TimeDiff) String() string {
+	return fmt.Sprintf("TIMEDIFF(%s, %s)", td.Left, td.Right)
+}
@@ -85,3 +85,66 @@ func TestDateSub(t *testing.T) {
 	_, err = f.Eval(ctx, sql.Row{"asdasdasd"})
 	require.Error(err)
 }
+
+func TestTimeDiff(t *testing.T) {
+	testCases := []struct {
+		name        string
+		left, right sql.Expression
+		typ         sql.Type
+		expected    interface{}
+	}{
+		{
+			"datetimes",
+			expression.NewLiteral("2019-01-02 00:00:00", sql.Datetime),
+			expression.NewLiteral("2019-01-01 23:00:00.5", sql.DatetimeWithPrecision(1)),
+			sql.TimeWithPrecision(1),
+			time.Hour - 500*time.Millisecond,
+		},
+		{
+			"times",
+			expression.NewLiteral("10:00:00", sql.Time),
+			expression.NewLiteral("12:30:00", sql.Time),
+			sql.Time,
+			-150 * time.Minute,
+		},
+		{
+			"texts",
+			expression.NewLiteral("10:00:00", sql.Text),
+			expression.NewLiteral("09:00:00", sql.Text),
+			sql.Time,
+			time.Hour,
+		},
+		{
+			"datetime and time",
+			expression.NewLiteral("2019-01-02 00:00:00", sql.Datetime),
+			expression.NewLiteral("10:00:00", sql.Time),
+			sql.Time,
+			nil,
+		},
+		{
+			"out of range",
+			expression.NewLiteral("2019-03-01 00:00:00", sql.Datetime),
+			expression.NewLiteral("2019-01-01 00:00:00", sql.Datetime),
+			sql.Time,
+			nil,
+		},
+		{
+			"null",
+			expression.NewLiteral(nil, sql.Null),
+			expression.NewLiteral("10:00:00", sql.Time),
+			sql.Time,
+			nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewTimeDiff(tt.left, tt.right)
+			require.Equal(t, tt.typ, f.Type())
+
+			result, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
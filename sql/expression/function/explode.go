@@ -7,14 +7,21 @@ import (
 )
 
 // Explode is a function that generates a row for each value of its child.
-// It is a placeholder expression node.
+// It is a placeholder expression node. It's also used for UNNEST, which is
+// the same function with another name.
 type Explode struct {
 	Child sql.Expression
+	name  string
 }
 
 // NewExplode creates a new Explode function.
 func NewExplode(child sql.Expression) sql.Expression {
-	return &Explode{child}
+	return &Explode{child, "EXPLODE"}
+}
+
+// NewUnnest creates a new Explode function for UNNEST.
+func NewUnnest(child sql.Expression) sql.Expression {
+	return &Explode{child, "UNNEST"}
 }
 
 // Resolved implements the sql.Expression interface.
@@ -37,7 +44,12 @@ func (e *Explode) Eval(*sql.Context, sql.Row) (interface{}, error) {
 }
 
 func (e *Explode) String() string {
-	return fmt.Sprintf("EXPLODE(%s)", e.Child)
+	return fmt.Sprintf("%s(%s)", e.name, e.Child)
+}
+
+// Generate returns the Generate function that replaces this placeholder.
+func (e *Explode) Generate() sql.Expression {
+	return &Generate{e.Child, e.name}
 }
 
 // WithChildren implements the Expression interface.
@@ -45,18 +57,19 @@ func (e *Explode) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
 	}
-	return NewExplode(children[0]), nil
+	return &Explode{children[0], e.name}, nil
 }
 
 // Generate is a function that generates a row for each value of its child.
 // This is the non-placeholder counterpart of Explode.
 type Generate struct {
 	Child sql.Expression
+	name  string
 }
 
 // NewGenerate creates a new Generate function.
 func NewGenerate(child sql.Expression) sql.Expression {
-	return &Generate{child}
+	return &Generate{child, "EXPLODE"}
 }
 
 // Resolved implements the sql.Expression interface.
@@ -79,7 +92,7 @@ func (e *Generate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 }
 
 func (e *Generate) String() string {
-	return fmt.Sprintf("EXPLODE(%s)", e.Child)
+	return fmt.Sprintf("%s(%s)", e.name, e.Child)
 }
 
 // WithChildren implements the Expression interface.
@@ -87,5 +100,5 @@ func (e *Generate) WithChildren(children ...sql.Expression) (sql.Expression, err
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
 	}
-	return NewGenerate(children[0]), nil
+	return &Generate{children[0], e.name}, nil
 }
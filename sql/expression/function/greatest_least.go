@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 )
 
@@ -69,9 +70,12 @@ func compEval(
 			if i == 0 || cmp(ival, int64(selectedNum)) {
 				selectedNum = float64(ival)
 			}
-		case float32, float64:
-			if x, ok := t.(float32); ok {
+		case float32, float64, decimal.Decimal:
+			switch x := t.(type) {
+			case float32:
 				t = float64(x)
+			case decimal.Decimal:
+				t, _ = x.Float64()
 			}
 
 			fval := t.(float64)
@@ -127,7 +131,7 @@ func compRetType(args ...sql.Expression) (sql.Type, error) {
 			return nil, sql.ErrInvalidType.New("tuple")
 		} else if sql.IsNumber(argType) {
 			allString = false
-			if sql.IsDecimal(argType) {
+			if sql.IsDecimal(argType) || sql.IsFixedPoint(argType) {
 				allString = false
 				allInt = false
 			}
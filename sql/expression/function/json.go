@@ -0,0 +1,104 @@
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// evalJSON returns the JSON document that is the value of the expression,
+// and false if it's NULL. Strings are parsed as JSON texts.
+func evalJSON(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, bool, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, false, err
+	}
+
+	doc, err := sql.JSON.Convert(v)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return doc.(sql.JSONDocument).Val, true, nil
+}
+
+// evalJSONValue returns the JSON value of the value of the expression, which
+// is a JSON string if the expression is a string. NULL is the JSON null.
+func evalJSONValue(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.ToJSONValue(e.Type(), v)
+}
+
+// evalJSONPath returns the JSON path that is the value of the expression, or
+// nil if it's NULL.
+func evalJSONPath(ctx *sql.Context, e sql.Expression, row sql.Row) (*jsonpath.Path, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	v, err = sql.Text.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonpath.Parse(v.(string))
+}
+
+// evalJSONPathValue returns the value matched by the JSON path that is the
+// value of the expression in the document, and false if it's NULL or it
+// doesn't match any value. The path can't have wildcards.
+func evalJSONPathValue(
+	ctx *sql.Context,
+	doc interface{},
+	e sql.Expression,
+	row sql.Row,
+) (interface{}, bool, error) {
+	path, err := evalJSONPath(ctx, e, row)
+	if err != nil || path == nil {
+		return nil, false, err
+	}
+
+	if path.HasWildcard() {
+		return nil, false, jsonpath.ErrWildcardPath.New(path)
+	}
+
+	values := path.Find(doc)
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+
+	return values[0], true, nil
+}
+
+func jsonArgsResolved(args []sql.Expression) bool {
+	for _, arg := range args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonArgsNullable(args []sql.Expression) bool {
+	for _, arg := range args {
+		if arg.IsNullable() {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonFuncString(name string, args []sql.Expression) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(parts, ", "))
+}
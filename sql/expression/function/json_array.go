@@ -0,0 +1,51 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// JSONArray returns a JSON array with the given values.
+type JSONArray struct {
+	args []sql.Expression
+}
+
+// NewJSONArray creates a new JSONArray UDF.
+func NewJSONArray(args ...sql.Expression) (sql.Expression, error) {
+	return &JSONArray{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONArray) Type() sql.Type { return sql.JSON }
+
+// IsNullable implements the Expression interface.
+func (f *JSONArray) IsNullable() bool { return false }
+
+// Resolved implements the Expression interface.
+func (f *JSONArray) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONArray) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONArray) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONArray(children...)
+}
+
+func (f *JSONArray) String() string { return jsonFuncString("JSON_ARRAY", f.args) }
+
+// Eval implements the Expression interface.
+func (f *JSONArray) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONArray")
+	defer span.Finish()
+
+	a := make([]interface{}, len(f.args))
+	for i, arg := range f.args {
+		v, err := evalJSONValue(ctx, arg, row)
+		if err != nil {
+			return nil, err
+		}
+		a[i] = v
+	}
+
+	return sql.JSONDocument{Val: a}, nil
+}
@@ -0,0 +1,221 @@
+package function
+
+import (
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrInvalidOneOrAll is returned when the second argument of
+// JSON_CONTAINS_PATH is neither 'one' nor 'all'.
+var ErrInvalidOneOrAll = errors.NewKind("the oneOrAll argument to JSON_CONTAINS_PATH may take these values: 'one' or 'all', %v given")
+
+// JSONContains tells whether a JSON document is contained in another one,
+// optionally at the given path.
+type JSONContains struct {
+	args []sql.Expression
+}
+
+// NewJSONContains creates a new JSONContains UDF.
+func NewJSONContains(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_CONTAINS", "2 or 3", len(args))
+	}
+
+	return &JSONContains{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONContains) Type() sql.Type { return sql.Boolean }
+
+// IsNullable implements the Expression interface.
+func (f *JSONContains) IsNullable() bool { return true }
+
+// Resolved implements the Expression interface.
+func (f *JSONContains) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONContains) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONContains) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONContains(children...)
+}
+
+func (f *JSONContains) String() string { return jsonFuncString("JSON_CONTAINS", f.args) }
+
+// Eval implements the Expression interface.
+func (f *JSONContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONContains")
+	defer span.Finish()
+
+	target, ok, err := evalJSON(ctx, f.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	candidate, ok, err := evalJSON(ctx, f.args[1], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if len(f.args) == 3 {
+		target, ok, err = evalJSONPathValue(ctx, target, f.args[2], row)
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	return jsonContains(target, candidate), nil
+}
+
+// jsonContains tells whether the candidate is contained in the target. A
+// scalar is contained in another one if they are equal, an array in another
+// one if all its elements are contained in elements of the same kind of it,
+// and any other value in an array if it's contained in any of its elements. An object is contained in
+// another one if all its members are in it with values contained in theirs.
+func jsonContains(target, candidate interface{}) bool {
+	switch t := target.(type) {
+	case []interface{}:
+		if c, ok := candidate.([]interface{}); ok {
+			for _, e := range c {
+				if !jsonContainsElement(t, e) {
+					return false
+				}
+			}
+			return true
+		}
+
+		for _, e := range t {
+			if jsonContains(e, candidate) {
+				return true
+			}
+		}
+		return false
+	case map[string]interface{}:
+		c, ok := candidate.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for k, v := range c {
+			e, ok := t[k]
+			if !ok || !jsonContains(e, v) {
+				return false
+			}
+		}
+		return true
+	default:
+		switch candidate.(type) {
+		case []interface{}, map[string]interface{}:
+			return false
+		}
+
+		cmp, err := sql.JSON.Compare(sql.JSONDocument{Val: target}, sql.JSONDocument{Val: candidate})
+		return err == nil && cmp == 0
+	}
+}
+
+// jsonContainsElement tells whether a value is contained in an element of the
+// same kind, array, object or scalar, of the target array.
+func jsonContainsElement(target []interface{}, candidate interface{}) bool {
+	for _, e := range target {
+		if jsonKind(e) == jsonKind(candidate) && jsonContains(e, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonKind(v interface{}) int {
+	switch v.(type) {
+	case []interface{}:
+		return 1
+	case map[string]interface{}:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// JSONContainsPath tells whether a JSON document has values at one or all of
+// the given paths.
+type JSONContainsPath struct {
+	args []sql.Expression
+}
+
+// NewJSONContainsPath creates a new JSONContainsPath UDF.
+func NewJSONContainsPath(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_CONTAINS_PATH", "3 or more", len(args))
+	}
+
+	return &JSONContainsPath{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONContainsPath) Type() sql.Type { return sql.Boolean }
+
+// IsNullable implements the Expression interface.
+func (f *JSONContainsPath) IsNullable() bool { return jsonArgsNullable(f.args) }
+
+// Resolved implements the Expression interface.
+func (f *JSONContainsPath) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONContainsPath) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONContainsPath) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONContainsPath(children...)
+}
+
+func (f *JSONContainsPath) String() string {
+	return jsonFuncString("JSON_CONTAINS_PATH", f.args)
+}
+
+// Eval implements the Expression interface.
+func (f *JSONContainsPath) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONContainsPath")
+	defer span.Finish()
+
+	doc, ok, err := evalJSON(ctx, f.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	oneOrAll, err := f.args[1].Eval(ctx, row)
+	if err != nil || oneOrAll == nil {
+		return nil, err
+	}
+
+	oneOrAll, err = sql.Text.Convert(oneOrAll)
+	if err != nil {
+		return nil, err
+	}
+
+	var all bool
+	switch strings.ToLower(oneOrAll.(string)) {
+	case "one":
+	case "all":
+		all = true
+	default:
+		return nil, ErrInvalidOneOrAll.New(oneOrAll)
+	}
+
+	result := all
+	for _, arg := range f.args[2:] {
+		path, err := evalJSONPath(ctx, arg, row)
+		if err != nil || path == nil {
+			return nil, err
+		}
+
+		found := len(path.Find(doc)) > 0
+		if found != all {
+			result = found
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,110 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONContains(t *testing.T) {
+	_, err := NewJSONContains(expression.NewLiteral(`{}`, sql.Text))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f2, err := NewJSONContains(
+		expression.NewGetField(0, sql.JSON, "target", true),
+		expression.NewGetField(1, sql.JSON, "candidate", true),
+	)
+	require.NoError(t, err)
+
+	f3, err := NewJSONContains(
+		expression.NewGetField(0, sql.JSON, "target", true),
+		expression.NewGetField(1, sql.JSON, "candidate", true),
+		expression.NewGetField(2, sql.Text, "path", true),
+	)
+	require.NoError(t, err)
+
+	doc := `{"a": 1, "b": [2, 3, {"c": 4}], "d": {"e": "x", "f": [5]}}`
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{f2, sql.Row{doc, `{"a": 1}`}, true},
+		{f2, sql.Row{doc, `{"a": 1.0}`}, true},
+		{f2, sql.Row{doc, `{"a": 2}`}, false},
+		{f2, sql.Row{doc, `{"d": {"f": [5]}}`}, true},
+		{f2, sql.Row{doc, `{"d": {"f": 5}}`}, true},
+		{f2, sql.Row{doc, `{"b": [3, 2]}`}, true},
+		{f2, sql.Row{doc, `{"b": {"c": 4}}`}, true},
+		{f2, sql.Row{doc, `1`}, false},
+		{f2, sql.Row{`[1, [2, 3]]`, `3`}, true},
+		{f2, sql.Row{`[1, [2, 3]]`, `[3]`}, false},
+		{f2, sql.Row{`[1, [2, 3]]`, `[1, [3]]`}, true},
+		{f2, sql.Row{`[1, [2, 3]]`, `[[1]]`}, false},
+		{f2, sql.Row{`"x"`, `"x"`}, true},
+		{f2, sql.Row{`[1]`, `{"a": 1}`}, false},
+		{f2, sql.Row{nil, `1`}, nil},
+		{f2, sql.Row{doc, nil}, nil},
+		{f3, sql.Row{doc, `2`, "$.b"}, true},
+		{f3, sql.Row{doc, `"x"`, "$.d.e"}, true},
+		{f3, sql.Row{doc, `"x"`, "$.nope"}, nil},
+		{f3, sql.Row{doc, `1`, nil}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestJSONContainsPath(t *testing.T) {
+	_, err := NewJSONContainsPath(
+		expression.NewLiteral(`{}`, sql.Text),
+		expression.NewLiteral(`one`, sql.Text),
+	)
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewJSONContainsPath(
+		expression.NewGetField(0, sql.JSON, "doc", true),
+		expression.NewGetField(1, sql.Text, "mode", true),
+		expression.NewGetField(2, sql.Text, "p1", true),
+		expression.NewGetField(3, sql.Text, "p2", true),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "JSON_CONTAINS_PATH(doc, mode, p1, p2)", f.String())
+
+	doc := `{"a": 1, "b": [2, {"c": 3}]}`
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"one of both", sql.Row{doc, "one", "$.a", "$.b[1].c"}, true},
+		{"one of one", sql.Row{doc, "ONE", "$.a", "$.x"}, true},
+		{"one of none", sql.Row{doc, "one", "$.x", "$.b[5]"}, false},
+		{"all of both", sql.Row{doc, "all", "$.a", "$**.c"}, true},
+		{"all of one", sql.Row{doc, "all", "$.a", "$.x"}, false},
+		{"null document", sql.Row{nil, "all", "$.a", "$.b"}, nil},
+		{"null mode", sql.Row{doc, nil, "$.a", "$.b"}, nil},
+		{"null path", sql.Row{doc, "one", "$.a", nil}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	_, err = f.Eval(sql.NewEmptyContext(), sql.Row{doc, "some", "$.a", "$.b"})
+	require.Error(t, err)
+	require.True(t, ErrInvalidOneOrAll.Is(err))
+}
@@ -1,11 +1,6 @@
 package function
 
 import (
-	"encoding/json"
-	"fmt"
-	"strings"
-
-	"github.com/oliveagle/jsonpath"
 	"github.com/mushiyu/go-mysql-server/sql"
 )
 
@@ -26,81 +21,51 @@ func NewJSONExtract(args ...sql.Expression) (sql.Expression, error) {
 
 // Resolved implements the sql.Expression interface.
 func (j *JSONExtract) Resolved() bool {
-	for _, p := range j.Paths {
-		if !p.Resolved() {
-			return false
-		}
-	}
-	return j.JSON.Resolved()
+	return jsonArgsResolved(j.Children())
 }
 
 // Type implements the sql.Expression interface.
 func (j *JSONExtract) Type() sql.Type { return sql.JSON }
 
-// Eval implements the sql.Expression interface.
+// Eval implements the sql.Expression interface. The value matched by the
+// path is returned if there is only one path without wildcards, and an array
+// with all the values matched by the paths otherwise. NULL is returned if no
+// value is matched.
 func (j *JSONExtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	span, ctx := ctx.Span("function.JSONExtract")
 	defer span.Finish()
 
-	js, err := j.JSON.Eval(ctx, row)
-	if err != nil {
-		return nil, err
-	}
-
-	doc, err := unmarshalVal(js)
-	if err != nil {
+	doc, ok, err := evalJSON(ctx, j.JSON, row)
+	if err != nil || !ok {
 		return nil, err
 	}
 
-	var result = make([]interface{}, len(j.Paths))
-	for i, p := range j.Paths {
-		path, err := p.Eval(ctx, row)
-		if err != nil {
-			return nil, err
-		}
-
-		path, err = sql.Text.Convert(path)
-		if err != nil {
-			return nil, err
-		}
-
-		c, err := jsonpath.Compile(path.(string))
-		if err != nil {
+	var result []interface{}
+	wrap := len(j.Paths) > 1
+	for _, p := range j.Paths {
+		path, err := evalJSONPath(ctx, p, row)
+		if err != nil || path == nil {
 			return nil, err
 		}
 
-		result[i], _ = c.Lookup(doc) // err ignored
-	}
-
-	if len(result) == 1 {
-		return result[0], nil
+		wrap = wrap || path.HasWildcard()
+		result = append(result, path.Find(doc)...)
 	}
 
-	return result, nil
-}
-
-func unmarshalVal(v interface{}) (interface{}, error) {
-	v, err := sql.JSON.Convert(v)
-	if err != nil {
-		return nil, err
+	if len(result) == 0 {
+		return nil, nil
 	}
 
-	var doc interface{}
-	if err := json.Unmarshal(v.([]byte), &doc); err != nil {
-		return nil, err
+	if !wrap {
+		return sql.JSONDocument{Val: result[0]}, nil
 	}
 
-	return doc, nil
+	return sql.JSONDocument{Val: result}, nil
 }
 
 // IsNullable implements the sql.Expression interface.
 func (j *JSONExtract) IsNullable() bool {
-	for _, p := range j.Paths {
-		if p.IsNullable() {
-			return true
-		}
-	}
-	return j.JSON.IsNullable()
+	return true
 }
 
 // Children implements the sql.Expression interface.
@@ -114,10 +79,5 @@ func (j *JSONExtract) WithChildren(children ...sql.Expression) (sql.Expression,
 }
 
 func (j *JSONExtract) String() string {
-	children := j.Children()
-	var parts = make([]string, len(children))
-	for i, c := range children {
-		parts[i] = c.String()
-	}
-	return fmt.Sprintf("JSON_EXTRACT(%s)", strings.Join(parts, ", "))
+	return jsonFuncString("JSON_EXTRACT", j.Children())
 }
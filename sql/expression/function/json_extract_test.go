@@ -1,12 +1,13 @@
 package function
 
 import (
-	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
 )
 
 func TestJSONExtract(t *testing.T) {
@@ -47,18 +48,25 @@ func TestJSONExtract(t *testing.T) {
 		f        sql.Expression
 		row      sql.Row
 		expected interface{}
-		err      error
+		err      *errors.Kind
 	}{
-		{f2, sql.Row{json, "FOO"}, nil, errors.New("should start with '$'")},
+		{f2, sql.Row{json, "FOO"}, nil, jsonpath.ErrInvalidPath},
 		{f2, sql.Row{nil, "$.b.c"}, nil, nil},
+		{f2, sql.Row{json, nil}, nil, nil},
 		{f2, sql.Row{json, "$.foo"}, nil, nil},
-		{f2, sql.Row{json, "$.b.c"}, "foo", nil},
-		{f3, sql.Row{json, "$.b.c", "$.b.d"}, []interface{}{"foo", true}, nil},
-		{f4, sql.Row{json, "$.b.c", "$.b.d", "$.e[0][*]"}, []interface{}{
+		{f2, sql.Row{json, "$.b.c"}, sql.JSONDocument{Val: "foo"}, nil},
+		{f2, sql.Row{`{"a": [1, 2]}`, "$.a[last]"}, sql.JSONDocument{Val: int64(2)}, nil},
+		{f2, sql.Row{json, "$.a[1 to 2]"}, sql.JSONDocument{Val: []interface{}{int64(2), int64(3)}}, nil},
+		{f2, sql.Row{json, "$**.c"}, sql.JSONDocument{Val: []interface{}{"foo"}}, nil},
+		{f2, sql.Row{json, "$.x[*]"}, nil, nil},
+		{f3, sql.Row{json, "$.b.c", "$.b.d"}, sql.JSONDocument{Val: []interface{}{"foo", true}}, nil},
+		{f3, sql.Row{json, "$.b.c", "$.foo"}, sql.JSONDocument{Val: []interface{}{"foo"}}, nil},
+		{f4, sql.Row{json, "$.b.c", "$.b.d", "$.e[0][*]"}, sql.JSONDocument{Val: []interface{}{
 			"foo",
 			true,
-			[]interface{}{1., 2.},
-		}, nil},
+			int64(1),
+			int64(2),
+		}}, nil},
 	}
 
 	for _, tt := range testCases {
@@ -68,7 +76,8 @@ func TestJSONExtract(t *testing.T) {
 			if tt.err == nil {
 				require.NoError(err)
 			} else {
-				require.Equal(err.Error(), tt.err.Error())
+				require.Error(err)
+				require.True(tt.err.Is(err))
 			}
 
 			require.Equal(tt.expected, result)
@@ -0,0 +1,70 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// JSONKeys returns the keys of a JSON object as a JSON array.
+type JSONKeys struct {
+	args []sql.Expression
+}
+
+// NewJSONKeys creates a new JSONKeys UDF.
+func NewJSONKeys(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_KEYS", "1 or 2", len(args))
+	}
+
+	return &JSONKeys{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONKeys) Type() sql.Type { return sql.JSON }
+
+// IsNullable implements the Expression interface.
+func (f *JSONKeys) IsNullable() bool { return true }
+
+// Resolved implements the Expression interface.
+func (f *JSONKeys) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONKeys) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONKeys) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONKeys(children...)
+}
+
+func (f *JSONKeys) String() string { return jsonFuncString("JSON_KEYS", f.args) }
+
+// Eval implements the Expression interface. NULL is returned if the value
+// is not an object.
+func (f *JSONKeys) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONKeys")
+	defer span.Finish()
+
+	doc, ok, err := evalJSON(ctx, f.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if len(f.args) == 2 {
+		doc, ok, err = evalJSONPathValue(ctx, doc, f.args[1], row)
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	o, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	keys := sql.JSONObjectKeys(o)
+	result := make([]interface{}, len(keys))
+	for i, k := range keys {
+		result[i] = k
+	}
+
+	return sql.JSONDocument{Val: result}, nil
+}
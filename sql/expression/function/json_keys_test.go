@@ -0,0 +1,52 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONKeys(t *testing.T) {
+	_, err := NewJSONKeys()
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f1, err := NewJSONKeys(expression.NewGetField(0, sql.JSON, "doc", true))
+	require.NoError(t, err)
+
+	f2, err := NewJSONKeys(
+		expression.NewGetField(0, sql.JSON, "doc", true),
+		expression.NewGetField(1, sql.Text, "path", true),
+	)
+	require.NoError(t, err)
+
+	doc := `{"bb": 1, "a": {"d": 2, "c": 3}, "ccc": [4]}`
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{f1, sql.Row{doc}, `["a", "bb", "ccc"]`},
+		{f1, sql.Row{`{}`}, `[]`},
+		{f1, sql.Row{`[1, 2]`}, nil},
+		{f1, sql.Row{nil}, nil},
+		{f2, sql.Row{doc, "$.a"}, `["c", "d"]`},
+		{f2, sql.Row{doc, "$.ccc"}, nil},
+		{f2, sql.Row{doc, "$.x"}, nil},
+		{f2, sql.Row{doc, nil}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, tt.expected, result.(sql.JSONDocument).String())
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// JSONLength returns the length of a JSON document, which is the number of
+// elements of an array, the number of members of an object and 1 for any
+// other value.
+type JSONLength struct {
+	args []sql.Expression
+}
+
+// NewJSONLength creates a new JSONLength UDF.
+func NewJSONLength(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_LENGTH", "1 or 2", len(args))
+	}
+
+	return &JSONLength{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONLength) Type() sql.Type { return sql.Int64 }
+
+// IsNullable implements the Expression interface.
+func (f *JSONLength) IsNullable() bool { return true }
+
+// Resolved implements the Expression interface.
+func (f *JSONLength) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONLength) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONLength) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONLength(children...)
+}
+
+func (f *JSONLength) String() string { return jsonFuncString("JSON_LENGTH", f.args) }
+
+// Eval implements the Expression interface.
+func (f *JSONLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONLength")
+	defer span.Finish()
+
+	doc, ok, err := evalJSON(ctx, f.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if len(f.args) == 2 {
+		doc, ok, err = evalJSONPathValue(ctx, doc, f.args[1], row)
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	switch doc := doc.(type) {
+	case []interface{}:
+		return int64(len(doc)), nil
+	case map[string]interface{}:
+		return int64(len(doc)), nil
+	default:
+		return int64(1), nil
+	}
+}
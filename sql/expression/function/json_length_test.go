@@ -0,0 +1,49 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONLength(t *testing.T) {
+	_, err := NewJSONLength()
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f1, err := NewJSONLength(expression.NewGetField(0, sql.JSON, "doc", true))
+	require.NoError(t, err)
+
+	f2, err := NewJSONLength(
+		expression.NewGetField(0, sql.JSON, "doc", true),
+		expression.NewGetField(1, sql.Text, "path", true),
+	)
+	require.NoError(t, err)
+
+	doc := `{"a": [1, 2, [3, 4]], "b": {"c": 1}, "d": "xyz"}`
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{f1, sql.Row{doc}, int64(3)},
+		{f1, sql.Row{`[]`}, int64(0)},
+		{f1, sql.Row{`"abc"`}, int64(1)},
+		{f1, sql.Row{nil}, nil},
+		{f2, sql.Row{doc, "$.a"}, int64(3)},
+		{f2, sql.Row{doc, "$.b"}, int64(1)},
+		{f2, sql.Row{doc, "$.d"}, int64(1)},
+		{f2, sql.Row{doc, "$.x"}, nil},
+		{f2, sql.Row{doc, nil}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
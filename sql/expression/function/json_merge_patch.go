@@ -0,0 +1,100 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// JSONMergePatch merges JSON documents as described in RFC 7396.
+type JSONMergePatch struct {
+	args []sql.Expression
+}
+
+// NewJSONMergePatch creates a new JSONMergePatch UDF.
+func NewJSONMergePatch(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_MERGE_PATCH", "2 or more", len(args))
+	}
+
+	return &JSONMergePatch{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONMergePatch) Type() sql.Type { return sql.JSON }
+
+// IsNullable implements the Expression interface.
+func (f *JSONMergePatch) IsNullable() bool { return jsonArgsNullable(f.args) }
+
+// Resolved implements the Expression interface.
+func (f *JSONMergePatch) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONMergePatch) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONMergePatch) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONMergePatch(children...)
+}
+
+func (f *JSONMergePatch) String() string { return jsonFuncString("JSON_MERGE_PATCH", f.args) }
+
+// Eval implements the Expression interface. Each document is merged as a
+// patch into the result of merging the previous ones. The result is NULL
+// after merging a NULL document, until a document that is not an object
+// replaces it.
+func (f *JSONMergePatch) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONMergePatch")
+	defer span.Finish()
+
+	var result interface{}
+	var isNull bool
+	for i, arg := range f.args {
+		patch, ok, err := evalJSON(ctx, arg, row)
+		if err != nil {
+			return nil, err
+		}
+
+		_, isObject := patch.(map[string]interface{})
+		switch {
+		case !ok:
+			isNull = true
+		case i == 0 || !isObject:
+			result, isNull = patch, false
+		case !isNull:
+			result = mergePatch(result, patch)
+		}
+	}
+
+	if isNull {
+		return nil, nil
+	}
+
+	return sql.JSONDocument{Val: result}, nil
+}
+
+// mergePatch merges the patch into the target: members of the patch with a
+// null value are removed from the target, and the rest are merged into the
+// members of the target. Any patch but an object replaces the target.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]interface{})
+	result := make(map[string]interface{}, len(t)+len(p))
+	if ok {
+		for k, v := range t {
+			result[k] = v
+		}
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(result, k)
+		} else {
+			result[k] = mergePatch(result[k], v)
+		}
+	}
+
+	return result
+}
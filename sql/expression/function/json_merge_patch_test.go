@@ -0,0 +1,48 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONMergePatch(t *testing.T) {
+	_, err := NewJSONMergePatch(expression.NewLiteral(`{}`, sql.Text))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewJSONMergePatch(
+		expression.NewGetField(0, sql.JSON, "a", true),
+		expression.NewGetField(1, sql.JSON, "b", true),
+		expression.NewGetField(2, sql.JSON, "c", true),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "JSON_MERGE_PATCH(a, b, c)", f.String())
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"objects", sql.Row{`{"a": 1, "b": 2}`, `{"b": 3, "c": 4}`, `{"d": 5}`}, `{"a": 1, "b": 3, "c": 4, "d": 5}`},
+		{"remove members", sql.Row{`{"a": 1, "b": 2}`, `{"b": null}`, `{"c": null}`}, `{"a": 1}`},
+		{"nested", sql.Row{`{"a": {"b": 1, "c": 2}}`, `{"a": {"b": null, "d": 3}}`, `{}`}, `{"a": {"c": 2, "d": 3}}`},
+		{"scalar patch", sql.Row{`{"a": 1}`, `[1, 2]`, `true`}, `true`},
+		{"object over scalar", sql.Row{`[1, 2]`, `{"a": null, "b": 1}`, `{}`}, `{"b": 1}`},
+		{"null document", sql.Row{nil, `{"a": 1}`, `{}`}, nil},
+		{"null replaced", sql.Row{`{"a": 1}`, nil, `[1]`}, `[1]`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, tt.expected, result.(sql.JSONDocument).String())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// JSONObject returns a JSON object with the given keys and values.
+type JSONObject struct {
+	args []sql.Expression
+}
+
+// NewJSONObject creates a new JSONObject UDF.
+func NewJSONObject(args ...sql.Expression) (sql.Expression, error) {
+	if len(args)%2 != 0 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_OBJECT", "an even number of", len(args))
+	}
+
+	return &JSONObject{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONObject) Type() sql.Type { return sql.JSON }
+
+// IsNullable implements the Expression interface.
+func (f *JSONObject) IsNullable() bool { return false }
+
+// Resolved implements the Expression interface.
+func (f *JSONObject) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONObject) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONObject) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONObject(children...)
+}
+
+func (f *JSONObject) String() string { return jsonFuncString("JSON_OBJECT", f.args) }
+
+// Eval implements the Expression interface. If a key is given more than
+// once, the last value given for it is kept.
+func (f *JSONObject) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONObject")
+	defer span.Finish()
+
+	o := make(map[string]interface{}, len(f.args)/2)
+	for i := 0; i < len(f.args); i += 2 {
+		k, err := f.args[i].Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+
+		if k == nil {
+			return nil, sql.ErrJSONNullKey.New()
+		}
+
+		k, err = sql.Text.Convert(k)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := evalJSONValue(ctx, f.args[i+1], row)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k.(string)] = v
+	}
+
+	return sql.JSONDocument{Val: o}, nil
+}
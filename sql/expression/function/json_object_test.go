@@ -0,0 +1,72 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONObject(t *testing.T) {
+	_, err := NewJSONObject(expression.NewLiteral("a", sql.Text))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewJSONObject(
+		expression.NewGetField(0, sql.Text, "k1", true),
+		expression.NewGetField(1, sql.Int64, "v1", true),
+		expression.NewGetField(2, sql.Text, "k2", true),
+		expression.NewGetField(3, sql.JSON, "v2", true),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "JSON_OBJECT(k1, v1, k2, v2)", f.String())
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected string
+		err      bool
+	}{
+		{"values", sql.Row{"a", int64(1), "b", `[1, "x"]`}, `{"a": 1, "b": [1, "x"]}`, false},
+		{"nulls", sql.Row{"a", nil, "b", nil}, `{"a": null, "b": null}`, false},
+		{"duplicated keys", sql.Row{"a", int64(1), "a", `2`}, `{"a": 2}`, false},
+		{"null key", sql.Row{nil, int64(1), "b", `2`}, "", true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err {
+				require.Error(t, err)
+				require.True(t, sql.ErrJSONNullKey.Is(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result.(sql.JSONDocument).String())
+		})
+	}
+}
+
+func TestJSONArray(t *testing.T) {
+	f, err := NewJSONArray(
+		expression.NewLiteral(int64(1), sql.Int64),
+		expression.NewLiteral(`{"a": 1}`, sql.Text),
+		expression.NewLiteral(`{"a": 1}`, sql.JSON),
+		expression.NewLiteral(nil, sql.Null),
+		expression.NewLiteral(true, sql.Boolean),
+		expression.NewLiteral(1.5, sql.Float64),
+	)
+	require.NoError(t, err)
+
+	result, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(t, err)
+	require.Equal(t, `[1, "{\"a\": 1}", {"a": 1}, null, true, 1.5]`, result.(sql.JSONDocument).String())
+
+	f, err = NewJSONArray()
+	require.NoError(t, err)
+
+	result, err = f.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, result.(sql.JSONDocument).String())
+}
@@ -0,0 +1,64 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// JSONRemove removes values from a JSON document.
+type JSONRemove struct {
+	args []sql.Expression
+}
+
+// NewJSONRemove creates a new JSONRemove UDF.
+func NewJSONRemove(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_REMOVE", "2 or more", len(args))
+	}
+
+	return &JSONRemove{args}, nil
+}
+
+// Type implements the Expression interface.
+func (f *JSONRemove) Type() sql.Type { return sql.JSON }
+
+// IsNullable implements the Expression interface.
+func (f *JSONRemove) IsNullable() bool { return jsonArgsNullable(f.args) }
+
+// Resolved implements the Expression interface.
+func (f *JSONRemove) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONRemove) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONRemove) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewJSONRemove(children...)
+}
+
+func (f *JSONRemove) String() string { return jsonFuncString("JSON_REMOVE", f.args) }
+
+// Eval implements the Expression interface. The values are removed in order,
+// so each path refers to the document with the previous values removed.
+func (f *JSONRemove) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONRemove")
+	defer span.Finish()
+
+	doc, ok, err := evalJSON(ctx, f.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	for _, arg := range f.args[1:] {
+		path, err := evalJSONPath(ctx, arg, row)
+		if err != nil || path == nil {
+			return nil, err
+		}
+
+		doc, err = path.Remove(doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.JSONDocument{Val: doc}, nil
+}
@@ -0,0 +1,60 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+func TestJSONRemove(t *testing.T) {
+	_, err := NewJSONRemove(expression.NewLiteral(`{}`, sql.Text))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewJSONRemove(
+		expression.NewGetField(0, sql.JSON, "doc", true),
+		expression.NewGetField(1, sql.Text, "p1", true),
+		expression.NewGetField(2, sql.Text, "p2", true),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "JSON_REMOVE(doc, p1, p2)", f.String())
+
+	doc := `{"a": 1, "b": [2, 3, 4]}`
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+		err      *errors.Kind
+	}{
+		{"members", sql.Row{doc, "$.a", "$.c"}, `{"b": [2, 3, 4]}`, nil},
+		{"elements", sql.Row{doc, "$.b[0]", "$.b[0]"}, `{"a": 1, "b": [4]}`, nil},
+		{"last", sql.Row{doc, "$.b[last]", "$.b[last]"}, `{"a": 1, "b": [2]}`, nil},
+		{"null document", sql.Row{nil, "$.a", "$.b"}, nil, nil},
+		{"null path", sql.Row{doc, "$.a", nil}, nil, nil},
+		{"root", sql.Row{doc, "$", "$.a"}, nil, jsonpath.ErrRootPath},
+		{"wildcard", sql.Row{doc, "$.a", "$.b[*]"}, nil, jsonpath.ErrWildcardPath},
+		{"invalid path", sql.Row{doc, "$.a", "b"}, nil, jsonpath.ErrInvalidPath},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.True(t, tt.err.Is(err))
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.expected == nil {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, tt.expected, result.(sql.JSONDocument).String())
+			}
+		})
+	}
+}
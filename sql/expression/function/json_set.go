@@ -0,0 +1,88 @@
+package function
+
+import (
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// JSONSet sets values in a JSON document, adding those that don't exist
+// and changing those that do. It is also JSON_INSERT, which only adds values,
+// and JSON_REPLACE, which only changes them.
+type JSONSet struct {
+	name string
+	mode jsonpath.Mode
+	args []sql.Expression
+}
+
+func newJSONSet(name string, mode jsonpath.Mode, args []sql.Expression) (sql.Expression, error) {
+	if len(args) < 3 || len(args)%2 != 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New(name, "an odd number, at least 3, of", len(args))
+	}
+
+	return &JSONSet{name, mode, args}, nil
+}
+
+// NewJSONSet creates a new JSONSet UDF for JSON_SET.
+func NewJSONSet(args ...sql.Expression) (sql.Expression, error) {
+	return newJSONSet("JSON_SET", jsonpath.Set, args)
+}
+
+// NewJSONInsert creates a new JSONSet UDF for JSON_INSERT.
+func NewJSONInsert(args ...sql.Expression) (sql.Expression, error) {
+	return newJSONSet("JSON_INSERT", jsonpath.Insert, args)
+}
+
+// NewJSONReplace creates a new JSONSet UDF for JSON_REPLACE.
+func NewJSONReplace(args ...sql.Expression) (sql.Expression, error) {
+	return newJSONSet("JSON_REPLACE", jsonpath.Replace, args)
+}
+
+// Type implements the Expression interface.
+func (f *JSONSet) Type() sql.Type { return sql.JSON }
+
+// IsNullable implements the Expression interface.
+func (f *JSONSet) IsNullable() bool { return jsonArgsNullable(f.args) }
+
+// Resolved implements the Expression interface.
+func (f *JSONSet) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *JSONSet) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *JSONSet) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return newJSONSet(f.name, f.mode, children)
+}
+
+func (f *JSONSet) String() string { return jsonFuncString(f.name, f.args) }
+
+// Eval implements the Expression interface. The values are set in order, so
+// each path refers to the document with the previous values already set.
+func (f *JSONSet) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.JSONSet")
+	defer span.Finish()
+
+	doc, ok, err := evalJSON(ctx, f.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	for i := 1; i < len(f.args); i += 2 {
+		path, err := evalJSONPath(ctx, f.args[i], row)
+		if err != nil || path == nil {
+			return nil, err
+		}
+
+		v, err := evalJSONValue(ctx, f.args[i+1], row)
+		if err != nil {
+			return nil, err
+		}
+
+		doc, err = path.Set(doc, v, f.mode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.JSONDocument{Val: doc}, nil
+}
@@ -0,0 +1,67 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONSet(t *testing.T) {
+	_, err := NewJSONSet(
+		expression.NewLiteral(`{}`, sql.Text),
+		expression.NewLiteral(`$.a`, sql.Text),
+	)
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	args := []sql.Expression{
+		expression.NewGetField(0, sql.JSON, "doc", true),
+		expression.NewGetField(1, sql.Text, "p1", true),
+		expression.NewGetField(2, sql.Int64, "v1", true),
+		expression.NewGetField(3, sql.Text, "p2", true),
+		expression.NewGetField(4, sql.Text, "v2", true),
+	}
+
+	set, err := NewJSONSet(args...)
+	require.NoError(t, err)
+	insert, err := NewJSONInsert(args...)
+	require.NoError(t, err)
+	replace, err := NewJSONReplace(args...)
+	require.NoError(t, err)
+	require.Equal(t, "JSON_INSERT(doc, p1, v1, p2, v2)", insert.String())
+
+	doc := `{"a": 1, "b": [2, 3]}`
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{set, sql.Row{doc, "$.a", int64(10), "$.c", "x"}, `{"a": 10, "b": [2, 3], "c": "x"}`},
+		{insert, sql.Row{doc, "$.a", int64(10), "$.c", "x"}, `{"a": 1, "b": [2, 3], "c": "x"}`},
+		{replace, sql.Row{doc, "$.a", int64(10), "$.c", "x"}, `{"a": 10, "b": [2, 3]}`},
+		{set, sql.Row{doc, "$.b[5]", int64(4), "$.b[0]", "[1]"}, `{"a": 1, "b": ["[1]", 3, 4]}`},
+		{insert, sql.Row{doc, "$.a[1]", int64(4), "$.b[0]", "x"}, `{"a": [1, 4], "b": [2, 3]}`},
+		{set, sql.Row{doc, "$.a", nil, "$.c", nil}, `{"a": null, "b": [2, 3], "c": null}`},
+		{set, sql.Row{nil, "$.a", int64(10), "$.c", "x"}, nil},
+		{set, sql.Row{doc, "$.a", int64(10), nil, "x"}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, tt.expected, result.(sql.JSONDocument).String())
+			}
+		})
+	}
+
+	_, err = set.Eval(sql.NewEmptyContext(), sql.Row{doc, "$[*]", int64(1), "$.a", "x"})
+	require.Error(t, err)
+	require.True(t, jsonpath.ErrWildcardPath.Is(err))
+}
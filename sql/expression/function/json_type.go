@@ -0,0 +1,60 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// JSONType returns the type of a JSON value.
+type JSONType struct {
+	expression.UnaryExpression
+}
+
+// NewJSONType creates a new JSONType UDF.
+func NewJSONType(json sql.Expression) sql.Expression {
+	return &JSONType{expression.UnaryExpression{Child: json}}
+}
+
+func (f *JSONType) String() string {
+	return fmt.Sprintf("JSON_TYPE(%s)", f.Child)
+}
+
+// Type implements the Expression interface.
+func (*JSONType) Type() sql.Type { return sql.Text }
+
+// WithChildren implements the Expression interface.
+func (f *JSONType) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewJSONType(children[0]), nil
+}
+
+// Eval implements the Expression interface.
+func (f *JSONType) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	doc, ok, err := evalJSON(ctx, f.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	switch doc.(type) {
+	case map[string]interface{}:
+		return "OBJECT", nil
+	case []interface{}:
+		return "ARRAY", nil
+	case string:
+		return "STRING", nil
+	case int64:
+		return "INTEGER", nil
+	case uint64:
+		return "UNSIGNED INTEGER", nil
+	case float64:
+		return "DOUBLE", nil
+	case bool:
+		return "BOOLEAN", nil
+	default:
+		return "NULL", nil
+	}
+}
@@ -0,0 +1,37 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONType(t *testing.T) {
+	testCases := []struct {
+		typ      sql.Type
+		val      interface{}
+		expected interface{}
+	}{
+		{sql.JSON, `{"a": 1}`, "OBJECT"},
+		{sql.JSON, `[1]`, "ARRAY"},
+		{sql.JSON, `"a"`, "STRING"},
+		{sql.JSON, `1`, "INTEGER"},
+		{sql.JSON, `18446744073709551615`, "UNSIGNED INTEGER"},
+		{sql.JSON, `1.5`, "DOUBLE"},
+		{sql.JSON, `true`, "BOOLEAN"},
+		{sql.JSON, `null`, "NULL"},
+		{sql.JSON, nil, nil},
+		{sql.Text, `[1, 2]`, "ARRAY"},
+	}
+
+	for _, tt := range testCases {
+		f := NewJSONType(expression.NewLiteral(tt.val, tt.typ))
+		t.Run(f.String(), func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// JSONValid tells whether a value is a valid JSON document.
+type JSONValid struct {
+	expression.UnaryExpression
+}
+
+// NewJSONValid creates a new JSONValid UDF.
+func NewJSONValid(json sql.Expression) sql.Expression {
+	return &JSONValid{expression.UnaryExpression{Child: json}}
+}
+
+func (f *JSONValid) String() string {
+	return fmt.Sprintf("JSON_VALID(%s)", f.Child)
+}
+
+// Type implements the Expression interface.
+func (*JSONValid) Type() sql.Type { return sql.Boolean }
+
+// WithChildren implements the Expression interface.
+func (f *JSONValid) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewJSONValid(children[0]), nil
+}
+
+// Eval implements the Expression interface. Strings are valid if they are
+// valid JSON texts, JSON documents are always valid and any other value is
+// never valid.
+func (f *JSONValid) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	v, err := f.Child.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	switch v.(type) {
+	case string, []byte:
+	case sql.JSONDocument:
+		return true, nil
+	default:
+		return f.Child.Type() == sql.JSON, nil
+	}
+
+	s, err := sql.Text.Convert(v)
+	if err != nil {
+		return false, nil
+	}
+
+	_, err = sql.ParseJSON(s.(string))
+	return err == nil, nil
+}
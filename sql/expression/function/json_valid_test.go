@@ -0,0 +1,37 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestJSONValid(t *testing.T) {
+	testCases := []struct {
+		typ      sql.Type
+		val      interface{}
+		expected interface{}
+	}{
+		{sql.Text, `{"a": [1, true, null]}`, true},
+		{sql.Text, `"a"`, true},
+		{sql.Text, `a`, false},
+		{sql.Text, `{"a": 1`, false},
+		{sql.Text, `[1] [2]`, false},
+		{sql.Blob, []byte(`[1]`), true},
+		{sql.JSON, sql.JSONDocument{Val: "a"}, true},
+		{sql.JSON, int64(1), true},
+		{sql.Int64, int64(1), false},
+		{sql.Text, nil, nil},
+	}
+
+	for _, tt := range testCases {
+		f := NewJSONValid(expression.NewLiteral(tt.val, tt.typ))
+		t.Run(f.String(), func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// LastInsertID returns the first value generated for an AUTO_INCREMENT
+// column by the last INSERT of the session. Given an argument, it sets the
+// value that will be returned to it and returns it.
+type LastInsertID struct {
+	arg sql.Expression
+}
+
+// NewLastInsertID creates a new LastInsertID UDF node.
+func NewLastInsertID(args ...sql.Expression) (sql.Expression, error) {
+	switch len(args) {
+	case 0:
+		return &LastInsertID{}, nil
+	case 1:
+		return &LastInsertID{args[0]}, nil
+	default:
+		return nil, sql.ErrInvalidArgumentNumber.New("LAST_INSERT_ID", "0 or 1", len(args))
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (l *LastInsertID) Children() []sql.Expression {
+	if l.arg == nil {
+		return nil
+	}
+	return []sql.Expression{l.arg}
+}
+
+// Type implements the sql.Expression interface.
+func (*LastInsertID) Type() sql.Type { return sql.Uint64 }
+
+// Resolved implements the sql.Expression interface.
+func (l *LastInsertID) Resolved() bool {
+	return l.arg == nil || l.arg.Resolved()
+}
+
+// WithChildren implements the Expression interface.
+func (l *LastInsertID) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(l.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), len(l.Children()))
+	}
+	return NewLastInsertID(children...)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (l *LastInsertID) IsNullable() bool {
+	return l.arg != nil && l.arg.IsNullable()
+}
+
+// String implements the fmt.Stringer interface.
+func (l *LastInsertID) String() string {
+	if l.arg == nil {
+		return "LAST_INSERT_ID()"
+	}
+	return fmt.Sprintf("LAST_INSERT_ID(%s)", l.arg)
+}
+
+// Eval implements the sql.Expression interface.
+func (l *LastInsertID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if l.arg == nil {
+		_, v := ctx.Get(sql.LastInsertIDVar)
+		return v, nil
+	}
+
+	v, err := l.arg.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	v, err = sql.Uint64.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Set(sql.LastInsertIDVar, sql.Uint64, v)
+	return v, nil
+}
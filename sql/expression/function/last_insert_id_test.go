@@ -0,0 +1,43 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestLastInsertID(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	f, err := NewLastInsertID()
+	require.NoError(err)
+
+	result, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(uint64(0), result)
+
+	ctx.Set(sql.LastInsertIDVar, sql.Uint64, uint64(3))
+	result, err = f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(uint64(3), result)
+
+	set, err := NewLastInsertID(expression.NewLiteral(int64(7), sql.Int64))
+	require.NoError(err)
+
+	result, err = set.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(uint64(7), result)
+
+	result, err = f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(uint64(7), result)
+
+	_, err = NewLastInsertID(
+		expression.NewLiteral(int64(1), sql.Int64),
+		expression.NewLiteral(int64(2), sql.Int64),
+	)
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+}
@@ -54,7 +54,7 @@ func (l *Lower) WithChildren(children ...sql.Expression) (sql.Expression, error)
 
 // Type implements the Expression interface.
 func (l *Lower) Type() sql.Type {
-	return l.Child.Type()
+	return stringType(l.Child.Type())
 }
 
 // Upper is a function that returns the UPPERCASE of the text provided.
@@ -103,5 +103,15 @@ func (u *Upper) WithChildren(children ...sql.Expression) (sql.Expression, error)
 
 // Type implements the Expression interface.
 func (u *Upper) Type() sql.Type {
-	return u.Child.Type()
+	return stringType(u.Child.Type())
+}
+
+// stringType returns the type of the result of a function that changes the
+// text of a value of the given type, which is the type itself except for
+// enums and sets, whose changed members are just text.
+func stringType(t sql.Type) sql.Type {
+	if sql.IsEnum(t) || sql.IsSet(t) {
+		return sql.Text
+	}
+	return t
 }
@@ -37,6 +37,15 @@ var Defaults = []sql.Function{
 		Name: "last",
 		Fn:   func(e sql.Expression) sql.Expression { return aggregation.NewLast(e) },
 	},
+	sql.Function1{
+		Name: "json_arrayagg",
+		Fn:   func(e sql.Expression) sql.Expression { return aggregation.NewJSONArrayAgg(e) },
+	},
+	sql.Function2{Name: "json_objectagg", Fn: aggregation.NewJSONObjectAgg},
+	sql.Function1{
+		Name: "array_agg",
+		Fn:   func(e sql.Expression) sql.Expression { return aggregation.NewArrayAgg(e) },
+	},
 	sql.Function1{Name: "is_binary", Fn: NewIsBinary},
 	sql.FunctionN{Name: "substring", Fn: NewSubstring},
 	sql.Function3{Name: "substring_index", Fn: NewSubstringIndex},
@@ -66,9 +75,24 @@ var Defaults = []sql.Function{
 	sql.Function1{Name: "floor", Fn: NewFloor},
 	sql.FunctionN{Name: "round", Fn: NewRound},
 	sql.Function0{Name: "connection_id", Fn: NewConnectionID},
+	sql.FunctionN{Name: "last_insert_id", Fn: NewLastInsertID},
+	sql.Function0{Name: "current_user", Fn: NewCurrentUser},
 	sql.Function1{Name: "soundex", Fn: NewSoundex},
 	sql.FunctionN{Name: "json_extract", Fn: NewJSONExtract},
 	sql.Function1{Name: "json_unquote", Fn: NewJSONUnquote},
+	sql.FunctionN{Name: "json_object", Fn: NewJSONObject},
+	sql.FunctionN{Name: "json_array", Fn: NewJSONArray},
+	sql.FunctionN{Name: "json_set", Fn: NewJSONSet},
+	sql.FunctionN{Name: "json_insert", Fn: NewJSONInsert},
+	sql.FunctionN{Name: "json_replace", Fn: NewJSONReplace},
+	sql.FunctionN{Name: "json_remove", Fn: NewJSONRemove},
+	sql.FunctionN{Name: "json_contains", Fn: NewJSONContains},
+	sql.FunctionN{Name: "json_contains_path", Fn: NewJSONContainsPath},
+	sql.FunctionN{Name: "json_keys", Fn: NewJSONKeys},
+	sql.FunctionN{Name: "json_length", Fn: NewJSONLength},
+	sql.Function1{Name: "json_type", Fn: NewJSONType},
+	sql.Function1{Name: "json_valid", Fn: NewJSONValid},
+	sql.FunctionN{Name: "json_merge_patch", Fn: NewJSONMergePatch},
 	sql.Function1{Name: "ln", Fn: NewLogBaseFunc(float64(math.E))},
 	sql.Function1{Name: "log2", Fn: NewLogBaseFunc(float64(2))},
 	sql.Function1{Name: "log10", Fn: NewLogBaseFunc(float64(10))},
@@ -87,16 +111,47 @@ var Defaults = []sql.Function{
 	sql.Function2{Name: "ifnull", Fn: NewIfNull},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
 	sql.Function0{Name: "now", Fn: NewNow},
+	sql.FunctionN{Name: "current_timestamp", Fn: NewCurrentTimestamp},
 	sql.Function1{Name: "sleep", Fn: NewSleep},
 	sql.Function1{Name: "to_base64", Fn: NewToBase64},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
 	sql.FunctionN{Name: "date_add", Fn: NewDateAdd},
 	sql.FunctionN{Name: "date_sub", Fn: NewDateSub},
+	sql.Function2{Name: "timediff", Fn: NewTimeDiff},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.FunctionN{Name: "least", Fn: NewLeast},
 	sql.Function1{Name: "length", Fn: NewLength},
 	sql.Function1{Name: "char_length", Fn: NewCharLength},
 	sql.Function1{Name: "character_length", Fn: NewCharLength},
 	sql.Function1{Name: "explode", Fn: NewExplode},
+	sql.Function1{Name: "unnest", Fn: NewUnnest},
 	sql.FunctionN{Name: "regexp_matches", Fn: NewRegexpMatches},
+	sql.FunctionN{Name: "st_geomfromtext", Fn: NewGeomFromText},
+	sql.FunctionN{Name: "st_geometryfromtext", Fn: NewGeomFromText},
+	sql.FunctionN{Name: "st_geomfromwkb", Fn: NewGeomFromWKB},
+	sql.FunctionN{Name: "st_geometryfromwkb", Fn: NewGeomFromWKB},
+	sql.Function2{Name: "point", Fn: NewPoint},
+	sql.Function1{Name: "st_astext", Fn: NewAsText},
+	sql.Function1{Name: "st_aswkt", Fn: NewAsText},
+	sql.Function1{Name: "st_asbinary", Fn: NewAsBinary},
+	sql.Function1{Name: "st_aswkb", Fn: NewAsBinary},
+	sql.Function1{Name: "st_geometrytype", Fn: NewGeometryType},
+	sql.Function1{Name: "st_srid", Fn: NewSRID},
+	sql.Function1{Name: "st_x", Fn: NewX},
+	sql.Function1{Name: "st_y", Fn: NewY},
+	sql.Function2{Name: "st_distance", Fn: NewDistance},
+	sql.FunctionN{Name: "st_distance_sphere", Fn: NewDistanceSphere},
+	sql.Function2{Name: "st_contains", Fn: NewContains},
+	sql.Function2{Name: "st_within", Fn: NewWithin},
+	sql.Function2{Name: "st_intersects", Fn: NewIntersects},
+	sql.Function2{Name: "mbrcontains", Fn: NewMBRContains},
+	sql.Function2{Name: "mbrwithin", Fn: NewMBRWithin},
+	sql.Function2{Name: "mbrintersects", Fn: NewMBRIntersects},
+	sql.Function2{Name: "st_buffer", Fn: NewBuffer},
+	sql.FunctionN{Name: "array", Fn: NewArray},
+	sql.Function2{Name: "element_at", Fn: NewElementAt},
+	sql.FunctionN{Name: "slice", Fn: NewSlice},
+	sql.Function2{Name: "array_contains", Fn: NewArrayContains},
+	sql.Function2{Name: "array_position", Fn: NewArrayPosition},
+	sql.FunctionN{Name: "array_join", Fn: NewArrayJoin},
 }
@@ -59,7 +59,7 @@ func (r *Reverse) WithChildren(children ...sql.Expression) (sql.Expression, erro
 
 // Type implements the Expression interface.
 func (r *Reverse) Type() sql.Type {
-	return r.Child.Type()
+	return stringType(r.Child.Type())
 }
 
 var ErrNegativeRepeatCount = errors.NewKind("negative Repeat count: %v")
@@ -0,0 +1,468 @@
+package function
+
+import (
+	"math"
+	"sort"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrDifferentSRIDs is returned when a spatial function is given two
+	// geometries with different SRIDs.
+	ErrDifferentSRIDs = errors.NewKind("%s given two geometries of different SRIDs: %d and %d, which should have been identical")
+
+	// ErrInvalidGeometryArgument is returned when a spatial function is
+	// given a geometry it can't be used with.
+	ErrInvalidGeometryArgument = errors.NewKind("invalid GIS data provided to function %s: %s")
+)
+
+// evalGeometry returns the geometry that is the value of the expression, or
+// nil if it's NULL.
+func evalGeometry(ctx *sql.Context, e sql.Expression, row sql.Row) (sql.GeometryValue, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	g, err := sql.Geometry.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.(sql.GeometryValue), nil
+}
+
+// evalGeometries returns the geometries that are the values of the
+// expressions, which must have the same SRID. Both are nil if any of them is
+// NULL.
+func evalGeometries(
+	ctx *sql.Context,
+	name string,
+	left, right sql.Expression,
+	row sql.Row,
+) (sql.GeometryValue, sql.GeometryValue, error) {
+	a, err := evalGeometry(ctx, left, row)
+	if err != nil || a == nil {
+		return nil, nil, err
+	}
+
+	b, err := evalGeometry(ctx, right, row)
+	if err != nil || b == nil {
+		return nil, nil, err
+	}
+
+	if a.SpatialReferenceID() != b.SpatialReferenceID() {
+		return nil, nil, ErrDifferentSRIDs.New(name, a.SpatialReferenceID(), b.SpatialReferenceID())
+	}
+
+	return a, b, nil
+}
+
+// evalPoint returns the point that is the value of the expression, or false
+// if it's NULL.
+func evalPoint(ctx *sql.Context, name string, e sql.Expression, row sql.Row) (sql.PointValue, bool, error) {
+	g, err := evalGeometry(ctx, e, row)
+	if err != nil || g == nil {
+		return sql.PointValue{}, false, err
+	}
+
+	p, ok := g.(sql.PointValue)
+	if !ok {
+		return sql.PointValue{}, false, ErrInvalidGeometryArgument.New(name, "expected a point, got a "+g.GeometryType())
+	}
+
+	return p, true, nil
+}
+
+// The following functions implement the spatial relations and the distance
+// between geometries in a Cartesian plane, whatever their SRID is.
+
+// segment is the segment between two points, which is a single point if
+// both are equal.
+type segment struct {
+	a, b sql.PointValue
+}
+
+func (s segment) at(t float64) sql.PointValue {
+	return sql.PointValue{X: s.a.X + (s.b.X-s.a.X)*t, Y: s.a.Y + (s.b.Y-s.a.Y)*t}
+}
+
+// segmentsOf returns the segments of the geometry. A point is a segment
+// with a single point, and polygons are the segments of their rings.
+func segmentsOf(g sql.GeometryValue) []segment {
+	switch g := g.(type) {
+	case sql.PointValue:
+		return []segment{{g, g}}
+	case sql.LineStringValue:
+		return lineSegments(g.Points)
+	case sql.PolygonValue:
+		var result []segment
+		for _, r := range g.Rings {
+			result = append(result, lineSegments(r.Points)...)
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+func lineSegments(points []sql.PointValue) []segment {
+	result := make([]segment, 0, len(points)-1)
+	for i := 1; i < len(points); i++ {
+		result = append(result, segment{points[i-1], points[i]})
+	}
+	return result
+}
+
+// cross returns the cross product of the vectors from o to a and from o to
+// b, which is positive if o, a and b are in counterclockwise order, negative
+// if they are in clockwise order, and zero if they are collinear.
+func cross(o, a, b sql.PointValue) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+func samePoint(a, b sql.PointValue) bool {
+	return a.X == b.X && a.Y == b.Y
+}
+
+// onSegment returns whether the point is in the segment.
+func onSegment(p sql.PointValue, s segment) bool {
+	return cross(s.a, s.b, p) == 0 &&
+		math.Min(s.a.X, s.b.X) <= p.X && p.X <= math.Max(s.a.X, s.b.X) &&
+		math.Min(s.a.Y, s.b.Y) <= p.Y && p.Y <= math.Max(s.a.Y, s.b.Y)
+}
+
+func sign(f float64) int {
+	switch {
+	case f > 0:
+		return 1
+	case f < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// segmentsIntersect returns whether the segments have some point in common.
+func segmentsIntersect(s, t segment) bool {
+	d1 := sign(cross(t.a, t.b, s.a))
+	d2 := sign(cross(t.a, t.b, s.b))
+	d3 := sign(cross(s.a, s.b, t.a))
+	d4 := sign(cross(s.a, s.b, t.b))
+
+	if d1*d2 < 0 && d3*d4 < 0 {
+		return true
+	}
+
+	return onSegment(s.a, t) || onSegment(s.b, t) || onSegment(t.a, s) || onSegment(t.b, s)
+}
+
+// crossings returns the positions in the segment s, from 0 to 1, of the
+// points where the segment t meets it, which are the ends of the part of s
+// they have in common if they are collinear.
+func crossings(s, t segment) []float64 {
+	if !segmentsIntersect(s, t) {
+		return nil
+	}
+
+	dx, dy := s.b.X-s.a.X, s.b.Y-s.a.Y
+	length := dx*dx + dy*dy
+	if length == 0 {
+		return nil
+	}
+
+	position := func(p sql.PointValue) float64 {
+		return ((p.X-s.a.X)*dx + (p.Y-s.a.Y)*dy) / length
+	}
+
+	if cross(s.a, s.b, t.a) == 0 && cross(s.a, s.b, t.b) == 0 {
+		var result []float64
+		for _, p := range []sql.PointValue{t.a, t.b} {
+			if pos := position(p); pos > 0 && pos < 1 {
+				result = append(result, pos)
+			}
+		}
+		return result
+	}
+
+	ex, ey := t.b.X-t.a.X, t.b.Y-t.a.Y
+	denom := dx*ey - dy*ex
+	if denom == 0 {
+		// t is a single point in s.
+		return []float64{position(t.a)}
+	}
+
+	return []float64{((t.a.X-s.a.X)*ey - (t.a.Y-s.a.Y)*ex) / denom}
+}
+
+// pointInRing returns 1 if the point is inside the ring, 0 if it's in the
+// ring and -1 if it's outside of it.
+func pointInRing(p sql.PointValue, ring []sql.PointValue) int {
+	inside := false
+	for i := 1; i < len(ring); i++ {
+		a, b := ring[i-1], ring[i]
+		if onSegment(p, segment{a, b}) {
+			return 0
+		}
+
+		if (a.Y > p.Y) != (b.Y > p.Y) &&
+			p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+
+	if inside {
+		return 1
+	}
+	return -1
+}
+
+// pointInPolygon returns 1 if the point is in the interior of the polygon, 0
+// if it's in its boundary and -1 if it's outside of it.
+func pointInPolygon(p sql.PointValue, poly sql.PolygonValue) int {
+	result := pointInRing(p, poly.Rings[0].Points)
+	if result <= 0 {
+		return result
+	}
+
+	for _, hole := range poly.Rings[1:] {
+		switch pointInRing(p, hole.Points) {
+		case 0:
+			return 0
+		case 1:
+			return -1
+		}
+	}
+
+	return 1
+}
+
+// intersects returns whether the geometries have some point in common.
+func intersects(a, b sql.GeometryValue) bool {
+	for _, s := range segmentsOf(a) {
+		for _, t := range segmentsOf(b) {
+			if segmentsIntersect(s, t) {
+				return true
+			}
+		}
+	}
+
+	// If their boundaries don't intersect, one of them can still be inside
+	// of the other one, and then any of its points is.
+	if poly, ok := a.(sql.PolygonValue); ok && pointInPolygon(firstPoint(b), poly) >= 0 {
+		return true
+	}
+
+	if poly, ok := b.(sql.PolygonValue); ok && pointInPolygon(firstPoint(a), poly) >= 0 {
+		return true
+	}
+
+	return false
+}
+
+func firstPoint(g sql.GeometryValue) sql.PointValue {
+	return segmentsOf(g)[0].a
+}
+
+// contains returns whether the geometry a contains b, which is true if no
+// point of b is outside of a and their interiors have some point in common.
+func contains(a, b sql.GeometryValue) bool {
+	if !a.Bounds().Contains(b.Bounds()) {
+		return false
+	}
+
+	switch a := a.(type) {
+	case sql.PointValue:
+		p, ok := b.(sql.PointValue)
+		return ok && samePoint(a, p)
+	case sql.LineStringValue:
+		switch b := b.(type) {
+		case sql.PointValue:
+			if !onLine(b, a.Points) {
+				return false
+			}
+
+			// The ends of a line string that is not closed are its
+			// boundary, so they are not in its interior.
+			first, last := a.Points[0], a.Points[len(a.Points)-1]
+			return samePoint(first, last) || (!samePoint(b, first) && !samePoint(b, last))
+		case sql.LineStringValue:
+			for _, s := range lineSegments(b.Points) {
+				if !lineCovers(a.Points, s) {
+					return false
+				}
+			}
+			return true
+		default:
+			return false
+		}
+	case sql.PolygonValue:
+		switch b := b.(type) {
+		case sql.PointValue:
+			return pointInPolygon(b, a) > 0
+		case sql.LineStringValue:
+			var interior bool
+			for _, s := range lineSegments(b.Points) {
+				covered, inside := polygonCovers(a, s)
+				if !covered {
+					return false
+				}
+				interior = interior || inside
+			}
+			return interior
+		case sql.PolygonValue:
+			for _, s := range lineSegments(b.Rings[0].Points) {
+				if covered, _ := polygonCovers(a, s); !covered {
+					return false
+				}
+			}
+
+			// The holes of a can't be inside of b.
+			for _, hole := range a.Rings[1:] {
+				for _, s := range lineSegments(hole.Points) {
+					if pointInPolygon(s.at(0.5), b) > 0 {
+						return false
+					}
+				}
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+func onLine(p sql.PointValue, points []sql.PointValue) bool {
+	for _, s := range lineSegments(points) {
+		if onSegment(p, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// lineCovers returns whether all the points of the segment are in the line
+// string with the given points.
+func lineCovers(points []sql.PointValue, s segment) bool {
+	if samePoint(s.a, s.b) {
+		return onLine(s.a, points)
+	}
+
+	type interval struct{ from, to float64 }
+
+	dx, dy := s.b.X-s.a.X, s.b.Y-s.a.Y
+	length := dx*dx + dy*dy
+	position := func(p sql.PointValue) float64 {
+		return ((p.X-s.a.X)*dx + (p.Y-s.a.Y)*dy) / length
+	}
+
+	// The parts of the segment covered by each segment of the line string
+	// collinear with it.
+	var intervals []interval
+	for _, t := range lineSegments(points) {
+		if cross(s.a, s.b, t.a) != 0 || cross(s.a, s.b, t.b) != 0 {
+			continue
+		}
+
+		from, to := position(t.a), position(t.b)
+		if from > to {
+			from, to = to, from
+		}
+		intervals = append(intervals, interval{from, to})
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].from < intervals[j].from
+	})
+
+	var covered float64
+	for _, i := range intervals {
+		if i.from > covered {
+			return false
+		}
+		covered = math.Max(covered, i.to)
+		if covered >= 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// polygonCovers returns whether no point of the segment is outside of the
+// polygon, and whether some point of it is in the interior of the polygon.
+func polygonCovers(poly sql.PolygonValue, s segment) (covered, interior bool) {
+	positions := []float64{0, 1}
+	for _, t := range segmentsOf(poly) {
+		positions = append(positions, crossings(s, t)...)
+	}
+	sort.Float64s(positions)
+
+	for _, p := range []sql.PointValue{s.a, s.b} {
+		switch pointInPolygon(p, poly) {
+		case -1:
+			return false, false
+		case 1:
+			interior = true
+		}
+	}
+
+	// The segment can only go in or out of the polygon where it meets its
+	// boundary, so the pieces between those points are either inside or
+	// outside of it.
+	for i := 1; i < len(positions); i++ {
+		if positions[i] <= positions[i-1] {
+			continue
+		}
+
+		switch pointInPolygon(s.at((positions[i-1]+positions[i])/2), poly) {
+		case -1:
+			return false, false
+		case 1:
+			interior = true
+		}
+	}
+
+	return true, interior
+}
+
+// distance returns the minimum distance between the points of the
+// geometries.
+func distance(a, b sql.GeometryValue) float64 {
+	if intersects(a, b) {
+		return 0
+	}
+
+	result := math.Inf(1)
+	for _, s := range segmentsOf(a) {
+		for _, t := range segmentsOf(b) {
+			result = math.Min(result, segmentDistance(s, t))
+		}
+	}
+	return result
+}
+
+// segmentDistance returns the distance between two segments that don't
+// intersect, which is the distance between one of them and an end of the
+// other one.
+func segmentDistance(s, t segment) float64 {
+	return math.Min(
+		math.Min(pointSegmentDistance(s.a, t), pointSegmentDistance(s.b, t)),
+		math.Min(pointSegmentDistance(t.a, s), pointSegmentDistance(t.b, s)),
+	)
+}
+
+func pointSegmentDistance(p sql.PointValue, s segment) float64 {
+	dx, dy := s.b.X-s.a.X, s.b.Y-s.a.Y
+	length := dx*dx + dy*dy
+
+	var closest = s.a
+	if length > 0 {
+		t := ((p.X-s.a.X)*dx + (p.Y-s.a.Y)*dy) / length
+		closest = s.at(math.Max(0, math.Min(1, t)))
+	}
+
+	return math.Hypot(p.X-closest.X, p.Y-closest.Y)
+}
@@ -0,0 +1,95 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+func mustWKT(t *testing.T, wkt string) sql.GeometryValue {
+	t.Helper()
+	g, err := sql.ParseWKT(wkt, 0)
+	require.NoError(t, err)
+	return g
+}
+
+const (
+	square     = "POLYGON((0 0,10 0,10 10,0 10,0 0))"
+	squareHole = "POLYGON((0 0,10 0,10 10,0 10,0 0),(4 4,6 4,6 6,4 6,4 4))"
+)
+
+func TestSpatialRelations(t *testing.T) {
+	testCases := []struct {
+		a, b       string
+		intersects bool
+		contains   bool
+		within     bool
+	}{
+		{"POINT(1 1)", "POINT(1 1)", true, true, true},
+		{"POINT(1 1)", "POINT(1 2)", false, false, false},
+		{square, "POINT(5 5)", true, true, false},
+		{square, "POINT(0 5)", true, false, false},
+		{square, "POINT(11 5)", false, false, false},
+		{squareHole, "POINT(5 5)", false, false, false},
+		{squareHole, "POINT(4 5)", true, false, false},
+		{squareHole, "POINT(2 2)", true, true, false},
+		{square, "LINESTRING(1 1,9 9)", true, true, false},
+		{square, "LINESTRING(0 0,10 0)", true, false, false},
+		{square, "LINESTRING(5 5,15 5)", true, false, false},
+		{squareHole, "LINESTRING(1 1,9 9)", true, false, false},
+		{squareHole, "LINESTRING(1 1,4 4,4 6,1 9)", true, true, false},
+		{square, "LINESTRING(-1 5,11 5)", true, false, false},
+		{square, "LINESTRING(11 0,11 10)", false, false, false},
+		{square, square, true, true, true},
+		{square, "POLYGON((2 2,8 2,8 8,2 8,2 2))", true, true, false},
+		{squareHole, "POLYGON((2 2,8 2,8 8,2 8,2 2))", true, false, false},
+		{squareHole, "POLYGON((1 1,3 1,3 3,1 3,1 1))", true, true, false},
+		{square, "POLYGON((5 5,15 5,15 15,5 15,5 5))", true, false, false},
+		{square, "POLYGON((10 0,20 0,20 10,10 10,10 0))", true, false, false},
+		{square, "POLYGON((-5 -5,15 -5,15 15,-5 15,-5 -5))", true, false, true},
+		{squareHole, "POLYGON((4.5 4.5,5.5 4.5,5.5 5.5,4.5 5.5,4.5 4.5))", false, false, false},
+		{"LINESTRING(0 0,10 0)", "POINT(5 0)", true, true, false},
+		{"LINESTRING(0 0,10 0)", "POINT(0 0)", true, false, false},
+		{"LINESTRING(0 0,10 0,10 10,0 0)", "POINT(0 0)", true, true, false},
+		{"LINESTRING(0 0,5 0,10 0)", "LINESTRING(2 0,8 0)", true, true, false},
+		{"LINESTRING(0 0,5 0,5 5)", "LINESTRING(2 0,5 0,5 2)", true, true, false},
+		{"LINESTRING(0 0,5 0)", "LINESTRING(2 0,8 0)", true, false, false},
+		{"LINESTRING(0 0,10 10)", "LINESTRING(0 10,10 0)", true, false, false},
+		{"LINESTRING(0 0,10 0)", "LINESTRING(0 1,10 1)", false, false, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			a, b := mustWKT(t, tt.a), mustWKT(t, tt.b)
+			require.Equal(t, tt.intersects, intersects(a, b))
+			require.Equal(t, tt.intersects, intersects(b, a))
+			require.Equal(t, tt.contains, contains(a, b))
+			require.Equal(t, tt.within, within(a, b))
+		})
+	}
+}
+
+func TestSpatialDistance(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"POINT(0 0)", "POINT(3 4)", 5},
+		{"POINT(1 1)", square, 0},
+		{"POINT(13 14)", square, 5},
+		{"POINT(5 5)", squareHole, 1},
+		{"POINT(5 -2)", "LINESTRING(0 0,10 0)", 2},
+		{"LINESTRING(0 2,10 12)", "LINESTRING(0 0,10 0)", 2},
+		{"LINESTRING(-1 5,11 5)", square, 0},
+		{"POLYGON((12 0,20 0,20 10,12 0))", square, 2},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			a, b := mustWKT(t, tt.a), mustWKT(t, tt.b)
+			require.Equal(t, tt.expected, distance(a, b))
+			require.Equal(t, tt.expected, distance(b, a))
+		})
+	}
+}
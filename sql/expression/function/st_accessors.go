@@ -0,0 +1,103 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// GeometryAccessor returns some property of a geometry, such as its WKT
+// representation for ST_AsText or its X coordinate for ST_X.
+type GeometryAccessor struct {
+	expression.UnaryExpression
+	name string
+	typ  sql.Type
+	fn   func(name string, g sql.GeometryValue) (interface{}, error)
+}
+
+func newGeometryAccessor(
+	name string,
+	typ sql.Type,
+	fn func(string, sql.GeometryValue) (interface{}, error),
+) func(sql.Expression) sql.Expression {
+	return func(e sql.Expression) sql.Expression {
+		return &GeometryAccessor{expression.UnaryExpression{Child: e}, name, typ, fn}
+	}
+}
+
+var (
+	// NewAsText creates a new GeometryAccessor UDF for ST_ASTEXT.
+	NewAsText = newGeometryAccessor("ST_ASTEXT", sql.Text, geometryText)
+	// NewAsBinary creates a new GeometryAccessor UDF for ST_ASBINARY.
+	NewAsBinary = newGeometryAccessor("ST_ASBINARY", sql.Blob, geometryBinary)
+	// NewGeometryType creates a new GeometryAccessor UDF for
+	// ST_GEOMETRYTYPE.
+	NewGeometryType = newGeometryAccessor("ST_GEOMETRYTYPE", sql.Text, geometryType)
+	// NewSRID creates a new GeometryAccessor UDF for ST_SRID.
+	NewSRID = newGeometryAccessor("ST_SRID", sql.Uint32, geometrySRID)
+	// NewX creates a new GeometryAccessor UDF for ST_X.
+	NewX = newGeometryAccessor("ST_X", sql.Float64, pointX)
+	// NewY creates a new GeometryAccessor UDF for ST_Y.
+	NewY = newGeometryAccessor("ST_Y", sql.Float64, pointY)
+)
+
+func geometryText(_ string, g sql.GeometryValue) (interface{}, error) {
+	return g.String(), nil
+}
+
+func geometryBinary(_ string, g sql.GeometryValue) (interface{}, error) {
+	return sql.WKB(g), nil
+}
+
+func geometryType(_ string, g sql.GeometryValue) (interface{}, error) {
+	return g.GeometryType(), nil
+}
+
+func geometrySRID(_ string, g sql.GeometryValue) (interface{}, error) {
+	return g.SpatialReferenceID(), nil
+}
+
+func pointX(name string, g sql.GeometryValue) (interface{}, error) {
+	p, ok := g.(sql.PointValue)
+	if !ok {
+		return nil, ErrInvalidGeometryArgument.New(name, "expected a point, got a "+g.GeometryType())
+	}
+	return p.X, nil
+}
+
+func pointY(name string, g sql.GeometryValue) (interface{}, error) {
+	p, ok := g.(sql.PointValue)
+	if !ok {
+		return nil, ErrInvalidGeometryArgument.New(name, "expected a point, got a "+g.GeometryType())
+	}
+	return p.Y, nil
+}
+
+// Type implements the Expression interface.
+func (f *GeometryAccessor) Type() sql.Type { return f.typ }
+
+func (f *GeometryAccessor) String() string {
+	return fmt.Sprintf("%s(%s)", f.name, f.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (f *GeometryAccessor) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return &GeometryAccessor{expression.UnaryExpression{Child: children[0]}, f.name, f.typ, f.fn}, nil
+}
+
+// Eval implements the Expression interface.
+func (f *GeometryAccessor) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.GeometryAccessor")
+	defer span.Finish()
+
+	g, err := evalGeometry(ctx, f.Child, row)
+	if err != nil || g == nil {
+		return nil, err
+	}
+
+	return f.fn(f.name, g)
+}
@@ -0,0 +1,50 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestGeometryAccessors(t *testing.T) {
+	g := expression.NewGetField(0, sql.Geometry, "g", true)
+	p := sql.PointValue{SRID: 4326, X: 1, Y: -2.5}
+	line := sql.LineStringValue{Points: []sql.PointValue{{X: 1, Y: 2}, {X: 3, Y: 4}}}
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{NewAsText(g), sql.Row{p}, "POINT(1 -2.5)", false},
+		{NewAsText(g), sql.Row{sql.GeometryBytes(line)}, "LINESTRING(1 2,3 4)", false},
+		{NewAsText(g), sql.Row{nil}, nil, false},
+		{NewAsBinary(g), sql.Row{p}, sql.WKB(p), false},
+		{NewGeometryType(g), sql.Row{line}, "LINESTRING", false},
+		{NewSRID(g), sql.Row{p}, uint32(4326), false},
+		{NewX(g), sql.Row{p}, float64(1), false},
+		{NewY(g), sql.Row{p}, float64(-2.5), false},
+		{NewX(g), sql.Row{line}, nil, true},
+		{NewY(g), sql.Row{nil}, nil, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err {
+				require.Error(t, err)
+				require.True(t, ErrInvalidGeometryArgument.Is(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+		})
+	}
+
+	_, err := NewAsText(g).Eval(sql.NewEmptyContext(), sql.Row{"foo"})
+	require.Error(t, err)
+	require.True(t, sql.ErrInvalidGeometry.Is(err))
+}
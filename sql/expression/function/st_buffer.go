@@ -0,0 +1,92 @@
+package function
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrBufferNotSupported is returned when ST_BUFFER is given a geometry or
+// distance it can't compute the buffer of.
+var ErrBufferNotSupported = errors.NewKind("ST_BUFFER is not supported for a %s at distance %v")
+
+// bufferSegments is the number of segments of the polygons used as circles
+// by ST_BUFFER.
+const bufferSegments = 32
+
+// Buffer returns the geometry with all the points whose distance to a
+// geometry is at most a given one. Only the buffers of points at positive
+// distances are supported, which are approximated by regular polygons.
+type Buffer struct {
+	expression.BinaryExpression
+}
+
+// NewBuffer creates a new Buffer UDF.
+func NewBuffer(g, d sql.Expression) sql.Expression {
+	return &Buffer{expression.BinaryExpression{Left: g, Right: d}}
+}
+
+// Type implements the Expression interface.
+func (b *Buffer) Type() sql.Type { return sql.Geometry }
+
+func (b *Buffer) String() string {
+	return fmt.Sprintf("ST_BUFFER(%s, %s)", b.Left, b.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (b *Buffer) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 2)
+	}
+	return NewBuffer(children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface.
+func (b *Buffer) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.Buffer")
+	defer span.Finish()
+
+	g, err := evalGeometry(ctx, b.Left, row)
+	if err != nil || g == nil {
+		return nil, err
+	}
+
+	d, err := b.Right.Eval(ctx, row)
+	if err != nil || d == nil {
+		return nil, err
+	}
+
+	d, err = sql.Float64.Convert(d)
+	if err != nil {
+		return nil, err
+	}
+
+	distance := d.(float64)
+	if distance == 0 {
+		return g, nil
+	}
+
+	p, ok := g.(sql.PointValue)
+	if !ok || distance < 0 {
+		return nil, ErrBufferNotSupported.New(g.GeometryType(), distance)
+	}
+
+	ring := make([]sql.PointValue, bufferSegments+1)
+	for i := 0; i < bufferSegments; i++ {
+		angle := 2 * math.Pi * float64(i) / bufferSegments
+		ring[i] = sql.PointValue{
+			SRID: p.SRID,
+			X:    p.X + distance*math.Cos(angle),
+			Y:    p.Y + distance*math.Sin(angle),
+		}
+	}
+	ring[bufferSegments] = ring[0]
+
+	return sql.PolygonValue{
+		SRID:  p.SRID,
+		Rings: []sql.LineStringValue{{SRID: p.SRID, Points: ring}},
+	}, nil
+}
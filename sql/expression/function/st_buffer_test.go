@@ -0,0 +1,49 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestBuffer(t *testing.T) {
+	require := require.New(t)
+	f := NewBuffer(
+		expression.NewGetField(0, sql.Geometry, "g", true),
+		expression.NewGetField(1, sql.Float64, "d", true),
+	)
+	ctx := sql.NewEmptyContext()
+
+	result, err := f.Eval(ctx, sql.Row{sql.PointValue{SRID: 4326, X: 1, Y: 1}, float64(2)})
+	require.NoError(err)
+
+	poly, ok := result.(sql.PolygonValue)
+	require.True(ok)
+	require.Equal(uint32(4326), poly.SRID)
+	require.Len(poly.Rings, 1)
+	require.Len(poly.Rings[0].Points, 33)
+	require.Equal(sql.PointValue{SRID: 4326, X: 3, Y: 1}, poly.Rings[0].Points[0])
+	require.Equal(poly.Rings[0].Points[0], poly.Rings[0].Points[32])
+	require.Equal(sql.BoundingBox{MinX: -1, MinY: -1, MaxX: 3, MaxY: 3}, poly.Bounds())
+	require.True(contains(poly, sql.PointValue{X: 2, Y: 2}))
+	require.False(contains(poly, sql.PointValue{X: 2.5, Y: 2.5}))
+
+	line := mustWKT(t, "LINESTRING(0 0,1 1)")
+	result, err = f.Eval(ctx, sql.Row{line, float64(0)})
+	require.NoError(err)
+	require.Equal(line, result)
+
+	_, err = f.Eval(ctx, sql.Row{line, float64(1)})
+	require.Error(err)
+	require.True(ErrBufferNotSupported.Is(err))
+
+	_, err = f.Eval(ctx, sql.Row{sql.PointValue{}, float64(-1)})
+	require.Error(err)
+	require.True(ErrBufferNotSupported.Is(err))
+
+	result, err = f.Eval(ctx, sql.Row{nil, float64(1)})
+	require.NoError(err)
+	require.Nil(result)
+}
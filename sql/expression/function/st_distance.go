@@ -0,0 +1,157 @@
+package function
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// Distance returns the minimum distance between the points of two
+// geometries in a Cartesian plane.
+type Distance struct {
+	expression.BinaryExpression
+}
+
+// NewDistance creates a new Distance UDF.
+func NewDistance(a, b sql.Expression) sql.Expression {
+	return &Distance{expression.BinaryExpression{Left: a, Right: b}}
+}
+
+// Type implements the Expression interface.
+func (d *Distance) Type() sql.Type { return sql.Float64 }
+
+func (d *Distance) String() string {
+	return fmt.Sprintf("ST_DISTANCE(%s, %s)", d.Left, d.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (d *Distance) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 2)
+	}
+	return NewDistance(children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface.
+func (d *Distance) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.Distance")
+	defer span.Finish()
+
+	a, b, err := evalGeometries(ctx, "ST_DISTANCE", d.Left, d.Right, row)
+	if err != nil || a == nil {
+		return nil, err
+	}
+
+	return distance(a, b), nil
+}
+
+// earthRadius is the radius of the earth used by default by
+// ST_DISTANCE_SPHERE, in meters.
+const earthRadius = 6370986
+
+var (
+	// ErrSphereCoordinate is returned when ST_DISTANCE_SPHERE is given a
+	// point whose longitude or latitude is out of range.
+	ErrSphereCoordinate = errors.NewKind("%s %v is out of range in function ST_DISTANCE_SPHERE, it must be within [%v, %v]")
+
+	// ErrSphereRadius is returned when ST_DISTANCE_SPHERE is given a radius
+	// that is not positive.
+	ErrSphereRadius = errors.NewKind("radius %v is not positive in function ST_DISTANCE_SPHERE")
+)
+
+// DistanceSphere returns the distance between two points on a sphere, whose
+// X coordinates are their longitudes and whose Y coordinates are their
+// latitudes, in degrees.
+type DistanceSphere struct {
+	args []sql.Expression
+}
+
+// NewDistanceSphere creates a new DistanceSphere UDF.
+func NewDistanceSphere(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ST_DISTANCE_SPHERE", "2 or 3", len(args))
+	}
+
+	return &DistanceSphere{args}, nil
+}
+
+// Type implements the Expression interface.
+func (d *DistanceSphere) Type() sql.Type { return sql.Float64 }
+
+// IsNullable implements the Expression interface.
+func (d *DistanceSphere) IsNullable() bool { return jsonArgsNullable(d.args) }
+
+// Resolved implements the Expression interface.
+func (d *DistanceSphere) Resolved() bool { return jsonArgsResolved(d.args) }
+
+// Children implements the Expression interface.
+func (d *DistanceSphere) Children() []sql.Expression { return d.args }
+
+// WithChildren implements the Expression interface.
+func (d *DistanceSphere) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewDistanceSphere(children...)
+}
+
+func (d *DistanceSphere) String() string { return jsonFuncString("ST_DISTANCE_SPHERE", d.args) }
+
+// Eval implements the Expression interface. The distance is computed with
+// the haversine formula.
+func (d *DistanceSphere) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.DistanceSphere")
+	defer span.Finish()
+
+	var points [2]sql.PointValue
+	for i := range points {
+		p, ok, err := evalPoint(ctx, "ST_DISTANCE_SPHERE", d.args[i], row)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		if p.X < -180 || p.X > 180 {
+			return nil, ErrSphereCoordinate.New("longitude", p.X, -180, 180)
+		}
+
+		if p.Y < -90 || p.Y > 90 {
+			return nil, ErrSphereCoordinate.New("latitude", p.Y, -90, 90)
+		}
+
+		points[i] = p
+	}
+
+	if points[0].SRID != points[1].SRID {
+		return nil, ErrDifferentSRIDs.New("ST_DISTANCE_SPHERE", points[0].SRID, points[1].SRID)
+	}
+
+	radius := float64(earthRadius)
+	if len(d.args) == 3 {
+		v, err := d.args[2].Eval(ctx, row)
+		if err != nil || v == nil {
+			return nil, err
+		}
+
+		v, err = sql.Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+
+		radius = v.(float64)
+		if radius <= 0 {
+			return nil, ErrSphereRadius.New(radius)
+		}
+	}
+
+	lon1, lat1 := radians(points[0].X), radians(points[0].Y)
+	lon2, lat2 := radians(points[1].X), radians(points[1].Y)
+
+	h := math.Pow(math.Sin((lat2-lat1)/2), 2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin((lon2-lon1)/2), 2)
+
+	return 2 * radius * math.Asin(math.Sqrt(h)), nil
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,93 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+func TestDistance(t *testing.T) {
+	f := NewDistance(
+		expression.NewGetField(0, sql.Geometry, "a", true),
+		expression.NewGetField(1, sql.Geometry, "b", true),
+	)
+
+	result, err := f.Eval(sql.NewEmptyContext(), sql.Row{
+		sql.PointValue{X: 0, Y: 0},
+		sql.PointValue{X: 3, Y: 4},
+	})
+	require.NoError(t, err)
+	require.Equal(t, float64(5), result)
+
+	result, err = f.Eval(sql.NewEmptyContext(), sql.Row{sql.PointValue{}, nil})
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	_, err = f.Eval(sql.NewEmptyContext(), sql.Row{
+		sql.PointValue{X: 0, Y: 0},
+		sql.PointValue{SRID: 4326, X: 3, Y: 4},
+	})
+	require.Error(t, err)
+	require.True(t, ErrDifferentSRIDs.Is(err))
+}
+
+func TestDistanceSphere(t *testing.T) {
+	_, err := NewDistanceSphere(expression.NewLiteral(nil, sql.Null))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f1, err := NewDistanceSphere(
+		expression.NewGetField(0, sql.Geometry, "a", true),
+		expression.NewGetField(1, sql.Geometry, "b", true),
+	)
+	require.NoError(t, err)
+
+	f2, err := NewDistanceSphere(
+		expression.NewGetField(0, sql.Geometry, "a", true),
+		expression.NewGetField(1, sql.Geometry, "b", true),
+		expression.NewGetField(2, sql.Float64, "radius", true),
+	)
+	require.NoError(t, err)
+
+	madrid := sql.PointValue{X: -3.7038, Y: 40.4168}
+	paris := sql.PointValue{X: 2.3522, Y: 48.8566}
+
+	testCases := []struct {
+		name     string
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+		err      *errors.Kind
+	}{
+		{"same point", f1, sql.Row{madrid, madrid}, float64(0), nil},
+		{"madrid paris", f1, sql.Row{madrid, paris}, 1052900.0, nil},
+		{"unit sphere", f2, sql.Row{sql.PointValue{}, sql.PointValue{X: 180}, float64(1)}, 3.1416, nil},
+		{"null", f1, sql.Row{madrid, nil}, nil, nil},
+		{"longitude", f1, sql.Row{madrid, sql.PointValue{X: 181}}, nil, ErrSphereCoordinate},
+		{"latitude", f1, sql.Row{madrid, sql.PointValue{Y: -91}}, nil, ErrSphereCoordinate},
+		{"radius", f2, sql.Row{madrid, paris, float64(0)}, nil, ErrSphereRadius},
+		{"srid", f1, sql.Row{madrid, sql.PointValue{SRID: 4326}}, nil, ErrDifferentSRIDs},
+		{"not a point", f1, sql.Row{madrid, sql.LineStringValue{
+			Points: []sql.PointValue{madrid, paris},
+		}}, nil, ErrInvalidGeometryArgument},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.True(t, tt.err.Is(err))
+			} else if tt.expected == nil {
+				require.NoError(t, err)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				expected := tt.expected.(float64)
+				require.InDelta(t, expected, result, expected*0.001)
+			}
+		})
+	}
+}
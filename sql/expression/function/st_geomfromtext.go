@@ -0,0 +1,141 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// GeomFromText returns the geometry with the given WKT representation and
+// optional SRID. It is also ST_GeomFromWKB, which takes its WKB
+// representation instead.
+type GeomFromText struct {
+	name  string
+	parse func([]byte, uint32) (sql.GeometryValue, error)
+	args  []sql.Expression
+}
+
+func newGeomFromText(
+	name string,
+	parse func([]byte, uint32) (sql.GeometryValue, error),
+	args []sql.Expression,
+) (sql.Expression, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(name, "1 or 2", len(args))
+	}
+
+	return &GeomFromText{name, parse, args}, nil
+}
+
+func parseWKT(data []byte, srid uint32) (sql.GeometryValue, error) {
+	return sql.ParseWKT(string(data), srid)
+}
+
+// NewGeomFromText creates a new GeomFromText UDF for ST_GEOMFROMTEXT.
+func NewGeomFromText(args ...sql.Expression) (sql.Expression, error) {
+	return newGeomFromText("ST_GEOMFROMTEXT", parseWKT, args)
+}
+
+// NewGeomFromWKB creates a new GeomFromText UDF for ST_GEOMFROMWKB.
+func NewGeomFromWKB(args ...sql.Expression) (sql.Expression, error) {
+	return newGeomFromText("ST_GEOMFROMWKB", sql.ParseWKB, args)
+}
+
+// Type implements the Expression interface.
+func (f *GeomFromText) Type() sql.Type { return sql.Geometry }
+
+// IsNullable implements the Expression interface.
+func (f *GeomFromText) IsNullable() bool { return jsonArgsNullable(f.args) }
+
+// Resolved implements the Expression interface.
+func (f *GeomFromText) Resolved() bool { return jsonArgsResolved(f.args) }
+
+// Children implements the Expression interface.
+func (f *GeomFromText) Children() []sql.Expression { return f.args }
+
+// WithChildren implements the Expression interface.
+func (f *GeomFromText) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return newGeomFromText(f.name, f.parse, children)
+}
+
+func (f *GeomFromText) String() string { return jsonFuncString(f.name, f.args) }
+
+// Eval implements the Expression interface.
+func (f *GeomFromText) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.GeomFromText")
+	defer span.Finish()
+
+	v, err := f.args[0].Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	v, err = sql.Blob.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var srid uint32
+	if len(f.args) == 2 {
+		s, err := f.args[1].Eval(ctx, row)
+		if err != nil || s == nil {
+			return nil, err
+		}
+
+		s, err = sql.Uint32.Convert(s)
+		if err != nil {
+			return nil, err
+		}
+		srid = s.(uint32)
+	}
+
+	return f.parse(v.([]byte), srid)
+}
+
+// Point returns the point with the given coordinates.
+type Point struct {
+	expression.BinaryExpression
+}
+
+// NewPoint creates a new Point UDF.
+func NewPoint(x, y sql.Expression) sql.Expression {
+	return &Point{expression.BinaryExpression{Left: x, Right: y}}
+}
+
+// Type implements the Expression interface.
+func (p *Point) Type() sql.Type { return sql.Point }
+
+func (p *Point) String() string {
+	return fmt.Sprintf("POINT(%s, %s)", p.Left, p.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (p *Point) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 2)
+	}
+	return NewPoint(children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface.
+func (p *Point) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.Point")
+	defer span.Finish()
+
+	var coords [2]float64
+	for i, e := range []sql.Expression{p.Left, p.Right} {
+		v, err := e.Eval(ctx, row)
+		if err != nil || v == nil {
+			return nil, err
+		}
+
+		v, err = sql.Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		coords[i] = v.(float64)
+	}
+
+	return sql.PointValue{X: coords[0], Y: coords[1]}, nil
+}
@@ -0,0 +1,85 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestGeomFromText(t *testing.T) {
+	_, err := NewGeomFromText()
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f1, err := NewGeomFromText(expression.NewGetField(0, sql.Text, "wkt", true))
+	require.NoError(t, err)
+
+	f2, err := NewGeomFromText(
+		expression.NewGetField(0, sql.Text, "wkt", true),
+		expression.NewGetField(1, sql.Int64, "srid", true),
+	)
+	require.NoError(t, err)
+
+	line := sql.LineStringValue{Points: []sql.PointValue{{X: 1, Y: 2}, {X: 3, Y: 4}}}
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{f1, sql.Row{"POINT(1 2)"}, sql.PointValue{X: 1, Y: 2}, false},
+		{f1, sql.Row{"linestring(1 2, 3 4)"}, line, false},
+		{f1, sql.Row{nil}, nil, false},
+		{f1, sql.Row{"POINT(1)"}, nil, true},
+		{f2, sql.Row{"POINT(1 2)", int64(4326)}, sql.PointValue{SRID: 4326, X: 1, Y: 2}, false},
+		{f2, sql.Row{"POINT(1 2)", nil}, nil, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err {
+				require.Error(t, err)
+				require.True(t, sql.ErrInvalidWKT.Is(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestGeomFromWKB(t *testing.T) {
+	f, err := NewGeomFromWKB(
+		expression.NewGetField(0, sql.Blob, "wkb", true),
+		expression.NewLiteral(int64(4326), sql.Int64),
+	)
+	require.NoError(t, err)
+
+	p := sql.PointValue{X: 1.5, Y: -2}
+	result, err := f.Eval(sql.NewEmptyContext(), sql.Row{sql.WKB(p)})
+	require.NoError(t, err)
+	require.Equal(t, sql.PointValue{SRID: 4326, X: 1.5, Y: -2}, result)
+
+	_, err = f.Eval(sql.NewEmptyContext(), sql.Row{[]byte{1, 2, 3}})
+	require.Error(t, err)
+	require.True(t, sql.ErrInvalidWKB.Is(err))
+}
+
+func TestPoint(t *testing.T) {
+	f := NewPoint(
+		expression.NewGetField(0, sql.Float64, "x", true),
+		expression.NewGetField(1, sql.Float64, "y", true),
+	)
+	require.Equal(t, "POINT(x, y)", f.String())
+
+	result, err := f.Eval(sql.NewEmptyContext(), sql.Row{int64(1), "2.5"})
+	require.NoError(t, err)
+	require.Equal(t, sql.PointValue{X: 1, Y: 2.5}, result)
+
+	result, err = f.Eval(sql.NewEmptyContext(), sql.Row{int64(1), nil})
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
@@ -0,0 +1,81 @@
+package function
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// SpatialRelation returns whether two geometries are in some spatial
+// relation, such as ST_Contains or MBRIntersects.
+type SpatialRelation struct {
+	expression.BinaryExpression
+	name string
+	fn   func(a, b sql.GeometryValue) bool
+}
+
+func newSpatialRelation(name string, fn func(a, b sql.GeometryValue) bool) func(a, b sql.Expression) sql.Expression {
+	return func(a, b sql.Expression) sql.Expression {
+		return &SpatialRelation{expression.BinaryExpression{Left: a, Right: b}, name, fn}
+	}
+}
+
+var (
+	// NewContains creates a new SpatialRelation UDF for ST_CONTAINS.
+	NewContains = newSpatialRelation("ST_CONTAINS", contains)
+	// NewWithin creates a new SpatialRelation UDF for ST_WITHIN.
+	NewWithin = newSpatialRelation("ST_WITHIN", within)
+	// NewIntersects creates a new SpatialRelation UDF for ST_INTERSECTS.
+	NewIntersects = newSpatialRelation("ST_INTERSECTS", intersects)
+	// NewMBRContains creates a new SpatialRelation UDF for MBRCONTAINS.
+	NewMBRContains = newSpatialRelation("MBRCONTAINS", mbrContains)
+	// NewMBRWithin creates a new SpatialRelation UDF for MBRWITHIN.
+	NewMBRWithin = newSpatialRelation("MBRWITHIN", mbrWithin)
+	// NewMBRIntersects creates a new SpatialRelation UDF for MBRINTERSECTS.
+	NewMBRIntersects = newSpatialRelation("MBRINTERSECTS", mbrIntersects)
+)
+
+func within(a, b sql.GeometryValue) bool {
+	return contains(b, a)
+}
+
+func mbrContains(a, b sql.GeometryValue) bool {
+	return a.Bounds().Contains(b.Bounds())
+}
+
+func mbrWithin(a, b sql.GeometryValue) bool {
+	return b.Bounds().Contains(a.Bounds())
+}
+
+func mbrIntersects(a, b sql.GeometryValue) bool {
+	return a.Bounds().Intersects(b.Bounds())
+}
+
+// Type implements the Expression interface.
+func (r *SpatialRelation) Type() sql.Type { return sql.Boolean }
+
+func (r *SpatialRelation) String() string {
+	return fmt.Sprintf("%s(%s, %s)", r.name, r.Left, r.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (r *SpatialRelation) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 2)
+	}
+	return &SpatialRelation{expression.BinaryExpression{Left: children[0], Right: children[1]}, r.name, r.fn}, nil
+}
+
+// Eval implements the Expression interface.
+func (r *SpatialRelation) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.SpatialRelation")
+	defer span.Finish()
+
+	a, b, err := evalGeometries(ctx, r.name, r.Left, r.Right, row)
+	if err != nil || a == nil {
+		return nil, err
+	}
+
+	return r.fn(a, b), nil
+}
@@ -0,0 +1,54 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+func TestSpatialRelation(t *testing.T) {
+	a := expression.NewGetField(0, sql.Geometry, "a", true)
+	b := expression.NewGetField(1, sql.Geometry, "b", true)
+
+	poly := sql.GeometryBytes(mustWKT(t, "POLYGON((0 0,10 0,10 10,0 0))"))
+	inside := mustWKT(t, "POINT(8 2)")
+	outside := mustWKT(t, "POINT(2 8)")
+
+	testCases := []struct {
+		f        sql.Expression
+		row      sql.Row
+		expected interface{}
+	}{
+		{NewContains(a, b), sql.Row{poly, inside}, true},
+		{NewContains(a, b), sql.Row{poly, outside}, false},
+		{NewContains(a, b), sql.Row{inside, poly}, false},
+		{NewWithin(a, b), sql.Row{inside, poly}, true},
+		{NewWithin(a, b), sql.Row{outside, poly}, false},
+		{NewIntersects(a, b), sql.Row{poly, inside}, true},
+		{NewIntersects(a, b), sql.Row{outside, poly}, false},
+		{NewMBRContains(a, b), sql.Row{poly, outside}, true},
+		{NewMBRWithin(a, b), sql.Row{outside, poly}, true},
+		{NewMBRIntersects(a, b), sql.Row{poly, mustWKT(t, "POINT(11 5)")}, false},
+		{NewContains(a, b), sql.Row{poly, nil}, nil},
+		{NewIntersects(a, b), sql.Row{nil, poly}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.f.String(), func(t *testing.T) {
+			result, err := tt.f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	f := NewContains(a, b)
+	_, err := f.Eval(sql.NewEmptyContext(), sql.Row{poly, sql.PointValue{SRID: 4326}})
+	require.Error(t, err)
+	require.True(t, ErrDifferentSRIDs.Is(err))
+
+	f2, err := f.WithChildren(b, a)
+	require.NoError(t, err)
+	require.Equal(t, "ST_CONTAINS(b, a)", f2.String())
+}
@@ -22,7 +22,7 @@ func getDate(ctx *sql.Context,
 		return nil, nil
 	}
 
-	date, err := sql.Timestamp.Convert(val)
+	date, err := sql.TimestampWithPrecision(sql.MaxTimePrecision).Convert(val)
 	if err != nil {
 		date, err = sql.Date.Convert(val)
 		if err != nil {
@@ -516,6 +516,16 @@ func NewNow() sql.Expression {
 	return &Now{defaultClock}
 }
 
+// NewCurrentTimestamp returns a new Now node for CURRENT_TIMESTAMP, which
+// can be given the fractional seconds precision of the time. The time has
+// always all its fractional seconds, so the precision is ignored.
+func NewCurrentTimestamp(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) > 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New("CURRENT_TIMESTAMP", "0 or 1", len(args))
+	}
+	return NewNow(), nil
+}
+
 // Type implements the sql.Expression interface.
 func (*Now) Type() sql.Type { return sql.Timestamp }
 
@@ -2,6 +2,7 @@ package expression
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/mushiyu/go-mysql-server/sql"
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -123,8 +124,14 @@ func (f *GetSessionField) IsNullable() bool { return f.value == nil }
 // Resolved implements the sql.Expression interface.
 func (f *GetSessionField) Resolved() bool { return true }
 
-// String implements the sql.Expression interface.
-func (f *GetSessionField) String() string { return "@@" + f.name }
+// String implements the sql.Expression interface. The names of user
+// variables already start with @.
+func (f *GetSessionField) String() string {
+	if strings.HasPrefix(f.name, "@") {
+		return f.name
+	}
+	return "@@" + f.name
+}
 
 // WithChildren implements the Expression interface.
 func (f *GetSessionField) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -208,6 +208,21 @@ func (td TimeDelta) Sub(t time.Time) time.Time {
 	return td.apply(t, -1)
 }
 
+// Duration returns the time delta as a duration, which is only possible if
+// it has no years or months, as their duration depends on the time they are
+// applied to.
+func (td TimeDelta) Duration() (time.Duration, bool) {
+	if td.Years != 0 || td.Months != 0 {
+		return 0, false
+	}
+
+	return time.Duration(td.Days)*day +
+		time.Duration(td.Hours)*time.Hour +
+		time.Duration(td.Minutes)*time.Minute +
+		time.Duration(td.Seconds)*time.Second +
+		time.Duration(td.Microseconds)*time.Microsecond, true
+}
+
 const (
 	day  = 24 * time.Hour
 	week = 7 * day
@@ -52,6 +52,15 @@ func (l *Like) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
+	// Strings are matched with the characters they are compared as in
+	// their collation, so case insensitive collations match regardless of
+	// the case of the value and the pattern.
+	collation, err := comparisonCollation(l.Left, l.Right, "like")
+	if err != nil {
+		return nil, err
+	}
+	left = collation.Fold(left.(string))
+
 	var (
 		matcher  regex.Matcher
 		disposer regex.Disposer
@@ -68,7 +77,7 @@ func (l *Like) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
-		right = patternToGoRegex(v.(string))
+		right = patternToGoRegex(collation.Fold(v.(string)))
 	}
 	// for non-cached regex every time create a new matcher
 	if !l.cached {
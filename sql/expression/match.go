@@ -0,0 +1,125 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrNoFullTextIndex is returned when a MATCH expression is evaluated without
+// a full-text index on its columns.
+var ErrNoFullTextIndex = errors.NewKind("can't find FULLTEXT index matching the column list: %s")
+
+// Match is a MATCH ... AGAINST expression, which returns the relevance of the
+// text of the given columns for a full-text search query. It can only be
+// evaluated when a full-text index on the columns has been assigned to it.
+type Match struct {
+	Columns []sql.Expression
+	Query   sql.Expression
+	Mode    sql.FullTextSearchMode
+	Index   sql.FullTextIndex
+}
+
+// NewMatch creates a new Match expression.
+func NewMatch(columns []sql.Expression, query sql.Expression, mode sql.FullTextSearchMode) *Match {
+	return &Match{Columns: columns, Query: query, Mode: mode}
+}
+
+// WithIndex returns a copy of the expression that uses the given index.
+func (m *Match) WithIndex(idx sql.FullTextIndex) *Match {
+	nm := *m
+	nm.Index = idx
+	return &nm
+}
+
+// Resolved implements the sql.Expression interface.
+func (m *Match) Resolved() bool {
+	for _, c := range m.Children() {
+		if !c.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the sql.Expression interface.
+func (m *Match) IsNullable() bool { return false }
+
+// Type implements the sql.Expression interface.
+func (m *Match) Type() sql.Type { return sql.Float64 }
+
+// Children implements the sql.Expression interface.
+func (m *Match) Children() []sql.Expression {
+	var children = make([]sql.Expression, len(m.Columns), len(m.Columns)+1)
+	copy(children, m.Columns)
+	return append(children, m.Query)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (m *Match) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(m.Columns)+1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), len(m.Columns)+1)
+	}
+
+	nm := *m
+	nm.Columns = children[:len(children)-1]
+	nm.Query = children[len(children)-1]
+	return &nm, nil
+}
+
+// QueryString evaluates the search query.
+func (m *Match) QueryString(ctx *sql.Context, row sql.Row) (string, error) {
+	v, err := m.Query.Eval(ctx, row)
+	if err != nil || v == nil {
+		return "", err
+	}
+
+	v, err = sql.Text.Convert(v)
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (m *Match) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("expression.Match")
+	defer span.Finish()
+
+	if m.Index == nil {
+		return nil, ErrNoFullTextIndex.New(m.columnList())
+	}
+
+	query, err := m.QueryString(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	var values = make([]interface{}, len(m.Columns))
+	for i, c := range m.Columns {
+		values[i], err = c.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m.Index.Relevance(query, m.Mode, values...)
+}
+
+func (m *Match) columnList() string {
+	var columns = make([]string, len(m.Columns))
+	for i, c := range m.Columns {
+		columns[i] = c.String()
+	}
+	return strings.Join(columns, ", ")
+}
+
+func (m *Match) String() string {
+	if m.Mode == sql.BooleanMode {
+		return fmt.Sprintf("MATCH(%s) AGAINST (%s %s)", m.columnList(), m.Query, m.Mode)
+	}
+	return fmt.Sprintf("MATCH(%s) AGAINST (%s)", m.columnList(), m.Query)
+}
@@ -0,0 +1,52 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+type relevanceIndex struct {
+	sql.FullTextIndex
+	query  string
+	mode   sql.FullTextSearchMode
+	values []interface{}
+}
+
+func (i *relevanceIndex) Relevance(query string, mode sql.FullTextSearchMode, values ...interface{}) (float64, error) {
+	i.query = query
+	i.mode = mode
+	i.values = values
+	return 1.5, nil
+}
+
+func TestMatch(t *testing.T) {
+	require := require.New(t)
+
+	m := NewMatch(
+		[]sql.Expression{
+			NewGetFieldWithTable(0, sql.Text, "t", "a", true),
+			NewGetFieldWithTable(1, sql.Text, "t", "b", true),
+		},
+		NewLiteral("foo bar", sql.Text),
+		sql.BooleanMode,
+	)
+	require.Equal(`MATCH(t.a, t.b) AGAINST ("foo bar" IN BOOLEAN MODE)`, m.String())
+
+	row := sql.NewRow("a", nil)
+	_, err := m.Eval(sql.NewEmptyContext(), row)
+	require.Error(err)
+	require.True(ErrNoFullTextIndex.Is(err))
+
+	idx := new(relevanceIndex)
+	e, err := m.WithIndex(idx).WithChildren(m.Children()...)
+	require.NoError(err)
+
+	v, err := e.Eval(sql.NewEmptyContext(), row)
+	require.NoError(err)
+	require.Equal(1.5, v)
+	require.Equal("foo bar", idx.query)
+	require.Equal(sql.BooleanMode, idx.mode)
+	require.Equal([]interface{}{"a", nil}, idx.values)
+}
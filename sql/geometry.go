@@ -0,0 +1,654 @@
+package sql
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrInvalidGeometry is returned when some data is not a valid geometry
+	// in the format MySQL stores them.
+	ErrInvalidGeometry = errors.NewKind("cannot get geometry object from data: %s")
+
+	// ErrInvalidWKT is returned when a string is not a valid WKT text.
+	ErrInvalidWKT = errors.NewKind("invalid WKT text: %s")
+
+	// ErrInvalidWKB is returned when some data is not a valid WKB value.
+	ErrInvalidWKB = errors.NewKind("invalid WKB data: %s")
+
+	// ErrGeometryType is returned when a geometry is stored in a column of
+	// a different geometry type.
+	ErrGeometryType = errors.NewKind("a geometry of type %s can't be stored in a column of type %s")
+)
+
+const (
+	// GeometryTypePoint is the name of the type of points.
+	GeometryTypePoint = "POINT"
+	// GeometryTypeLineString is the name of the type of line strings.
+	GeometryTypeLineString = "LINESTRING"
+	// GeometryTypePolygon is the name of the type of polygons.
+	GeometryTypePolygon = "POLYGON"
+	// GeometryTypeGeometry is the name of the type of any geometry.
+	GeometryTypeGeometry = "GEOMETRY"
+)
+
+// WKB codes of the geometry types.
+const (
+	wkbPoint      = 1
+	wkbLineString = 2
+	wkbPolygon    = 3
+)
+
+// GeometryValue is a value of the geometry types. All its coordinates are in
+// the spatial reference system with the given SRID, although they are always
+// used as Cartesian coordinates.
+type GeometryValue interface {
+	// GeometryType returns the name of the type of the geometry.
+	GeometryType() string
+	// SpatialReferenceID returns the SRID of the geometry.
+	SpatialReferenceID() uint32
+	// Bounds returns the minimum bounding rectangle of the geometry.
+	Bounds() BoundingBox
+	// String returns the WKT text of the geometry.
+	String() string
+
+	writeWKT(*bytes.Buffer)
+	writeWKB(*bytes.Buffer)
+}
+
+// PointValue is a point.
+type PointValue struct {
+	SRID uint32
+	X, Y float64
+}
+
+// LineStringValue is a line string, which is made of the segments between
+// its points. It has at least two points.
+type LineStringValue struct {
+	SRID   uint32
+	Points []PointValue
+}
+
+// PolygonValue is a polygon, with an exterior ring and any number of
+// interior rings, which are the holes of the polygon. Rings are closed line
+// strings, with at least four points.
+type PolygonValue struct {
+	SRID  uint32
+	Rings []LineStringValue
+}
+
+var (
+	_ GeometryValue = PointValue{}
+	_ GeometryValue = LineStringValue{}
+	_ GeometryValue = PolygonValue{}
+)
+
+// GeometryType implements the GeometryValue interface.
+func (PointValue) GeometryType() string { return GeometryTypePoint }
+
+// SpatialReferenceID implements the GeometryValue interface.
+func (p PointValue) SpatialReferenceID() uint32 { return p.SRID }
+
+// Bounds implements the GeometryValue interface.
+func (p PointValue) Bounds() BoundingBox {
+	return BoundingBox{MinX: p.X, MinY: p.Y, MaxX: p.X, MaxY: p.Y}
+}
+
+func (p PointValue) String() string { return geometryWKT(p) }
+
+func (p PointValue) writeWKT(buf *bytes.Buffer) {
+	buf.WriteString(GeometryTypePoint)
+	buf.WriteByte('(')
+	writeWKTCoords(buf, p)
+	buf.WriteByte(')')
+}
+
+func (p PointValue) writeWKB(buf *bytes.Buffer) {
+	writeWKBHeader(buf, wkbPoint)
+	writeWKBPoint(buf, p)
+}
+
+// GeometryType implements the GeometryValue interface.
+func (LineStringValue) GeometryType() string { return GeometryTypeLineString }
+
+// SpatialReferenceID implements the GeometryValue interface.
+func (l LineStringValue) SpatialReferenceID() uint32 { return l.SRID }
+
+// Bounds implements the GeometryValue interface.
+func (l LineStringValue) Bounds() BoundingBox {
+	b := l.Points[0].Bounds()
+	for _, p := range l.Points[1:] {
+		b = b.Union(p.Bounds())
+	}
+	return b
+}
+
+func (l LineStringValue) String() string { return geometryWKT(l) }
+
+func (l LineStringValue) writeWKT(buf *bytes.Buffer) {
+	buf.WriteString(GeometryTypeLineString)
+	writeWKTPoints(buf, l.Points)
+}
+
+func (l LineStringValue) writeWKB(buf *bytes.Buffer) {
+	writeWKBHeader(buf, wkbLineString)
+	writeWKBPoints(buf, l.Points)
+}
+
+// GeometryType implements the GeometryValue interface.
+func (PolygonValue) GeometryType() string { return GeometryTypePolygon }
+
+// SpatialReferenceID implements the GeometryValue interface.
+func (p PolygonValue) SpatialReferenceID() uint32 { return p.SRID }
+
+// Bounds implements the GeometryValue interface. Interior rings are always
+// inside the exterior one, so only the exterior ring is used.
+func (p PolygonValue) Bounds() BoundingBox {
+	return p.Rings[0].Bounds()
+}
+
+func (p PolygonValue) String() string { return geometryWKT(p) }
+
+func (p PolygonValue) writeWKT(buf *bytes.Buffer) {
+	buf.WriteString(GeometryTypePolygon)
+	buf.WriteByte('(')
+	for i, r := range p.Rings {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeWKTPoints(buf, r.Points)
+	}
+	buf.WriteByte(')')
+}
+
+func (p PolygonValue) writeWKB(buf *bytes.Buffer) {
+	writeWKBHeader(buf, wkbPolygon)
+	writeWKBUint32(buf, uint32(len(p.Rings)))
+	for _, r := range p.Rings {
+		writeWKBPoints(buf, r.Points)
+	}
+}
+
+// WithSRID returns the geometry with the given SRID.
+func WithSRID(g GeometryValue, srid uint32) GeometryValue {
+	switch g := g.(type) {
+	case PointValue:
+		g.SRID = srid
+		return g
+	case LineStringValue:
+		g.SRID = srid
+		return g
+	case PolygonValue:
+		g.SRID = srid
+		return g
+	default:
+		return g
+	}
+}
+
+// BoundingBox is an axis-aligned rectangle, which is used as the minimum
+// bounding rectangle of geometries.
+type BoundingBox struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// Intersects returns whether the boxes have some point in common, including
+// the points of their boundaries.
+func (b BoundingBox) Intersects(o BoundingBox) bool {
+	return b.MinX <= o.MaxX && o.MinX <= b.MaxX &&
+		b.MinY <= o.MaxY && o.MinY <= b.MaxY
+}
+
+// Contains returns whether all the points of the given box are in the box.
+func (b BoundingBox) Contains(o BoundingBox) bool {
+	return b.MinX <= o.MinX && o.MaxX <= b.MaxX &&
+		b.MinY <= o.MinY && o.MaxY <= b.MaxY
+}
+
+// Union returns the smallest box that contains both boxes.
+func (b BoundingBox) Union(o BoundingBox) BoundingBox {
+	return BoundingBox{
+		MinX: math.Min(b.MinX, o.MinX),
+		MinY: math.Min(b.MinY, o.MinY),
+		MaxX: math.Max(b.MaxX, o.MaxX),
+		MaxY: math.Max(b.MaxY, o.MaxY),
+	}
+}
+
+// Expand returns the box with each side moved away from its center by the
+// given distance.
+func (b BoundingBox) Expand(d float64) BoundingBox {
+	return BoundingBox{MinX: b.MinX - d, MinY: b.MinY - d, MaxX: b.MaxX + d, MaxY: b.MaxY + d}
+}
+
+// Area returns the area of the box.
+func (b BoundingBox) Area() float64 {
+	return (b.MaxX - b.MinX) * (b.MaxY - b.MinY)
+}
+
+func geometryWKT(g GeometryValue) string {
+	var buf bytes.Buffer
+	g.writeWKT(&buf)
+	return buf.String()
+}
+
+func writeWKTPoints(buf *bytes.Buffer, points []PointValue) {
+	buf.WriteByte('(')
+	for i, p := range points {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeWKTCoords(buf, p)
+	}
+	buf.WriteByte(')')
+}
+
+func writeWKTCoords(buf *bytes.Buffer, p PointValue) {
+	buf.WriteString(formatCoord(p.X))
+	buf.WriteByte(' ')
+	buf.WriteString(formatCoord(p.Y))
+}
+
+// formatCoord formats a coordinate with as few digits as needed, as MySQL
+// does in WKT texts.
+func formatCoord(f float64) string {
+	if f == 0 {
+		// Avoids writing negative zeros.
+		return "0"
+	}
+	return strings.Replace(strconv.FormatFloat(f, 'g', -1, 64), "e+", "e", 1)
+}
+
+// ParseWKT parses a WKT text with a POINT, LINESTRING or POLYGON and returns
+// the geometry with the given SRID.
+func ParseWKT(s string, srid uint32) (GeometryValue, error) {
+	p := &wktParser{s: s}
+	typ := strings.ToUpper(p.word())
+
+	var g GeometryValue
+	var err error
+	switch typ {
+	case GeometryTypePoint:
+		var pt PointValue
+		if !p.expect('(') {
+			return nil, ErrInvalidWKT.New(s)
+		}
+		if pt, err = p.point(srid); err != nil {
+			return nil, err
+		}
+		if !p.expect(')') {
+			return nil, ErrInvalidWKT.New(s)
+		}
+		g = pt
+	case GeometryTypeLineString:
+		points, err := p.points(srid)
+		if err != nil {
+			return nil, err
+		}
+		g = LineStringValue{SRID: srid, Points: points}
+	case GeometryTypePolygon:
+		if !p.expect('(') {
+			return nil, ErrInvalidWKT.New(s)
+		}
+
+		var rings []LineStringValue
+		for {
+			points, err := p.points(srid)
+			if err != nil {
+				return nil, err
+			}
+			rings = append(rings, LineStringValue{SRID: srid, Points: points})
+
+			if !p.expect(',') {
+				break
+			}
+		}
+
+		if !p.expect(')') {
+			return nil, ErrInvalidWKT.New(s)
+		}
+		g = PolygonValue{SRID: srid, Rings: rings}
+	default:
+		return nil, ErrInvalidWKT.New(s)
+	}
+
+	p.skipSpaces()
+	if p.pos < len(p.s) {
+		return nil, ErrInvalidWKT.New(s)
+	}
+
+	if err := validateGeometry(g); err != nil {
+		return nil, ErrInvalidWKT.Wrap(err, s)
+	}
+
+	return g, nil
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpaces() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *wktParser) expect(c byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *wktParser) word() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z' || p.s[p.pos] >= 'A' && p.s[p.pos] <= 'Z') {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *wktParser) number() (float64, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("0123456789+-.eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+
+	f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, ErrInvalidWKT.New(p.s)
+	}
+	return f, nil
+}
+
+func (p *wktParser) point(srid uint32) (PointValue, error) {
+	x, err := p.number()
+	if err != nil {
+		return PointValue{}, err
+	}
+
+	y, err := p.number()
+	if err != nil {
+		return PointValue{}, err
+	}
+
+	return PointValue{SRID: srid, X: x, Y: y}, nil
+}
+
+// points parses a list of points between parentheses.
+func (p *wktParser) points(srid uint32) ([]PointValue, error) {
+	if !p.expect('(') {
+		return nil, ErrInvalidWKT.New(p.s)
+	}
+
+	var points []PointValue
+	for {
+		pt, err := p.point(srid)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, pt)
+
+		if !p.expect(',') {
+			break
+		}
+	}
+
+	if !p.expect(')') {
+		return nil, ErrInvalidWKT.New(p.s)
+	}
+
+	return points, nil
+}
+
+// validateGeometry checks that line strings have at least two points, and
+// rings are closed and have at least four points.
+func validateGeometry(g GeometryValue) error {
+	switch g := g.(type) {
+	case LineStringValue:
+		if len(g.Points) < 2 {
+			return ErrInvalidGeometry.New("a line string must have at least two points")
+		}
+	case PolygonValue:
+		if len(g.Rings) == 0 {
+			return ErrInvalidGeometry.New("a polygon must have at least one ring")
+		}
+
+		for _, r := range g.Rings {
+			if len(r.Points) < 4 {
+				return ErrInvalidGeometry.New("a polygon ring must have at least four points")
+			}
+
+			first, last := r.Points[0], r.Points[len(r.Points)-1]
+			if first.X != last.X || first.Y != last.Y {
+				return ErrInvalidGeometry.New("a polygon ring must be closed")
+			}
+		}
+	}
+	return nil
+}
+
+// WKB returns the WKB representation of the geometry, in little-endian
+// byte order.
+func WKB(g GeometryValue) []byte {
+	var buf bytes.Buffer
+	g.writeWKB(&buf)
+	return buf.Bytes()
+}
+
+// ParseWKB parses a WKB value with a POINT, LINESTRING or POLYGON in any
+// byte order and returns the geometry with the given SRID.
+func ParseWKB(data []byte, srid uint32) (GeometryValue, error) {
+	r := &wkbReader{data: data, srid: srid}
+	g := r.geometry()
+	if r.err == nil && r.pos < len(data) {
+		r.err = ErrInvalidWKB.New("trailing data")
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if err := validateGeometry(g); err != nil {
+		return nil, ErrInvalidWKB.Wrap(err, err.Error())
+	}
+
+	return g, nil
+}
+
+// GeometryBytes returns the geometry as MySQL stores it and sends it to
+// clients, which is the SRID as a little-endian 32-bit integer followed by
+// the WKB representation of the geometry.
+func GeometryBytes(g GeometryValue) []byte {
+	var buf bytes.Buffer
+	writeWKBUint32(&buf, g.SpatialReferenceID())
+	g.writeWKB(&buf)
+	return buf.Bytes()
+}
+
+// ParseGeometryBytes parses a geometry in the format returned by
+// GeometryBytes.
+func ParseGeometryBytes(data []byte) (GeometryValue, error) {
+	if len(data) < 4 {
+		return nil, ErrInvalidGeometry.New("data is too short")
+	}
+
+	g, err := ParseWKB(data[4:], binary.LittleEndian.Uint32(data))
+	if err != nil {
+		return nil, ErrInvalidGeometry.Wrap(err, err.Error())
+	}
+	return g, nil
+}
+
+func writeWKBHeader(buf *bytes.Buffer, typ uint32) {
+	buf.WriteByte(1)
+	writeWKBUint32(buf, typ)
+}
+
+func writeWKBUint32(buf *bytes.Buffer, n uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], n)
+	buf.Write(b[:])
+}
+
+func writeWKBPoint(buf *bytes.Buffer, p PointValue) {
+	var b [16]byte
+	binary.LittleEndian.PutUint64(b[:8], math.Float64bits(p.X))
+	binary.LittleEndian.PutUint64(b[8:], math.Float64bits(p.Y))
+	buf.Write(b[:])
+}
+
+func writeWKBPoints(buf *bytes.Buffer, points []PointValue) {
+	writeWKBUint32(buf, uint32(len(points)))
+	for _, p := range points {
+		writeWKBPoint(buf, p)
+	}
+}
+
+// wkbReader reads WKB values. The first error found is kept, and nothing
+// else is read after it.
+type wkbReader struct {
+	data  []byte
+	pos   int
+	order binary.ByteOrder
+	srid  uint32
+	err   error
+}
+
+func (r *wkbReader) read(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if r.pos+n > len(r.data) {
+		r.err = ErrInvalidWKB.New("unexpected end of data")
+		return nil
+	}
+
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *wkbReader) uint32() uint32 {
+	b := r.read(4)
+	if b == nil {
+		return 0
+	}
+	return r.order.Uint32(b)
+}
+
+// count reads the number of elements of a list, each of which is at least
+// the given number of bytes, so invalid data can't make it allocate too much
+// memory.
+func (r *wkbReader) count(size int) int {
+	n := int(r.uint32())
+	if r.err == nil && n > (len(r.data)-r.pos)/size {
+		r.err = ErrInvalidWKB.New("unexpected end of data")
+		return 0
+	}
+	return n
+}
+
+func (r *wkbReader) point() PointValue {
+	b := r.read(16)
+	if b == nil {
+		return PointValue{}
+	}
+
+	x := math.Float64frombits(r.order.Uint64(b[:8]))
+	y := math.Float64frombits(r.order.Uint64(b[8:]))
+	if math.IsInf(x, 0) || math.IsNaN(x) || math.IsInf(y, 0) || math.IsNaN(y) {
+		r.err = ErrInvalidWKB.New("invalid coordinate")
+	}
+
+	return PointValue{SRID: r.srid, X: x, Y: y}
+}
+
+func (r *wkbReader) points() []PointValue {
+	n := r.count(16)
+	points := make([]PointValue, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		points = append(points, r.point())
+	}
+	return points
+}
+
+func (r *wkbReader) geometry() GeometryValue {
+	b := r.read(1)
+	if b == nil {
+		return nil
+	}
+
+	switch b[0] {
+	case 0:
+		r.order = binary.BigEndian
+	case 1:
+		r.order = binary.LittleEndian
+	default:
+		r.err = ErrInvalidWKB.New("invalid byte order")
+		return nil
+	}
+
+	switch typ := r.uint32(); typ {
+	case wkbPoint:
+		return r.point()
+	case wkbLineString:
+		return LineStringValue{SRID: r.srid, Points: r.points()}
+	case wkbPolygon:
+		n := r.count(4)
+		rings := make([]LineStringValue, 0, n)
+		for i := 0; i < n && r.err == nil; i++ {
+			rings = append(rings, LineStringValue{SRID: r.srid, Points: r.points()})
+		}
+		return PolygonValue{SRID: r.srid, Rings: rings}
+	default:
+		if r.err == nil {
+			r.err = ErrInvalidWKB.New("unsupported geometry type " + strconv.Itoa(int(typ)))
+		}
+		return nil
+	}
+}
+
+// geoJSON returns the GeoJSON object of the geometry, which is how
+// geometries are converted to JSON.
+func geoJSON(g GeometryValue) map[string]interface{} {
+	coords := func(p PointValue) []interface{} {
+		return []interface{}{p.X, p.Y}
+	}
+
+	list := func(points []PointValue) []interface{} {
+		result := make([]interface{}, len(points))
+		for i, p := range points {
+			result[i] = coords(p)
+		}
+		return result
+	}
+
+	var typ string
+	var coordinates []interface{}
+	switch g := g.(type) {
+	case PointValue:
+		typ, coordinates = "Point", coords(g)
+	case LineStringValue:
+		typ, coordinates = "LineString", list(g.Points)
+	case PolygonValue:
+		typ = "Polygon"
+		for _, r := range g.Rings {
+			coordinates = append(coordinates, list(r.Points))
+		}
+	}
+
+	return map[string]interface{}{"type": typ, "coordinates": coordinates}
+}
@@ -0,0 +1,119 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWKT(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected GeometryValue
+		wkt      string
+	}{
+		{"POINT(1 2)", PointValue{X: 1, Y: 2}, "POINT(1 2)"},
+		{" point ( -1.5  2e3 ) ", PointValue{X: -1.5, Y: 2000}, "POINT(-1.5 2000)"},
+		{"POINT(0.1 1e21)", PointValue{X: 0.1, Y: 1e21}, "POINT(0.1 1e21)"},
+		{
+			"LineString(0 0, 1 1,2 0)",
+			LineStringValue{Points: []PointValue{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}}},
+			"LINESTRING(0 0,1 1,2 0)",
+		},
+		{
+			"POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))",
+			PolygonValue{Rings: []LineStringValue{
+				{Points: []PointValue{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 4}, {X: 0, Y: 0}}},
+				{Points: []PointValue{{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 2, Y: 2}, {X: 1, Y: 1}}},
+			}},
+			"POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.text, func(t *testing.T) {
+			require := require.New(t)
+			g, err := ParseWKT(tt.text, 0)
+			require.NoError(err)
+			require.Equal(tt.expected, g)
+			require.Equal(tt.wkt, g.String())
+
+			g2, err := ParseWKB(WKB(g), 0)
+			require.NoError(err)
+			require.Equal(g, g2)
+
+			g3, err := ParseGeometryBytes(GeometryBytes(WithSRID(g, 4326)))
+			require.NoError(err)
+			require.Equal(uint32(4326), g3.SpatialReferenceID())
+			require.Equal(tt.wkt, g3.String())
+		})
+	}
+}
+
+func TestParseWKTErrors(t *testing.T) {
+	testCases := []string{
+		"",
+		"POINT",
+		"POINT()",
+		"POINT(1)",
+		"POINT(1 2",
+		"POINT(1 2) x",
+		"POINT(a b)",
+		"LINESTRING(1 2)",
+		"POLYGON((0 0,1 1,0 0))",
+		"POLYGON((0 0,1 0,1 1,0 1))",
+		"MULTIPOINT(1 2)",
+	}
+
+	for _, text := range testCases {
+		t.Run(text, func(t *testing.T) {
+			_, err := ParseWKT(text, 0)
+			require.Error(t, err)
+			require.True(t, ErrInvalidWKT.Is(err))
+		})
+	}
+}
+
+func TestParseWKB(t *testing.T) {
+	require := require.New(t)
+
+	// POINT(1 2) in big-endian byte order.
+	g, err := ParseWKB([]byte{
+		0, 0, 0, 0, 1,
+		0x3f, 0xf0, 0, 0, 0, 0, 0, 0,
+		0x40, 0, 0, 0, 0, 0, 0, 0,
+	}, 3857)
+	require.NoError(err)
+	require.Equal(PointValue{SRID: 3857, X: 1, Y: 2}, g)
+
+	for _, data := range [][]byte{
+		nil,
+		{1, 1, 0, 0},
+		{1, 4, 0, 0, 0},
+		{1, 2, 0, 0, 0, 0xff, 0xff, 0xff, 0xff},
+		append(WKB(PointValue{}), 0),
+	} {
+		_, err := ParseWKB(data, 0)
+		require.Error(err)
+		require.True(ErrInvalidWKB.Is(err))
+	}
+
+	_, err = ParseGeometryBytes([]byte{1, 2})
+	require.Error(err)
+	require.True(ErrInvalidGeometry.Is(err))
+}
+
+func TestBoundingBox(t *testing.T) {
+	require := require.New(t)
+
+	a := BoundingBox{MinX: 0, MinY: 0, MaxX: 2, MaxY: 2}
+	b := BoundingBox{MinX: 2, MinY: 1, MaxX: 3, MaxY: 4}
+
+	require.True(a.Intersects(b))
+	require.False(a.Intersects(BoundingBox{MinX: 2.5, MinY: 0, MaxX: 3, MaxY: 1}))
+	require.False(a.Contains(b))
+	require.True(a.Union(b).Contains(b))
+	require.Equal(BoundingBox{MinX: 0, MinY: 0, MaxX: 3, MaxY: 4}, a.Union(b))
+	require.Equal(BoundingBox{MinX: -1, MinY: -1, MaxX: 3, MaxY: 3}, a.Expand(1))
+	require.Equal(float64(4), a.Area())
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mushiyu/go-mysql-server/internal/similartext"
 
@@ -17,6 +18,14 @@ const IndexBatchSize = uint64(10000)
 // ChecksumKey is the key in an index config to store the checksum.
 const ChecksumKey = "checksum"
 
+// FullTextDriverID is the ID of the index driver used by default to create
+// FULLTEXT indexes.
+const FullTextDriverID = "fulltext"
+
+// SpatialDriverID is the ID of the index driver used by default to create
+// SPATIAL indexes.
+const SpatialDriverID = "rtree"
+
 // Checksumable provides the checksum of some data.
 type Checksumable interface {
 	// Checksum returns a checksum and an error if there was any problem
@@ -136,6 +145,100 @@ type Mergeable interface {
 	IsMergeable(IndexLookup) bool
 }
 
+// SortedIndex is an index that can return all its values sorted by the
+// indexed expressions.
+type SortedIndex interface {
+	Index
+	// All returns a SortedIndexLookup with all the values in the index,
+	// including the ones whose keys have NULL values.
+	All() (IndexLookup, error)
+}
+
+// SortedIndexLookup is a specialization of IndexLookup that can return the
+// values of each partition sorted by the expressions of the index it was
+// created from.
+type SortedIndexLookup interface {
+	IndexLookup
+	// Sorted returns a lookup with the same values, which are returned in
+	// ascending order of their keys, or in descending order if descending is
+	// true. NULL values are lower than any other value.
+	Sorted(descending bool) IndexLookup
+}
+
+// CoveringIndexLookup is a specialization of IndexLookup that can return the
+// values of the indexed expressions of the rows, so the table doesn't need to
+// be read when they are the only values needed.
+type CoveringIndexLookup interface {
+	IndexLookup
+	// KeyValues returns the values of the expressions of the index the
+	// lookup was created from, along with the location of each row, in the
+	// same order as Values.
+	KeyValues(Partition) (IndexKeyValueIter, error)
+}
+
+// CountableIndexLookup is a specialization of IndexLookup that can report
+// how many values it has in a partition without iterating them or reading
+// the rows of the table.
+type CountableIndexLookup interface {
+	IndexLookup
+	// Count returns the number of values in the subset of the index for the
+	// given partition.
+	Count(Partition) (int64, error)
+}
+
+// BoundedIndex is an index that can return its lowest and highest keys
+// without reading the table.
+type BoundedIndex interface {
+	Index
+	// Bounds returns the first and the last keys of the index in ascending
+	// order among the ones whose first value is not NULL. Both are nil if
+	// there are no such keys.
+	Bounds() (first, last []interface{}, err error)
+}
+
+// FullTextSearchMode is the mode in which a full-text search query is
+// interpreted.
+type FullTextSearchMode byte
+
+const (
+	// NaturalLanguageMode interprets the query as a list of words, and
+	// matches the texts containing any of them.
+	NaturalLanguageMode FullTextSearchMode = iota
+	// BooleanMode interprets the query as a list of words with operators
+	// to require or exclude them, to match prefixes or to match phrases.
+	BooleanMode
+)
+
+func (m FullTextSearchMode) String() string {
+	if m == BooleanMode {
+		return "IN BOOLEAN MODE"
+	}
+	return "IN NATURAL LANGUAGE MODE"
+}
+
+// FullTextIndex is an index of the words in the text of the indexed
+// expressions, which is used to resolve MATCH ... AGAINST expressions instead
+// of looking up key values.
+type FullTextIndex interface {
+	Index
+	// Match returns a lookup of the rows that match the given search query,
+	// which are the ones with a relevance greater than zero.
+	Match(query string, mode FullTextSearchMode) (IndexLookup, error)
+	// Relevance returns the relevance for the given search query of a row
+	// with the given values of the indexed expressions.
+	Relevance(query string, mode FullTextSearchMode, values ...interface{}) (float64, error)
+}
+
+// SpatialIndex is an index of the minimum bounding rectangles of geometries,
+// which is used to look up the rows whose geometries may satisfy a spatial
+// relation with another geometry instead of looking up key values.
+type SpatialIndex interface {
+	Index
+	// Intersecting returns a lookup of the rows whose indexed geometry has
+	// a minimum bounding rectangle that intersects the given one.
+	Intersecting(BoundingBox) (IndexLookup, error)
+}
+
 // IndexDriver manages the coordination between the indexes and their
 // representation on disk.
 type IndexDriver interface {
@@ -153,6 +256,68 @@ type IndexDriver interface {
 	Delete(Index, PartitionIter) error
 }
 
+// IncrementalIndexDriver is an IndexDriver that can update its indexes when
+// rows are inserted or deleted, instead of saving them again for the whole
+// table.
+type IncrementalIndexDriver interface {
+	IndexDriver
+	// InsertKey adds to the index the key values of the row stored at the
+	// given location of the partition.
+	InsertKey(ctx *Context, idx Index, p Partition, values []interface{}, location []byte) error
+	// DeleteKey removes from the index the key values of the row that was
+	// stored at the given location of the partition.
+	DeleteKey(ctx *Context, idx Index, p Partition, values []interface{}, location []byte) error
+}
+
+// RebuildableIndexDriver is an IndexDriver that can build a new version of
+// an index while the current one is still being used, so the index can be
+// rebuilt without being deleted first.
+type RebuildableIndexDriver interface {
+	IndexDriver
+	// NewVersion returns a new empty version of the given index, with the same
+	// ID, expressions and configuration but the given checksum. Saving the
+	// new version must not change the given index, which keeps being used
+	// until it's replaced by the new version.
+	NewVersion(idx Index, checksum string) (Index, error)
+	// DeleteVersion deletes the data of a version of an index that has been
+	// replaced by a newer one, or of a new version that could not be saved.
+	DeleteVersion(idx Index) error
+}
+
+// RowLocation is the location of a row in a partition of a table, as
+// returned by the IndexKeyValueIter of the table.
+type RowLocation struct {
+	Partition Partition
+	Location  []byte
+	Row       Row
+}
+
+// RowLocationChanges are the changes in the locations of the rows of a
+// table after rows are inserted or deleted.
+type RowLocationChanges struct {
+	// Removed are the rows that are no longer stored in their location.
+	Removed []RowLocation
+	// Added are the rows stored in a new location.
+	Added []RowLocation
+}
+
+// LocatedInserter is an Inserter that reports where rows are stored, so the
+// indexes of the table can be updated incrementally.
+type LocatedInserter interface {
+	// InsertLocated inserts the given row and returns the changes in the
+	// locations of the rows of the table.
+	InsertLocated(*Context, Row) (RowLocationChanges, error)
+}
+
+// LocatedDeleter is a Deleter that reports where rows were stored, so the
+// indexes of the table can be updated incrementally.
+type LocatedDeleter interface {
+	// DeleteLocated deletes the given row and returns the changes in the
+	// locations of the rows of the table. Returns ErrDeleteRowNotFound if
+	// the row was not found.
+	DeleteLocated(*Context, Row) (RowLocationChanges, error)
+}
+
 type indexKey struct {
 	db, id string
 }
@@ -162,10 +327,24 @@ type IndexRegistry struct {
 	// Root path where all the data of the indexes is stored on disk.
 	Root string
 
+	// ChecksumInterval is the minimum time between two checks of the
+	// checksum of a table before its indexes are used. If it's zero, the
+	// checksum is checked every time the indexes of the table may be used,
+	// and if it's negative, it's only checked when the indexes are loaded.
+	ChecksumInterval time.Duration
+
+	// RebuildOutdated makes the indexes that are found outdated when the
+	// checksum of their table is checked be rebuilt in the background.
+	RebuildOutdated bool
+
 	mut        sync.RWMutex
 	indexes    map[indexKey]Index
 	indexOrder []indexKey
 	statuses   map[indexKey]IndexStatus
+	rebuilding map[indexKey]*indexRebuild
+	// checksums are the checksums of the tables the indexes are known to be
+	// up to date with, when they are not the checksums of the indexes.
+	checksums map[indexKey]string
 
 	driversMut sync.RWMutex
 	drivers    map[string]IndexDriver
@@ -173,6 +352,41 @@ type IndexRegistry struct {
 	rcmut            sync.RWMutex
 	refCounts        map[indexKey]int
 	deleteIndexQueue map[indexKey]chan<- struct{}
+	unusedQueue      map[indexKey][]chan<- struct{}
+
+	usageMut sync.Mutex
+	usage    map[indexKey]*IndexUsage
+
+	checkMut  sync.Mutex
+	checkedAt map[tableKey]time.Time
+}
+
+type tableKey struct {
+	db, table string
+}
+
+// indexRebuild is the state of the rebuild of an index.
+type indexRebuild struct {
+	// done is closed when the rebuild finishes, or nil if nobody waits for
+	// it to finish.
+	done chan struct{}
+	// queued is closed when the rebuild queued while this one was running
+	// finishes, or nil if no rebuild was queued.
+	queued chan struct{}
+	// pending is true if the rebuild was queued and has not started yet.
+	pending bool
+}
+
+// IndexUsage contains the statistics about the usage of an index since it
+// was added to the registry.
+type IndexUsage struct {
+	// Lookups is the number of times the index was used to look up rows.
+	Lookups uint64
+	// Rows is the number of rows returned using the index.
+	Rows uint64
+	// LastUsed is the last time the index was used, or the zero time if it
+	// was never used.
+	LastUsed time.Time
 }
 
 // NewIndexRegistry returns a new Index Registry.
@@ -183,6 +397,11 @@ func NewIndexRegistry() *IndexRegistry {
 		drivers:          make(map[string]IndexDriver),
 		refCounts:        make(map[indexKey]int),
 		deleteIndexQueue: make(map[indexKey]chan<- struct{}),
+		unusedQueue:      make(map[indexKey][]chan<- struct{}),
+		rebuilding:       make(map[indexKey]*indexRebuild),
+		checksums:        make(map[indexKey]string),
+		usage:            make(map[indexKey]*IndexUsage),
+		checkedAt:        make(map[tableKey]time.Time),
 	}
 }
 
@@ -260,7 +479,7 @@ func (r *IndexRegistry) LoadIndexes(dbs Databases) error {
 							idx.ID(),
 							idx.Table(),
 						)
-						r.MarkOutdated(idx)
+						r.setStatus(idx, IndexOutdated)
 					}
 				}
 			}
@@ -270,10 +489,124 @@ func (r *IndexRegistry) LoadIndexes(dbs Databases) error {
 	return nil
 }
 
-// MarkOutdated sets the index status as outdated. This method is not thread
-// safe and should not be used directly except for testing.
+// MarkOutdated sets the index status as outdated, so it will not be used
+// anymore.
 func (r *IndexRegistry) MarkOutdated(idx Index) {
-	r.statuses[indexKey{idx.Database(), idx.ID()}] = IndexOutdated
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.setStatus(idx, IndexOutdated)
+}
+
+// CheckIndexChecksums compares the checksum of the given table, if it's
+// Checksumable, with the checksum of its usable indexes, and marks as
+// outdated the ones that don't match, so they are not used anymore. It
+// returns the indexes that were marked as outdated. Tables are checked at
+// most once every ChecksumInterval.
+func (r *IndexRegistry) CheckIndexChecksums(db string, table Table) ([]Index, error) {
+	if r.ChecksumInterval < 0 {
+		return nil, nil
+	}
+
+	checksumable := getChecksumable(table)
+	if checksumable == nil {
+		return nil, nil
+	}
+
+	indexes := r.IndexesByTable(db, table.Name())
+	defer func() {
+		for _, idx := range indexes {
+			r.ReleaseIndex(idx)
+		}
+	}()
+
+	if len(indexes) == 0 || !r.shouldCheckChecksum(tableKey{db, table.Name()}) {
+		return nil, nil
+	}
+
+	checksum, err := checksumable.Checksum()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	var outdated []Index
+	for _, idx := range indexes {
+		key := indexKey{idx.Database(), idx.ID()}
+		if r.indexes[key] != idx || !r.canUseIndex(idx) {
+			continue
+		}
+
+		idxChecksum, err := r.indexChecksum(idx)
+		if err != nil {
+			return nil, err
+		}
+
+		if idxChecksum != checksum {
+			logrus.Warnf(
+				"index %q is outdated because its table changed and will not be used, you can rebuild it using `ALTER INDEX %s ON %s REBUILD`",
+				idx.ID(),
+				idx.ID(),
+				idx.Table(),
+			)
+			r.setStatus(idx, IndexOutdated)
+			outdated = append(outdated, idx)
+		}
+	}
+
+	return outdated, nil
+}
+
+// shouldCheckChecksum returns whether the checksum of the table with the
+// given key has to be checked, and records the check if so.
+func (r *IndexRegistry) shouldCheckChecksum(key tableKey) bool {
+	r.checkMut.Lock()
+	defer r.checkMut.Unlock()
+
+	now := time.Now()
+	if last, ok := r.checkedAt[key]; ok && now.Sub(last) < r.ChecksumInterval {
+		return false
+	}
+
+	r.checkedAt[key] = now
+	return true
+}
+
+// indexChecksum is not thread-safe, it should be guarded using mut.
+func (r *IndexRegistry) indexChecksum(idx Index) (string, error) {
+	if checksum, ok := r.checksums[indexKey{idx.Database(), idx.ID()}]; ok {
+		return checksum, nil
+	}
+
+	if c, ok := idx.(Checksumable); ok {
+		return c.Checksum()
+	}
+
+	return "", nil
+}
+
+// UpdateIndexChecksum records that the given index is up to date with the
+// given checksum of its table, such as after the index is updated with the
+// rows inserted into or deleted from the table.
+func (r *IndexRegistry) UpdateIndexChecksum(idx Index, checksum string) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	key := indexKey{idx.Database(), idx.ID()}
+	if r.indexes[key] == idx {
+		r.checksums[key] = checksum
+	}
+}
+
+func getChecksumable(t Table) Checksumable {
+	switch t := t.(type) {
+	case Checksumable:
+		return t
+	case TableWrapper:
+		return getChecksumable(t.Underlying())
+	default:
+		return nil
+	}
 }
 
 func (r *IndexRegistry) retainIndex(db, id string) {
@@ -309,6 +642,13 @@ func (r *IndexRegistry) canUseIndex(idx Index) bool {
 	return r.statuses[indexKey{idx.Database(), idx.ID()}].IsUsable()
 }
 
+// IndexStatus returns the status of the given index.
+func (r *IndexRegistry) IndexStatus(idx Index) IndexStatus {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+	return r.statuses[indexKey{idx.Database(), idx.ID()}]
+}
+
 // setStatus is not thread-safe, it should be guarded using mut.
 func (r *IndexRegistry) setStatus(idx Index, status IndexStatus) {
 	r.statuses[indexKey{idx.Database(), idx.ID()}] = status
@@ -328,6 +668,11 @@ func (r *IndexRegistry) ReleaseIndex(idx Index) {
 		close(ch)
 		delete(r.deleteIndexQueue, key)
 	}
+
+	for _, ch := range r.unusedQueue[key] {
+		close(ch)
+	}
+	delete(r.unusedQueue, key)
 }
 
 // Index returns the index with the given id. It may return nil if the index is
@@ -340,6 +685,20 @@ func (r *IndexRegistry) Index(db, id string) Index {
 	return r.indexes[indexKey{db, strings.ToLower(id)}]
 }
 
+// Indexes returns all the indexes in the registry in the order they were
+// added. The indexes are not retained, so only their metadata should be used.
+func (r *IndexRegistry) Indexes() []Index {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+
+	var indexes = make([]Index, len(r.indexOrder))
+	for i, key := range r.indexOrder {
+		indexes[i] = r.indexes[key]
+	}
+
+	return indexes
+}
+
 // IndexesByTable returns a slice of all the indexes existing on the given table.
 func (r *IndexRegistry) IndexesByTable(db, table string) []Index {
 	r.mut.RLock()
@@ -361,6 +720,17 @@ func (r *IndexRegistry) IndexesByTable(db, table string) []Index {
 // nil it the index is not found. If more than one expression is given, all
 // of them must match for the index to be matched.
 func (r *IndexRegistry) IndexByExpression(db string, expr ...Expression) Index {
+	return r.FilteredIndexByExpression(db, nil, expr...)
+}
+
+// FilteredIndexByExpression returns an index by the given expression, like
+// IndexByExpression, but only among the indexes for which the given filter
+// returns true. A nil filter accepts all indexes.
+func (r *IndexRegistry) FilteredIndexByExpression(
+	db string,
+	filter func(Index) bool,
+	expr ...Expression,
+) Index {
 	r.mut.RLock()
 	defer r.mut.RUnlock()
 
@@ -375,6 +745,10 @@ func (r *IndexRegistry) IndexByExpression(db string, expr ...Expression) Index {
 			continue
 		}
 
+		if filter != nil && !filter(idx) {
+			continue
+		}
+
 		if idx.Database() == db {
 			if exprListsMatch(idx.Expressions(), expressions) {
 				r.retainIndex(db, idx.ID())
@@ -391,6 +765,18 @@ func (r *IndexRegistry) IndexByExpression(db string, expr ...Expression) Index {
 func (r *IndexRegistry) ExpressionsWithIndexes(
 	db string,
 	exprs ...Expression,
+) [][]Expression {
+	return r.FilteredExpressionsWithIndexes(db, nil, exprs...)
+}
+
+// FilteredExpressionsWithIndexes finds all the combinations of expressions
+// with matching indexes, like ExpressionsWithIndexes, but only among the
+// indexes for which the given filter returns true. A nil filter accepts all
+// indexes.
+func (r *IndexRegistry) FilteredExpressionsWithIndexes(
+	db string,
+	filter func(Index) bool,
+	exprs ...Expression,
 ) [][]Expression {
 	r.mut.RLock()
 	defer r.mut.RUnlock()
@@ -402,6 +788,10 @@ Indexes:
 			continue
 		}
 
+		if filter != nil && !filter(idx) {
+			continue
+		}
+
 		if ln := len(idx.Expressions()); ln <= len(exprs) && ln > 1 {
 			var used = make(map[int]struct{})
 			var matched []Expression
@@ -447,6 +837,11 @@ var (
 	// ErrIndexDeleteInvalidStatus is returned when the index trying to delete
 	// does not have a ready or outdated state.
 	ErrIndexDeleteInvalidStatus = errors.NewKind("can't delete index %q because it's not ready for removal")
+
+	// ErrIndexRebuildInvalidStatus is returned when the index trying to
+	// rebuild does not have a ready or outdated state, or it's already being
+	// rebuilt.
+	ErrIndexRebuildInvalidStatus = errors.NewKind("can't rebuild index %q because it's not ready or it's already being rebuilt")
 )
 
 func (r *IndexRegistry) validateIndexToAdd(idx Index) error {
@@ -462,7 +857,11 @@ func (r *IndexRegistry) validateIndexToAdd(idx Index) error {
 			return ErrIndexIDAlreadyRegistered.New(idx.ID())
 		}
 
-		if exprListsEqual(i.Expressions(), idx.Expressions()) {
+		// Full-text indexes can coexist with other indexes on the same
+		// expressions, because they are not used for the same queries.
+		_, fulltext := i.(FullTextIndex)
+		_, newFulltext := idx.(FullTextIndex)
+		if fulltext == newFulltext && exprListsEqual(i.Expressions(), idx.Expressions()) {
 			return ErrIndexExpressionAlreadyRegistered.New(
 				strings.Join(idx.Expressions(), ", "),
 			)
@@ -526,7 +925,9 @@ func (r *IndexRegistry) AddIndex(
 	key := indexKey{idx.Database(), idx.ID()}
 	r.indexes[key] = idx
 	r.indexOrder = append(r.indexOrder, key)
+	delete(r.checksums, key)
 	r.mut.Unlock()
+	r.resetIndexUsage(key)
 
 	var _created = make(chan struct{})
 	var _ready = make(chan struct{})
@@ -586,6 +987,9 @@ func (r *IndexRegistry) DeleteIndex(db, id string, force bool) (<-chan struct{},
 		defer r.rcmut.Unlock()
 
 		delete(r.indexes, key)
+		r.dropIndexRebuild(key)
+		delete(r.checksums, key)
+		r.resetIndexUsage(key)
 		var pos = -1
 		for i, k := range r.indexOrder {
 			if k == key {
@@ -609,6 +1013,9 @@ func (r *IndexRegistry) DeleteIndex(db, id string, force bool) (<-chan struct{},
 		r.mut.Lock()
 		defer r.mut.Unlock()
 		delete(r.indexes, key)
+		r.dropIndexRebuild(key)
+		delete(r.checksums, key)
+		r.resetIndexUsage(key)
 
 		done <- struct{}{}
 	}()
@@ -616,6 +1023,214 @@ func (r *IndexRegistry) DeleteIndex(db, id string, force bool) (<-chan struct{},
 	return done, nil
 }
 
+// StartIndexRebuild marks the given index as being rebuilt, so it can't be
+// rebuilt again until the new version replaces it with ReplaceIndex or the
+// rebuild is cancelled with CancelIndexRebuild. The index keeps being used
+// in the meantime.
+func (r *IndexRegistry) StartIndexRebuild(idx Index) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	key := indexKey{idx.Database(), idx.ID()}
+	if r.indexes[key] != idx || !r.canRebuildIndex(key) {
+		return ErrIndexRebuildInvalidStatus.New(idx.ID())
+	}
+
+	r.rebuilding[key] = new(indexRebuild)
+	return nil
+}
+
+// QueueIndexRebuild queues a new rebuild of the given index if it's being
+// rebuilt, which starts once the current rebuild finishes. All the rebuilds
+// queued while the index is being rebuilt are coalesced into one. It returns
+// a channel that is closed once the queued rebuild has finished, whether it
+// succeeded or not, or false if the index is not being rebuilt.
+func (r *IndexRegistry) QueueIndexRebuild(idx Index) (<-chan struct{}, bool) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	rebuild, ok := r.rebuilding[indexKey{idx.Database(), idx.ID()}]
+	if !ok {
+		return nil, false
+	}
+
+	if rebuild.pending {
+		return rebuild.done, true
+	}
+
+	if rebuild.queued == nil {
+		rebuild.queued = make(chan struct{})
+	}
+	return rebuild.queued, true
+}
+
+// StartQueuedIndexRebuild returns the index with the given id of the given
+// database if a rebuild of it was queued with QueueIndexRebuild and the
+// previous rebuild has finished, in which case the caller must rebuild it.
+// As with StartIndexRebuild, the index is already marked as being rebuilt
+// until the new version replaces it or the rebuild is cancelled. It returns
+// nil if no rebuild is pending.
+func (r *IndexRegistry) StartQueuedIndexRebuild(db, id string) Index {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	key := indexKey{db, id}
+	rebuild, ok := r.rebuilding[key]
+	if !ok || !rebuild.pending {
+		return nil
+	}
+
+	rebuild.pending = false
+	return r.indexes[key]
+}
+
+// finishIndexRebuild marks the rebuild of the index as finished, and if
+// another one was queued meanwhile, marks it as pending. It's not
+// thread-safe, it should be guarded using mut.
+func (r *IndexRegistry) finishIndexRebuild(key indexKey) {
+	rebuild, ok := r.rebuilding[key]
+	if !ok {
+		return
+	}
+
+	if rebuild.done != nil {
+		close(rebuild.done)
+	}
+
+	if rebuild.queued != nil {
+		r.rebuilding[key] = &indexRebuild{done: rebuild.queued, pending: true}
+		return
+	}
+
+	delete(r.rebuilding, key)
+}
+
+// dropIndexRebuild forgets the rebuilds of a deleted index, including the
+// queued one. It's not thread-safe, it should be guarded using mut.
+func (r *IndexRegistry) dropIndexRebuild(key indexKey) {
+	rebuild, ok := r.rebuilding[key]
+	if !ok {
+		return
+	}
+
+	if rebuild.done != nil {
+		close(rebuild.done)
+	}
+
+	if rebuild.queued != nil {
+		close(rebuild.queued)
+	}
+
+	delete(r.rebuilding, key)
+}
+
+// canRebuildIndex is not thread-safe, it should be guarded using mut.
+func (r *IndexRegistry) canRebuildIndex(key indexKey) bool {
+	if _, ok := r.rebuilding[key]; ok {
+		return false
+	}
+
+	status := r.statuses[key]
+	return status == IndexReady || status == IndexOutdated
+}
+
+// CancelIndexRebuild marks the given index as no longer being rebuilt. If
+// another rebuild was queued meanwhile, it can be started with
+// StartQueuedIndexRebuild.
+func (r *IndexRegistry) CancelIndexRebuild(idx Index) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.finishIndexRebuild(indexKey{idx.Database(), idx.ID()})
+}
+
+// ReplaceIndex atomically replaces an index that is being rebuilt with its
+// new version, which is marked as ready. Queries that already retained the
+// old version may keep using it, so the returned channel is closed when no
+// query is using the index anymore and the old version can be deleted.
+// It fails if the old index is not being rebuilt, or if it was deleted or
+// is being deleted. If another rebuild was queued meanwhile, it can be
+// started with StartQueuedIndexRebuild.
+func (r *IndexRegistry) ReplaceIndex(old, new Index) (<-chan struct{}, error) {
+	r.mut.Lock()
+	key := indexKey{old.Database(), old.ID()}
+	if r.indexes[key] != old {
+		r.mut.Unlock()
+		return nil, ErrIndexNotFound.New(old.ID())
+	}
+
+	if rebuild, ok := r.rebuilding[key]; !ok || rebuild.pending {
+		r.mut.Unlock()
+		return nil, ErrIndexRebuildInvalidStatus.New(old.ID())
+	}
+
+	r.finishIndexRebuild(key)
+	if status := r.statuses[key]; status != IndexReady && status != IndexOutdated {
+		r.mut.Unlock()
+		return nil, ErrIndexRebuildInvalidStatus.New(old.ID())
+	}
+
+	r.indexes[key] = new
+	r.setStatus(new, IndexReady)
+	delete(r.checksums, key)
+	r.mut.Unlock()
+
+	var unused = make(chan struct{})
+
+	r.rcmut.Lock()
+	defer r.rcmut.Unlock()
+	if r.refCounts[key] <= 0 {
+		close(unused)
+	} else {
+		r.unusedQueue[key] = append(r.unusedQueue[key], unused)
+	}
+
+	return unused, nil
+}
+
+// RecordIndexLookup records that the given index was used to look up rows.
+func (r *IndexRegistry) RecordIndexLookup(idx Index) {
+	r.usageMut.Lock()
+	defer r.usageMut.Unlock()
+	u := r.indexUsage(idx)
+	u.Lookups++
+	u.LastUsed = time.Now()
+}
+
+// RecordIndexRows records that the given number of rows were returned using
+// the given index.
+func (r *IndexRegistry) RecordIndexRows(idx Index, rows uint64) {
+	r.usageMut.Lock()
+	defer r.usageMut.Unlock()
+	r.indexUsage(idx).Rows += rows
+}
+
+// IndexUsage returns the usage statistics of the given index.
+func (r *IndexRegistry) IndexUsage(idx Index) IndexUsage {
+	r.usageMut.Lock()
+	defer r.usageMut.Unlock()
+	if u, ok := r.usage[indexKey{idx.Database(), idx.ID()}]; ok {
+		return *u
+	}
+	return IndexUsage{}
+}
+
+// indexUsage is not thread-safe, it should be guarded using usageMut.
+func (r *IndexRegistry) indexUsage(idx Index) *IndexUsage {
+	key := indexKey{idx.Database(), idx.ID()}
+	u, ok := r.usage[key]
+	if !ok {
+		u = new(IndexUsage)
+		r.usage[key] = u
+	}
+	return u
+}
+
+func (r *IndexRegistry) resetIndexUsage(key indexKey) {
+	r.usageMut.Lock()
+	defer r.usageMut.Unlock()
+	delete(r.usage, key)
+}
+
 // IndexStatus represents the current status in which the index is.
 type IndexStatus byte
 
@@ -638,6 +1253,8 @@ func (s IndexStatus) String() string {
 	switch s {
 	case IndexReady:
 		return "ready"
+	case IndexOutdated:
+		return "outdated"
 	default:
 		return "not ready"
 	}
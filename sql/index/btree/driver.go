@@ -0,0 +1,210 @@
+package btree
+
+import (
+	"io"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// DriverID is the unique name of the btree driver.
+const DriverID = "btree"
+
+var errInvalidIndexType = errors.NewKind("expecting a btree index, instead got %T")
+
+// Driver implements sql.IndexDriver, sql.IncrementalIndexDriver and
+// sql.RebuildableIndexDriver interfaces. Indexes are kept in memory as
+// B-trees, one per partition, so they are lost when the process exits.
+type Driver struct{}
+
+// NewDriver returns a new instance of btree.Driver which satisfies the
+// sql.IndexDriver interface.
+func NewDriver() *Driver {
+	return new(Driver)
+}
+
+// ID returns the unique name of the driver.
+func (*Driver) ID() string {
+	return DriverID
+}
+
+// Create a new index.
+func (d *Driver) Create(
+	db, table, id string,
+	expressions []sql.Expression,
+	config map[string]string,
+) (sql.Index, error) {
+	exprs := make([]string, len(expressions))
+	types := make([]sql.Type, len(expressions))
+	for i, e := range expressions {
+		exprs[i] = e.String()
+		types[i] = e.Type()
+	}
+
+	return newBTreeIndex(db, table, id, exprs, types, config[sql.ChecksumKey]), nil
+}
+
+// LoadAll loads all indexes for given db and table. Since indexes are not
+// persisted, there is never anything to load.
+func (*Driver) LoadAll(db, table string) ([]sql.Index, error) {
+	return nil, nil
+}
+
+// Save the given index for all partitions.
+func (d *Driver) Save(
+	ctx *sql.Context,
+	i sql.Index,
+	iter sql.PartitionIndexKeyValueIter,
+) error {
+	idx, ok := i.(*btreeIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	span, ctx := ctx.Span("btree.Save")
+	defer span.Finish()
+
+	defer iter.Close()
+
+	var partitions = make(map[string]*tree)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		p, kviter, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		t, err := idx.build(ctx, kviter)
+		if err != nil {
+			return err
+		}
+
+		partitions[partitionKey(p)] = t
+	}
+
+	idx.mu.Lock()
+	for k, t := range partitions {
+		idx.partitions[k] = t
+	}
+	idx.mu.Unlock()
+
+	return nil
+}
+
+// InsertKey adds to the index the given key values with the location of the
+// row in the partition.
+func (d *Driver) InsertKey(
+	ctx *sql.Context,
+	i sql.Index,
+	p sql.Partition,
+	values []interface{},
+	location []byte,
+) error {
+	idx, ok := i.(*btreeIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	key, err := idx.convert(values)
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	t, ok := idx.partitions[partitionKey(p)]
+	if !ok {
+		t = newTree(idx.compare)
+		idx.partitions[partitionKey(p)] = t
+	}
+	t.insert(entry{key, location})
+
+	return nil
+}
+
+// DeleteKey removes from the index the given key values with the location of
+// the row in the partition.
+func (d *Driver) DeleteKey(
+	ctx *sql.Context,
+	i sql.Index,
+	p sql.Partition,
+	values []interface{},
+	location []byte,
+) error {
+	idx, ok := i.(*btreeIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	key, err := idx.convert(values)
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if t, ok := idx.partitions[partitionKey(p)]; ok {
+		t.remove(entry{key, location})
+	}
+
+	return nil
+}
+
+// NewVersion returns a new empty version of the given index with the given
+// checksum.
+func (d *Driver) NewVersion(i sql.Index, checksum string) (sql.Index, error) {
+	idx, ok := i.(*btreeIndex)
+	if !ok {
+		return nil, errInvalidIndexType.New(i)
+	}
+
+	return newBTreeIndex(idx.db, idx.table, idx.id, idx.expressions, idx.types, checksum), nil
+}
+
+// DeleteVersion deletes a version of an index. Since indexes are kept in
+// memory, there is nothing to delete.
+func (d *Driver) DeleteVersion(i sql.Index) error {
+	if _, ok := i.(*btreeIndex); !ok {
+		return errInvalidIndexType.New(i)
+	}
+	return nil
+}
+
+// Delete the given index for all partitions in the iterator.
+func (d *Driver) Delete(i sql.Index, partitions sql.PartitionIter) error {
+	idx, ok := i.(*btreeIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	for {
+		p, err := partitions.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = partitions.Close()
+			return err
+		}
+
+		idx.mu.Lock()
+		delete(idx.partitions, partitionKey(p))
+		idx.mu.Unlock()
+	}
+
+	return partitions.Close()
+}
+
+func partitionKey(p sql.Partition) string {
+	return string(p.Key())
+}
@@ -0,0 +1,420 @@
+package btree
+
+import (
+	"io"
+	"sync"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var errInvalidKeys = errors.NewKind("expecting %d keys for index %q, got %d")
+
+// btreeIndex is an in-memory implementation of sql.Index interface. It also
+// implements sql.AscendIndex, sql.DescendIndex, sql.NegateIndex,
+// sql.SortedIndex and sql.BoundedIndex.
+type btreeIndex struct {
+	mu         sync.RWMutex
+	partitions map[string]*tree
+
+	db          string
+	table       string
+	id          string
+	expressions []string
+	types       []sql.Type
+	checksum    string
+}
+
+func newBTreeIndex(
+	db, table, id string,
+	expressions []string,
+	types []sql.Type,
+	checksum string,
+) *btreeIndex {
+	return &btreeIndex{
+		partitions:  make(map[string]*tree),
+		db:          db,
+		table:       table,
+		id:          id,
+		expressions: expressions,
+		types:       types,
+		checksum:    checksum,
+	}
+}
+
+// ID returns the identifier of the index.
+func (idx *btreeIndex) ID() string { return idx.id }
+
+// Database returns the database name this index belongs to.
+func (idx *btreeIndex) Database() string { return idx.db }
+
+// Table returns the table name this index belongs to.
+func (idx *btreeIndex) Table() string { return idx.table }
+
+// Expressions returns the indexed expressions.
+func (idx *btreeIndex) Expressions() []string { return idx.expressions }
+
+// Driver returns the identifier of the driver of the index.
+func (*btreeIndex) Driver() string { return DriverID }
+
+// Checksum returns the checksum of the table when the index was created.
+func (idx *btreeIndex) Checksum() (string, error) { return idx.checksum, nil }
+
+// Get returns an IndexLookup for the given key in the index.
+func (idx *btreeIndex) Get(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		if hasNulls(keys) {
+			return
+		}
+
+		from := func(e entry) bool { return idx.compare(e.key, keys) >= 0 }
+		t.ascend(from, func(e entry) bool {
+			if idx.compare(e.key, keys) != 0 {
+				return false
+			}
+			return fn(e)
+		})
+	}), nil
+}
+
+// Has checks if the given key is present in the index.
+func (idx *btreeIndex) Has(p sql.Partition, keys ...interface{}) (bool, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return false, err
+	}
+
+	if hasNulls(keys) {
+		return false, nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	t, ok := idx.partitions[partitionKey(p)]
+	if !ok {
+		return false, nil
+	}
+
+	var found bool
+	from := func(e entry) bool { return idx.compare(e.key, keys) >= 0 }
+	t.ascend(from, func(e entry) bool {
+		found = idx.compare(e.key, keys) == 0
+		return false
+	})
+
+	return found, nil
+}
+
+// AscendGreaterOrEqual returns an IndexLookup for keys that are greater or
+// equal to the given keys.
+func (idx *btreeIndex) AscendGreaterOrEqual(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.ascend(idx.firstColumn(keys, greaterOrEqual), idx.filter(fn, nil, func(i int, v interface{}) bool {
+			return idx.matches(i, v, keys, greaterOrEqual)
+		}))
+	}), nil
+}
+
+// AscendLessThan returns an IndexLookup for keys that are less than the
+// given keys.
+func (idx *btreeIndex) AscendLessThan(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.ascend(nil, idx.filter(fn, idx.firstColumn(keys, greaterOrEqual), func(i int, v interface{}) bool {
+			return idx.matches(i, v, keys, lessThan)
+		}))
+	}), nil
+}
+
+// AscendRange returns an IndexLookup for keys that are within the given
+// range.
+func (idx *btreeIndex) AscendRange(greaterOrEqualKeys, lessThanKeys []interface{}) (sql.IndexLookup, error) {
+	gte, err := idx.convert(greaterOrEqualKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	lt, err := idx.convert(lessThanKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.ascend(idx.firstColumn(gte, greaterOrEqual), idx.filter(fn, idx.firstColumn(lt, greaterOrEqual), func(i int, v interface{}) bool {
+			return idx.matches(i, v, gte, greaterOrEqual) && idx.matches(i, v, lt, lessThan)
+		}))
+	}), nil
+}
+
+// DescendGreater returns an IndexLookup for keys that are greater than the
+// given keys.
+func (idx *btreeIndex) DescendGreater(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.descend(nil, idx.filter(fn, idx.firstColumn(keys, lessOrEqual), func(i int, v interface{}) bool {
+			return idx.matches(i, v, keys, greater)
+		}))
+	}), nil
+}
+
+// DescendLessOrEqual returns an IndexLookup for keys that are less than or
+// equal to the given keys.
+func (idx *btreeIndex) DescendLessOrEqual(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.descend(idx.firstColumn(keys, lessOrEqual), idx.filter(fn, nil, func(i int, v interface{}) bool {
+			return idx.matches(i, v, keys, lessOrEqual)
+		}))
+	}), nil
+}
+
+// DescendRange returns an IndexLookup for keys that are within the given
+// range.
+func (idx *btreeIndex) DescendRange(lessOrEqualKeys, greaterThanKeys []interface{}) (sql.IndexLookup, error) {
+	lte, err := idx.convert(lessOrEqualKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	gt, err := idx.convert(greaterThanKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.descend(idx.firstColumn(lte, lessOrEqual), idx.filter(fn, idx.firstColumn(gt, lessOrEqual), func(i int, v interface{}) bool {
+			return idx.matches(i, v, lte, lessOrEqual) && idx.matches(i, v, gt, greater)
+		}))
+	}), nil
+}
+
+// Not returns an IndexLookup for keys that are not equal to the given keys.
+func (idx *btreeIndex) Not(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.ascend(nil, idx.filter(fn, nil, func(i int, v interface{}) bool {
+			return idx.matches(i, v, keys, notEqual)
+		}))
+	}), nil
+}
+
+// All returns an IndexLookup with all the keys in the index, including the
+// ones with NULL values.
+func (idx *btreeIndex) All() (sql.IndexLookup, error) {
+	return idx.newLookup(func(t *tree, fn func(entry) bool) {
+		t.ascend(nil, fn)
+	}), nil
+}
+
+// Bounds returns the first and the last keys of the index whose first value
+// is not NULL.
+func (idx *btreeIndex) Bounds() (first, last []interface{}, err error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	notNull := func(e entry) bool { return e.key[0] != nil }
+	for _, t := range idx.partitions {
+		t.ascend(notNull, func(e entry) bool {
+			if first == nil || idx.compare(e.key, first) < 0 {
+				first = e.key
+			}
+			return false
+		})
+
+		// NULL values are sorted first, so the last entry is only NULL if
+		// all of them are.
+		t.descend(nil, func(e entry) bool {
+			if notNull(e) && (last == nil || idx.compare(e.key, last) > 0) {
+				last = e.key
+			}
+			return false
+		})
+	}
+
+	return first, last, nil
+}
+
+func (idx *btreeIndex) newLookup(scan func(*tree, func(entry) bool)) *indexLookup {
+	return &indexLookup{
+		index:   idx,
+		scan:    scan,
+		indexes: map[string]struct{}{idx.ID(): struct{}{}},
+	}
+}
+
+// build returns a new tree with all the key values in the iterator, which is
+// closed afterwards.
+func (idx *btreeIndex) build(ctx *sql.Context, iter sql.IndexKeyValueIter) (*tree, error) {
+	t := newTree(idx.compare)
+	for {
+		select {
+		case <-ctx.Done():
+			_ = iter.Close()
+			return nil, ctx.Err()
+		default:
+		}
+
+		values, location, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return nil, err
+		}
+
+		key, err := idx.convert(values)
+		if err != nil {
+			_ = iter.Close()
+			return nil, err
+		}
+
+		t.insert(entry{key, location})
+	}
+
+	return t, iter.Close()
+}
+
+// convert converts the given keys to the types of the indexed expressions, so
+// they can always be compared with the keys in the trees.
+func (idx *btreeIndex) convert(keys []interface{}) ([]interface{}, error) {
+	if len(keys) != len(idx.types) {
+		return nil, errInvalidKeys.New(len(idx.types), idx.ID(), len(keys))
+	}
+
+	var result = make([]interface{}, len(keys))
+	for i, k := range keys {
+		if k == nil {
+			continue
+		}
+
+		v, err := idx.types[i].Convert(k)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = v
+	}
+
+	return result, nil
+}
+
+// compare compares two keys column by column. NULL values are sorted before
+// any other value.
+func (idx *btreeIndex) compare(a, b []interface{}) int {
+	for i, t := range idx.types {
+		// Keys are always converted to the column types before being
+		// compared, so there can't be any error.
+		cmp, _ := t.Compare(a[i], b[i])
+		if cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+type comparison func(cmp int) bool
+
+func greaterOrEqual(cmp int) bool { return cmp >= 0 }
+func greater(cmp int) bool        { return cmp > 0 }
+func lessOrEqual(cmp int) bool    { return cmp <= 0 }
+func lessThan(cmp int) bool       { return cmp < 0 }
+func notEqual(cmp int) bool       { return cmp != 0 }
+
+// matches reports whether the value of the column i satisfies the comparison
+// with the key of that column. Comparisons with NULL are never satisfied.
+func (idx *btreeIndex) matches(i int, v interface{}, keys []interface{}, op comparison) bool {
+	if v == nil || keys[i] == nil {
+		return false
+	}
+
+	cmp, _ := idx.types[i].Compare(v, keys[i])
+	return op(cmp)
+}
+
+// firstColumn returns a function that reports whether the first column of an
+// entry satisfies the comparison with the first key. Since the trees are
+// sorted by their first column, it can be used to seek or stop iterations.
+func (idx *btreeIndex) firstColumn(keys []interface{}, op comparison) func(entry) bool {
+	return func(e entry) bool {
+		cmp, _ := idx.types[0].Compare(e.key[0], keys[0])
+		return op(cmp)
+	}
+}
+
+// filter returns an iteration function that calls fn only with the entries
+// whose columns all satisfy match. The iteration stops if stop is not nil and
+// returns true for an entry.
+func (idx *btreeIndex) filter(
+	fn func(entry) bool,
+	stop func(entry) bool,
+	match func(int, interface{}) bool,
+) func(entry) bool {
+	return func(e entry) bool {
+		if stop != nil && stop(e) {
+			return false
+		}
+
+		for i, v := range e.key {
+			if !match(i, v) {
+				return true
+			}
+		}
+
+		return fn(e)
+	}
+}
+
+// partitionKeys returns the keys of all the entries in the partition by
+// their location.
+func (idx *btreeIndex) partitionKeys(p sql.Partition) map[string][]interface{} {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	t, ok := idx.partitions[partitionKey(p)]
+	if !ok {
+		return nil
+	}
+
+	var keys = make(map[string][]interface{}, t.Len())
+	t.ascend(nil, func(e entry) bool {
+		keys[string(e.location)] = e.key
+		return true
+	})
+	return keys
+}
+
+func hasNulls(keys []interface{}) bool {
+	for _, k := range keys {
+		if k == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,484 @@
+package btree
+
+import (
+	"io"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = sql.Schema{
+	{Name: "a", Type: sql.Int64, Source: "foo", Nullable: true},
+	{Name: "b", Type: sql.Text, Source: "foo", Nullable: true},
+}
+
+func setupIndex(t *testing.T, partitions int, columns ...string) (*memory.Table, sql.Index) {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("foo", testSchema, partitions)
+	rows := []sql.Row{
+		sql.NewRow(int64(3), "c"),
+		sql.NewRow(int64(1), "a"),
+		sql.NewRow(int64(5), "e"),
+		sql.NewRow(nil, "x"),
+		sql.NewRow(int64(2), "b"),
+		sql.NewRow(int64(4), "d"),
+		sql.NewRow(int64(2), "z"),
+	}
+	for _, r := range rows {
+		require.NoError(table.Insert(ctx, r))
+	}
+
+	var exprs []sql.Expression
+	for _, c := range columns {
+		idx := testSchema.IndexOf(c, "foo")
+		exprs = append(exprs, expression.NewGetFieldWithTable(idx, testSchema[idx].Type, "foo", c, true))
+	}
+
+	d := NewDriver()
+	idx, err := d.Create("db", "foo", "idx", exprs, map[string]string{sql.ChecksumKey: "1"})
+	require.NoError(err)
+
+	iter, err := table.IndexKeyValues(ctx, columns)
+	require.NoError(err)
+	require.NoError(d.Save(ctx, idx, iter))
+
+	return table, idx
+}
+
+func lookupRows(t *testing.T, table *memory.Table, lookup sql.IndexLookup) []sql.Row {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	indexed := table.WithIndexLookup(lookup)
+	partitions, err := indexed.Partitions(ctx)
+	require.NoError(err)
+
+	var rows []sql.Row
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+
+		iter, err := indexed.PartitionRows(ctx, p)
+		require.NoError(err)
+		r, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		rows = append(rows, r...)
+	}
+	require.NoError(partitions.Close())
+
+	return rows
+}
+
+func TestIndexLookups(t *testing.T) {
+	table, idx := setupIndex(t, 1, "a")
+
+	testCases := []struct {
+		name     string
+		lookup   func() (sql.IndexLookup, error)
+		expected []sql.Row
+	}{
+		{
+			"get",
+			func() (sql.IndexLookup, error) { return idx.Get(int64(2)) },
+			[]sql.Row{{int64(2), "b"}, {int64(2), "z"}},
+		},
+		{
+			"get converts keys",
+			func() (sql.IndexLookup, error) { return idx.Get(int8(4)) },
+			[]sql.Row{{int64(4), "d"}},
+		},
+		{
+			"get null",
+			func() (sql.IndexLookup, error) { return idx.Get(nil) },
+			nil,
+		},
+		{
+			"ascend greater or equal",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(3))
+			},
+			[]sql.Row{{int64(3), "c"}, {int64(4), "d"}, {int64(5), "e"}},
+		},
+		{
+			"ascend less than",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.AscendIndex).AscendLessThan(int64(3))
+			},
+			[]sql.Row{{int64(1), "a"}, {int64(2), "b"}, {int64(2), "z"}},
+		},
+		{
+			"ascend range",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.AscendIndex).AscendRange([]interface{}{int64(2)}, []interface{}{int64(4)})
+			},
+			[]sql.Row{{int64(2), "b"}, {int64(2), "z"}, {int64(3), "c"}},
+		},
+		{
+			"descend greater",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.DescendIndex).DescendGreater(int64(3))
+			},
+			[]sql.Row{{int64(5), "e"}, {int64(4), "d"}},
+		},
+		{
+			"descend less or equal",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.DescendIndex).DescendLessOrEqual(int64(2))
+			},
+			[]sql.Row{{int64(2), "z"}, {int64(2), "b"}, {int64(1), "a"}},
+		},
+		{
+			"descend range",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.DescendIndex).DescendRange([]interface{}{int64(4)}, []interface{}{int64(2)})
+			},
+			[]sql.Row{{int64(4), "d"}, {int64(3), "c"}},
+		},
+		{
+			"not",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.NegateIndex).Not(int64(2))
+			},
+			[]sql.Row{{int64(1), "a"}, {int64(3), "c"}, {int64(4), "d"}, {int64(5), "e"}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			lookup, err := tt.lookup()
+			require.NoError(err)
+			require.Equal([]string{"idx"}, lookup.Indexes())
+			require.Equal(tt.expected, lookupRows(t, table, lookup))
+		})
+	}
+}
+
+func TestIndexInvalidKeys(t *testing.T) {
+	require := require.New(t)
+	_, idx := setupIndex(t, 1, "a")
+
+	_, err := idx.Get(int64(1), int64(2))
+	require.True(errInvalidKeys.Is(err))
+
+	_, err = idx.(sql.AscendIndex).AscendRange([]interface{}{int64(1)}, nil)
+	require.True(errInvalidKeys.Is(err))
+
+	_, err = idx.Get("foo")
+	require.Error(err)
+}
+
+func TestIndexMultipleColumns(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 2, "a", "b")
+
+	lookup, err := idx.Get(int64(2), "z")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(2), "z"}}, lookupRows(t, table, lookup))
+
+	// Every column is compared with its own key.
+	lookup, err = idx.(sql.AscendIndex).AscendRange(
+		[]interface{}{int64(2), "c"},
+		[]interface{}{int64(5), "z"},
+	)
+	require.NoError(err)
+	require.ElementsMatch(
+		[]sql.Row{{int64(3), "c"}, {int64(4), "d"}},
+		lookupRows(t, table, lookup),
+	)
+
+	lookup, err = idx.(sql.NegateIndex).Not(int64(2), "a")
+	require.NoError(err)
+	require.ElementsMatch(
+		[]sql.Row{{int64(3), "c"}, {int64(4), "d"}, {int64(5), "e"}},
+		lookupRows(t, table, lookup),
+	)
+}
+
+func TestIndexSetOperations(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 2, "a")
+
+	gte, err := idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(2))
+	require.NoError(err)
+	lt, err := idx.(sql.AscendIndex).AscendLessThan(int64(4))
+	require.NoError(err)
+	eq, err := idx.Get(int64(3))
+	require.NoError(err)
+	one, err := idx.Get(int64(1))
+	require.NoError(err)
+
+	require.True(gte.(sql.Mergeable).IsMergeable(lt))
+
+	ops := gte.(sql.SetOperations)
+	require.ElementsMatch(
+		[]sql.Row{{int64(2), "b"}, {int64(2), "z"}, {int64(3), "c"}},
+		lookupRows(t, table, ops.Intersection(lt)),
+	)
+	require.ElementsMatch(
+		[]sql.Row{{int64(2), "b"}, {int64(2), "z"}, {int64(4), "d"}, {int64(5), "e"}},
+		lookupRows(t, table, ops.Difference(eq)),
+	)
+	require.ElementsMatch(
+		[]sql.Row{{int64(1), "a"}, {int64(3), "c"}},
+		lookupRows(t, table, eq.(sql.SetOperations).Union(one)),
+	)
+
+	// Operations don't modify the original lookup.
+	require.Len(lookupRows(t, table, gte), 5)
+}
+
+func TestIndexSorted(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 1, "a")
+
+	all, err := idx.(sql.SortedIndex).All()
+	require.NoError(err)
+	require.Len(lookupRows(t, table, all), 7)
+
+	sorted := all.(sql.SortedIndexLookup)
+	require.Equal(
+		[]sql.Row{
+			{nil, "x"}, {int64(1), "a"}, {int64(2), "b"}, {int64(2), "z"},
+			{int64(3), "c"}, {int64(4), "d"}, {int64(5), "e"},
+		},
+		lookupRows(t, table, sorted.Sorted(false)),
+	)
+	require.Equal(
+		[]sql.Row{
+			{int64(5), "e"}, {int64(4), "d"}, {int64(3), "c"}, {int64(2), "z"},
+			{int64(2), "b"}, {int64(1), "a"}, {nil, "x"},
+		},
+		lookupRows(t, table, sorted.Sorted(true)),
+	)
+
+	gt, err := idx.(sql.DescendIndex).DescendGreater(int64(3))
+	require.NoError(err)
+	one, err := idx.Get(int64(1))
+	require.NoError(err)
+
+	union := gt.(sql.SetOperations).Union(one).(sql.SortedIndexLookup)
+	require.Equal(
+		[]sql.Row{{int64(1), "a"}, {int64(4), "d"}, {int64(5), "e"}},
+		lookupRows(t, table, union.Sorted(false)),
+	)
+
+	// Sorting is kept after set operations.
+	lookup := gt.(sql.SortedIndexLookup).Sorted(false).(sql.SetOperations).Union(one)
+	require.Equal(
+		[]sql.Row{{int64(1), "a"}, {int64(4), "d"}, {int64(5), "e"}},
+		lookupRows(t, table, lookup),
+	)
+}
+
+func TestIndexKeyValues(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table, idx := setupIndex(t, 1, "a")
+
+	d := NewDriver()
+	other, err := d.Create("db", "foo", "idx_b", []sql.Expression{
+		expression.NewGetFieldWithTable(1, sql.Text, "foo", "b", true),
+	}, nil)
+	require.NoError(err)
+	iter, err := table.IndexKeyValues(ctx, []string{"b"})
+	require.NoError(err)
+	require.NoError(d.Save(ctx, other, iter))
+
+	gte, err := idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(4))
+	require.NoError(err)
+	eq, err := other.Get("a")
+	require.NoError(err)
+
+	lookup := gte.(sql.SetOperations).Union(eq).(sql.SortedIndexLookup).Sorted(true)
+
+	partitions, err := table.Partitions(ctx)
+	require.NoError(err)
+	p, err := partitions.Next()
+	require.NoError(err)
+	require.NoError(partitions.Close())
+
+	kv, err := lookup.(sql.CoveringIndexLookup).KeyValues(p)
+	require.NoError(err)
+
+	var keys []interface{}
+	for {
+		values, _, err := kv.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		keys = append(keys, values...)
+	}
+	require.NoError(kv.Close())
+
+	require.Equal([]interface{}{int64(5), int64(4), int64(1)}, keys)
+}
+
+func TestIndexHas(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 1, "a")
+
+	partitions, err := table.Partitions(sql.NewEmptyContext())
+	require.NoError(err)
+	p, err := partitions.Next()
+	require.NoError(err)
+
+	ok, err := idx.Has(p, int64(5))
+	require.NoError(err)
+	require.True(ok)
+
+	ok, err = idx.Has(p, int64(6))
+	require.NoError(err)
+	require.False(ok)
+
+	ok, err = idx.Has(p, nil)
+	require.NoError(err)
+	require.False(ok)
+}
+
+func TestDriverDelete(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 2, "a")
+
+	checksum, err := idx.(sql.Checksumable).Checksum()
+	require.NoError(err)
+	require.Equal("1", checksum)
+
+	partitions, err := table.Partitions(sql.NewEmptyContext())
+	require.NoError(err)
+	require.NoError(NewDriver().Delete(idx, partitions))
+
+	lookup, err := idx.Get(int64(2))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 0)
+}
+
+func TestDriverInsertDeleteKey(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table, idx := setupIndex(t, 2, "a")
+	d := NewDriver()
+
+	apply := func(changes sql.RowLocationChanges) {
+		for _, l := range changes.Removed {
+			require.NoError(d.DeleteKey(ctx, idx, l.Partition, []interface{}{l.Row[0]}, l.Location))
+		}
+		for _, l := range changes.Added {
+			require.NoError(d.InsertKey(ctx, idx, l.Partition, []interface{}{l.Row[0]}, l.Location))
+		}
+	}
+
+	changes, err := table.InsertLocated(ctx, sql.NewRow(int64(2), "y"))
+	require.NoError(err)
+	apply(changes)
+
+	lookup, err := idx.Get(int64(2))
+	require.NoError(err)
+	require.ElementsMatch([]sql.Row{
+		sql.NewRow(int64(2), "b"),
+		sql.NewRow(int64(2), "z"),
+		sql.NewRow(int64(2), "y"),
+	}, lookupRows(t, table, lookup))
+
+	changes, err = table.DeleteLocated(ctx, sql.NewRow(int64(3), "c"))
+	require.NoError(err)
+	apply(changes)
+
+	lookup, err = idx.Get(int64(3))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 0)
+
+	lookup, err = idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(0))
+	require.NoError(err)
+	require.ElementsMatch([]sql.Row{
+		sql.NewRow(int64(1), "a"),
+		sql.NewRow(int64(2), "b"),
+		sql.NewRow(int64(2), "y"),
+		sql.NewRow(int64(2), "z"),
+		sql.NewRow(int64(4), "d"),
+		sql.NewRow(int64(5), "e"),
+	}, lookupRows(t, table, lookup))
+
+	require.True(errInvalidKeys.Is(d.InsertKey(ctx, idx, nil, nil, nil)))
+}
+
+func lookupCount(t *testing.T, table *memory.Table, lookup sql.IndexLookup) int64 {
+	t.Helper()
+	require := require.New(t)
+
+	partitions, err := table.Partitions(sql.NewEmptyContext())
+	require.NoError(err)
+
+	var count int64
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+
+		n, err := lookup.(sql.CountableIndexLookup).Count(p)
+		require.NoError(err)
+		count += n
+	}
+	require.NoError(partitions.Close())
+
+	return count
+}
+
+func TestIndexCount(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 2, "a")
+
+	gte, err := idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(2))
+	require.NoError(err)
+	eq, err := idx.Get(int64(2))
+	require.NoError(err)
+	all, err := idx.(sql.SortedIndex).All()
+	require.NoError(err)
+
+	require.Equal(int64(5), lookupCount(t, table, gte))
+	require.Equal(int64(2), lookupCount(t, table, eq))
+	require.Equal(int64(7), lookupCount(t, table, all))
+	require.Equal(int64(3), lookupCount(t, table, gte.(sql.SetOperations).Difference(eq)))
+}
+
+func TestIndexBounds(t *testing.T) {
+	require := require.New(t)
+
+	_, idx := setupIndex(t, 2, "a", "b")
+	first, last, err := idx.(sql.BoundedIndex).Bounds()
+	require.NoError(err)
+	require.Equal([]interface{}{int64(1), "a"}, first)
+	require.Equal([]interface{}{int64(5), "e"}, last)
+
+	d := NewDriver()
+	empty, err := d.Create("db", "foo", "empty", nil, nil)
+	require.NoError(err)
+	first, last, err = empty.(sql.BoundedIndex).Bounds()
+	require.NoError(err)
+	require.Nil(first)
+	require.Nil(last)
+}
+
+func TestIndexCollation(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 1, "b")
+
+	// Strings are compared with the default collation, which doesn't tell
+	// apart uppercase and lowercase letters.
+	lookup, err := idx.Get("C")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(3), "c"}}, lookupRows(t, table, lookup))
+}
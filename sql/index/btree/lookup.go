@@ -0,0 +1,355 @@
+package btree
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// indexLookup implements sql.IndexLookup, sql.Mergeable, sql.SetOperations,
+// sql.SortedIndexLookup, sql.CoveringIndexLookup and sql.CountableIndexLookup
+// interfaces. Unless it's sorted, the locations it returns keep the order in
+// which the lookup scanned the index.
+type indexLookup struct {
+	index      *btreeIndex
+	scan       func(*tree, func(entry) bool)
+	operations []lookupOperation
+	indexes    map[string]struct{}
+
+	// sorted is true if the locations must be returned sorted by key, in
+	// descending order if descending is true.
+	sorted     bool
+	descending bool
+}
+
+type lookupOperation struct {
+	lookup    sql.IndexLookup
+	operation func(a, b [][]byte) [][]byte
+}
+
+// Values returns the values in the subset of the index.
+func (l *indexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	if !l.sorted {
+		locations, err := l.locations(p)
+		if err != nil {
+			return nil, err
+		}
+
+		return &locationIter{locations: locations}, nil
+	}
+
+	entries, err := l.entries(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations = make([][]byte, len(entries))
+	for i, e := range entries {
+		locations[i] = e.location
+	}
+
+	return &locationIter{locations: locations}, nil
+}
+
+// KeyValues implements the sql.CoveringIndexLookup interface.
+func (l *indexLookup) KeyValues(p sql.Partition) (sql.IndexKeyValueIter, error) {
+	entries, err := l.entries(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entryIter{entries: entries}, nil
+}
+
+// Sorted implements the sql.SortedIndexLookup interface.
+func (l *indexLookup) Sorted(descending bool) sql.IndexLookup {
+	lookup := l.withOperation(nil, nil)
+	lookup.sorted = true
+	lookup.descending = descending
+	return lookup
+}
+
+// Count implements the sql.CountableIndexLookup interface.
+func (l *indexLookup) Count(p sql.Partition) (int64, error) {
+	if len(l.operations) > 0 {
+		locations, err := l.locations(p)
+		if err != nil {
+			return 0, err
+		}
+		return int64(len(locations)), nil
+	}
+
+	var n int64
+	l.index.mu.RLock()
+	if t, ok := l.index.partitions[partitionKey(p)]; ok {
+		l.scan(t, func(entry) bool {
+			n++
+			return true
+		})
+	}
+	l.index.mu.RUnlock()
+
+	return n, nil
+}
+
+func (l *indexLookup) scanEntries(p sql.Partition) []entry {
+	var entries []entry
+
+	l.index.mu.RLock()
+	if t, ok := l.index.partitions[partitionKey(p)]; ok {
+		l.scan(t, func(e entry) bool {
+			entries = append(entries, e)
+			return true
+		})
+	}
+	l.index.mu.RUnlock()
+
+	return entries
+}
+
+func (l *indexLookup) locations(p sql.Partition) ([][]byte, error) {
+	var locations [][]byte
+	for _, e := range l.scanEntries(p) {
+		locations = append(locations, e.location)
+	}
+
+	for _, op := range l.operations {
+		other, err := lookupLocations(op.lookup, p)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = op.operation(locations, other)
+	}
+
+	return locations, nil
+}
+
+// entries returns the entries of the index for the locations of the lookup
+// in the given partition, sorted if the lookup is sorted.
+func (l *indexLookup) entries(p sql.Partition) ([]entry, error) {
+	entries := l.scanEntries(p)
+
+	if len(l.operations) > 0 {
+		var locations = make([][]byte, len(entries))
+		var keys = make(map[string][]interface{}, len(entries))
+		for i, e := range entries {
+			locations[i] = e.location
+			keys[string(e.location)] = e.key
+		}
+
+		for _, op := range l.operations {
+			other, err := lookupLocations(op.lookup, p)
+			if err != nil {
+				return nil, err
+			}
+
+			locations = op.operation(locations, other)
+		}
+
+		for _, location := range locations {
+			if _, ok := keys[string(location)]; !ok {
+				// The location comes from the lookup of another index, so
+				// its key needs to be found in the whole partition.
+				keys = l.index.partitionKeys(p)
+				break
+			}
+		}
+
+		entries = make([]entry, 0, len(locations))
+		for _, location := range locations {
+			if key, ok := keys[string(location)]; ok {
+				entries = append(entries, entry{key, location})
+			}
+		}
+	}
+
+	if l.sorted {
+		sort.SliceStable(entries, func(i, j int) bool {
+			cmp := l.index.compare(entries[i].key, entries[j].key)
+			if cmp == 0 {
+				cmp = bytes.Compare(entries[i].location, entries[j].location)
+			}
+
+			if l.descending {
+				return cmp > 0
+			}
+			return cmp < 0
+		})
+	}
+
+	return entries, nil
+}
+
+func lookupLocations(lookup sql.IndexLookup, p sql.Partition) ([][]byte, error) {
+	if l, ok := lookup.(*indexLookup); ok {
+		return l.locations(p)
+	}
+
+	iter, err := lookup.Values(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations [][]byte
+	for {
+		location, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+
+	return locations, iter.Close()
+}
+
+// Indexes returns the IDs of all indexes involved in this lookup.
+func (l *indexLookup) Indexes() []string {
+	var result = make([]string, 0, len(l.indexes))
+	for idx := range l.indexes {
+		result = append(result, idx)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// IsMergeable implements sql.Mergeable interface. Lookups of btree indexes
+// on the same table can be merged.
+func (l *indexLookup) IsMergeable(lookup sql.IndexLookup) bool {
+	if il, ok := lookup.(*indexLookup); ok {
+		return il.index.Database() == l.index.Database() &&
+			il.index.Table() == l.index.Table()
+	}
+
+	return false
+}
+
+// Intersection implements sql.SetOperations interface.
+func (l *indexLookup) Intersection(lookups ...sql.IndexLookup) sql.IndexLookup {
+	return l.withOperation(intersection, lookups)
+}
+
+// Union implements sql.SetOperations interface.
+func (l *indexLookup) Union(lookups ...sql.IndexLookup) sql.IndexLookup {
+	return l.withOperation(union, lookups)
+}
+
+// Difference implements sql.SetOperations interface.
+func (l *indexLookup) Difference(lookups ...sql.IndexLookup) sql.IndexLookup {
+	return l.withOperation(difference, lookups)
+}
+
+func (l *indexLookup) withOperation(
+	operation func(a, b [][]byte) [][]byte,
+	lookups []sql.IndexLookup,
+) *indexLookup {
+	lookup := &indexLookup{
+		index:      l.index,
+		scan:       l.scan,
+		operations: make([]lookupOperation, len(l.operations), len(l.operations)+len(lookups)),
+		indexes:    make(map[string]struct{}, len(l.indexes)),
+		sorted:     l.sorted,
+		descending: l.descending,
+	}
+	copy(lookup.operations, l.operations)
+	for idx := range l.indexes {
+		lookup.indexes[idx] = struct{}{}
+	}
+
+	for _, li := range lookups {
+		for _, idx := range li.Indexes() {
+			lookup.indexes[idx] = struct{}{}
+		}
+		lookup.operations = append(lookup.operations, lookupOperation{li, operation})
+	}
+
+	return lookup
+}
+
+func locationSet(locations [][]byte) map[string]struct{} {
+	var set = make(map[string]struct{}, len(locations))
+	for _, l := range locations {
+		set[string(l)] = struct{}{}
+	}
+	return set
+}
+
+// intersection returns the locations of a that are also in b.
+func intersection(a, b [][]byte) [][]byte {
+	set := locationSet(b)
+	var result [][]byte
+	for _, l := range a {
+		if _, ok := set[string(l)]; ok {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// union returns the locations of a followed by the ones of b that are not
+// in a.
+func union(a, b [][]byte) [][]byte {
+	set := locationSet(a)
+	result := a
+	for _, l := range b {
+		if _, ok := set[string(l)]; !ok {
+			set[string(l)] = struct{}{}
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// difference returns the locations of a that are not in b.
+func difference(a, b [][]byte) [][]byte {
+	set := locationSet(b)
+	var result [][]byte
+	for _, l := range a {
+		if _, ok := set[string(l)]; !ok {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// locationIter is a sql.IndexValueIter over a list of locations.
+type locationIter struct {
+	locations [][]byte
+	pos       int
+}
+
+func (i *locationIter) Next() ([]byte, error) {
+	if i.pos >= len(i.locations) {
+		return nil, io.EOF
+	}
+
+	i.pos++
+	return i.locations[i.pos-1], nil
+}
+
+func (i *locationIter) Close() error { return nil }
+
+// entryIter is a sql.IndexKeyValueIter over a list of entries.
+type entryIter struct {
+	entries []entry
+	pos     int
+}
+
+func (i *entryIter) Next() ([]interface{}, []byte, error) {
+	if i.pos >= len(i.entries) {
+		return nil, nil, io.EOF
+	}
+
+	i.pos++
+	e := i.entries[i.pos-1]
+	return e.key, e.location, nil
+}
+
+func (i *entryIter) Close() error { return nil }
@@ -0,0 +1,335 @@
+package btree
+
+import (
+	"bytes"
+	"sort"
+)
+
+// entry is an item stored in the tree: the values of the indexed expressions
+// for a row and the location of that row. Several rows can have the same
+// values, so entries are sorted by their values and then by their location.
+type entry struct {
+	key      []interface{}
+	location []byte
+}
+
+// compareFunc compares two keys of the tree. It returns -1, 0 or 1 if a is
+// less than, equal to or greater than b.
+type compareFunc func(a, b []interface{}) int
+
+// tree is a B-tree of entries.
+type tree struct {
+	degree  int
+	compare compareFunc
+	root    *node
+	length  int
+}
+
+type node struct {
+	entries  []entry
+	children []*node
+}
+
+// defaultDegree is the minimum degree of the trees. Every node but the root
+// has between defaultDegree-1 and 2*defaultDegree-1 entries.
+const defaultDegree = 32
+
+func newTree(compare compareFunc) *tree {
+	return &tree{degree: defaultDegree, compare: compare}
+}
+
+func (t *tree) maxEntries() int { return t.degree*2 - 1 }
+
+func (t *tree) minEntries() int { return t.degree - 1 }
+
+func (t *tree) less(a, b entry) bool {
+	if cmp := t.compare(a.key, b.key); cmp != 0 {
+		return cmp < 0
+	}
+	return bytes.Compare(a.location, b.location) < 0
+}
+
+// Len returns the number of entries in the tree.
+func (t *tree) Len() int { return t.length }
+
+// insert adds the entry to the tree. It returns false if the tree already
+// had an entry with the same key and location.
+func (t *tree) insert(e entry) bool {
+	if t.root == nil {
+		t.root = &node{entries: []entry{e}}
+		t.length++
+		return true
+	}
+
+	if len(t.root.entries) >= t.maxEntries() {
+		mid, second := t.root.split(t.maxEntries() / 2)
+		t.root = &node{
+			entries:  []entry{mid},
+			children: []*node{t.root, second},
+		}
+	}
+
+	if !t.insertInto(t.root, e) {
+		return false
+	}
+
+	t.length++
+	return true
+}
+
+// insertInto inserts the entry in the subtree of n, which must not be full.
+func (t *tree) insertInto(n *node, e entry) bool {
+	for {
+		i, found := t.find(n, e)
+		if found {
+			return false
+		}
+
+		if len(n.children) == 0 {
+			n.insertEntryAt(i, e)
+			return true
+		}
+
+		if len(n.children[i].entries) >= t.maxEntries() {
+			mid, second := n.children[i].split(t.maxEntries() / 2)
+			n.insertEntryAt(i, mid)
+			n.insertChildAt(i+1, second)
+
+			switch {
+			case t.less(e, mid):
+			case t.less(mid, e):
+				i++
+			default:
+				return false
+			}
+		}
+
+		n = n.children[i]
+	}
+}
+
+// remove removes the entry from the tree. It returns false if the entry
+// was not in the tree.
+func (t *tree) remove(e entry) bool {
+	if t.root == nil {
+		return false
+	}
+
+	removed := t.removeFrom(t.root, e, false)
+	if len(t.root.entries) == 0 {
+		if len(t.root.children) > 0 {
+			t.root = t.root.children[0]
+		} else {
+			t.root = nil
+		}
+	}
+
+	if removed {
+		t.length--
+	}
+
+	return removed
+}
+
+// removeFrom removes the entry, or the maximum entry if max is true, from the
+// subtree of n. Every node but the root must have more than the minimum
+// number of entries when it's visited, so entries can be taken from it.
+func (t *tree) removeFrom(n *node, e entry, max bool) bool {
+	var i int
+	var found bool
+	if max {
+		i = len(n.entries)
+		if len(n.children) == 0 {
+			i--
+			found = true
+		}
+	} else {
+		i, found = t.find(n, e)
+	}
+
+	if len(n.children) == 0 {
+		if found {
+			n.removeEntryAt(i)
+		}
+		return found
+	}
+
+	if len(n.children[i].entries) <= t.minEntries() {
+		t.growChild(n, i)
+		return t.removeFrom(n, e, max)
+	}
+
+	if found {
+		// The entry is replaced with its predecessor, which is the maximum
+		// entry of the child on its left.
+		child := n.children[i]
+		pred := child.maxEntry()
+		t.removeFrom(child, entry{}, true)
+		n.entries[i] = pred
+		return true
+	}
+
+	return t.removeFrom(n.children[i], e, max)
+}
+
+// growChild makes the child i of n have more than the minimum number of
+// entries, taking one from a sibling or merging it with one.
+func (t *tree) growChild(n *node, i int) {
+	switch {
+	case i > 0 && len(n.children[i-1].entries) > t.minEntries():
+		child, left := n.children[i], n.children[i-1]
+		child.insertEntryAt(0, n.entries[i-1])
+		n.entries[i-1] = left.entries[len(left.entries)-1]
+		left.entries = left.entries[:len(left.entries)-1]
+		if len(left.children) > 0 {
+			child.insertChildAt(0, left.children[len(left.children)-1])
+			left.children = left.children[:len(left.children)-1]
+		}
+	case i < len(n.entries) && len(n.children[i+1].entries) > t.minEntries():
+		child, right := n.children[i], n.children[i+1]
+		child.entries = append(child.entries, n.entries[i])
+		n.entries[i] = right.entries[0]
+		right.removeEntryAt(0)
+		if len(right.children) > 0 {
+			child.children = append(child.children, right.children[0])
+			right.children = append(right.children[:0], right.children[1:]...)
+		}
+	default:
+		if i >= len(n.entries) {
+			i--
+		}
+
+		child, right := n.children[i], n.children[i+1]
+		child.entries = append(child.entries, n.entries[i])
+		child.entries = append(child.entries, right.entries...)
+		child.children = append(child.children, right.children...)
+		n.removeEntryAt(i)
+		n.children = append(n.children[:i+1], n.children[i+2:]...)
+	}
+}
+
+// find returns the position of the entry in the node or, if it's not in the
+// node, the position of the child where it could be.
+func (t *tree) find(n *node, e entry) (int, bool) {
+	i := sort.Search(len(n.entries), func(i int) bool {
+		return t.less(e, n.entries[i])
+	})
+
+	if i > 0 && !t.less(n.entries[i-1], e) {
+		return i - 1, true
+	}
+
+	return i, false
+}
+
+// ascend calls fn for every entry in ascending order, starting with the first
+// one for which from returns true, until fn returns false. from must return
+// false for a (possibly empty) prefix of the entries and true for the rest.
+// If from is nil, the iteration starts with the first entry.
+func (t *tree) ascend(from func(entry) bool, fn func(entry) bool) {
+	if t.root != nil {
+		t.root.ascend(from, fn)
+	}
+}
+
+// descend calls fn for every entry in descending order, starting with the
+// last one for which to returns true, until fn returns false. to must return
+// true for a (possibly empty) prefix of the entries and false for the rest.
+// If to is nil, the iteration starts with the last entry.
+func (t *tree) descend(to func(entry) bool, fn func(entry) bool) {
+	if t.root != nil {
+		t.root.descend(to, fn)
+	}
+}
+
+func (n *node) ascend(from func(entry) bool, fn func(entry) bool) bool {
+	var i int
+	if from != nil {
+		i = sort.Search(len(n.entries), func(i int) bool {
+			return from(n.entries[i])
+		})
+	}
+
+	for ; i < len(n.entries); i++ {
+		if len(n.children) > 0 && !n.children[i].ascend(from, fn) {
+			return false
+		}
+
+		if !fn(n.entries[i]) {
+			return false
+		}
+
+		// Once an entry is in the range, all the following ones are too.
+		from = nil
+	}
+
+	if len(n.children) > 0 {
+		return n.children[len(n.children)-1].ascend(from, fn)
+	}
+
+	return true
+}
+
+func (n *node) descend(to func(entry) bool, fn func(entry) bool) bool {
+	i := len(n.entries) - 1
+	if to != nil {
+		i = sort.Search(len(n.entries), func(i int) bool {
+			return !to(n.entries[i])
+		}) - 1
+	}
+
+	if len(n.children) > 0 && !n.children[i+1].descend(to, fn) {
+		return false
+	}
+
+	for ; i >= 0; i-- {
+		if !fn(n.entries[i]) {
+			return false
+		}
+
+		if len(n.children) > 0 && !n.children[i].descend(nil, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// split splits the node at the entry i, which is returned along with a new
+// node with all the entries after it.
+func (n *node) split(i int) (entry, *node) {
+	mid := n.entries[i]
+	next := new(node)
+	next.entries = append(next.entries, n.entries[i+1:]...)
+	n.entries = n.entries[:i:i]
+	if len(n.children) > 0 {
+		next.children = append(next.children, n.children[i+1:]...)
+		n.children = n.children[: i+1 : i+1]
+	}
+	return mid, next
+}
+
+func (n *node) maxEntry() entry {
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+	return n.entries[len(n.entries)-1]
+}
+
+func (n *node) insertEntryAt(i int, e entry) {
+	n.entries = append(n.entries, entry{})
+	copy(n.entries[i+1:], n.entries[i:])
+	n.entries[i] = e
+}
+
+func (n *node) removeEntryAt(i int) {
+	copy(n.entries[i:], n.entries[i+1:])
+	n.entries[len(n.entries)-1] = entry{}
+	n.entries = n.entries[:len(n.entries)-1]
+}
+
+func (n *node) insertChildAt(i int, c *node) {
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = c
+}
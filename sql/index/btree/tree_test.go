@@ -0,0 +1,129 @@
+package btree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func compareInts(a, b []interface{}) int {
+	x, y := a[0].(int), b[0].(int)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func treeEntries(t *tree) []int {
+	var result []int
+	t.ascend(nil, func(e entry) bool {
+		result = append(result, e.key[0].(int))
+		return true
+	})
+	return result
+}
+
+func TestTreeInsertRemove(t *testing.T) {
+	require := require.New(t)
+
+	tr := newTree(compareInts)
+	tr.degree = 3
+
+	r := rand.New(rand.NewSource(1))
+	var expected []int
+	for _, n := range r.Perm(1000) {
+		require.True(tr.insert(entry{key: []interface{}{n}}))
+		expected = append(expected, n)
+	}
+	require.False(tr.insert(entry{key: []interface{}{5}}))
+	require.Equal(1000, tr.Len())
+
+	sort.Ints(expected)
+	require.Equal(expected, treeEntries(tr))
+
+	for _, n := range r.Perm(1000)[:600] {
+		require.True(tr.remove(entry{key: []interface{}{n}}))
+		require.False(tr.remove(entry{key: []interface{}{n}}))
+
+		i := sort.SearchInts(expected, n)
+		expected = append(expected[:i], expected[i+1:]...)
+	}
+	require.Equal(400, tr.Len())
+	require.Equal(expected, treeEntries(tr))
+
+	for _, n := range expected {
+		require.True(tr.remove(entry{key: []interface{}{n}}))
+	}
+	require.Equal(0, tr.Len())
+	require.Nil(tr.root)
+}
+
+func TestTreeDuplicateKeys(t *testing.T) {
+	require := require.New(t)
+
+	tr := newTree(compareInts)
+	tr.degree = 2
+	for i := 0; i < 20; i++ {
+		require.True(tr.insert(entry{key: []interface{}{i % 3}, location: []byte{byte(i)}}))
+	}
+
+	var locations []byte
+	tr.ascend(func(e entry) bool { return e.key[0].(int) >= 1 }, func(e entry) bool {
+		if e.key[0].(int) > 1 {
+			return false
+		}
+		locations = append(locations, e.location[0])
+		return true
+	})
+	require.Equal([]byte{1, 4, 7, 10, 13, 16, 19}, locations)
+
+	require.True(tr.remove(entry{key: []interface{}{1}, location: []byte{10}}))
+	require.False(tr.remove(entry{key: []interface{}{1}, location: []byte{9}}))
+	require.Equal(19, tr.Len())
+}
+
+func TestTreeAscendDescend(t *testing.T) {
+	require := require.New(t)
+
+	tr := newTree(compareInts)
+	tr.degree = 2
+	for _, n := range rand.New(rand.NewSource(1)).Perm(100) {
+		tr.insert(entry{key: []interface{}{n}})
+	}
+
+	var result []int
+	tr.ascend(func(e entry) bool { return e.key[0].(int) >= 90 }, func(e entry) bool {
+		result = append(result, e.key[0].(int))
+		return true
+	})
+	require.Equal([]int{90, 91, 92, 93, 94, 95, 96, 97, 98, 99}, result)
+
+	result = nil
+	tr.descend(func(e entry) bool { return e.key[0].(int) <= 10 }, func(e entry) bool {
+		result = append(result, e.key[0].(int))
+		return len(result) < 5
+	})
+	require.Equal([]int{10, 9, 8, 7, 6}, result)
+
+	result = nil
+	tr.descend(nil, func(e entry) bool {
+		result = append(result, e.key[0].(int))
+		return true
+	})
+	require.Len(result, 100)
+	require.Equal(99, result[0])
+	require.Equal(0, result[99])
+
+	result = nil
+	tr.ascend(func(e entry) bool { return e.key[0].(int) >= 100 }, func(e entry) bool {
+		result = append(result, e.key[0].(int))
+		return true
+	})
+	require.Len(result, 0)
+}
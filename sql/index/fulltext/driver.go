@@ -0,0 +1,237 @@
+package fulltext
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// DriverID is the unique name of the full-text driver.
+const DriverID = sql.FullTextDriverID
+
+// MinTokenSizeKey is the key in the index config to set the minimum number
+// of characters of the indexed words.
+const MinTokenSizeKey = "min_token_size"
+
+var (
+	errInvalidIndexType    = errors.NewKind("expecting a full-text index, instead got %T")
+	errInvalidKeys         = errors.NewKind("expecting %d keys for index %q, got %d")
+	errNotTextExpression   = errors.NewKind("full-text indexes can only index text expressions, but %s is of type %s")
+	errInvalidMinTokenSize = errors.NewKind("invalid %s for full-text index: %q")
+)
+
+// Driver implements sql.IndexDriver, sql.IncrementalIndexDriver and
+// sql.RebuildableIndexDriver interfaces. Indexes are inverted indexes of the
+// words of the indexed expressions, which are kept in memory, one per
+// partition, so they are lost when the process exits.
+type Driver struct{}
+
+// NewDriver returns a new instance of fulltext.Driver which satisfies the
+// sql.IndexDriver interface.
+func NewDriver() *Driver {
+	return new(Driver)
+}
+
+// ID returns the unique name of the driver.
+func (*Driver) ID() string {
+	return DriverID
+}
+
+// Create a new index. All the expressions must be of a text type.
+func (d *Driver) Create(
+	db, table, id string,
+	expressions []sql.Expression,
+	config map[string]string,
+) (sql.Index, error) {
+	exprs := make([]string, len(expressions))
+	for i, e := range expressions {
+		if !sql.IsText(e.Type()) {
+			return nil, errNotTextExpression.New(e, e.Type())
+		}
+		exprs[i] = e.String()
+	}
+
+	var minTokenSize = DefaultMinTokenSize
+	if v, ok := config[MinTokenSizeKey]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, errInvalidMinTokenSize.New(MinTokenSizeKey, v)
+		}
+		minTokenSize = n
+	}
+
+	return newFullTextIndex(
+		db, table, id,
+		exprs,
+		NewTokenizer(minTokenSize),
+		config[sql.ChecksumKey],
+	), nil
+}
+
+// LoadAll loads all indexes for given db and table. Since indexes are not
+// persisted, there is never anything to load.
+func (*Driver) LoadAll(db, table string) ([]sql.Index, error) {
+	return nil, nil
+}
+
+// Save the given index for all partitions.
+func (d *Driver) Save(
+	ctx *sql.Context,
+	i sql.Index,
+	iter sql.PartitionIndexKeyValueIter,
+) error {
+	idx, ok := i.(*fullTextIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	span, ctx := ctx.Span("fulltext.Save")
+	defer span.Finish()
+
+	defer iter.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		p, kviter, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		if err := d.savePartition(ctx, idx, p, kviter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Driver) savePartition(
+	ctx *sql.Context,
+	idx *fullTextIndex,
+	p sql.Partition,
+	iter sql.IndexKeyValueIter,
+) error {
+	for {
+		select {
+		case <-ctx.Done():
+			_ = iter.Close()
+			return ctx.Err()
+		default:
+		}
+
+		values, location, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return err
+		}
+
+		if err := idx.insert(p, values, location); err != nil {
+			_ = iter.Close()
+			return err
+		}
+	}
+
+	return iter.Close()
+}
+
+// InsertKey adds to the index the text of the given key values with the
+// location of the row in the partition.
+func (d *Driver) InsertKey(
+	ctx *sql.Context,
+	i sql.Index,
+	p sql.Partition,
+	values []interface{},
+	location []byte,
+) error {
+	idx, ok := i.(*fullTextIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	return idx.insert(p, values, location)
+}
+
+// DeleteKey removes from the index the row with the given location in the
+// partition.
+func (d *Driver) DeleteKey(
+	ctx *sql.Context,
+	i sql.Index,
+	p sql.Partition,
+	values []interface{},
+	location []byte,
+) error {
+	idx, ok := i.(*fullTextIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	idx.delete(p, location)
+	return nil
+}
+
+// NewVersion returns a new empty version of the given index with the given
+// checksum.
+func (d *Driver) NewVersion(i sql.Index, checksum string) (sql.Index, error) {
+	idx, ok := i.(*fullTextIndex)
+	if !ok {
+		return nil, errInvalidIndexType.New(i)
+	}
+
+	return newFullTextIndex(
+		idx.db, idx.table, idx.id,
+		idx.expressions,
+		idx.tokenizer,
+		checksum,
+	), nil
+}
+
+// DeleteVersion deletes a version of an index. Since indexes are kept in
+// memory, there is nothing to delete.
+func (d *Driver) DeleteVersion(i sql.Index) error {
+	if _, ok := i.(*fullTextIndex); !ok {
+		return errInvalidIndexType.New(i)
+	}
+	return nil
+}
+
+// Delete the given index for all partitions in the iterator.
+func (d *Driver) Delete(i sql.Index, partitions sql.PartitionIter) error {
+	idx, ok := i.(*fullTextIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	for {
+		p, err := partitions.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = partitions.Close()
+			return err
+		}
+
+		idx.mu.Lock()
+		delete(idx.partitions, partitionKey(p))
+		idx.mu.Unlock()
+	}
+
+	return partitions.Close()
+}
+
+func partitionKey(p sql.Partition) string {
+	return string(p.Key())
+}
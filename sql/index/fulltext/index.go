@@ -0,0 +1,259 @@
+package fulltext
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// partitionIndex is the inverted index of the rows of a partition.
+type partitionIndex struct {
+	// docs contains the tokens of the text of each row by location.
+	docs map[string][]string
+	// postings contains the locations of the rows in which each token
+	// appears.
+	postings map[string]map[string]struct{}
+}
+
+func newPartitionIndex() *partitionIndex {
+	return &partitionIndex{
+		docs:     make(map[string][]string),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+func (p *partitionIndex) insert(location string, tokens []string) {
+	p.delete(location)
+	p.docs[location] = tokens
+	for _, token := range tokens {
+		locations, ok := p.postings[token]
+		if !ok {
+			locations = make(map[string]struct{})
+			p.postings[token] = locations
+		}
+		locations[location] = struct{}{}
+	}
+}
+
+func (p *partitionIndex) delete(location string) {
+	tokens, ok := p.docs[location]
+	if !ok {
+		return
+	}
+
+	delete(p.docs, location)
+	for _, token := range tokens {
+		delete(p.postings[token], location)
+		if len(p.postings[token]) == 0 {
+			delete(p.postings, token)
+		}
+	}
+}
+
+// candidates returns the locations of the rows that contain some word of the
+// term, which are the only ones where the term may appear.
+func (p *partitionIndex) candidates(term searchTerm) map[string]struct{} {
+	if !term.prefix {
+		return p.postings[term.words[0]]
+	}
+
+	var result = make(map[string]struct{})
+	for token, locations := range p.postings {
+		if strings.HasPrefix(token, term.words[0]) {
+			for l := range locations {
+				result[l] = struct{}{}
+			}
+		}
+	}
+	return result
+}
+
+// docFreq returns the number of rows in which the term appears.
+func (p *partitionIndex) docFreq(term searchTerm) int {
+	candidates := p.candidates(term)
+	if term.prefix || len(term.words) == 1 {
+		return len(candidates)
+	}
+
+	var n int
+	for l := range candidates {
+		if term.count(p.docs[l]) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// fullTextIndex is an in-memory implementation of sql.FullTextIndex, with an
+// inverted index of the words of the indexed expressions of each partition.
+type fullTextIndex struct {
+	mu         sync.RWMutex
+	partitions map[string]*partitionIndex
+
+	db          string
+	table       string
+	id          string
+	expressions []string
+	tokenizer   *Tokenizer
+	checksum    string
+}
+
+var _ sql.FullTextIndex = (*fullTextIndex)(nil)
+
+func newFullTextIndex(
+	db, table, id string,
+	expressions []string,
+	tokenizer *Tokenizer,
+	checksum string,
+) *fullTextIndex {
+	return &fullTextIndex{
+		partitions:  make(map[string]*partitionIndex),
+		db:          db,
+		table:       table,
+		id:          id,
+		expressions: expressions,
+		tokenizer:   tokenizer,
+		checksum:    checksum,
+	}
+}
+
+// ID returns the identifier of the index.
+func (idx *fullTextIndex) ID() string { return idx.id }
+
+// Database returns the database name this index belongs to.
+func (idx *fullTextIndex) Database() string { return idx.db }
+
+// Table returns the table name this index belongs to.
+func (idx *fullTextIndex) Table() string { return idx.table }
+
+// Expressions returns the indexed expressions.
+func (idx *fullTextIndex) Expressions() []string { return idx.expressions }
+
+// Driver returns the identifier of the driver of the index.
+func (*fullTextIndex) Driver() string { return DriverID }
+
+// Checksum returns the checksum of the table when the index was created.
+func (idx *fullTextIndex) Checksum() (string, error) { return idx.checksum, nil }
+
+// Get returns an IndexLookup of the rows that match the text of the given
+// keys in natural language mode.
+func (idx *fullTextIndex) Get(keys ...interface{}) (sql.IndexLookup, error) {
+	text, err := idx.text(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.Match(text, sql.NaturalLanguageMode)
+}
+
+// Has checks if there is some row in the partition that matches the text of
+// the given keys in natural language mode.
+func (idx *fullTextIndex) Has(p sql.Partition, keys ...interface{}) (bool, error) {
+	text, err := idx.text(keys)
+	if err != nil {
+		return false, err
+	}
+
+	lookup, err := idx.Match(text, sql.NaturalLanguageMode)
+	if err != nil {
+		return false, err
+	}
+
+	locations, err := lookup.(*indexLookup).locations(p)
+	if err != nil {
+		return false, err
+	}
+
+	return len(locations) > 0, nil
+}
+
+// Match implements the sql.FullTextIndex interface.
+func (idx *fullTextIndex) Match(query string, mode sql.FullTextSearchMode) (sql.IndexLookup, error) {
+	return &indexLookup{
+		index: idx,
+		query: parseQuery(idx.tokenizer, query, mode),
+	}, nil
+}
+
+// Relevance implements the sql.FullTextIndex interface.
+func (idx *fullTextIndex) Relevance(
+	query string,
+	mode sql.FullTextSearchMode,
+	values ...interface{},
+) (float64, error) {
+	text, err := idx.text(values)
+	if err != nil {
+		return 0, err
+	}
+
+	q := parseQuery(idx.tokenizer, query, mode)
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return q.relevance(idx.tokenizer.Tokenize(text), idx.weight), nil
+}
+
+// weight returns the weight of the term among all the rows of the index. It
+// must be called while holding the lock.
+func (idx *fullTextIndex) weight(term searchTerm) float64 {
+	var docs, docFreq int
+	for _, p := range idx.partitions {
+		docs += len(p.docs)
+		docFreq += p.docFreq(term)
+	}
+	return inverseDocumentFrequency(docs, docFreq)
+}
+
+// text returns the text of the given values of the indexed expressions.
+func (idx *fullTextIndex) text(values []interface{}) (string, error) {
+	if len(values) != len(idx.expressions) {
+		return "", errInvalidKeys.New(len(idx.expressions), idx.ID(), len(values))
+	}
+
+	var texts []string
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+
+		s, err := sql.Text.Convert(v)
+		if err != nil {
+			return "", err
+		}
+		texts = append(texts, s.(string))
+	}
+
+	return strings.Join(texts, " "), nil
+}
+
+func (idx *fullTextIndex) insert(p sql.Partition, values []interface{}, location []byte) error {
+	text, err := idx.text(values)
+	if err != nil {
+		return err
+	}
+
+	tokens := idx.tokenizer.Tokenize(text)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	pi, ok := idx.partitions[partitionKey(p)]
+	if !ok {
+		pi = newPartitionIndex()
+		idx.partitions[partitionKey(p)] = pi
+	}
+	pi.insert(string(location), tokens)
+
+	return nil
+}
+
+func (idx *fullTextIndex) delete(p sql.Partition, location []byte) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if pi, ok := idx.partitions[partitionKey(p)]; ok {
+		pi.delete(string(location))
+	}
+}
@@ -0,0 +1,207 @@
+package fulltext
+
+import (
+	"io"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = sql.Schema{
+	{Name: "id", Type: sql.Int64, Source: "foo"},
+	{Name: "title", Type: sql.Text, Source: "foo", Nullable: true},
+	{Name: "body", Type: sql.Text, Source: "foo", Nullable: true},
+}
+
+func setupIndex(t *testing.T, partitions int) (*memory.Table, *fullTextIndex) {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("foo", testSchema, partitions)
+	rows := []sql.Row{
+		sql.NewRow(int64(1), "MySQL Tutorial", "DBMS stands for DataBase management system"),
+		sql.NewRow(int64(2), "How To Use MySQL Well", "After you went through a tutorial"),
+		sql.NewRow(int64(3), "Optimizing MySQL", "In this tutorial we show how to optimize"),
+		sql.NewRow(int64(4), "1001 MySQL Tricks", "Never run mysqld as root"),
+		sql.NewRow(int64(5), "MySQL vs. YourSQL", nil),
+		sql.NewRow(int64(6), nil, "Database design"),
+	}
+	for _, r := range rows {
+		require.NoError(table.Insert(ctx, r))
+	}
+
+	exprs := []sql.Expression{
+		expression.NewGetFieldWithTable(1, sql.Text, "foo", "title", true),
+		expression.NewGetFieldWithTable(2, sql.Text, "foo", "body", true),
+	}
+
+	d := NewDriver()
+	idx, err := d.Create("db", "foo", "idx", exprs, map[string]string{sql.ChecksumKey: "1"})
+	require.NoError(err)
+
+	iter, err := table.IndexKeyValues(ctx, []string{"title", "body"})
+	require.NoError(err)
+	require.NoError(d.Save(ctx, idx, iter))
+
+	return table, idx.(*fullTextIndex)
+}
+
+func lookupIDs(t *testing.T, table *memory.Table, lookup sql.IndexLookup) []int64 {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	indexed := table.WithIndexLookup(lookup)
+	partitions, err := indexed.Partitions(ctx)
+	require.NoError(err)
+
+	var ids []int64
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+
+		iter, err := indexed.PartitionRows(ctx, p)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		for _, r := range rows {
+			ids = append(ids, r[0].(int64))
+		}
+	}
+	require.NoError(partitions.Close())
+
+	return ids
+}
+
+func TestIndexMatch(t *testing.T) {
+	table, idx := setupIndex(t, 2)
+
+	testCases := []struct {
+		query    string
+		mode     sql.FullTextSearchMode
+		expected []int64
+	}{
+		{"database", sql.NaturalLanguageMode, []int64{1, 6}},
+		{"DataBase, tricks!", sql.NaturalLanguageMode, []int64{1, 4, 6}},
+		{"the a", sql.NaturalLanguageMode, nil},
+		{"+mysql -tutorial", sql.BooleanMode, []int64{4, 5}},
+		{"+mysql +tutorial", sql.BooleanMode, []int64{1, 2, 3}},
+		{"-tutorial", sql.BooleanMode, nil},
+		{"optim*", sql.BooleanMode, []int64{3}},
+		{"mysql*", sql.BooleanMode, []int64{1, 2, 3, 4, 5}},
+		{`"database management"`, sql.BooleanMode, []int64{1}},
+		{`"management database"`, sql.BooleanMode, nil},
+		{`+"database design" tricks`, sql.BooleanMode, []int64{6}},
+		{`+ (database)`, sql.BooleanMode, []int64{1, 6}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			lookup, err := idx.Match(tt.query, tt.mode)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expected, lookupIDs(t, table, lookup))
+		})
+	}
+}
+
+func TestIndexRelevance(t *testing.T) {
+	require := require.New(t)
+	_, idx := setupIndex(t, 1)
+
+	r1, err := idx.Relevance("tutorial", sql.NaturalLanguageMode, "MySQL Tutorial", "a tutorial")
+	require.NoError(err)
+	r2, err := idx.Relevance("tutorial", sql.NaturalLanguageMode, "MySQL Tutorial", nil)
+	require.NoError(err)
+	require.True(r1 > r2)
+	require.True(r2 > 0)
+
+	// Rarer words are more relevant.
+	r3, err := idx.Relevance("tricks", sql.NaturalLanguageMode, "MySQL Tricks", nil)
+	require.NoError(err)
+	require.True(r3 > r2)
+
+	r, err := idx.Relevance("+mysql -tricks", sql.BooleanMode, "MySQL Tricks", nil)
+	require.NoError(err)
+	require.Equal(float64(0), r)
+
+	r, err = idx.Relevance("database", sql.NaturalLanguageMode, nil, nil)
+	require.NoError(err)
+	require.Equal(float64(0), r)
+
+	_, err = idx.Relevance("database", sql.NaturalLanguageMode, "foo")
+	require.Error(err)
+}
+
+func TestMatchOrderedByRelevance(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 1)
+
+	lookup, err := idx.Match("tutorial mysql", sql.NaturalLanguageMode)
+	require.NoError(err)
+
+	ids := lookupIDs(t, table, lookup)
+	require.Len(ids, 5)
+	// Rows with both words come first.
+	require.ElementsMatch([]int64{1, 2, 3}, ids[:3])
+}
+
+func TestDriverInsertDeleteKey(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table, idx := setupIndex(t, 1)
+	d := NewDriver()
+
+	partitions, err := table.Partitions(ctx)
+	require.NoError(err)
+	p, err := partitions.Next()
+	require.NoError(err)
+	require.NoError(partitions.Close())
+
+	lookup, err := idx.Match("design", sql.NaturalLanguageMode)
+	require.NoError(err)
+	require.Equal([]int64{6}, lookupIDs(t, table, lookup))
+
+	locations, err := lookup.(*indexLookup).locations(p)
+	require.NoError(err)
+	require.Len(locations, 1)
+
+	require.NoError(d.DeleteKey(ctx, idx, p, []interface{}{nil, "Database design"}, locations[0]))
+	ok, err := idx.Has(p, "design", nil)
+	require.NoError(err)
+	require.False(ok)
+
+	require.NoError(d.InsertKey(ctx, idx, p, []interface{}{"Design", nil}, locations[0]))
+	ok, err = idx.Has(p, "design", nil)
+	require.NoError(err)
+	require.True(ok)
+}
+
+func TestDriverCreate(t *testing.T) {
+	require := require.New(t)
+	d := NewDriver()
+
+	_, err := d.Create("db", "foo", "idx", []sql.Expression{
+		expression.NewGetFieldWithTable(0, sql.Int64, "foo", "id", false),
+	}, nil)
+	require.Error(err)
+	require.True(errNotTextExpression.Is(err))
+
+	exprs := []sql.Expression{
+		expression.NewGetFieldWithTable(1, sql.Text, "foo", "title", true),
+	}
+
+	_, err = d.Create("db", "foo", "idx", exprs, map[string]string{MinTokenSizeKey: "0"})
+	require.Error(err)
+	require.True(errInvalidMinTokenSize.Is(err))
+
+	idx, err := d.Create("db", "foo", "idx", exprs, map[string]string{MinTokenSizeKey: "1"})
+	require.NoError(err)
+	require.Equal([]string{"x", "y"}, idx.(*fullTextIndex).tokenizer.Tokenize("x, Y"))
+}
@@ -0,0 +1,110 @@
+package fulltext
+
+import (
+	"io"
+	"sort"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// indexLookup implements sql.IndexLookup interface. It contains the rows that
+// match a search query, which are returned from the most relevant to the
+// least relevant.
+type indexLookup struct {
+	index *fullTextIndex
+	query searchQuery
+}
+
+// Values returns the locations of the rows of the partition that match the
+// search query.
+func (l *indexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	locations, err := l.locations(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &locationIter{locations: locations}, nil
+}
+
+// Indexes returns the IDs of all indexes involved in this lookup.
+func (l *indexLookup) Indexes() []string {
+	return []string{l.index.ID()}
+}
+
+func (l *indexLookup) locations(p sql.Partition) ([][]byte, error) {
+	l.index.mu.RLock()
+	defer l.index.mu.RUnlock()
+
+	pi, ok := l.index.partitions[partitionKey(p)]
+	if !ok {
+		return nil, nil
+	}
+
+	// The weights of the terms are the same for all the rows, so they are
+	// only computed once.
+	var weights = make(map[string]float64, len(l.query))
+	weight := func(term searchTerm) float64 {
+		w, ok := weights[term.key()]
+		if !ok {
+			w = l.index.weight(term)
+			weights[term.key()] = w
+		}
+		return w
+	}
+
+	// Only the rows containing some term that is not excluded can match.
+	var candidates = make(map[string]struct{})
+	for _, term := range l.query {
+		if term.operator == excludedTerm {
+			continue
+		}
+
+		for location := range pi.candidates(term) {
+			candidates[location] = struct{}{}
+		}
+	}
+
+	type match struct {
+		location  string
+		relevance float64
+	}
+
+	var matches []match
+	for location := range candidates {
+		relevance := l.query.relevance(pi.docs[location], weight)
+		if relevance > 0 {
+			matches = append(matches, match{location, relevance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].relevance != matches[j].relevance {
+			return matches[i].relevance > matches[j].relevance
+		}
+		return matches[i].location < matches[j].location
+	})
+
+	var locations = make([][]byte, len(matches))
+	for i, m := range matches {
+		locations[i] = []byte(m.location)
+	}
+
+	return locations, nil
+}
+
+// locationIter is a sql.IndexValueIter over a list of locations.
+type locationIter struct {
+	locations [][]byte
+	pos       int
+}
+
+func (i *locationIter) Next() ([]byte, error) {
+	if i.pos >= len(i.locations) {
+		return nil, io.EOF
+	}
+
+	i.pos++
+	return i.locations[i.pos-1], nil
+}
+
+func (i *locationIter) Close() error { return nil }
@@ -0,0 +1,187 @@
+package fulltext
+
+import (
+	"math"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+type termOperator byte
+
+const (
+	optionalTerm termOperator = iota
+	requiredTerm
+	excludedTerm
+)
+
+// searchTerm is a term of a search query, which is a single word, a word
+// prefix or a phrase of several words.
+type searchTerm struct {
+	words    []string
+	prefix   bool
+	operator termOperator
+}
+
+// key returns a string that identifies the term.
+func (t searchTerm) key() string {
+	key := strings.Join(t.words, " ")
+	if t.prefix {
+		key += "*"
+	}
+	return key
+}
+
+// count returns the number of times the term appears in the given tokens.
+func (t searchTerm) count(tokens []string) int {
+	var n int
+	for i := range tokens {
+		if t.matchesAt(tokens, i) {
+			n++
+		}
+	}
+	return n
+}
+
+// matchesAt reports whether the term appears in the tokens starting at i.
+func (t searchTerm) matchesAt(tokens []string, i int) bool {
+	if t.prefix {
+		return strings.HasPrefix(tokens[i], t.words[0])
+	}
+
+	if len(tokens)-i < len(t.words) {
+		return false
+	}
+
+	for j, w := range t.words {
+		if tokens[i+j] != w {
+			return false
+		}
+	}
+	return true
+}
+
+// searchQuery is a parsed full-text search query.
+type searchQuery []searchTerm
+
+// parseQuery parses a search query in the given mode. In natural language
+// mode, every word is an optional term. In boolean mode, words can be
+// preceded by + to require them or by - to exclude them, followed by * to
+// match them as prefixes, and quoted to match them as a phrase.
+func parseQuery(t *Tokenizer, query string, mode sql.FullTextSearchMode) searchQuery {
+	if mode != sql.BooleanMode {
+		var q searchQuery
+		var seen = make(map[string]struct{})
+		for _, token := range t.Tokenize(query) {
+			if _, ok := seen[token]; !ok {
+				seen[token] = struct{}{}
+				q = append(q, searchTerm{words: []string{token}})
+			}
+		}
+		return q
+	}
+
+	var q searchQuery
+	var rs = []rune(query)
+	for i := 0; i < len(rs); {
+		if isDelimiter(rs[i]) && !isOperator(rs[i]) && rs[i] != '"' {
+			i++
+			continue
+		}
+
+		var op = optionalTerm
+		for ; i < len(rs) && isOperator(rs[i]); i++ {
+			switch rs[i] {
+			case '+':
+				op = requiredTerm
+			case '-':
+				op = excludedTerm
+			}
+		}
+
+		if i >= len(rs) {
+			break
+		}
+
+		// Operators not followed by a term are ignored.
+		if isDelimiter(rs[i]) && rs[i] != '"' {
+			continue
+		}
+
+		if rs[i] == '"' {
+			end := i + 1
+			for end < len(rs) && rs[end] != '"' {
+				end++
+			}
+
+			words := t.Tokenize(string(rs[i+1 : end]))
+			if len(words) > 0 {
+				q = append(q, searchTerm{words: words, operator: op})
+			}
+			i = end + 1
+			continue
+		}
+
+		end := i
+		for end < len(rs) && !isDelimiter(rs[end]) {
+			end++
+		}
+
+		word := strings.ToLower(string(rs[i:end]))
+		if end < len(rs) && rs[end] == '*' {
+			if word != "" {
+				q = append(q, searchTerm{words: []string{word}, prefix: true, operator: op})
+			}
+			i = end + 1
+			continue
+		}
+
+		if word != "" && t.IsIndexed(word) {
+			q = append(q, searchTerm{words: []string{word}, operator: op})
+		}
+		i = end
+	}
+
+	return q
+}
+
+func isOperator(r rune) bool {
+	switch r {
+	case '+', '-', '~', '<', '>', '(', ')':
+		return true
+	default:
+		return false
+	}
+}
+
+// relevance returns the relevance of the given tokens for the query. Each
+// term that appears in the tokens adds the number of times it appears
+// multiplied by the square of its weight, which is given by the weight
+// function. The relevance is zero if a required term does not appear or an
+// excluded term does.
+func (q searchQuery) relevance(tokens []string, weight func(searchTerm) float64) float64 {
+	var result float64
+	for _, term := range q {
+		n := term.count(tokens)
+		switch {
+		case term.operator == requiredTerm && n == 0:
+			return 0
+		case term.operator == excludedTerm && n > 0:
+			return 0
+		case term.operator != excludedTerm && n > 0:
+			w := weight(term)
+			result += float64(n) * w * w
+		}
+	}
+	return result
+}
+
+// inverseDocumentFrequency returns the weight of a term that appears in
+// docFreq of the total docs. Terms appearing in fewer docs weigh more, but
+// all the terms weigh more than zero.
+func inverseDocumentFrequency(docs, docFreq int) float64 {
+	if docFreq < 1 {
+		docFreq = 1
+	}
+	return math.Log10(1 + float64(docs)/float64(docFreq))
+}
@@ -0,0 +1,61 @@
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultMinTokenSize is the minimum number of characters a word must have to
+// be indexed by default.
+const DefaultMinTokenSize = 3
+
+// stopwords are the words that are too common to be indexed or searched.
+var stopwords = map[string]struct{}{
+	"a": {}, "about": {}, "an": {}, "are": {}, "as": {}, "at": {}, "be": {},
+	"by": {}, "com": {}, "de": {}, "en": {}, "for": {}, "from": {}, "how": {},
+	"i": {}, "in": {}, "is": {}, "it": {}, "la": {}, "of": {}, "on": {},
+	"or": {}, "that": {}, "the": {}, "this": {}, "to": {}, "was": {},
+	"what": {}, "when": {}, "where": {}, "who": {}, "will": {}, "with": {},
+	"und": {}, "www": {},
+}
+
+// Tokenizer splits texts into the words that are indexed. Words are sequences
+// of letters, digits and underscores, and they are lower cased. Stopwords and
+// words shorter than the minimum token size are discarded.
+type Tokenizer struct {
+	minTokenSize int
+}
+
+// NewTokenizer returns a new Tokenizer that discards the words with less
+// characters than the given minimum.
+func NewTokenizer(minTokenSize int) *Tokenizer {
+	return &Tokenizer{minTokenSize}
+}
+
+// Tokenize returns the words of the text in the order they appear in it,
+// including repeated ones.
+func (t *Tokenizer) Tokenize(text string) []string {
+	var tokens []string
+	for _, word := range strings.FieldsFunc(text, isDelimiter) {
+		word = strings.ToLower(word)
+		if t.IsIndexed(word) {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// IsIndexed reports whether the given lower cased word is indexed.
+func (t *Tokenizer) IsIndexed(word string) bool {
+	if utf8.RuneCountInString(word) < t.minTokenSize {
+		return false
+	}
+
+	_, ok := stopwords[word]
+	return !ok
+}
+
+func isDelimiter(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+}
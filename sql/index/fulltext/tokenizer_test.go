@@ -0,0 +1,76 @@
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected []string
+	}{
+		{"", nil},
+		{"The quick brown fox", []string{"quick", "brown", "fox"}},
+		{"foo-bar, foo_bar;FOO", []string{"foo", "bar", "foo_bar", "foo"}},
+		{"ab is it a", nil},
+		{"Ñandú 2019", []string{"ñandú", "2019"}},
+	}
+
+	tokenizer := NewTokenizer(DefaultMinTokenSize)
+	for _, tt := range testCases {
+		t.Run(tt.text, func(t *testing.T) {
+			require.Equal(t, tt.expected, tokenizer.Tokenize(tt.text))
+		})
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	testCases := []struct {
+		query    string
+		mode     sql.FullTextSearchMode
+		expected searchQuery
+	}{
+		{
+			"foo bar foo the",
+			sql.NaturalLanguageMode,
+			searchQuery{
+				{words: []string{"foo"}},
+				{words: []string{"bar"}},
+			},
+		},
+		{
+			"+foo -bar baz* ~qux",
+			sql.BooleanMode,
+			searchQuery{
+				{words: []string{"foo"}, operator: requiredTerm},
+				{words: []string{"bar"}, operator: excludedTerm},
+				{words: []string{"baz"}, prefix: true},
+				{words: []string{"qux"}},
+			},
+		},
+		{
+			`+"foo the bar" - ( the`,
+			sql.BooleanMode,
+			searchQuery{
+				{words: []string{"foo", "bar"}, operator: requiredTerm},
+			},
+		},
+		{
+			`"foo`,
+			sql.BooleanMode,
+			searchQuery{
+				{words: []string{"foo"}},
+			},
+		},
+	}
+
+	tokenizer := NewTokenizer(DefaultMinTokenSize)
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, parseQuery(tokenizer, tt.query, tt.mode))
+		})
+	}
+}
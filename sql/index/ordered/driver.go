@@ -0,0 +1,573 @@
+package ordered
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/index"
+	"github.com/sirupsen/logrus"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+const (
+	// DriverID is the unique name of the ordered driver.
+	DriverID = "ordered"
+
+	// ConfigFileName is the name of an index config file.
+	ConfigFileName = "config.yml"
+
+	// ProcessingFileName is the name of the lock/processing index file.
+	ProcessingFileName = ".processing"
+
+	// IndexFileName is the name of the file with the index data.
+	IndexFileName = "index.db"
+
+	// VersionKey is the key in the driver config with the version of the
+	// index, which is increased every time the index is rebuilt.
+	VersionKey = "version"
+)
+
+const (
+	processingFileOnCreate = 'C'
+	processingFileOnSave   = 'S'
+)
+
+var (
+	errCorruptedIndex   = errors.NewKind("the index db: %s, table: %s, id: %s is corrupted")
+	errInvalidIndexType = errors.NewKind("expecting an ordered index, instead got %T")
+)
+
+// Driver implements sql.IndexDriver and sql.RebuildableIndexDriver
+// interfaces. Each index is stored in a single file with its entries sorted
+// by key, see file.go for its format. When an index is rebuilt, the new
+// version is stored in a new file.
+type Driver struct {
+	root string
+}
+
+// NewDriver returns a new instance of ordered.Driver which satisfies the
+// sql.IndexDriver interface and stores the indexes in the given directory.
+func NewDriver(root string) *Driver {
+	return &Driver{root: root}
+}
+
+// ID returns the unique name of the driver.
+func (*Driver) ID() string {
+	return DriverID
+}
+
+// Create a new index.
+func (d *Driver) Create(
+	db, table, id string,
+	expressions []sql.Expression,
+	config map[string]string,
+) (sql.Index, error) {
+	exprs := make([]string, len(expressions))
+	types := make([]sql.Type, len(expressions))
+	for i, e := range expressions {
+		exprs[i] = e.String()
+		t, err := sql.MysqlTypeToType(e.Type().Type())
+		if err != nil {
+			return nil, err
+		}
+
+		// Strings are sorted and compared with the collation of the
+		// indexed expression.
+		if c, ok := sql.CollationOf(e.Type()); ok {
+			t = sql.WithCollation(t, c)
+		}
+		types[i] = t
+	}
+
+	if err := os.MkdirAll(filepath.Join(d.root, db, table, id), 0750); err != nil {
+		return nil, err
+	}
+
+	if config == nil {
+		config = make(map[string]string)
+	}
+
+	cfg := index.NewConfig(db, table, id, exprs, d.ID(), config)
+	err := index.WriteConfigFile(d.configFilePath(db, table, id), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = index.WriteProcessingFile(
+		d.processingFilePath(db, table, id),
+		[]byte{processingFileOnCreate},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	path := d.indexFilePath(db, table, id, 0)
+	size, err := createIndexFile(path, types)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderedIndex{
+		path:        path,
+		checkpoint:  make(checkpoint),
+		size:        size,
+		headerSize:  size,
+		db:          db,
+		table:       table,
+		id:          id,
+		expressions: exprs,
+		types:       types,
+		checksum:    config[sql.ChecksumKey],
+	}, nil
+}
+
+// LoadAll loads all indexes for given db and table.
+func (d *Driver) LoadAll(db, table string) ([]sql.Index, error) {
+	var (
+		indexes []sql.Index
+		errors  []string
+		root    = filepath.Join(d.root, db, table)
+	)
+
+	dirs, err := ioutil.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return indexes, nil
+		}
+		return nil, err
+	}
+
+	for _, info := range dirs {
+		if info.IsDir() && !strings.HasPrefix(info.Name(), ".") {
+			idx, err := d.loadIndex(db, table, info.Name())
+			if err != nil {
+				if !errCorruptedIndex.Is(err) {
+					errors = append(errors, err.Error())
+				}
+				continue
+			}
+
+			indexes = append(indexes, idx)
+		}
+	}
+
+	if len(errors) > 0 {
+		return nil, fmt.Errorf(strings.Join(errors, "\n"))
+	}
+
+	return indexes, nil
+}
+
+func (d *Driver) loadIndex(db, table, id string) (*orderedIndex, error) {
+	dir := filepath.Join(d.root, db, table, id)
+	log := logrus.WithFields(logrus.Fields{
+		"db":    db,
+		"table": table,
+		"id":    id,
+		"dir":   dir,
+	})
+
+	corrupted := func(err error) error {
+		log.WithField("err", err).
+			Warn("could not read index file, index is corrupt and will be deleted")
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warn("unable to remove corrupted index: " + dir)
+		}
+		return errCorruptedIndex.New(db, table, id)
+	}
+
+	config := d.configFilePath(db, table, id)
+	if _, err := os.Stat(config); err != nil {
+		return nil, corrupted(err)
+	}
+
+	cfg, err := index.ReadConfigFile(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgDriver := cfg.Driver(DriverID)
+	if cfgDriver == nil {
+		return nil, corrupted(fmt.Errorf("missing %s driver config", DriverID))
+	}
+
+	var version int
+	if v, ok := cfgDriver[VersionKey]; ok {
+		version, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, corrupted(err)
+		}
+	}
+
+	path := d.indexFilePath(db, table, id, version)
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, corrupted(err)
+	}
+	defer f.Close()
+
+	types, headerSize, err := readHeader(f)
+	if err != nil {
+		return nil, corrupted(err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+
+	processing := d.processingFilePath(db, table, id)
+	data, err := ioutil.ReadFile(processing)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err == nil {
+		// The index was not completely saved. If it was being saved over a
+		// previous checkpoint, everything after it is discarded.
+		if len(data) != 9 || data[0] != processingFileOnSave {
+			return nil, corrupted(fmt.Errorf("index was not created"))
+		}
+
+		size = int64(binary.BigEndian.Uint64(data[1:]))
+		if size <= headerSize {
+			return nil, corrupted(fmt.Errorf("index was not saved"))
+		}
+
+		if err := f.Truncate(size); err != nil {
+			return nil, err
+		}
+
+		if err := f.Sync(); err != nil {
+			return nil, err
+		}
+
+		log.Warn("index was not completely saved, restoring previous checkpoint")
+	}
+
+	c, err := readCheckpoint(f, size, headerSize, len(types))
+	if err != nil {
+		return nil, corrupted(err)
+	}
+
+	if data != nil {
+		if err := index.RemoveProcessingFile(processing); err != nil {
+			return nil, err
+		}
+	}
+
+	return &orderedIndex{
+		path:        path,
+		checkpoint:  c,
+		size:        size,
+		headerSize:  headerSize,
+		version:     version,
+		db:          cfg.DB,
+		table:       cfg.Table,
+		id:          cfg.ID,
+		expressions: cfg.Expressions,
+		types:       types,
+		checksum:    cfgDriver[sql.ChecksumKey],
+	}, nil
+}
+
+// Save the given index for all partitions. The entries of all partitions are
+// appended to the index file and become visible once the new checkpoint is
+// written. If the process stops before that, the index will be restored to
+// the previous checkpoint when it's loaded again. A new version of an index
+// replaces the previous one in the config file once it's saved.
+func (d *Driver) Save(
+	ctx *sql.Context,
+	i sql.Index,
+	iter sql.PartitionIndexKeyValueIter,
+) (err error) {
+	idx, ok := i.(*orderedIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	span, ctx := ctx.Span("ordered.Save")
+	defer span.Finish()
+
+	defer iter.Close()
+
+	idx.saving.Lock()
+	defer idx.saving.Unlock()
+
+	idx.mu.RLock()
+	size := idx.size
+	var c = make(checkpoint, len(idx.checkpoint))
+	for k, s := range idx.checkpoint {
+		c[k] = s
+	}
+	idx.mu.RUnlock()
+
+	// The processing file refers to the file of the saved version, so a new
+	// version that could not be saved is just ignored when loading.
+	processing := d.processingFilePath(idx.Database(), idx.Table(), idx.ID())
+	if !idx.pending {
+		var data = make([]byte, 9)
+		data[0] = processingFileOnSave
+		binary.BigEndian.PutUint64(data[1:], uint64(size))
+		if err := index.WriteProcessingFile(processing, data); err != nil {
+			return err
+		}
+	}
+
+	a, err := newAppender(idx.path)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if e := a.Close(); err == nil {
+			err = e
+		}
+
+		if err != nil && !idx.pending {
+			// Discard everything appended since the last checkpoint. If
+			// that fails, it will be done when the index is loaded. If there
+			// was no checkpoint, the index was never saved and it's kept
+			// marked as corrupted.
+			if os.Truncate(idx.path, size) == nil && size > idx.headerSize {
+				_ = index.RemoveProcessingFile(processing)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		p, kviter, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		entries, err := idx.entries(ctx, kviter)
+		if err != nil {
+			return err
+		}
+
+		s, err := a.writeSegment(entries)
+		if err != nil {
+			return err
+		}
+
+		c[partitionKey(p)] = s
+	}
+
+	if err := a.writeCheckpoint(c); err != nil {
+		return err
+	}
+
+	if idx.pending {
+		if err := d.commitVersion(idx); err != nil {
+			return err
+		}
+
+		idx.mu.Lock()
+		idx.checkpoint = c
+		idx.size = a.offset
+		idx.pending = false
+		idx.mu.Unlock()
+		return nil
+	}
+
+	idx.mu.Lock()
+	idx.checkpoint = c
+	idx.size = a.offset
+	idx.mu.Unlock()
+
+	return index.RemoveProcessingFile(processing)
+}
+
+// commitVersion writes the version and checksum of the given index to its
+// config file, so it's the version loaded from now on. The config file is
+// replaced atomically, so it always points to a version that was saved.
+func (d *Driver) commitVersion(idx *orderedIndex) error {
+	path := d.configFilePath(idx.Database(), idx.Table(), idx.ID())
+	cfg, err := index.ReadConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	cfgDriver := cfg.Driver(DriverID)
+	if cfgDriver == nil {
+		return errCorruptedIndex.New(idx.Database(), idx.Table(), idx.ID())
+	}
+	cfgDriver[VersionKey] = strconv.Itoa(idx.version)
+	cfgDriver[sql.ChecksumKey] = idx.checksum
+
+	tmp := path + ".tmp"
+	if err := index.WriteConfigFile(tmp, cfg); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// NewVersion returns a new empty version of the given index with the given
+// checksum, which is stored in a new file.
+func (d *Driver) NewVersion(i sql.Index, checksum string) (sql.Index, error) {
+	idx, ok := i.(*orderedIndex)
+	if !ok {
+		return nil, errInvalidIndexType.New(i)
+	}
+
+	version := idx.version + 1
+	path := d.indexFilePath(idx.Database(), idx.Table(), idx.ID(), version)
+	size, err := createIndexFile(path, idx.types)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderedIndex{
+		path:        path,
+		checkpoint:  make(checkpoint),
+		size:        size,
+		headerSize:  size,
+		version:     version,
+		pending:     true,
+		db:          idx.db,
+		table:       idx.table,
+		id:          idx.id,
+		expressions: idx.expressions,
+		types:       idx.types,
+		checksum:    checksum,
+	}, nil
+}
+
+// DeleteVersion deletes the file of a version of an index.
+func (d *Driver) DeleteVersion(i sql.Index) error {
+	idx, ok := i.(*orderedIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	if err := os.Remove(idx.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// createIndexFile creates an index file with just the header for keys of
+// the given types and returns its size.
+func createIndexFile(path string, types []sql.Type) (int64, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := writeHeader(f, types)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	return size, err
+}
+
+// entries returns all the entries in the iterator sorted by key, and closes
+// the iterator.
+func (idx *orderedIndex) entries(ctx *sql.Context, iter sql.IndexKeyValueIter) ([]entry, error) {
+	var entries []entry
+	for {
+		select {
+		case <-ctx.Done():
+			_ = iter.Close()
+			return nil, ctx.Err()
+		default:
+		}
+
+		values, location, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return nil, err
+		}
+
+		key, err := idx.convert(values)
+		if err != nil {
+			_ = iter.Close()
+			return nil, err
+		}
+
+		entries = append(entries, entry{key, location})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return idx.compare(entries[i].key, entries[j].key) < 0
+	})
+
+	return entries, iter.Close()
+}
+
+// Delete the given index for all partitions in the iterator.
+func (d *Driver) Delete(i sql.Index, partitions sql.PartitionIter) error {
+	idx, ok := i.(*orderedIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if err := os.RemoveAll(filepath.Join(d.root, i.Database(), i.Table(), i.ID())); err != nil {
+		return err
+	}
+
+	for {
+		p, err := partitions.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = partitions.Close()
+			return err
+		}
+
+		delete(idx.checkpoint, partitionKey(p))
+	}
+
+	return partitions.Close()
+}
+
+func partitionKey(p sql.Partition) string {
+	return string(p.Key())
+}
+
+func (d *Driver) configFilePath(db, table, id string) string {
+	return filepath.Join(d.root, db, table, id, ConfigFileName)
+}
+
+func (d *Driver) processingFilePath(db, table, id string) string {
+	return filepath.Join(d.root, db, table, id, ProcessingFileName)
+}
+
+// indexFilePath returns the path of the file of the given version of an
+// index. The first version is stored in IndexFileName and the following ones
+// have the version before the extension.
+func (d *Driver) indexFilePath(db, table, id string, version int) string {
+	name := IndexFileName
+	if version > 0 {
+		ext := filepath.Ext(name)
+		name = fmt.Sprintf("%s.%d%s", strings.TrimSuffix(name, ext), version, ext)
+	}
+	return filepath.Join(d.root, db, table, id, name)
+}
@@ -0,0 +1,556 @@
+package ordered
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/index"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = sql.Schema{
+	{Name: "a", Type: sql.Int64, Source: "foo", Nullable: true},
+	{Name: "b", Type: sql.Text, Source: "foo", Nullable: true},
+}
+
+func setup(t *testing.T) (string, func()) {
+	t.Helper()
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "ordered-test")
+	require.NoError(t, err)
+
+	return tmpDir, func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}
+}
+
+// newTable returns a table with rows (i%n, "row i") for i in [0, rows).
+func newTable(t *testing.T, partitions, rows, n int) *memory.Table {
+	t.Helper()
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("foo", testSchema, partitions)
+	for i := 0; i < rows; i++ {
+		var a interface{} = int64(i % n)
+		if i%n == n-1 {
+			a = nil
+		}
+		require.NoError(t, table.Insert(ctx, sql.NewRow(a, "row "+string(rune('a'+i%26)))))
+	}
+
+	return table
+}
+
+func createIndex(t *testing.T, d *Driver, table *memory.Table, columns ...string) sql.Index {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var exprs []sql.Expression
+	for _, c := range columns {
+		idx := testSchema.IndexOf(c, "foo")
+		exprs = append(exprs, expression.NewGetFieldWithTable(idx, testSchema[idx].Type, "foo", c, true))
+	}
+
+	idx, err := d.Create("db", "foo", "idx", exprs, map[string]string{sql.ChecksumKey: "1"})
+	require.NoError(err)
+
+	iter, err := table.IndexKeyValues(ctx, columns)
+	require.NoError(err)
+	require.NoError(d.Save(ctx, idx, iter))
+
+	return idx
+}
+
+func lookupRows(t *testing.T, table *memory.Table, lookup sql.IndexLookup) []sql.Row {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	indexed := table.WithIndexLookup(lookup)
+	partitions, err := indexed.Partitions(ctx)
+	require.NoError(err)
+
+	var rows []sql.Row
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+
+		iter, err := indexed.PartitionRows(ctx, p)
+		require.NoError(err)
+		r, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		rows = append(rows, r...)
+	}
+	require.NoError(partitions.Close())
+
+	return rows
+}
+
+func firstColumn(rows []sql.Row) []interface{} {
+	var result []interface{}
+	for _, r := range rows {
+		result = append(result, r[0])
+	}
+	return result
+}
+
+func TestLookups(t *testing.T) {
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	// 1000 rows with 10 distinct values take several pages.
+	table := newTable(t, 1, 1000, 10)
+	idx := createIndex(t, NewDriver(dir), table, "a")
+
+	for _, s := range idx.(*orderedIndex).checkpoint {
+		require.True(t, len(s.pages) > 1)
+	}
+
+	repeat := func(values ...int64) []interface{} {
+		var result []interface{}
+		for _, v := range values {
+			for i := 0; i < 100; i++ {
+				result = append(result, v)
+			}
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name     string
+		lookup   func() (sql.IndexLookup, error)
+		expected []interface{}
+	}{
+		{
+			"get",
+			func() (sql.IndexLookup, error) { return idx.Get(int64(4)) },
+			repeat(4),
+		},
+		{
+			"get missing",
+			func() (sql.IndexLookup, error) { return idx.Get(int64(42)) },
+			nil,
+		},
+		{
+			"get null",
+			func() (sql.IndexLookup, error) { return idx.Get(nil) },
+			nil,
+		},
+		{
+			"ascend greater or equal",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(7))
+			},
+			repeat(7, 8),
+		},
+		{
+			"ascend less than",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.AscendIndex).AscendLessThan(int64(2))
+			},
+			repeat(0, 1),
+		},
+		{
+			"ascend range",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.AscendIndex).AscendRange([]interface{}{int64(3)}, []interface{}{int64(5)})
+			},
+			repeat(3, 4),
+		},
+		{
+			"descend greater",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.DescendIndex).DescendGreater(int64(6))
+			},
+			repeat(8, 7),
+		},
+		{
+			"descend less or equal",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.DescendIndex).DescendLessOrEqual(int64(1))
+			},
+			repeat(1, 0),
+		},
+		{
+			"descend range",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.DescendIndex).DescendRange([]interface{}{int64(5)}, []interface{}{int64(3)})
+			},
+			repeat(5, 4),
+		},
+		{
+			"not",
+			func() (sql.IndexLookup, error) {
+				return idx.(sql.NegateIndex).Not(int64(0))
+			},
+			repeat(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			lookup, err := tt.lookup()
+			require.NoError(err)
+			require.Equal(tt.expected, firstColumn(lookupRows(t, table, lookup)))
+		})
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	table := newTable(t, 2, 20, 5)
+	idx := createIndex(t, NewDriver(dir), table, "a")
+
+	gte, err := idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(1))
+	require.NoError(err)
+	lt, err := idx.(sql.AscendIndex).AscendLessThan(int64(3))
+	require.NoError(err)
+	eq, err := idx.Get(int64(0))
+	require.NoError(err)
+
+	require.True(gte.(sql.Mergeable).IsMergeable(lt))
+
+	ops := gte.(sql.SetOperations)
+	require.ElementsMatch(
+		[]interface{}{int64(1), int64(1), int64(1), int64(1), int64(2), int64(2), int64(2), int64(2)},
+		firstColumn(lookupRows(t, table, ops.Intersection(lt))),
+	)
+	require.Len(lookupRows(t, table, ops.Union(eq)), 16)
+	require.Len(lookupRows(t, table, ops.Difference(lt)), 4)
+	require.Len(lookupRows(t, table, gte), 12)
+}
+
+func TestMultipleColumns(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	table := newTable(t, 2, 10, 5)
+	idx := createIndex(t, NewDriver(dir), table, "a", "b")
+
+	lookup, err := idx.Get(int64(2), "row h")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(2), "row h"}}, lookupRows(t, table, lookup))
+
+	partitions, err := table.Partitions(sql.NewEmptyContext())
+	require.NoError(err)
+	var found bool
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+
+		ok, err := idx.Has(p, int64(2), "row c")
+		require.NoError(err)
+		found = found || ok
+	}
+	require.True(found)
+
+	_, err = idx.Get(int64(1))
+	require.True(errInvalidKeys.Is(err))
+}
+
+func TestLoadAll(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	d := NewDriver(dir)
+	table := newTable(t, 2, 100, 10)
+	createIndex(t, d, table, "a")
+
+	indexes, err := d.LoadAll("db", "foo")
+	require.NoError(err)
+	require.Len(indexes, 1)
+
+	idx := indexes[0]
+	require.Equal("idx", idx.ID())
+	require.Equal([]string{"foo.a"}, idx.Expressions())
+	checksum, err := idx.(sql.Checksumable).Checksum()
+	require.NoError(err)
+	require.Equal("1", checksum)
+
+	lookup, err := idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(7))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 20)
+
+	indexes, err = d.LoadAll("db", "bar")
+	require.NoError(err)
+	require.Len(indexes, 0)
+}
+
+func TestLoadAllInterruptedSave(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	d := NewDriver(dir)
+	table := newTable(t, 2, 100, 10)
+	idx := createIndex(t, d, table, "a")
+	size := idx.(*orderedIndex).size
+
+	// Simulate a save that stopped after appending some data.
+	path := d.indexFilePath("db", "foo", "idx", 0)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(err)
+	_, err = f.Write([]byte("garbage"))
+	require.NoError(err)
+	require.NoError(f.Close())
+
+	processing := d.processingFilePath("db", "foo", "idx")
+	data := []byte{processingFileOnSave, 0, 0, 0, 0, 0, 0, 0, 0}
+	for i := 0; i < 8; i++ {
+		data[8-i] = byte(size >> (8 * uint(i)))
+	}
+	require.NoError(index.WriteProcessingFile(processing, data))
+
+	indexes, err := d.LoadAll("db", "foo")
+	require.NoError(err)
+	require.Len(indexes, 1)
+
+	info, err := os.Stat(path)
+	require.NoError(err)
+	require.Equal(size, info.Size())
+
+	ok, err := index.ExistsProcessingFile(processing)
+	require.NoError(err)
+	require.False(ok)
+
+	lookup, err := indexes[0].Get(int64(3))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 10)
+}
+
+func TestLoadAllCorrupted(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	d := NewDriver(dir)
+	table := newTable(t, 1, 10, 5)
+
+	// The index was created but never saved.
+	_, err := d.Create("db", "foo", "idx", []sql.Expression{
+		expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", true),
+	}, nil)
+	require.NoError(err)
+
+	indexes, err := d.LoadAll("db", "foo")
+	require.NoError(err)
+	require.Len(indexes, 0)
+
+	_, err = os.Stat(filepath.Join(dir, "db", "foo", "idx"))
+	require.True(os.IsNotExist(err))
+
+	// The index file is damaged.
+	createIndex(t, d, table, "a")
+	path := d.indexFilePath("db", "foo", "idx", 0)
+	require.NoError(os.Truncate(path, 30))
+
+	indexes, err = d.LoadAll("db", "foo")
+	require.NoError(err)
+	require.Len(indexes, 0)
+}
+
+func TestNewVersion(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	d := NewDriver(dir)
+	table := newTable(t, 2, 100, 10)
+	idx := createIndex(t, d, table, "a")
+
+	ctx := sql.NewEmptyContext()
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(3), "new row")))
+
+	newIdx, err := d.NewVersion(idx, "2")
+	require.NoError(err)
+
+	// An interrupted save of the new version does not affect the old one.
+	indexes, err := d.LoadAll("db", "foo")
+	require.NoError(err)
+	require.Len(indexes, 1)
+	checksum, err := indexes[0].(sql.Checksumable).Checksum()
+	require.NoError(err)
+	require.Equal("1", checksum)
+
+	iter, err := table.IndexKeyValues(ctx, []string{"a"})
+	require.NoError(err)
+	require.NoError(d.Save(ctx, newIdx, iter))
+
+	lookup, err := idx.Get(int64(3))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 10)
+
+	lookup, err = newIdx.Get(int64(3))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 11)
+
+	require.NoError(d.DeleteVersion(idx))
+	_, err = os.Stat(d.indexFilePath("db", "foo", "idx", 0))
+	require.True(os.IsNotExist(err))
+
+	indexes, err = d.LoadAll("db", "foo")
+	require.NoError(err)
+	require.Len(indexes, 1)
+	checksum, err = indexes[0].(sql.Checksumable).Checksum()
+	require.NoError(err)
+	require.Equal("2", checksum)
+
+	lookup, err = indexes[0].Get(int64(3))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 11)
+}
+
+func TestDelete(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	d := NewDriver(dir)
+	table := newTable(t, 2, 10, 5)
+	idx := createIndex(t, d, table, "a")
+
+	partitions, err := table.Partitions(sql.NewEmptyContext())
+	require.NoError(err)
+	require.NoError(d.Delete(idx, partitions))
+
+	_, err = os.Stat(filepath.Join(dir, "db", "foo", "idx"))
+	require.True(os.IsNotExist(err))
+
+	lookup, err := idx.Get(int64(1))
+	require.NoError(err)
+	require.Len(lookupRows(t, table, lookup), 0)
+}
+
+func TestCount(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	table := newTable(t, 2, 1000, 10)
+	idx := createIndex(t, NewDriver(dir), table, "a")
+
+	count := func(lookup sql.IndexLookup, err error) int64 {
+		t.Helper()
+		require.NoError(err)
+
+		partitions, err := table.Partitions(sql.NewEmptyContext())
+		require.NoError(err)
+
+		var count int64
+		for {
+			p, err := partitions.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(err)
+
+			n, err := lookup.(sql.CountableIndexLookup).Count(p)
+			require.NoError(err)
+			count += n
+		}
+		require.NoError(partitions.Close())
+
+		require.Len(lookupRows(t, table, lookup), int(count))
+		return count
+	}
+
+	gte, err := idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(1))
+	require.NoError(err)
+	lt, err := idx.(sql.AscendIndex).AscendLessThan(int64(3))
+	require.NoError(err)
+
+	require.Equal(int64(100), count(idx.Get(int64(3))))
+	require.Equal(int64(0), count(idx.Get(nil)))
+	require.Equal(int64(400), count(idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(5))))
+	require.Equal(int64(800), count(idx.(sql.NegateIndex).Not(int64(0))))
+	require.Equal(int64(200), count(gte.(sql.SetOperations).Intersection(lt), nil))
+}
+
+func TestBounds(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+
+	idx := createIndex(t, NewDriver(filepath.Join(dir, "1")), newTable(t, 2, 1000, 10), "a")
+	first, last, err := idx.(sql.BoundedIndex).Bounds()
+	require.NoError(err)
+	require.Equal([]interface{}{int64(0)}, first)
+	require.Equal([]interface{}{int64(8)}, last)
+
+	// Half of the keys are NULL, so there are pages with only NULL keys.
+	idx = createIndex(t, NewDriver(filepath.Join(dir, "2")), newTable(t, 2, 2000, 2), "a", "b")
+	first, last, err = idx.(sql.BoundedIndex).Bounds()
+	require.NoError(err)
+	require.Equal([]interface{}{int64(0), "row a"}, first)
+	require.Equal([]interface{}{int64(0), "row y"}, last)
+
+	idx = createIndex(t, NewDriver(filepath.Join(dir, "3")), newTable(t, 2, 0, 1), "a")
+	first, last, err = idx.(sql.BoundedIndex).Bounds()
+	require.NoError(err)
+	require.Nil(first)
+	require.Nil(last)
+}
+
+func TestCollations(t *testing.T) {
+	require := require.New(t)
+	dir, cleanup := setup(t)
+	defer cleanup()
+	ctx := sql.NewEmptyContext()
+
+	bin, err := sql.LookupCollation("utf8mb4_bin")
+	require.NoError(err)
+
+	schema := sql.Schema{
+		{Name: "ci", Type: sql.Text, Source: "foo"},
+		{Name: "cs", Type: sql.WithCollation(sql.Text, bin), Source: "foo"},
+	}
+
+	table := memory.NewPartitionedTable("foo", schema, 1)
+	for _, s := range []string{"a", "B", "A", "b"} {
+		require.NoError(table.Insert(ctx, sql.NewRow(s, s)))
+	}
+
+	d := NewDriver(dir)
+	for i, col := range schema {
+		expr := expression.NewGetFieldWithTable(i, col.Type, "foo", col.Name, false)
+		idx, err := d.Create("db", "foo", "idx_"+col.Name, []sql.Expression{expr}, nil)
+		require.NoError(err)
+
+		iter, err := table.IndexKeyValues(ctx, []string{col.Name})
+		require.NoError(err)
+		require.NoError(d.Save(ctx, idx, iter))
+	}
+
+	// Collations are kept when the indexes are loaded again.
+	indexes, err := d.LoadAll("db", "foo")
+	require.NoError(err)
+	require.Len(indexes, 2)
+
+	expected := map[string][]interface{}{
+		"idx_ci": {"a", "A"},
+		"idx_cs": {"A"},
+	}
+	for _, idx := range indexes {
+		lookup, err := idx.Get("A")
+		require.NoError(err)
+		require.ElementsMatch(expected[idx.ID()], firstColumn(lookupRows(t, table, lookup)), idx.ID())
+	}
+}
@@ -0,0 +1,260 @@
+package ordered
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var errUnsupportedValue = errors.NewKind("unsupported value %v of type %T")
+
+// Tags identifying the Go type of an encoded value.
+const (
+	tagNull byte = iota
+	tagBool
+	tagInt8
+	tagInt16
+	tagInt32
+	tagInt64
+	tagInt
+	tagUint8
+	tagUint16
+	tagUint32
+	tagUint64
+	tagUint
+	tagFloat32
+	tagFloat64
+	tagString
+	tagBytes
+	tagTime
+	tagDecimal
+	tagDuration
+)
+
+func putUvarint(buf *bytes.Buffer, x uint64) {
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(scratch[:], x)
+	buf.Write(scratch[:n])
+}
+
+func putVarint(buf *bytes.Buffer, x int64) {
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(scratch[:], x)
+	buf.Write(scratch[:n])
+}
+
+func putBytes(buf *bytes.Buffer, b []byte) {
+	putUvarint(buf, uint64(len(b)))
+	buf.Write(b)
+}
+
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if n > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// encodeValue writes the value in the buffer keeping its Go type, so it's
+// decoded exactly as it was.
+func encodeValue(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteByte(tagNull)
+	case bool:
+		buf.WriteByte(tagBool)
+		if v {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case int8:
+		buf.WriteByte(tagInt8)
+		putVarint(buf, int64(v))
+	case int16:
+		buf.WriteByte(tagInt16)
+		putVarint(buf, int64(v))
+	case int32:
+		buf.WriteByte(tagInt32)
+		putVarint(buf, int64(v))
+	case int64:
+		buf.WriteByte(tagInt64)
+		putVarint(buf, v)
+	case int:
+		buf.WriteByte(tagInt)
+		putVarint(buf, int64(v))
+	case uint8:
+		buf.WriteByte(tagUint8)
+		putUvarint(buf, uint64(v))
+	case uint16:
+		buf.WriteByte(tagUint16)
+		putUvarint(buf, uint64(v))
+	case uint32:
+		buf.WriteByte(tagUint32)
+		putUvarint(buf, uint64(v))
+	case uint64:
+		buf.WriteByte(tagUint64)
+		putUvarint(buf, v)
+	case uint:
+		buf.WriteByte(tagUint)
+		putUvarint(buf, uint64(v))
+	case float32:
+		buf.WriteByte(tagFloat32)
+		putUvarint(buf, uint64(math.Float32bits(v)))
+	case float64:
+		buf.WriteByte(tagFloat64)
+		putUvarint(buf, math.Float64bits(v))
+	case string:
+		buf.WriteByte(tagString)
+		putBytes(buf, []byte(v))
+	case []byte:
+		buf.WriteByte(tagBytes)
+		putBytes(buf, v)
+	case time.Time:
+		data, err := v.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(tagTime)
+		putBytes(buf, data)
+	case decimal.Decimal:
+		data, err := v.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(tagDecimal)
+		putBytes(buf, data)
+	case time.Duration:
+		buf.WriteByte(tagDuration)
+		putVarint(buf, int64(v))
+	default:
+		return errUnsupportedValue.New(v, v)
+	}
+
+	return nil
+}
+
+// decodeValue reads a value written with encodeValue.
+func decodeValue(r *bytes.Reader) (interface{}, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagNull:
+		return nil, nil
+	case tagBool:
+		b, err := r.ReadByte()
+		return b == 1, err
+	case tagInt8, tagInt16, tagInt32, tagInt64, tagInt, tagDuration:
+		n, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		switch tag {
+		case tagInt8:
+			return int8(n), nil
+		case tagInt16:
+			return int16(n), nil
+		case tagInt32:
+			return int32(n), nil
+		case tagInt64:
+			return n, nil
+		case tagDuration:
+			return time.Duration(n), nil
+		default:
+			return int(n), nil
+		}
+	case tagUint8, tagUint16, tagUint32, tagUint64, tagUint,
+		tagFloat32, tagFloat64:
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		switch tag {
+		case tagUint8:
+			return uint8(n), nil
+		case tagUint16:
+			return uint16(n), nil
+		case tagUint32:
+			return uint32(n), nil
+		case tagUint64:
+			return n, nil
+		case tagUint:
+			return uint(n), nil
+		case tagFloat32:
+			return math.Float32frombits(uint32(n)), nil
+		default:
+			return math.Float64frombits(n), nil
+		}
+	case tagString:
+		b, err := readBytes(r)
+		return string(b), err
+	case tagBytes:
+		return readBytes(r)
+	case tagTime:
+		b, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var t time.Time
+		if err := t.UnmarshalBinary(b); err != nil {
+			return nil, err
+		}
+		return t, nil
+	case tagDecimal:
+		b, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+		var d decimal.Decimal
+		if err := d.UnmarshalBinary(b); err != nil {
+			return nil, err
+		}
+		return d, nil
+	default:
+		return nil, errUnsupportedValue.New(tag, tag)
+	}
+}
+
+// encodeKey writes all the values of a key.
+func encodeKey(buf *bytes.Buffer, key []interface{}) error {
+	for _, v := range key {
+		if err := encodeValue(buf, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeKey reads a key of n values written with encodeKey.
+func decodeKey(r *bytes.Reader, n int) ([]interface{}, error) {
+	var key = make([]interface{}, n)
+	for i := range key {
+		v, err := decodeValue(r)
+		if err != nil {
+			return nil, err
+		}
+		key[i] = v
+	}
+	return key, nil
+}
@@ -0,0 +1,50 @@
+package ordered
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeValue(t *testing.T) {
+	require := require.New(t)
+
+	values := []interface{}{
+		nil,
+		true,
+		false,
+		int8(-8),
+		int16(-16),
+		int32(-32),
+		int64(-64),
+		int(-1),
+		uint8(8),
+		uint16(16),
+		uint32(32),
+		uint64(64),
+		uint(1),
+		float32(3.5),
+		float64(-2.25),
+		"foo",
+		"",
+		[]byte{1, 2, 3},
+		time.Date(2019, time.January, 2, 3, 4, 5, 6, time.UTC),
+		decimal.New(-1250, -2),
+		-90 * time.Minute,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(encodeKey(&buf, values))
+
+	result, err := decodeKey(bytes.NewReader(buf.Bytes()), len(values))
+	require.NoError(err)
+	require.Equal(values, result)
+
+	require.True(errUnsupportedValue.Is(encodeValue(&buf, struct{}{})))
+
+	_, err = decodeKey(bytes.NewReader(buf.Bytes()[:buf.Len()-2]), len(values))
+	require.Error(err)
+}
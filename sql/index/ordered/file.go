@@ -0,0 +1,398 @@
+package ordered
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/vitess/go/vt/proto/query"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// An index file starts with a header with the types of the indexed
+// expressions and the collations of the strings, followed by an append-only sequence of pages and checkpoints.
+//
+// Every time an index is saved, the sorted entries of each partition are
+// appended in pages of around pageSize bytes. Then, a checkpoint is appended
+// with the list of pages of every partition and the first key of each page.
+// The file always ends with the trailer of the last checkpoint, which points
+// to the beginning of the checkpoint.
+//
+// Only the checkpoint is kept in memory, pages are read from disk when they
+// are needed. Since pages are never modified, lookups can keep reading them
+// while new ones are appended.
+const (
+	headerMagic  = "GMSORDIX"
+	trailerMagic = "GMSORDCP"
+	// trailerSize is the size of the checkpoint offset, the checkpoint size,
+	// the checkpoint CRC and the trailer magic.
+	trailerSize = 8 + 4 + 4 + len(trailerMagic)
+	pageSize    = 4096
+)
+
+var errInvalidFile = errors.NewKind("invalid index file %s: %s")
+
+// entry is an item in the index: the values of the indexed expressions
+// for a row and the location of that row.
+type entry struct {
+	key      []interface{}
+	location []byte
+}
+
+// page is the position of a page in the file, along with the key of its
+// first entry.
+type page struct {
+	offset int64
+	length uint32
+	crc    uint32
+	first  []interface{}
+}
+
+// segment is the list of pages with the sorted entries of a partition.
+type segment struct {
+	entries uint64
+	pages   []page
+}
+
+// checkpoint is the list of segments of all partitions by partition key.
+type checkpoint map[string]*segment
+
+// writeHeader writes the header of a new index file with the given types and
+// returns its size.
+func writeHeader(f *os.File, types []sql.Type) (int64, error) {
+	var body bytes.Buffer
+	putUvarint(&body, uint64(len(types)))
+	for _, t := range types {
+		putVarint(&body, int64(t.Type()))
+	}
+
+	// Collations are written after the types, so the headers of the files
+	// written before they were supported can still be read.
+	for _, t := range types {
+		var name string
+		if sql.HasCollation(t) {
+			c, _ := sql.CollationOf(t)
+			name = c.Name
+		}
+		putBytes(&body, []byte(name))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(headerMagic)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(body.Len()))
+	buf.Write(body.Bytes())
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return int64(buf.Len()), f.Sync()
+}
+
+// readHeader reads the header of an index file and returns the types of the
+// keys and the size of the header.
+func readHeader(f *os.File) ([]sql.Type, int64, error) {
+	var head = make([]byte, len(headerMagic)+4)
+	if _, err := f.ReadAt(head, 0); err != nil {
+		return nil, 0, errInvalidFile.New(f.Name(), err)
+	}
+
+	if string(head[:len(headerMagic)]) != headerMagic {
+		return nil, 0, errInvalidFile.New(f.Name(), "wrong header")
+	}
+
+	var body = make([]byte, binary.BigEndian.Uint32(head[len(headerMagic):]))
+	if _, err := f.ReadAt(body, int64(len(head))); err != nil {
+		return nil, 0, errInvalidFile.New(f.Name(), err)
+	}
+
+	r := bytes.NewReader(body)
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, 0, errInvalidFile.New(f.Name(), err)
+	}
+
+	var types = make([]sql.Type, n)
+	for i := range types {
+		t, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, 0, errInvalidFile.New(f.Name(), err)
+		}
+
+		types[i], err = sql.MysqlTypeToType(query.Type(t))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	for i := 0; i < len(types) && r.Len() > 0; i++ {
+		name, err := readBytes(r)
+		if err != nil {
+			return nil, 0, errInvalidFile.New(f.Name(), err)
+		}
+
+		if len(name) == 0 {
+			continue
+		}
+
+		c, err := sql.LookupCollation(string(name))
+		if err != nil {
+			return nil, 0, err
+		}
+		types[i] = sql.WithCollation(types[i], c)
+	}
+
+	return types, int64(len(head) + len(body)), nil
+}
+
+// appender appends pages and checkpoints to an index file.
+type appender struct {
+	f      *os.File
+	w      *bufio.Writer
+	offset int64
+	page   bytes.Buffer
+}
+
+func newAppender(path string) (*appender, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &appender{
+		f:      f,
+		w:      bufio.NewWriter(f),
+		offset: info.Size(),
+	}, nil
+}
+
+// writeSegment appends the given entries, which must be sorted, and returns
+// the segment with their pages.
+func (a *appender) writeSegment(entries []entry) (*segment, error) {
+	s := &segment{entries: uint64(len(entries))}
+
+	var first []interface{}
+	for _, e := range entries {
+		if a.page.Len() == 0 {
+			first = e.key
+		}
+
+		if err := encodeKey(&a.page, e.key); err != nil {
+			return nil, err
+		}
+		putBytes(&a.page, e.location)
+
+		if a.page.Len() >= pageSize {
+			p, err := a.flushPage(first)
+			if err != nil {
+				return nil, err
+			}
+			s.pages = append(s.pages, p)
+		}
+	}
+
+	if a.page.Len() > 0 {
+		p, err := a.flushPage(first)
+		if err != nil {
+			return nil, err
+		}
+		s.pages = append(s.pages, p)
+	}
+
+	return s, nil
+}
+
+func (a *appender) flushPage(first []interface{}) (page, error) {
+	p := page{
+		offset: a.offset,
+		length: uint32(a.page.Len()),
+		crc:    crc32.ChecksumIEEE(a.page.Bytes()),
+		first:  first,
+	}
+
+	if _, err := a.w.Write(a.page.Bytes()); err != nil {
+		return page{}, err
+	}
+
+	a.offset += int64(a.page.Len())
+	a.page.Reset()
+	return p, nil
+}
+
+// writeCheckpoint appends the checkpoint and its trailer and syncs the file.
+// Once it returns, the checkpoint is the one that will be read from the file.
+func (a *appender) writeCheckpoint(c checkpoint) error {
+	var buf bytes.Buffer
+	putUvarint(&buf, uint64(len(c)))
+	for key, s := range c {
+		putBytes(&buf, []byte(key))
+		putUvarint(&buf, s.entries)
+		putUvarint(&buf, uint64(len(s.pages)))
+		for _, p := range s.pages {
+			putUvarint(&buf, uint64(p.offset))
+			putUvarint(&buf, uint64(p.length))
+			putUvarint(&buf, uint64(p.crc))
+			if err := encodeKey(&buf, p.first); err != nil {
+				return err
+			}
+		}
+	}
+
+	var trailer bytes.Buffer
+	_ = binary.Write(&trailer, binary.BigEndian, uint64(a.offset))
+	_ = binary.Write(&trailer, binary.BigEndian, uint32(buf.Len()))
+	_ = binary.Write(&trailer, binary.BigEndian, crc32.ChecksumIEEE(buf.Bytes()))
+	trailer.WriteString(trailerMagic)
+
+	if _, err := a.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	if _, err := a.w.Write(trailer.Bytes()); err != nil {
+		return err
+	}
+
+	if err := a.w.Flush(); err != nil {
+		return err
+	}
+
+	a.offset += int64(buf.Len() + trailer.Len())
+	return a.f.Sync()
+}
+
+func (a *appender) Close() error {
+	return a.f.Close()
+}
+
+// readCheckpoint reads the last checkpoint of an index file of the given size
+// with keys of n columns. If nothing has been saved yet, the file only has the
+// header and the checkpoint is empty.
+func readCheckpoint(f *os.File, size, headerSize int64, n int) (checkpoint, error) {
+	if size == headerSize {
+		return make(checkpoint), nil
+	}
+
+	if size < headerSize+int64(trailerSize) {
+		return nil, errInvalidFile.New(f.Name(), "truncated checkpoint")
+	}
+
+	var trailer = make([]byte, trailerSize)
+	if _, err := f.ReadAt(trailer, size-int64(trailerSize)); err != nil {
+		return nil, errInvalidFile.New(f.Name(), err)
+	}
+
+	if string(trailer[16:]) != trailerMagic {
+		return nil, errInvalidFile.New(f.Name(), "wrong checkpoint trailer")
+	}
+
+	offset := int64(binary.BigEndian.Uint64(trailer))
+	length := int64(binary.BigEndian.Uint32(trailer[8:]))
+	if offset < headerSize || offset+length+int64(trailerSize) != size {
+		return nil, errInvalidFile.New(f.Name(), "wrong checkpoint position")
+	}
+
+	var data = make([]byte, length)
+	if _, err := f.ReadAt(data, offset); err != nil {
+		return nil, errInvalidFile.New(f.Name(), err)
+	}
+
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(trailer[12:]) {
+		return nil, errInvalidFile.New(f.Name(), "wrong checkpoint checksum")
+	}
+
+	c, err := decodeCheckpoint(bytes.NewReader(data), n)
+	if err != nil {
+		return nil, errInvalidFile.New(f.Name(), err)
+	}
+
+	return c, nil
+}
+
+func decodeCheckpoint(r *bytes.Reader, n int) (checkpoint, error) {
+	partitions, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var c = make(checkpoint, partitions)
+	for i := uint64(0); i < partitions; i++ {
+		key, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var s = new(segment)
+		if s.entries, err = binary.ReadUvarint(r); err != nil {
+			return nil, err
+		}
+
+		pages, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := uint64(0); j < pages; j++ {
+			var values [3]uint64
+			for k := range values {
+				if values[k], err = binary.ReadUvarint(r); err != nil {
+					return nil, err
+				}
+			}
+
+			first, err := decodeKey(r, n)
+			if err != nil {
+				return nil, err
+			}
+
+			s.pages = append(s.pages, page{
+				offset: int64(values[0]),
+				length: uint32(values[1]),
+				crc:    uint32(values[2]),
+				first:  first,
+			})
+		}
+
+		c[string(key)] = s
+	}
+
+	return c, nil
+}
+
+// readPage reads the entries of the page, which have keys of n columns.
+func readPage(f *os.File, p page, n int) ([]entry, error) {
+	var data = make([]byte, p.length)
+	if _, err := f.ReadAt(data, p.offset); err != nil {
+		return nil, errInvalidFile.New(f.Name(), err)
+	}
+
+	if crc32.ChecksumIEEE(data) != p.crc {
+		return nil, errInvalidFile.New(f.Name(), "wrong page checksum")
+	}
+
+	var entries []entry
+	br := bytes.NewReader(data)
+	for br.Len() > 0 {
+		key, err := decodeKey(br, n)
+		if err != nil {
+			return nil, err
+		}
+
+		location, err := readBytes(br)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry{key, location})
+	}
+
+	return entries, nil
+}
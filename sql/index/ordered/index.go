@@ -0,0 +1,364 @@
+package ordered
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var errInvalidKeys = errors.NewKind("expecting %d keys for index %q, got %d")
+
+// orderedIndex is an implementation of sql.Index interface backed by a
+// single index file. It also implements sql.AscendIndex, sql.DescendIndex,
+// sql.NegateIndex and sql.BoundedIndex.
+type orderedIndex struct {
+	// saving is held while the index is being saved, since only one save
+	// can append to the index file at a time.
+	saving     sync.Mutex
+	mu         sync.RWMutex
+	path       string
+	checkpoint checkpoint
+	// size is the size of the index file up to the end of the checkpoint.
+	size       int64
+	headerSize int64
+	// version is the number of times the index has been rebuilt, each
+	// version is stored in its own file.
+	version int
+	// pending is true for a new version until it's saved, since the
+	// config file still points to the previous version until then.
+	pending bool
+
+	db          string
+	table       string
+	id          string
+	expressions []string
+	types       []sql.Type
+	checksum    string
+}
+
+// ID returns the identifier of the index.
+func (idx *orderedIndex) ID() string { return idx.id }
+
+// Database returns the database name this index belongs to.
+func (idx *orderedIndex) Database() string { return idx.db }
+
+// Table returns the table name this index belongs to.
+func (idx *orderedIndex) Table() string { return idx.table }
+
+// Expressions returns the indexed expressions.
+func (idx *orderedIndex) Expressions() []string { return idx.expressions }
+
+// Driver returns the identifier of the driver of the index.
+func (*orderedIndex) Driver() string { return DriverID }
+
+// Checksum returns the checksum of the table when the index was created.
+func (idx *orderedIndex) Checksum() (string, error) { return idx.checksum, nil }
+
+// Get returns an IndexLookup for the given key in the index.
+func (idx *orderedIndex) Get(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasNulls(keys) {
+		return idx.newLookup(scan{empty: true}), nil
+	}
+
+	return idx.newLookup(scan{
+		seek: func(e entry) bool { return idx.compare(e.key, keys) >= 0 },
+		stop: func(e entry) bool { return idx.compare(e.key, keys) != 0 },
+	}), nil
+}
+
+// Has checks if the given key is present in the index.
+func (idx *orderedIndex) Has(p sql.Partition, keys ...interface{}) (bool, error) {
+	lookup, err := idx.Get(keys...)
+	if err != nil {
+		return false, err
+	}
+
+	iter, err := lookup.Values(p)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = iter.Next()
+	if e := iter.Close(); err == nil && e != nil {
+		return false, e
+	}
+
+	if err == io.EOF {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// AscendGreaterOrEqual returns an IndexLookup for keys that are greater or
+// equal to the given keys.
+func (idx *orderedIndex) AscendGreaterOrEqual(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(scan{
+		seek:  idx.firstColumn(keys, greaterOrEqual),
+		match: idx.allColumns(keys, greaterOrEqual),
+	}), nil
+}
+
+// AscendLessThan returns an IndexLookup for keys that are less than the
+// given keys.
+func (idx *orderedIndex) AscendLessThan(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(scan{
+		stop:  idx.firstColumn(keys, greaterOrEqual),
+		match: idx.allColumns(keys, lessThan),
+	}), nil
+}
+
+// AscendRange returns an IndexLookup for keys that are within the given
+// range.
+func (idx *orderedIndex) AscendRange(greaterOrEqualKeys, lessThanKeys []interface{}) (sql.IndexLookup, error) {
+	gte, err := idx.convert(greaterOrEqualKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	lt, err := idx.convert(lessThanKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(scan{
+		seek:  idx.firstColumn(gte, greaterOrEqual),
+		stop:  idx.firstColumn(lt, greaterOrEqual),
+		match: both(idx.allColumns(gte, greaterOrEqual), idx.allColumns(lt, lessThan)),
+	}), nil
+}
+
+// DescendGreater returns an IndexLookup for keys that are greater than the
+// given keys.
+func (idx *orderedIndex) DescendGreater(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(scan{
+		descending: true,
+		stop:       idx.firstColumn(keys, lessOrEqual),
+		match:      idx.allColumns(keys, greater),
+	}), nil
+}
+
+// DescendLessOrEqual returns an IndexLookup for keys that are less than or
+// equal to the given keys.
+func (idx *orderedIndex) DescendLessOrEqual(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(scan{
+		descending: true,
+		seek:       idx.firstColumn(keys, lessOrEqual),
+		match:      idx.allColumns(keys, lessOrEqual),
+	}), nil
+}
+
+// DescendRange returns an IndexLookup for keys that are within the given
+// range.
+func (idx *orderedIndex) DescendRange(lessOrEqualKeys, greaterThanKeys []interface{}) (sql.IndexLookup, error) {
+	lte, err := idx.convert(lessOrEqualKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	gt, err := idx.convert(greaterThanKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(scan{
+		descending: true,
+		seek:       idx.firstColumn(lte, lessOrEqual),
+		stop:       idx.firstColumn(gt, lessOrEqual),
+		match:      both(idx.allColumns(lte, lessOrEqual), idx.allColumns(gt, greater)),
+	}), nil
+}
+
+// Not returns an IndexLookup for keys that are not equal to the given keys.
+func (idx *orderedIndex) Not(keys ...interface{}) (sql.IndexLookup, error) {
+	keys, err := idx.convert(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.newLookup(scan{match: idx.allColumns(keys, notEqual)}), nil
+}
+
+// Bounds returns the first and the last keys of the index whose first value
+// is not NULL. Only the pages with them are read from the index file.
+func (idx *orderedIndex) Bounds() (first, last []interface{}, err error) {
+	idx.mu.RLock()
+	var segments = make([]*segment, 0, len(idx.checkpoint))
+	for _, s := range idx.checkpoint {
+		if len(s.pages) > 0 {
+			segments = append(segments, s)
+		}
+	}
+	idx.mu.RUnlock()
+
+	if len(segments) == 0 {
+		return nil, nil, nil
+	}
+
+	f, err := os.Open(idx.path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	isNull := func(e entry) bool { return e.key[0] == nil }
+	notNull := func(e entry) bool { return e.key[0] != nil }
+	for _, s := range segments {
+		// NULL values are sorted first, so they are skipped at the
+		// beginning of an ascending scan and end a descending one.
+		e, err := newCursor(f, s.pages, len(idx.types), scan{seek: notNull}).nextEntry()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if first == nil || idx.compare(e.key, first) < 0 {
+			first = e.key
+		}
+
+		e, err = newCursor(f, s.pages, len(idx.types), scan{descending: true, stop: isNull}).nextEntry()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if last == nil || idx.compare(e.key, last) > 0 {
+			last = e.key
+		}
+	}
+
+	return first, last, nil
+}
+
+func (idx *orderedIndex) newLookup(s scan) *indexLookup {
+	return &indexLookup{
+		index:   idx,
+		scan:    s,
+		indexes: map[string]struct{}{idx.ID(): struct{}{}},
+	}
+}
+
+// segment returns the segment of the partition with the given key in the
+// last checkpoint.
+func (idx *orderedIndex) segment(key string) (*segment, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	s, ok := idx.checkpoint[key]
+	return s, ok
+}
+
+// convert converts the given keys to the types of the indexed expressions, so
+// they can always be compared with the keys in the index.
+func (idx *orderedIndex) convert(keys []interface{}) ([]interface{}, error) {
+	if len(keys) != len(idx.types) {
+		return nil, errInvalidKeys.New(len(idx.types), idx.ID(), len(keys))
+	}
+
+	var result = make([]interface{}, len(keys))
+	for i, k := range keys {
+		if k == nil {
+			continue
+		}
+
+		v, err := idx.types[i].Convert(k)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = v
+	}
+
+	return result, nil
+}
+
+// compare compares two keys column by column. NULL values are sorted before
+// any other value.
+func (idx *orderedIndex) compare(a, b []interface{}) int {
+	for i, t := range idx.types {
+		// Keys are always converted to the column types before being
+		// compared, so there can't be any error.
+		cmp, _ := t.Compare(a[i], b[i])
+		if cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+type comparison func(cmp int) bool
+
+func greaterOrEqual(cmp int) bool { return cmp >= 0 }
+func greater(cmp int) bool        { return cmp > 0 }
+func lessOrEqual(cmp int) bool    { return cmp <= 0 }
+func lessThan(cmp int) bool       { return cmp < 0 }
+func notEqual(cmp int) bool       { return cmp != 0 }
+
+// firstColumn returns a function that reports whether the first column of an
+// entry satisfies the comparison with the first key. Since entries are
+// sorted by their first column, it can be used to seek or stop scans.
+func (idx *orderedIndex) firstColumn(keys []interface{}, op comparison) func(entry) bool {
+	return func(e entry) bool {
+		cmp, _ := idx.types[0].Compare(e.key[0], keys[0])
+		return op(cmp)
+	}
+}
+
+// allColumns returns a function that reports whether every column of an
+// entry satisfies the comparison with its key. Comparisons with NULL are
+// never satisfied.
+func (idx *orderedIndex) allColumns(keys []interface{}, op comparison) func(entry) bool {
+	return func(e entry) bool {
+		for i, v := range e.key {
+			if v == nil || keys[i] == nil {
+				return false
+			}
+
+			cmp, _ := idx.types[i].Compare(v, keys[i])
+			if !op(cmp) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func both(a, b func(entry) bool) func(entry) bool {
+	return func(e entry) bool { return a(e) && b(e) }
+}
+
+func hasNulls(keys []interface{}) bool {
+	for _, k := range keys {
+		if k == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,398 @@
+package ordered
+
+import (
+	"io"
+	"os"
+	"sort"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// scan describes how to go through the entries of a partition to get the
+// ones of a lookup.
+type scan struct {
+	// empty is true if no entry can match the lookup.
+	empty bool
+	// descending scans the entries in descending order.
+	descending bool
+	// seek reports whether an entry is past the beginning of the scan. It
+	// must return false for a (possibly empty) prefix of the entries in the
+	// scan order and true for the rest. If nil, the scan starts with the
+	// first entry.
+	seek func(entry) bool
+	// stop reports whether an entry is past the end of the scan. If nil, the
+	// scan goes on until the last entry.
+	stop func(entry) bool
+	// match reports whether an entry within the scan is part of the lookup.
+	// If nil, all of them are.
+	match func(entry) bool
+}
+
+// indexLookup implements sql.IndexLookup, sql.Mergeable, sql.SetOperations
+// and sql.CountableIndexLookup interfaces. The locations it returns keep the order in
+// which the lookup scanned the index.
+type indexLookup struct {
+	index      *orderedIndex
+	scan       scan
+	operations []lookupOperation
+	indexes    map[string]struct{}
+}
+
+type lookupOperation struct {
+	lookup    sql.IndexLookup
+	operation func(a, b [][]byte) [][]byte
+}
+
+// Values returns the values in the subset of the index. Unless the lookup
+// has set operations, values are read from the index file as they are
+// needed.
+func (l *indexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	if len(l.operations) == 0 {
+		return l.cursor(p)
+	}
+
+	locations, err := l.locations(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &locationIter{locations: locations}, nil
+}
+
+// Count implements the sql.CountableIndexLookup interface. The entries of
+// the lookup are read from the index file, but the table is not.
+func (l *indexLookup) Count(p sql.Partition) (int64, error) {
+	if len(l.operations) > 0 {
+		locations, err := l.locations(p)
+		if err != nil {
+			return 0, err
+		}
+		return int64(len(locations)), nil
+	}
+
+	iter, err := l.cursor(p)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	for {
+		_, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return 0, err
+		}
+		n++
+	}
+
+	return n, iter.Close()
+}
+
+func (l *indexLookup) cursor(p sql.Partition) (sql.IndexValueIter, error) {
+	s, ok := l.index.segment(partitionKey(p))
+	if !ok || l.scan.empty || len(s.pages) == 0 {
+		return &locationIter{}, nil
+	}
+
+	f, err := os.Open(l.index.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCursor(f, s.pages, len(l.index.types), l.scan), nil
+}
+
+func (l *indexLookup) locations(p sql.Partition) ([][]byte, error) {
+	iter, err := l.cursor(p)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := readLocations(iter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range l.operations {
+		var other [][]byte
+		if ol, ok := op.lookup.(*indexLookup); ok {
+			other, err = ol.locations(p)
+		} else {
+			var iter sql.IndexValueIter
+			iter, err = op.lookup.Values(p)
+			if err == nil {
+				other, err = readLocations(iter)
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		locations = op.operation(locations, other)
+	}
+
+	return locations, nil
+}
+
+func readLocations(iter sql.IndexValueIter) ([][]byte, error) {
+	var locations [][]byte
+	for {
+		location, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+
+	return locations, iter.Close()
+}
+
+// Indexes returns the IDs of all indexes involved in this lookup.
+func (l *indexLookup) Indexes() []string {
+	var result = make([]string, 0, len(l.indexes))
+	for idx := range l.indexes {
+		result = append(result, idx)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// IsMergeable implements sql.Mergeable interface. Lookups of ordered indexes
+// on the same table can be merged.
+func (l *indexLookup) IsMergeable(lookup sql.IndexLookup) bool {
+	if il, ok := lookup.(*indexLookup); ok {
+		return il.index.Database() == l.index.Database() &&
+			il.index.Table() == l.index.Table()
+	}
+
+	return false
+}
+
+// Intersection implements sql.SetOperations interface.
+func (l *indexLookup) Intersection(lookups ...sql.IndexLookup) sql.IndexLookup {
+	return l.withOperation(intersection, lookups)
+}
+
+// Union implements sql.SetOperations interface.
+func (l *indexLookup) Union(lookups ...sql.IndexLookup) sql.IndexLookup {
+	return l.withOperation(union, lookups)
+}
+
+// Difference implements sql.SetOperations interface.
+func (l *indexLookup) Difference(lookups ...sql.IndexLookup) sql.IndexLookup {
+	return l.withOperation(difference, lookups)
+}
+
+func (l *indexLookup) withOperation(
+	operation func(a, b [][]byte) [][]byte,
+	lookups []sql.IndexLookup,
+) *indexLookup {
+	lookup := &indexLookup{
+		index:      l.index,
+		scan:       l.scan,
+		operations: make([]lookupOperation, len(l.operations), len(l.operations)+len(lookups)),
+		indexes:    make(map[string]struct{}, len(l.indexes)),
+	}
+	copy(lookup.operations, l.operations)
+	for idx := range l.indexes {
+		lookup.indexes[idx] = struct{}{}
+	}
+
+	for _, li := range lookups {
+		for _, idx := range li.Indexes() {
+			lookup.indexes[idx] = struct{}{}
+		}
+		lookup.operations = append(lookup.operations, lookupOperation{li, operation})
+	}
+
+	return lookup
+}
+
+func locationSet(locations [][]byte) map[string]struct{} {
+	var set = make(map[string]struct{}, len(locations))
+	for _, l := range locations {
+		set[string(l)] = struct{}{}
+	}
+	return set
+}
+
+// intersection returns the locations of a that are also in b.
+func intersection(a, b [][]byte) [][]byte {
+	set := locationSet(b)
+	var result [][]byte
+	for _, l := range a {
+		if _, ok := set[string(l)]; ok {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// union returns the locations of a followed by the ones of b that are not
+// in a.
+func union(a, b [][]byte) [][]byte {
+	set := locationSet(a)
+	result := a
+	for _, l := range b {
+		if _, ok := set[string(l)]; !ok {
+			set[string(l)] = struct{}{}
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// difference returns the locations of a that are not in b.
+func difference(a, b [][]byte) [][]byte {
+	set := locationSet(b)
+	var result [][]byte
+	for _, l := range a {
+		if _, ok := set[string(l)]; !ok {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// cursor is a sql.IndexValueIter that reads the pages of a segment one at a
+// time, so only one page of the index is in memory.
+type cursor struct {
+	f       *os.File
+	pages   []page
+	columns int
+	scan    scan
+	// next is the position of the next page to read.
+	next    int
+	entries []entry
+	pos     int
+	done    bool
+}
+
+func newCursor(f *os.File, pages []page, columns int, s scan) *cursor {
+	c := &cursor{f: f, pages: pages, columns: columns, scan: s}
+
+	// Since the first entry of each page is known, pages before the
+	// beginning of the scan are skipped.
+	if !s.descending {
+		if s.seek != nil {
+			i := sort.Search(len(pages), func(i int) bool {
+				return s.seek(entry{key: pages[i].first})
+			})
+			// The page before may have entries past the beginning too.
+			if i > 0 {
+				c.next = i - 1
+			}
+		}
+		return c
+	}
+
+	c.next = len(pages) - 1
+	if s.seek != nil {
+		// In descending order, entries are past the beginning of the scan
+		// while seek returns true, so the scan starts in the last page whose
+		// first entry satisfies it.
+		c.next = sort.Search(len(pages), func(i int) bool {
+			return !s.seek(entry{key: pages[i].first})
+		}) - 1
+		c.done = c.next < 0
+	}
+
+	return c
+}
+
+func (c *cursor) Next() ([]byte, error) {
+	e, err := c.nextEntry()
+	if err != nil {
+		return nil, err
+	}
+	return e.location, nil
+}
+
+// nextEntry returns the next entry of the scan.
+func (c *cursor) nextEntry() (entry, error) {
+	for !c.done {
+		if c.pos >= len(c.entries) {
+			if err := c.readPage(); err != nil {
+				return entry{}, err
+			}
+			continue
+		}
+
+		e := c.entries[c.pos]
+		c.pos++
+
+		if c.scan.seek != nil {
+			if !c.scan.seek(e) {
+				continue
+			}
+			// Once an entry is past the beginning, so are all the next ones.
+			c.scan.seek = nil
+		}
+
+		if c.scan.stop != nil && c.scan.stop(e) {
+			c.done = true
+			break
+		}
+
+		if c.scan.match == nil || c.scan.match(e) {
+			return e, nil
+		}
+	}
+
+	return entry{}, io.EOF
+}
+
+func (c *cursor) readPage() error {
+	if c.next < 0 || c.next >= len(c.pages) {
+		c.done = true
+		return nil
+	}
+
+	entries, err := readPage(c.f, c.pages[c.next], c.columns)
+	if err != nil {
+		return err
+	}
+
+	if c.scan.descending {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+		c.next--
+	} else {
+		c.next++
+	}
+
+	c.entries = entries
+	c.pos = 0
+	return nil
+}
+
+func (c *cursor) Close() error {
+	return c.f.Close()
+}
+
+// locationIter is a sql.IndexValueIter over a list of locations.
+type locationIter struct {
+	locations [][]byte
+	pos       int
+}
+
+func (i *locationIter) Next() ([]byte, error) {
+	if i.pos >= len(i.locations) {
+		return nil, io.EOF
+	}
+
+	i.pos++
+	return i.locations[i.pos-1], nil
+}
+
+func (i *locationIter) Close() error { return nil }
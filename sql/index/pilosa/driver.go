@@ -49,6 +49,11 @@ const (
 	MappingFileNameExtension = ".db"
 )
 
+// collationsKey is the key of the driver config holding the collations of
+// the indexed expressions, separated by commas. Expressions that are not
+// strings have an empty collation.
+const collationsKey = "collations"
+
 const (
 	processingFileOnCreate = 'C'
 	processingFileOnSave   = 'S'
@@ -126,9 +131,16 @@ func (d *Driver) Create(
 	}
 
 	exprs := make([]string, len(expressions))
+	collations := make([]string, len(expressions))
 	for i, e := range expressions {
 		exprs[i] = e.String()
+		// Strings are stored and looked up by their collation key, so
+		// they match the same way they are compared.
+		if c, ok := sql.CollationOf(e.Type()); ok {
+			collations[i] = c.Name
+		}
 	}
+	config[collationsKey] = strings.Join(collations, ",")
 
 	cfg := index.NewConfig(db, table, id, exprs, d.ID(), config)
 	err = index.WriteConfigFile(d.configFilePath(db, table, id), cfg)
@@ -314,6 +326,7 @@ func (d *Driver) savePartition(
 			break
 		}
 
+		values = idx.collationKeys(values)
 		for i, field := range b.fields {
 			if values[i] == nil {
 				continue
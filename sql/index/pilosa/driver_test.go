@@ -1496,3 +1496,64 @@ func (i *partitionIter) Close() error {
 	i.pos = i.partitions
 	return nil
 }
+
+func TestCount(t *testing.T) {
+	require := require.New(t)
+	setup(t)
+	defer cleanup(t)
+
+	db, table := "db_name", "table_name"
+
+	d := NewDriver(tmpDir)
+	idx, err := d.Create(db, table, "index_id", makeExpressions(table, "a"), nil)
+	require.NoError(err)
+
+	it := &fixturePartitionKeyValueIter{
+		fixtures: []partitionKeyValueFixture{
+			{
+				testPartition(0),
+				[]kvfixture{
+					{"1", []interface{}{int64(2)}},
+					{"2", []interface{}{int64(7)}},
+					{"3", []interface{}{int64(1)}},
+					{"4", []interface{}{int64(1)}},
+					{"5", []interface{}{int64(7)}},
+				},
+			},
+			{
+				testPartition(1),
+				[]kvfixture{
+					{"1", []interface{}{int64(2)}},
+					{"2", []interface{}{int64(7)}},
+				},
+			},
+		},
+	}
+
+	err = d.Save(sql.NewEmptyContext(), idx, it)
+	require.NoError(err)
+
+	count := func(lookup sql.IndexLookup, err error) []int64 {
+		t.Helper()
+		require.NoError(err)
+
+		var counts []int64
+		for i := 0; i < 2; i++ {
+			n, err := lookup.(sql.CountableIndexLookup).Count(testPartition(i))
+			require.NoError(err)
+			counts = append(counts, n)
+		}
+		return counts
+	}
+
+	two, err := idx.Get(int64(2))
+	require.NoError(err)
+	seven, err := idx.Get(int64(7))
+	require.NoError(err)
+
+	require.Equal([]int64{2, 1}, count(seven, nil))
+	require.Equal([]int64{0, 0}, count(idx.Get(int64(12739487))))
+	require.Equal([]int64{3, 2}, count(two.(sql.SetOperations).Union(seven), nil))
+	require.Equal([]int64{3, 2}, count(idx.(sql.NegateIndex).Not(int64(1))))
+	require.Equal([]int64{3, 2}, count(idx.(sql.AscendIndex).AscendGreaterOrEqual(int64(2))))
+}
@@ -4,6 +4,7 @@ package pilosa
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/pilosa/pilosa"
@@ -69,6 +70,7 @@ type pilosaIndex struct {
 	table       string
 	id          string
 	expressions []string
+	collations  []*sql.Collation
 	checksum    string
 }
 
@@ -87,11 +89,53 @@ func newPilosaIndex(idx *pilosa.Index, cfg *index.Config) *pilosaIndex {
 		table:       cfg.Table,
 		id:          cfg.ID,
 		expressions: cfg.Expressions,
+		collations:  indexCollations(cfg),
 		mapping:     make(map[string]*mapping),
 		checksum:    checksum,
 	}
 }
 
+// indexCollations returns the collations of the indexed expressions stored
+// in the config, with nil for the expressions that are not strings.
+func indexCollations(cfg *index.Config) []*sql.Collation {
+	collations := make([]*sql.Collation, len(cfg.Expressions))
+	names, ok := cfg.Driver(DriverID)[collationsKey]
+	if !ok {
+		return collations
+	}
+
+	for i, name := range strings.Split(names, ",") {
+		if i >= len(collations) || name == "" {
+			continue
+		}
+
+		c, err := sql.LookupCollation(name)
+		if err == nil {
+			collations[i] = c
+		}
+	}
+	return collations
+}
+
+// collationKeys returns the keys replacing every string with its collation
+// key, which is what is stored in the index mapping.
+func (idx *pilosaIndex) collationKeys(keys []interface{}) []interface{} {
+	if len(keys) == 0 {
+		return keys
+	}
+
+	result := make([]interface{}, len(keys))
+	for i, k := range keys {
+		s, ok := k.(string)
+		if ok && i < len(idx.collations) && idx.collations[i] != nil {
+			result[i] = idx.collations[i].Key(s)
+		} else {
+			result[i] = k
+		}
+	}
+	return result
+}
+
 func (idx *pilosaIndex) Checksum() (string, error) {
 	return idx.checksum, nil
 }
@@ -103,6 +147,7 @@ func (idx *pilosaIndex) Get(keys ...interface{}) (sql.IndexLookup, error) {
 	if len(keys) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(keys))
 	}
+	keys = idx.collationKeys(keys)
 
 	return &indexLookup{
 		id:          idx.ID(),
@@ -129,6 +174,7 @@ func (idx *pilosaIndex) Has(p sql.Partition, key ...interface{}) (bool, error) {
 	}
 	defer m.close()
 
+	key = idx.collationKeys(key)
 	for i, expr := range idx.expressions {
 		name := fieldName(idx.ID(), expr, p)
 
@@ -169,6 +215,7 @@ func (idx *pilosaIndex) AscendGreaterOrEqual(keys ...interface{}) (sql.IndexLook
 	if len(keys) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(keys))
 	}
+	keys = idx.collationKeys(keys)
 
 	return newAscendLookup(&filteredLookup{
 		id:          idx.ID(),
@@ -186,6 +233,7 @@ func (idx *pilosaIndex) AscendLessThan(keys ...interface{}) (sql.IndexLookup, er
 	if len(keys) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(keys))
 	}
+	keys = idx.collationKeys(keys)
 
 	return newAscendLookup(&filteredLookup{
 		id:          idx.ID(),
@@ -207,6 +255,8 @@ func (idx *pilosaIndex) AscendRange(greaterOrEqual, lessThan []interface{}) (sql
 	if len(lessThan) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(lessThan))
 	}
+	greaterOrEqual = idx.collationKeys(greaterOrEqual)
+	lessThan = idx.collationKeys(lessThan)
 
 	return newAscendLookup(&filteredLookup{
 		id:          idx.ID(),
@@ -223,6 +273,7 @@ func (idx *pilosaIndex) DescendGreater(keys ...interface{}) (sql.IndexLookup, er
 	if len(keys) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(keys))
 	}
+	keys = idx.collationKeys(keys)
 
 	return newDescendLookup(&filteredLookup{
 		id:          idx.ID(),
@@ -241,6 +292,7 @@ func (idx *pilosaIndex) DescendLessOrEqual(keys ...interface{}) (sql.IndexLookup
 	if len(keys) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(keys))
 	}
+	keys = idx.collationKeys(keys)
 
 	return newDescendLookup(&filteredLookup{
 		id:          idx.ID(),
@@ -263,6 +315,8 @@ func (idx *pilosaIndex) DescendRange(lessOrEqual, greaterThan []interface{}) (sq
 	if len(greaterThan) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(greaterThan))
 	}
+	lessOrEqual = idx.collationKeys(lessOrEqual)
+	greaterThan = idx.collationKeys(greaterThan)
 
 	return newDescendLookup(&filteredLookup{
 		id:          idx.ID(),
@@ -280,6 +334,7 @@ func (idx *pilosaIndex) Not(keys ...interface{}) (sql.IndexLookup, error) {
 	if len(keys) != len(idx.expressions) {
 		return nil, errInvalidKeys.New(len(idx.expressions), idx.ID(), len(keys))
 	}
+	keys = idx.collationKeys(keys)
 
 	return &negateLookup{
 		id:          idx.ID(),
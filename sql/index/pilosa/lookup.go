@@ -59,7 +59,8 @@ var (
 type (
 
 	// indexLookup implement following interfaces:
-	// sql.IndexLookup, sql.Mergeable, sql.SetOperations
+	// sql.IndexLookup, sql.Mergeable, sql.SetOperations,
+	// sql.CountableIndexLookup
 	indexLookup struct {
 		id          string
 		index       *concurrentPilosaIndex
@@ -185,6 +186,11 @@ func (l *indexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
 	}, nil
 }
 
+// Count implements sql.CountableIndexLookup.Count
+func (l *indexLookup) Count(p sql.Partition) (int64, error) {
+	return count(l, p)
+}
+
 func (l *indexLookup) Indexes() []string {
 	return sortedIndexes(l.indexes)
 }
@@ -365,6 +371,11 @@ func (l *filteredLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
 	return &locationValueIter{locations: locations}, nil
 }
 
+// Count implements sql.CountableIndexLookup.Count
+func (l *filteredLookup) Count(p sql.Partition) (int64, error) {
+	return count(l, p)
+}
+
 func (l *filteredLookup) Indexes() []string {
 	return sortedIndexes(l.indexes)
 }
@@ -561,6 +572,11 @@ func (l *negateLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
 	}, nil
 }
 
+// Count implements sql.CountableIndexLookup.Count
+func (l *negateLookup) Count(p sql.Partition) (int64, error) {
+	return count(l, p)
+}
+
 func (l *negateLookup) Indexes() []string {
 	return sortedIndexes(l.indexes)
 }
@@ -815,6 +831,17 @@ func compare(a, b interface{}) (int, error) {
 	}
 }
 
+// count returns the number of bits set in the row of the lookup for the given
+// partition, so the locations don't need to be read from the mapping.
+func count(l pilosaLookup, p sql.Partition) (int64, error) {
+	row, err := l.values(p)
+	if err != nil || row == nil {
+		return 0, err
+	}
+
+	return int64(row.Count()), nil
+}
+
 func sortedIndexes(indexes map[string]struct{}) []string {
 	var result = make([]string, 0, len(indexes))
 	for idx := range indexes {
@@ -0,0 +1,214 @@
+package rtree
+
+import (
+	"io"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// DriverID is the unique name of the R-tree driver.
+const DriverID = sql.SpatialDriverID
+
+var (
+	errInvalidIndexType      = errors.NewKind("expecting a spatial index, instead got %T")
+	errInvalidKeys           = errors.NewKind("expecting 1 key for index %q, got %d")
+	errInvalidExpressions    = errors.NewKind("spatial indexes can only index one expression, got %d")
+	errNotGeometryExpression = errors.NewKind("spatial indexes can only index geometry expressions, but %s is of type %s")
+)
+
+// Driver implements sql.IndexDriver, sql.IncrementalIndexDriver and
+// sql.RebuildableIndexDriver interfaces. Indexes are R-trees of the minimum
+// bounding rectangles of the indexed geometries, which are kept in memory,
+// one per partition, so they are lost when the process exits.
+type Driver struct{}
+
+// NewDriver returns a new instance of rtree.Driver which satisfies the
+// sql.IndexDriver interface.
+func NewDriver() *Driver {
+	return new(Driver)
+}
+
+// ID returns the unique name of the driver.
+func (*Driver) ID() string {
+	return DriverID
+}
+
+// Create a new index. There must be a single expression of a geometry type.
+func (d *Driver) Create(
+	db, table, id string,
+	expressions []sql.Expression,
+	config map[string]string,
+) (sql.Index, error) {
+	if len(expressions) != 1 {
+		return nil, errInvalidExpressions.New(len(expressions))
+	}
+
+	e := expressions[0]
+	if !sql.IsGeometry(e.Type()) {
+		return nil, errNotGeometryExpression.New(e, e.Type())
+	}
+
+	return newSpatialIndex(db, table, id, e.String(), config[sql.ChecksumKey]), nil
+}
+
+// LoadAll loads all indexes for given db and table. Since indexes are not
+// persisted, there is never anything to load.
+func (*Driver) LoadAll(db, table string) ([]sql.Index, error) {
+	return nil, nil
+}
+
+// Save the given index for all partitions.
+func (d *Driver) Save(
+	ctx *sql.Context,
+	i sql.Index,
+	iter sql.PartitionIndexKeyValueIter,
+) error {
+	idx, ok := i.(*spatialIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	span, ctx := ctx.Span("rtree.Save")
+	defer span.Finish()
+
+	defer iter.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		p, kviter, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		if err := d.savePartition(ctx, idx, p, kviter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Driver) savePartition(
+	ctx *sql.Context,
+	idx *spatialIndex,
+	p sql.Partition,
+	iter sql.IndexKeyValueIter,
+) error {
+	for {
+		select {
+		case <-ctx.Done():
+			_ = iter.Close()
+			return ctx.Err()
+		default:
+		}
+
+		values, location, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return err
+		}
+
+		if err := idx.insert(p, values, location); err != nil {
+			_ = iter.Close()
+			return err
+		}
+	}
+
+	return iter.Close()
+}
+
+// InsertKey adds to the index the geometry of the given key values with the
+// location of the row in the partition.
+func (d *Driver) InsertKey(
+	ctx *sql.Context,
+	i sql.Index,
+	p sql.Partition,
+	values []interface{},
+	location []byte,
+) error {
+	idx, ok := i.(*spatialIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	return idx.insert(p, values, location)
+}
+
+// DeleteKey removes from the index the row with the given location in the
+// partition.
+func (d *Driver) DeleteKey(
+	ctx *sql.Context,
+	i sql.Index,
+	p sql.Partition,
+	values []interface{},
+	location []byte,
+) error {
+	idx, ok := i.(*spatialIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	idx.delete(p, location)
+	return nil
+}
+
+// NewVersion returns a new empty version of the given index with the given
+// checksum.
+func (d *Driver) NewVersion(i sql.Index, checksum string) (sql.Index, error) {
+	idx, ok := i.(*spatialIndex)
+	if !ok {
+		return nil, errInvalidIndexType.New(i)
+	}
+
+	return newSpatialIndex(idx.db, idx.table, idx.id, idx.expression, checksum), nil
+}
+
+// DeleteVersion deletes a version of an index. Since indexes are kept in
+// memory, there is nothing to delete.
+func (d *Driver) DeleteVersion(i sql.Index) error {
+	if _, ok := i.(*spatialIndex); !ok {
+		return errInvalidIndexType.New(i)
+	}
+	return nil
+}
+
+// Delete the given index for all partitions in the iterator.
+func (d *Driver) Delete(i sql.Index, partitions sql.PartitionIter) error {
+	idx, ok := i.(*spatialIndex)
+	if !ok {
+		return errInvalidIndexType.New(i)
+	}
+
+	for {
+		p, err := partitions.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = partitions.Close()
+			return err
+		}
+
+		idx.mu.Lock()
+		delete(idx.partitions, partitionKey(p))
+		idx.mu.Unlock()
+	}
+
+	return partitions.Close()
+}
+
+func partitionKey(p sql.Partition) string {
+	return string(p.Key())
+}
@@ -0,0 +1,150 @@
+package rtree
+
+import (
+	"sync"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// spatialIndex is an in-memory implementation of sql.SpatialIndex, with an
+// R-tree of the minimum bounding rectangles of the indexed geometries of
+// each partition.
+type spatialIndex struct {
+	mu         sync.RWMutex
+	partitions map[string]*tree
+
+	db         string
+	table      string
+	id         string
+	expression string
+	checksum   string
+}
+
+var _ sql.SpatialIndex = (*spatialIndex)(nil)
+
+func newSpatialIndex(db, table, id, expression, checksum string) *spatialIndex {
+	return &spatialIndex{
+		partitions: make(map[string]*tree),
+		db:         db,
+		table:      table,
+		id:         id,
+		expression: expression,
+		checksum:   checksum,
+	}
+}
+
+// ID returns the identifier of the index.
+func (idx *spatialIndex) ID() string { return idx.id }
+
+// Database returns the database name this index belongs to.
+func (idx *spatialIndex) Database() string { return idx.db }
+
+// Table returns the table name this index belongs to.
+func (idx *spatialIndex) Table() string { return idx.table }
+
+// Expressions returns the indexed expressions.
+func (idx *spatialIndex) Expressions() []string { return []string{idx.expression} }
+
+// Driver returns the identifier of the driver of the index.
+func (*spatialIndex) Driver() string { return DriverID }
+
+// Checksum returns the checksum of the table when the index was created.
+func (idx *spatialIndex) Checksum() (string, error) { return idx.checksum, nil }
+
+// Get returns an IndexLookup of the rows whose geometry has a minimum
+// bounding rectangle that intersects the one of the given geometry, which
+// include all the rows with that geometry.
+func (idx *spatialIndex) Get(keys ...interface{}) (sql.IndexLookup, error) {
+	g, err := idx.geometry(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if g == nil {
+		return &indexLookup{index: idx, empty: true}, nil
+	}
+
+	return idx.Intersecting(g.Bounds())
+}
+
+// Has checks if there is some row in the partition whose geometry has the
+// same minimum bounding rectangle as the given geometry.
+func (idx *spatialIndex) Has(p sql.Partition, keys ...interface{}) (bool, error) {
+	g, err := idx.geometry(keys)
+	if err != nil || g == nil {
+		return false, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	t, ok := idx.partitions[partitionKey(p)]
+	if !ok {
+		return false, nil
+	}
+
+	var found bool
+	bounds := g.Bounds()
+	t.search(bounds, func(location string) {
+		found = found || t.locations[location] == bounds
+	})
+	return found, nil
+}
+
+// Intersecting implements the sql.SpatialIndex interface.
+func (idx *spatialIndex) Intersecting(bounds sql.BoundingBox) (sql.IndexLookup, error) {
+	return &indexLookup{index: idx, bounds: bounds}, nil
+}
+
+// geometry returns the geometry of the given key, or nil if it's NULL.
+func (idx *spatialIndex) geometry(keys []interface{}) (sql.GeometryValue, error) {
+	if len(keys) != 1 {
+		return nil, errInvalidKeys.New(idx.ID(), len(keys))
+	}
+
+	if keys[0] == nil {
+		return nil, nil
+	}
+
+	g, err := sql.Geometry.Convert(keys[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return g.(sql.GeometryValue), nil
+}
+
+// insert adds the row with the given values in the partition to the index.
+// Rows whose geometry is NULL are not indexed.
+func (idx *spatialIndex) insert(p sql.Partition, values []interface{}, location []byte) error {
+	g, err := idx.geometry(values)
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	t, ok := idx.partitions[partitionKey(p)]
+	if !ok {
+		t = newTree()
+		idx.partitions[partitionKey(p)] = t
+	}
+
+	if g == nil {
+		t.delete(string(location))
+	} else {
+		t.insert(string(location), g.Bounds())
+	}
+
+	return nil
+}
+
+func (idx *spatialIndex) delete(p sql.Partition, location []byte) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if t, ok := idx.partitions[partitionKey(p)]; ok {
+		t.delete(string(location))
+	}
+}
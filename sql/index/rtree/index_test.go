@@ -0,0 +1,177 @@
+package rtree
+
+import (
+	"io"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = sql.Schema{
+	{Name: "id", Type: sql.Int64, Source: "foo"},
+	{Name: "g", Type: sql.Geometry, Source: "foo", Nullable: true},
+}
+
+func point(x, y float64) sql.PointValue {
+	return sql.PointValue{X: x, Y: y}
+}
+
+func setupIndex(t *testing.T, partitions int) (*memory.Table, *spatialIndex) {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("foo", testSchema, partitions)
+	rows := []sql.Row{
+		sql.NewRow(int64(1), point(0, 0)),
+		sql.NewRow(int64(2), point(5, 5)),
+		sql.NewRow(int64(3), sql.LineStringValue{Points: []sql.PointValue{point(1, 8), point(3, 10)}}),
+		sql.NewRow(int64(4), sql.PolygonValue{Rings: []sql.LineStringValue{{Points: []sql.PointValue{
+			point(6, 0), point(9, 0), point(9, 3), point(6, 0),
+		}}}}),
+		sql.NewRow(int64(5), nil),
+	}
+	for _, r := range rows {
+		require.NoError(table.Insert(ctx, r))
+	}
+
+	exprs := []sql.Expression{
+		expression.NewGetFieldWithTable(1, sql.Geometry, "foo", "g", true),
+	}
+
+	d := NewDriver()
+	idx, err := d.Create("db", "foo", "idx", exprs, map[string]string{sql.ChecksumKey: "1"})
+	require.NoError(err)
+
+	iter, err := table.IndexKeyValues(ctx, []string{"g"})
+	require.NoError(err)
+	require.NoError(d.Save(ctx, idx, iter))
+
+	return table, idx.(*spatialIndex)
+}
+
+func lookupIDs(t *testing.T, table *memory.Table, lookup sql.IndexLookup) []int64 {
+	t.Helper()
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	indexed := table.WithIndexLookup(lookup)
+	partitions, err := indexed.Partitions(ctx)
+	require.NoError(err)
+
+	var ids []int64
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+
+		iter, err := indexed.PartitionRows(ctx, p)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		for _, r := range rows {
+			ids = append(ids, r[0].(int64))
+		}
+	}
+	require.NoError(partitions.Close())
+
+	return ids
+}
+
+func TestIndexIntersecting(t *testing.T) {
+	table, idx := setupIndex(t, 2)
+
+	testCases := []struct {
+		name     string
+		bounds   sql.BoundingBox
+		expected []int64
+	}{
+		{"everything", sql.BoundingBox{MinX: -1, MinY: -1, MaxX: 10, MaxY: 10}, []int64{1, 2, 3, 4}},
+		{"point", sql.BoundingBox{MinX: 5, MinY: 5, MaxX: 5, MaxY: 5}, []int64{2}},
+		{"boundary", sql.BoundingBox{MinX: 3, MinY: 10, MaxX: 4, MaxY: 11}, []int64{3}},
+		{"bounding rectangle", sql.BoundingBox{MinX: 6, MinY: 2, MaxX: 7, MaxY: 3}, []int64{4}},
+		{"nothing", sql.BoundingBox{MinX: 1, MinY: 1, MaxX: 4, MaxY: 4}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			lookup, err := idx.Intersecting(tt.bounds)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expected, lookupIDs(t, table, lookup))
+		})
+	}
+}
+
+func TestIndexGet(t *testing.T) {
+	require := require.New(t)
+	table, idx := setupIndex(t, 2)
+
+	lookup, err := idx.Get(point(5, 5))
+	require.NoError(err)
+	require.Equal([]int64{2}, lookupIDs(t, table, lookup))
+
+	lookup, err = idx.Get(nil)
+	require.NoError(err)
+	require.Len(lookupIDs(t, table, lookup), 0)
+
+	_, err = idx.Get(point(5, 5), point(0, 0))
+	require.Error(err)
+	require.True(errInvalidKeys.Is(err))
+}
+
+func TestDriverInsertDeleteKey(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table, idx := setupIndex(t, 1)
+	d := NewDriver()
+
+	partitions, err := table.Partitions(ctx)
+	require.NoError(err)
+	p, err := partitions.Next()
+	require.NoError(err)
+	require.NoError(partitions.Close())
+
+	lookup, err := idx.Get(point(5, 5))
+	require.NoError(err)
+	locations := lookup.(*indexLookup).locations(p)
+	require.Len(locations, 1)
+
+	require.NoError(d.DeleteKey(ctx, idx, p, []interface{}{point(5, 5)}, locations[0]))
+	ok, err := idx.Has(p, point(5, 5))
+	require.NoError(err)
+	require.False(ok)
+
+	require.NoError(d.InsertKey(ctx, idx, p, []interface{}{point(7, 7)}, locations[0]))
+	ok, err = idx.Has(p, point(7, 7))
+	require.NoError(err)
+	require.True(ok)
+
+	lookup, err = idx.Get(point(7, 7))
+	require.NoError(err)
+	require.Equal([]int64{2}, lookupIDs(t, table, lookup))
+}
+
+func TestDriverCreate(t *testing.T) {
+	require := require.New(t)
+	d := NewDriver()
+
+	_, err := d.Create("db", "foo", "idx", []sql.Expression{
+		expression.NewGetFieldWithTable(0, sql.Int64, "foo", "id", false),
+	}, nil)
+	require.Error(err)
+	require.True(errNotGeometryExpression.Is(err))
+
+	g := expression.NewGetFieldWithTable(1, sql.Geometry, "foo", "g", true)
+	_, err = d.Create("db", "foo", "idx", []sql.Expression{g, g}, nil)
+	require.Error(err)
+	require.True(errInvalidExpressions.Is(err))
+
+	idx, err := d.Create("db", "foo", "idx", []sql.Expression{g}, nil)
+	require.NoError(err)
+	require.Equal([]string{"foo.g"}, idx.Expressions())
+}
@@ -0,0 +1,75 @@
+package rtree
+
+import (
+	"io"
+	"sort"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// indexLookup implements sql.IndexLookup interface. It contains the rows
+// whose geometry has a minimum bounding rectangle that intersects a given
+// one, which are a superset of the rows whose geometry intersects any
+// geometry with that minimum bounding rectangle.
+type indexLookup struct {
+	index  *spatialIndex
+	bounds sql.BoundingBox
+	// empty is true if the lookup contains no rows.
+	empty bool
+}
+
+// Values returns the locations of the rows of the partition in the lookup.
+func (l *indexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	return &locationIter{locations: l.locations(p)}, nil
+}
+
+// Indexes returns the IDs of all indexes involved in this lookup.
+func (l *indexLookup) Indexes() []string {
+	return []string{l.index.ID()}
+}
+
+func (l *indexLookup) locations(p sql.Partition) [][]byte {
+	if l.empty {
+		return nil
+	}
+
+	l.index.mu.RLock()
+	defer l.index.mu.RUnlock()
+
+	t, ok := l.index.partitions[partitionKey(p)]
+	if !ok {
+		return nil
+	}
+
+	var locations []string
+	t.search(l.bounds, func(location string) {
+		locations = append(locations, location)
+	})
+
+	// Rows are returned in the order of their locations, so they don't
+	// depend on the shape of the tree.
+	sort.Strings(locations)
+
+	var result = make([][]byte, len(locations))
+	for i, location := range locations {
+		result[i] = []byte(location)
+	}
+	return result
+}
+
+// locationIter is a sql.IndexValueIter over a list of locations.
+type locationIter struct {
+	locations [][]byte
+	pos       int
+}
+
+func (i *locationIter) Next() ([]byte, error) {
+	if i.pos >= len(i.locations) {
+		return nil, io.EOF
+	}
+
+	i.pos++
+	return i.locations[i.pos-1], nil
+}
+
+func (i *locationIter) Close() error { return nil }
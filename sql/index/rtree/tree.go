@@ -0,0 +1,283 @@
+package rtree
+
+import (
+	"math"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+const (
+	// maxEntries is the maximum number of entries of a node, which is split
+	// in two when it has more.
+	maxEntries = 16
+	// minEntries is the minimum number of entries of a node other than the
+	// root. The entries of nodes with less are inserted again.
+	minEntries = 6
+)
+
+// entry is an entry of a node of the tree. Entries of leaves are the
+// locations of rows with the bounds of their geometries, and entries of the
+// rest of the nodes are their children with their bounds.
+type entry struct {
+	bounds   sql.BoundingBox
+	child    *node
+	location string
+}
+
+type node struct {
+	leaf    bool
+	entries []entry
+}
+
+func (n *node) bounds() sql.BoundingBox {
+	b := n.entries[0].bounds
+	for _, e := range n.entries[1:] {
+		b = b.Union(e.bounds)
+	}
+	return b
+}
+
+// tree is an R-tree of the minimum bounding rectangles of the geometries of
+// the rows of a partition, whose nodes are split with the quadratic
+// algorithm of Guttman.
+type tree struct {
+	root *node
+	// locations contains the bounds of each location in the tree.
+	locations map[string]sql.BoundingBox
+}
+
+func newTree() *tree {
+	return &tree{
+		root:      &node{leaf: true},
+		locations: make(map[string]sql.BoundingBox),
+	}
+}
+
+func (t *tree) len() int { return len(t.locations) }
+
+// insert adds the location with the given bounds to the tree, replacing it
+// if it's already in it.
+func (t *tree) insert(location string, bounds sql.BoundingBox) {
+	t.delete(location)
+	t.locations[location] = bounds
+	t.insertEntry(entry{bounds: bounds, location: location})
+}
+
+func (t *tree) insertEntry(e entry) {
+	sibling := insertEntry(t.root, e)
+	if sibling != nil {
+		t.root = &node{entries: []entry{
+			{bounds: t.root.bounds(), child: t.root},
+			{bounds: sibling.bounds(), child: sibling},
+		}}
+	}
+}
+
+// insertEntry adds the leaf entry to the subtree of the node and returns the
+// new sibling of the node if it had to be split.
+func insertEntry(n *node, e entry) *node {
+	if n.leaf {
+		n.entries = append(n.entries, e)
+	} else {
+		i := chooseSubtree(n, e.bounds)
+		child := n.entries[i].child
+		sibling := insertEntry(child, e)
+		n.entries[i].bounds = child.bounds()
+		if sibling != nil {
+			n.entries = append(n.entries, entry{bounds: sibling.bounds(), child: sibling})
+		}
+	}
+
+	if len(n.entries) > maxEntries {
+		return split(n)
+	}
+	return nil
+}
+
+// chooseSubtree returns the entry of the node whose bounds need the least
+// enlargement to contain the given ones, or the one with the least area if
+// there is a tie.
+func chooseSubtree(n *node, bounds sql.BoundingBox) int {
+	var result int
+	var minEnlargement, minArea = math.Inf(1), math.Inf(1)
+	for i, e := range n.entries {
+		area := e.bounds.Area()
+		enlargement := e.bounds.Union(bounds).Area() - area
+		if enlargement < minEnlargement || (enlargement == minEnlargement && area < minArea) {
+			result, minEnlargement, minArea = i, enlargement, area
+		}
+	}
+	return result
+}
+
+// split distributes the entries of the node between it and a new sibling,
+// which is returned.
+func split(n *node) *node {
+	entries := n.entries
+
+	// The seeds of both groups are the pair of entries that would waste the
+	// most area if they were in the same one.
+	var seed1, seed2 int
+	var maxWaste = math.Inf(-1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			waste := entries[i].bounds.Union(entries[j].bounds).Area() -
+				entries[i].bounds.Area() - entries[j].bounds.Area()
+			if waste > maxWaste {
+				seed1, seed2, maxWaste = i, j, waste
+			}
+		}
+	}
+
+	groups := [2][]entry{{entries[seed1]}, {entries[seed2]}}
+	bounds := [2]sql.BoundingBox{entries[seed1].bounds, entries[seed2].bounds}
+
+	var remaining []entry
+	for i, e := range entries {
+		if i != seed1 && i != seed2 {
+			remaining = append(remaining, e)
+		}
+	}
+
+	for len(remaining) > 0 {
+		// If a group needs all the remaining entries to have the minimum
+		// number of them, it gets them.
+		for g := range groups {
+			if len(groups[g])+len(remaining) == minEntries {
+				for _, e := range remaining {
+					groups[g] = append(groups[g], e)
+					bounds[g] = bounds[g].Union(e.bounds)
+				}
+				remaining = nil
+			}
+		}
+
+		if len(remaining) == 0 {
+			break
+		}
+
+		// The next entry is the one with the greatest preference for a
+		// group, which is the difference of the enlargements of both groups
+		// to contain it.
+		var next int
+		var enlargements [2]float64
+		var maxDiff = math.Inf(-1)
+		for i, e := range remaining {
+			var d [2]float64
+			for g := range groups {
+				d[g] = bounds[g].Union(e.bounds).Area() - bounds[g].Area()
+			}
+
+			if diff := math.Abs(d[0] - d[1]); diff > maxDiff {
+				next, enlargements, maxDiff = i, d, diff
+			}
+		}
+
+		g := 0
+		switch {
+		case enlargements[1] < enlargements[0]:
+			g = 1
+		case enlargements[1] > enlargements[0]:
+		case bounds[1].Area() < bounds[0].Area():
+			g = 1
+		case bounds[1].Area() > bounds[0].Area():
+		case len(groups[1]) < len(groups[0]):
+			g = 1
+		}
+
+		e := remaining[next]
+		remaining = append(remaining[:next], remaining[next+1:]...)
+		groups[g] = append(groups[g], e)
+		bounds[g] = bounds[g].Union(e.bounds)
+	}
+
+	n.entries = groups[0]
+	return &node{leaf: n.leaf, entries: groups[1]}
+}
+
+// delete removes the location from the tree, if it's in it.
+func (t *tree) delete(location string) {
+	bounds, ok := t.locations[location]
+	if !ok {
+		return
+	}
+	delete(t.locations, location)
+
+	var orphans []entry
+	remove(t.root, bounds, location, &orphans)
+
+	for !t.root.leaf && len(t.root.entries) == 1 {
+		t.root = t.root.entries[0].child
+	}
+
+	if !t.root.leaf && len(t.root.entries) == 0 {
+		t.root = &node{leaf: true}
+	}
+
+	for _, e := range orphans {
+		t.insertEntry(e)
+	}
+}
+
+// remove removes the leaf entry with the given location and bounds from the
+// subtree of the node, and returns whether it was found. The nodes left with
+// less than the minimum number of entries are removed, and the leaf entries
+// of their subtrees are added to the orphans to be inserted again.
+func remove(n *node, bounds sql.BoundingBox, location string, orphans *[]entry) bool {
+	if n.leaf {
+		for i, e := range n.entries {
+			if e.location == location {
+				n.entries = append(n.entries[:i], n.entries[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+
+	for i, e := range n.entries {
+		if !e.bounds.Contains(bounds) || !remove(e.child, bounds, location, orphans) {
+			continue
+		}
+
+		if len(e.child.entries) < minEntries {
+			*orphans = appendLeafEntries(*orphans, e.child)
+			n.entries = append(n.entries[:i], n.entries[i+1:]...)
+		} else {
+			n.entries[i].bounds = e.child.bounds()
+		}
+		return true
+	}
+
+	return false
+}
+
+func appendLeafEntries(entries []entry, n *node) []entry {
+	if n.leaf {
+		return append(entries, n.entries...)
+	}
+
+	for _, e := range n.entries {
+		entries = appendLeafEntries(entries, e.child)
+	}
+	return entries
+}
+
+// search calls the function with the locations whose bounds intersect the
+// given ones.
+func (t *tree) search(bounds sql.BoundingBox, fn func(location string)) {
+	search(t.root, bounds, fn)
+}
+
+func search(n *node, bounds sql.BoundingBox, fn func(string)) {
+	for _, e := range n.entries {
+		if !e.bounds.Intersects(bounds) {
+			continue
+		}
+
+		if n.leaf {
+			fn(e.location)
+		} else {
+			search(e.child, bounds, fn)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package rtree
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree(t *testing.T) {
+	require := require.New(t)
+	r := rand.New(rand.NewSource(1))
+	tr := newTree()
+
+	boxes := make(map[string]sql.BoundingBox)
+	randomBox := func() sql.BoundingBox {
+		x, y := r.Float64()*100, r.Float64()*100
+		return sql.BoundingBox{MinX: x, MinY: y, MaxX: x + r.Float64()*5, MaxY: y + r.Float64()*5}
+	}
+
+	for i := 0; i < 1000; i++ {
+		location := fmt.Sprint(i)
+		boxes[location] = randomBox()
+		tr.insert(location, boxes[location])
+	}
+
+	// Replace some of them and delete others.
+	for i := 0; i < 1000; i += 3 {
+		location := fmt.Sprint(i)
+		boxes[location] = randomBox()
+		tr.insert(location, boxes[location])
+	}
+
+	for i := 1; i < 1000; i += 3 {
+		location := fmt.Sprint(i)
+		delete(boxes, location)
+		tr.delete(location)
+	}
+
+	require.Equal(len(boxes), tr.len())
+	checkTree(t, tr.root, true)
+
+	for i := 0; i < 100; i++ {
+		query := randomBox().Expand(r.Float64() * 10)
+
+		var expected []string
+		for location, b := range boxes {
+			if b.Intersects(query) {
+				expected = append(expected, location)
+			}
+		}
+
+		var result []string
+		tr.search(query, func(location string) {
+			result = append(result, location)
+		})
+
+		sort.Strings(expected)
+		sort.Strings(result)
+		require.Equal(expected, result)
+	}
+
+	for location := range boxes {
+		tr.delete(location)
+	}
+
+	require.Equal(0, tr.len())
+	require.True(tr.root.leaf)
+	require.Len(tr.root.entries, 0)
+}
+
+// checkTree checks that the bounds of the entries of the subtree contain
+// the ones of their children and that nodes have a valid number of entries.
+func checkTree(t *testing.T, n *node, root bool) {
+	t.Helper()
+
+	require.True(t, len(n.entries) <= maxEntries)
+	if !root {
+		require.True(t, len(n.entries) >= minEntries)
+	}
+
+	if n.leaf {
+		return
+	}
+
+	for _, e := range n.entries {
+		require.Equal(t, e.child.bounds(), e.bounds)
+		checkTree(t, e.child, false)
+	}
+}
@@ -3,6 +3,7 @@ package sql
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -162,6 +163,42 @@ func TestDeleteIndex(t *testing.T) {
 	require.Len(r.indexes, 0)
 }
 
+func TestIndexUsage(t *testing.T) {
+	require := require.New(t)
+	r := NewIndexRegistry()
+	idx := &dummyIdx{
+		id:       "foo",
+		expr:     []Expression{new(dummyExpr)},
+		database: "foo",
+		table:    "foo",
+	}
+
+	done, ready, err := r.AddIndex(idx)
+	require.NoError(err)
+	require.Equal([]Index{idx}, r.Indexes())
+	require.Equal(IndexNotReady, r.IndexStatus(idx))
+
+	close(done)
+	<-ready
+	require.Equal(IndexReady, r.IndexStatus(idx))
+	require.Equal(IndexUsage{}, r.IndexUsage(idx))
+
+	r.RecordIndexLookup(idx)
+	r.RecordIndexRows(idx, 3)
+	r.RecordIndexLookup(idx)
+	r.RecordIndexRows(idx, 2)
+
+	usage := r.IndexUsage(idx)
+	require.Equal(uint64(2), usage.Lookups)
+	require.Equal(uint64(5), usage.Rows)
+	require.False(usage.LastUsed.IsZero())
+
+	_, err = r.DeleteIndex("foo", "foo", false)
+	require.NoError(err)
+	require.Len(r.Indexes(), 0)
+	require.Equal(IndexUsage{}, r.IndexUsage(idx))
+}
+
 func TestDeleteIndex_InUse(t *testing.T) {
 	require := require.New(t)
 	r := NewIndexRegistry()
@@ -186,6 +223,110 @@ func TestDeleteIndex_InUse(t *testing.T) {
 	require.Len(r.indexes, 0)
 }
 
+func TestReplaceIndex(t *testing.T) {
+	require := require.New(t)
+	r := NewIndexRegistry()
+	idx := &dummyIdx{"foo", nil, "foo", "foo"}
+	r.indexes[indexKey{"foo", "foo"}] = idx
+	r.indexOrder = append(r.indexOrder, indexKey{"foo", "foo"})
+	r.setStatus(idx, IndexOutdated)
+
+	require.NoError(r.StartIndexRebuild(idx))
+	err := r.StartIndexRebuild(idx)
+	require.Error(err)
+	require.True(ErrIndexRebuildInvalidStatus.Is(err))
+
+	r.retainIndex("foo", "foo")
+	r.RecordIndexLookup(idx)
+
+	idx2 := &dummyIdx{"foo", nil, "foo", "foo"}
+	unused, err := r.ReplaceIndex(idx, idx2)
+	require.NoError(err)
+	require.Equal([]Index{idx2}, r.Indexes())
+	require.Equal(IndexReady, r.IndexStatus(idx2))
+	require.Equal(uint64(1), r.IndexUsage(idx2).Lookups)
+
+	select {
+	case <-unused:
+		require.FailNow("old version should still be in use")
+	default:
+	}
+
+	go func() {
+		r.ReleaseIndex(idx)
+	}()
+	<-unused
+
+	_, err = r.ReplaceIndex(idx2, idx)
+	require.Error(err)
+	require.True(ErrIndexRebuildInvalidStatus.Is(err))
+
+	require.NoError(r.StartIndexRebuild(idx2))
+	r.CancelIndexRebuild(idx2)
+	require.NoError(r.StartIndexRebuild(idx2))
+
+	_, err = r.DeleteIndex("foo", "foo", false)
+	require.NoError(err)
+	_, err = r.ReplaceIndex(idx2, idx)
+	require.Error(err)
+	require.True(ErrIndexNotFound.Is(err))
+	require.Len(r.Indexes(), 0)
+}
+
+func TestQueueIndexRebuild(t *testing.T) {
+	require := require.New(t)
+	r := NewIndexRegistry()
+	idx := &dummyIdx{"foo", nil, "foo", "foo"}
+	r.indexes[indexKey{"foo", "foo"}] = idx
+	r.indexOrder = append(r.indexOrder, indexKey{"foo", "foo"})
+	r.setStatus(idx, IndexReady)
+
+	_, ok := r.QueueIndexRebuild(idx)
+	require.False(ok)
+	require.Nil(r.StartQueuedIndexRebuild("foo", "foo"))
+
+	require.NoError(r.StartIndexRebuild(idx))
+	queued, ok := r.QueueIndexRebuild(idx)
+	require.True(ok)
+	queued2, ok := r.QueueIndexRebuild(idx)
+	require.True(ok)
+	require.Equal(queued, queued2)
+	require.Nil(r.StartQueuedIndexRebuild("foo", "foo"))
+
+	idx2 := &dummyIdx{"foo", nil, "foo", "foo"}
+	_, err := r.ReplaceIndex(idx, idx2)
+	require.NoError(err)
+
+	// The queued rebuild has not started yet, so new rebuilds are coalesced
+	// with it.
+	err = r.StartIndexRebuild(idx2)
+	require.Error(err)
+	require.True(ErrIndexRebuildInvalidStatus.Is(err))
+	queued2, ok = r.QueueIndexRebuild(idx2)
+	require.True(ok)
+	require.Equal(queued, queued2)
+
+	require.Equal(Index(idx2), r.StartQueuedIndexRebuild("foo", "foo"))
+	require.Nil(r.StartQueuedIndexRebuild("foo", "foo"))
+
+	select {
+	case <-queued:
+		require.FailNow("queued rebuild should not have finished")
+	default:
+	}
+
+	r.CancelIndexRebuild(idx2)
+	<-queued
+	require.NoError(r.StartIndexRebuild(idx2))
+
+	// Deleting the index finishes the rebuilds waiting for it.
+	queued, ok = r.QueueIndexRebuild(idx2)
+	require.True(ok)
+	_, err = r.DeleteIndex("foo", "foo", false)
+	require.NoError(err)
+	<-queued
+}
+
 func TestExpressionsWithIndexes(t *testing.T) {
 	require := require.New(t)
 
@@ -429,3 +570,54 @@ type checksumIndex struct {
 func (idx *checksumIndex) Checksum() (string, error) {
 	return idx.checksum, nil
 }
+
+func TestCheckIndexChecksums(t *testing.T) {
+	require := require.New(t)
+
+	idx1 := &checksumIndex{&dummyIdx{id: "idx1", database: "db1", table: "t1"}, "1"}
+	idx2 := &checksumIndex{&dummyIdx{id: "idx2", database: "db1", table: "t1"}, "1"}
+	registry := NewIndexRegistry()
+	for _, idx := range []Index{idx1, idx2} {
+		k := indexKey{idx.Database(), idx.ID()}
+		registry.indexes[k] = idx
+		registry.indexOrder = append(registry.indexOrder, k)
+		registry.setStatus(idx, IndexReady)
+	}
+
+	table := &checksumTable{&dummyTable{name: "t1"}, "1"}
+	outdated, err := registry.CheckIndexChecksums("db1", table)
+	require.NoError(err)
+	require.Len(outdated, 0)
+
+	// The index is known to be up to date with the new checksum, such as
+	// after being updated incrementally.
+	table.checksum = "2"
+	registry.UpdateIndexChecksum(idx1, "2")
+	outdated, err = registry.CheckIndexChecksums("db1", table)
+	require.NoError(err)
+	require.Equal([]Index{idx2}, outdated)
+	require.Equal(IndexReady, registry.IndexStatus(idx1))
+	require.Equal(IndexOutdated, registry.IndexStatus(idx2))
+
+	// Tables are not checked again until the interval has passed.
+	registry.ChecksumInterval = time.Hour
+	table.checksum = "3"
+	outdated, err = registry.CheckIndexChecksums("db1", table)
+	require.NoError(err)
+	require.Len(outdated, 0)
+
+	registry.ChecksumInterval = 0
+	outdated, err = registry.CheckIndexChecksums("db1", table)
+	require.NoError(err)
+	require.Equal([]Index{idx1}, outdated)
+
+	registry.setStatus(idx1, IndexReady)
+	registry.ChecksumInterval = -1
+	outdated, err = registry.CheckIndexChecksums("db1", table)
+	require.NoError(err)
+	require.Len(outdated, 0)
+
+	for _, idx := range []Index{idx1, idx2} {
+		require.Equal(0, registry.refCounts[indexKey{idx.Database(), idx.ID()}])
+	}
+}
@@ -19,6 +19,10 @@ const (
 	ColumnsTableName = "columns"
 	// SchemataTableName is the name of the schemata table.
 	SchemataTableName = "schemata"
+	// StatisticsTableName is the name of the statistics table.
+	StatisticsTableName = "statistics"
+	// IndexStatisticsTableName is the name of the index statistics table.
+	IndexStatisticsTableName = "index_statistics"
 )
 
 type informationSchemaDatabase struct {
@@ -153,6 +157,39 @@ var schemataSchema = Schema{
 	{Name: "sql_path", Type: Text, Default: nil, Nullable: true, Source: SchemataTableName},
 }
 
+var statisticsSchema = Schema{
+	{Name: "table_catalog", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "table_schema", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "table_name", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "non_unique", Type: Int64, Default: 0, Nullable: false, Source: StatisticsTableName},
+	{Name: "index_schema", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "index_name", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "seq_in_index", Type: Uint64, Default: 0, Nullable: false, Source: StatisticsTableName},
+	{Name: "column_name", Type: Text, Default: nil, Nullable: true, Source: StatisticsTableName},
+	{Name: "collation", Type: Text, Default: nil, Nullable: true, Source: StatisticsTableName},
+	{Name: "cardinality", Type: Int64, Default: nil, Nullable: true, Source: StatisticsTableName},
+	{Name: "sub_part", Type: Int64, Default: nil, Nullable: true, Source: StatisticsTableName},
+	{Name: "packed", Type: Text, Default: nil, Nullable: true, Source: StatisticsTableName},
+	{Name: "nullable", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "index_type", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "comment", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "index_comment", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "is_visible", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "expression", Type: Text, Default: nil, Nullable: true, Source: StatisticsTableName},
+	{Name: "driver", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+	{Name: "status", Type: Text, Default: "", Nullable: false, Source: StatisticsTableName},
+}
+
+var indexStatisticsSchema = Schema{
+	{Name: "table_schema", Type: Text, Default: "", Nullable: false, Source: IndexStatisticsTableName},
+	{Name: "table_name", Type: Text, Default: "", Nullable: false, Source: IndexStatisticsTableName},
+	{Name: "index_name", Type: Text, Default: "", Nullable: false, Source: IndexStatisticsTableName},
+	{Name: "driver", Type: Text, Default: "", Nullable: false, Source: IndexStatisticsTableName},
+	{Name: "lookups", Type: Uint64, Default: 0, Nullable: false, Source: IndexStatisticsTableName},
+	{Name: "rows_selected", Type: Uint64, Default: 0, Nullable: false, Source: IndexStatisticsTableName},
+	{Name: "last_used", Type: Timestamp, Default: nil, Nullable: true, Source: IndexStatisticsTableName},
+}
+
 func tablesRowIter(cat *Catalog) RowIter {
 	var rows []Row
 	for _, db := range cat.AllDatabases() {
@@ -166,27 +203,27 @@ func tablesRowIter(cat *Catalog) RowIter {
 		}
 		for _, t := range db.Tables() {
 			rows = append(rows, Row{
-				"def",      //table_catalog
-				db.Name(),  // table_schema
-				t.Name(),   // table_name
-				tableType,  // table_type
-				engine,     // engine
-				10,         //version (protocol, always 10)
-				rowFormat,  //row_format
-				nil,        //table_rows
-				nil,        //avg_row_length
-				nil,        //data_length
-				nil,        //max_data_length
-				nil,        //max_data_length
-				nil,        //data_free
-				nil,        //auto_increment
-				nil,        //create_time
-				nil,        //update_time
-				nil,        //check_time
-				"utf8_bin", //table_collation
-				nil,        //checksum
-				nil,        //create_options
-				"",         //table_comment
+				"def",                  //table_catalog
+				db.Name(),              // table_schema
+				t.Name(),               // table_name
+				tableType,              // table_type
+				engine,                 // engine
+				10,                     //version (protocol, always 10)
+				rowFormat,              //row_format
+				nil,                    //table_rows
+				nil,                    //avg_row_length
+				nil,                    //data_length
+				nil,                    //max_data_length
+				nil,                    //max_data_length
+				nil,                    //data_free
+				nil,                    //auto_increment
+				nil,                    //create_time
+				nil,                    //update_time
+				nil,                    //check_time
+				TableCollation(t).Name, //table_collation
+				nil,                    //checksum
+				nil,                    //create_options
+				"",                     //table_comment
 			})
 		}
 	}
@@ -200,41 +237,62 @@ func columnsRowIter(cat *Catalog) RowIter {
 		for _, t := range db.Tables() {
 			for i, c := range t.Schema() {
 				var (
-					nullable string
-					charName interface{}
-					collName interface{}
+					nullable    string
+					charName    interface{}
+					collName    interface{}
+					maxLength   interface{}
+					octetLength interface{}
 				)
 				if c.Nullable {
 					nullable = "YES"
 				} else {
 					nullable = "NO"
 				}
-				if IsText(c.Type) {
-					charName = "utf8mb4"
-					collName = "utf8_bin"
+				collation, ok := CollationOf(c.Type)
+				if !ok && (c.Type == JSON || IsEnum(c.Type) || IsSet(c.Type)) {
+					collation, ok = DefaultCollation, true
+				}
+				if ok && collation != BinaryCollation {
+					charName = collation.CharacterSet
+					collName = collation.Name
+				}
+				if length, ok := CharacterLength(c.Type); ok {
+					maxLength = uint64(length)
+					octetLength = uint64(length)
+					if !IsBinary(c.Type) {
+						octetLength = uint64(length) * uint64(characterMaxLength(collation))
+					}
+				}
+				var def interface{}
+				if s, ok := c.DefaultString(); ok {
+					def = s
+				}
+				var generation string
+				if c.Generated != nil {
+					generation = c.Generated.String()
 				}
 				rows = append(rows, Row{
-					"def",                 // table_catalog
-					db.Name(),             // table_schema
-					t.Name(),              // table_name
-					c.Name,                // column_name
-					uint64(i),             // ordinal_position
-					c.Default,             // column_default
-					nullable,              // is_nullable
-					MySQLTypeName(c.Type), // data_type
-					nil,                   // character_maximum_length
-					nil,                   // character_octet_length
-					nil,                   // numeric_precision
-					nil,                   // numeric_scale
-					nil,                   // datetime_precision
-					charName,              // character_set_name
-					collName,              // collation_name
-					MySQLTypeName(c.Type), // column_type
-					"",                    // column_key
-					"",                    // extra
-					"select",              // privileges
-					"",                    // column_comment
-					"",                    // generation_expression
+					"def",                   // table_catalog
+					db.Name(),               // table_schema
+					t.Name(),                // table_name
+					c.Name,                  // column_name
+					uint64(i),               // ordinal_position
+					def,                     // column_default
+					nullable,                // is_nullable
+					MySQLTypeName(c.Type),   // data_type
+					maxLength,               // character_maximum_length
+					octetLength,             // character_octet_length
+					nil,                     // numeric_precision
+					nil,                     // numeric_scale
+					nil,                     // datetime_precision
+					charName,                // character_set_name
+					collName,                // collation_name
+					MySQLColumnType(c.Type), // column_type
+					"",                      // column_key
+					c.Extra(),               // extra
+					"select",                // privileges
+					"",                      // column_comment
+					generation,              // generation_expression
 				})
 			}
 		}
@@ -254,8 +312,8 @@ func schemataRowIter(c *Catalog) RowIter {
 		rows = append(rows, Row{
 			"def",
 			db.Name(),
-			"utf8mb4",
-			"utf8_bin",
+			DefaultCollation.CharacterSet,
+			DefaultCollation.Name,
 			nil,
 		})
 	}
@@ -263,6 +321,98 @@ func schemataRowIter(c *Catalog) RowIter {
 	return RowsToRowIter(rows...)
 }
 
+func statisticsRowIter(cat *Catalog) RowIter {
+	var tables = make(map[string]map[string]Table)
+	for _, db := range cat.AllDatabases() {
+		tables[db.Name()] = db.Tables()
+	}
+
+	var rows []Row
+	for _, idx := range cat.Indexes() {
+		var schema Schema
+		if t, ok := tables[idx.Database()][idx.Table()]; ok {
+			schema = t.Schema()
+		}
+
+		indexType := "BTREE"
+		if _, ok := idx.(FullTextIndex); ok {
+			indexType = "FULLTEXT"
+		}
+
+		visible := "NO"
+		status := cat.IndexStatus(idx)
+		if status.IsUsable() {
+			visible = "YES"
+		}
+
+		for i, e := range idx.Expressions() {
+			var (
+				columnName interface{}
+				expression interface{} = e
+				nullable               = ""
+			)
+			for _, col := range schema {
+				if col.Source+"."+col.Name == e {
+					columnName, expression = col.Name, nil
+					if col.Nullable {
+						nullable = "YES"
+					}
+					break
+				}
+			}
+
+			rows = append(rows, Row{
+				"def",           // table_catalog
+				idx.Database(),  // table_schema
+				idx.Table(),     // table_name
+				int64(1),        // non_unique
+				idx.Database(),  // index_schema
+				idx.ID(),        // index_name
+				uint64(i + 1),   // seq_in_index
+				columnName,      // column_name
+				nil,             // collation
+				nil,             // cardinality
+				nil,             // sub_part
+				nil,             // packed
+				nullable,        // nullable
+				indexType,       // index_type
+				"",              // comment
+				"",              // index_comment
+				visible,         // is_visible
+				expression,      // expression
+				idx.Driver(),    // driver
+				status.String(), // status
+			})
+		}
+	}
+
+	return RowsToRowIter(rows...)
+}
+
+func indexStatisticsRowIter(cat *Catalog) RowIter {
+	var rows []Row
+	for _, idx := range cat.Indexes() {
+		usage := cat.IndexUsage(idx)
+
+		var lastUsed interface{}
+		if !usage.LastUsed.IsZero() {
+			lastUsed = usage.LastUsed
+		}
+
+		rows = append(rows, Row{
+			idx.Database(), // table_schema
+			idx.Table(),    // table_name
+			idx.ID(),       // index_name
+			idx.Driver(),   // driver
+			usage.Lookups,  // lookups
+			usage.Rows,     // rows_selected
+			lastUsed,       // last_used
+		})
+	}
+
+	return RowsToRowIter(rows...)
+}
+
 // NewInformationSchemaDatabase creates a new INFORMATION_SCHEMA Database.
 func NewInformationSchemaDatabase(cat *Catalog) Database {
 	return &informationSchemaDatabase{
@@ -296,6 +446,18 @@ func NewInformationSchemaDatabase(cat *Catalog) Database {
 				catalog: cat,
 				rowIter: schemataRowIter,
 			},
+			StatisticsTableName: &informationSchemaTable{
+				name:    StatisticsTableName,
+				schema:  statisticsSchema,
+				catalog: cat,
+				rowIter: statisticsRowIter,
+			},
+			IndexStatisticsTableName: &informationSchemaTable{
+				name:    IndexStatisticsTableName,
+				schema:  indexStatisticsSchema,
+				catalog: cat,
+				rowIter: indexStatisticsRowIter,
+			},
 		},
 	}
 }
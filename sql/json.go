@@ -0,0 +1,417 @@
+package sql
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrInvalidJSONText is returned when a string is not a valid JSON text.
+	ErrInvalidJSONText = errors.NewKind("invalid JSON text: %s")
+
+	// ErrConvertToJSON is returned when a value can't be converted to a
+	// JSON value.
+	ErrConvertToJSON = errors.NewKind("value of type %T can't be converted to JSON")
+
+	// ErrJSONNullKey is returned when NULL is used as the key of a member of
+	// a JSON object.
+	ErrJSONNullKey = errors.NewKind("JSON documents may not contain NULL member names")
+)
+
+// JSONDocument is a value of the JSON type. Val is the value of the document
+// decoded: objects are map[string]interface{}, arrays []interface{}, numbers
+// int64, uint64 or float64, and strings, booleans and null are string, bool
+// and nil, respectively.
+type JSONDocument struct {
+	Val interface{}
+}
+
+// String returns the JSON text of the document as MySQL writes it.
+func (d JSONDocument) String() string {
+	var buf bytes.Buffer
+	writeJSON(&buf, d.Val)
+	return buf.String()
+}
+
+// ParseJSON parses a JSON text and returns its decoded value.
+func ParseJSON(s string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, ErrInvalidJSONText.Wrap(err, s)
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, ErrInvalidJSONText.New(s)
+	}
+
+	return normalizeJSON(v)
+}
+
+// ToJSONValue returns the JSON value of a value of the given type, as it's
+// used in JSON documents and compared with JSON values. Values of the JSON
+// type are parsed, while strings are JSON strings even if they are a valid
+// JSON text.
+func ToJSONValue(t Type, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch {
+	case t == JSON:
+		doc, err := JSON.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return doc.(JSONDocument).Val, nil
+	case t == Boolean:
+		return Boolean.Convert(v)
+	case IsArray(t):
+		v, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+
+		array := v.([]interface{})
+		result := make([]interface{}, len(array))
+		for i, e := range array {
+			if result[i], err = ToJSONValue(UnderlyingType(t), e); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	case IsTime(t), IsDuration(t), IsYear(t), IsEnum(t), IsSet(t):
+		val, err := t.SQL(v)
+		if err != nil {
+			return nil, err
+		}
+		return val.ToString(), nil
+	case IsText(t):
+		if b, ok := v.([]byte); ok {
+			return string(b), nil
+		}
+		return Text.Convert(v)
+	default:
+		return normalizeJSON(v)
+	}
+}
+
+// JSONObjectKeys returns the keys of a JSON object in the order MySQL keeps
+// them, which is by length and then by their bytes.
+func JSONObjectKeys(o map[string]interface{}) []string {
+	keys := make([]string, 0, len(o))
+	for k := range o {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// normalizeJSON returns the JSON value of a Go value, with all its numbers
+// converted to int64, uint64 or float64.
+func normalizeJSON(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil, bool, string, int64, uint64, float64:
+		return v, nil
+	case JSONDocument:
+		return v.Val, nil
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case uint:
+		return uint64(v), nil
+	case uint8:
+		return uint64(v), nil
+	case uint16:
+		return uint64(v), nil
+	case uint32:
+		return uint64(v), nil
+	case float32:
+		return float64(v), nil
+	case decimal.Decimal:
+		f, _ := v.Float64()
+		return f, nil
+	case json.Number:
+		return jsonNumber(string(v))
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		return v.Format(TimestampLayout), nil
+	case GeometryValue:
+		return normalizeJSON(geoJSON(v))
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			e, err := normalizeJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = e
+		}
+		return result, nil
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			e, err := normalizeJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = e
+		}
+		return result, nil
+	default:
+		// Any other value is normalized by encoding it and decoding it
+		// again, as long as it can be encoded.
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return nil, ErrConvertToJSON.New(v)
+		}
+		return ParseJSON(string(bs))
+	}
+}
+
+// jsonNumber returns the value of a JSON number, which is an integer unless
+// it has a fraction or an exponent, or it's out of the range of integers.
+func jsonNumber(s string) (interface{}, error) {
+	if !strings.ContainsAny(s, ".eE") {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, nil
+		}
+
+		if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return n, nil
+		}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, ErrInvalidJSONText.New(s)
+	}
+	return f, nil
+}
+
+func writeJSON(buf *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case uint64:
+		buf.WriteString(strconv.FormatUint(v, 10))
+	case float64:
+		writeJSONFloat(buf, v)
+	case string:
+		writeJSONString(buf, v)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeJSON(buf, e)
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		buf.WriteByte('{')
+		for i, k := range JSONObjectKeys(v) {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeJSONString(buf, k)
+			buf.WriteString(": ")
+			writeJSON(buf, v[k])
+		}
+		buf.WriteByte('}')
+	default:
+		v, err := normalizeJSON(v)
+		if err != nil {
+			buf.WriteString("null")
+			return
+		}
+		writeJSON(buf, v)
+	}
+}
+
+// writeJSONFloat writes a double, which always has a fraction or an exponent
+// so it's not taken for an integer.
+func writeJSONFloat(buf *bytes.Buffer, f float64) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		buf.WriteString("null")
+		return
+	}
+
+	bs, _ := json.Marshal(f)
+	buf.Write(bs)
+	if !bytes.ContainsAny(bs, ".e") {
+		buf.WriteString(".0")
+	}
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	// Encode always terminates the value with a new line.
+	buf.Truncate(buf.Len() - 1)
+}
+
+// jsonTypeRanks are the ranks of the types of JSON values in comparisons,
+// where values of a type with a greater rank are greater.
+var jsonTypeRanks = map[reflect.Kind]int{
+	reflect.Invalid: 0,
+	reflect.Int64:   1,
+	reflect.Uint64:  1,
+	reflect.Float64: 1,
+	reflect.String:  2,
+	reflect.Map:     3,
+	reflect.Slice:   4,
+	reflect.Bool:    5,
+}
+
+// compareJSON compares two JSON values as MySQL does. Values of different
+// types are ordered by their types, numbers are compared by their values,
+// strings by their bytes, and arrays element by element. Objects are only
+// meaningfully compared for equality.
+func compareJSON(a, b interface{}) int {
+	ra := jsonTypeRanks[reflect.ValueOf(a).Kind()]
+	rb := jsonTypeRanks[reflect.ValueOf(b).Kind()]
+	if ra != rb {
+		return compareInts(ra, rb)
+	}
+
+	switch a := a.(type) {
+	case nil:
+		return 0
+	case bool:
+		b := b.(bool)
+		if a == b {
+			return 0
+		} else if !a {
+			return -1
+		}
+		return 1
+	case string:
+		return strings.Compare(a, b.(string))
+	case []interface{}:
+		b := b.([]interface{})
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if cmp := compareJSON(a[i], b[i]); cmp != 0 {
+				return cmp
+			}
+		}
+		return compareInts(len(a), len(b))
+	case map[string]interface{}:
+		b := b.(map[string]interface{})
+		if len(a) != len(b) {
+			return compareInts(len(a), len(b))
+		}
+
+		ka, kb := JSONObjectKeys(a), JSONObjectKeys(b)
+		for i := range ka {
+			if cmp := strings.Compare(ka[i], kb[i]); cmp != 0 {
+				return cmp
+			}
+		}
+
+		for _, k := range ka {
+			if cmp := compareJSON(a[k], b[k]); cmp != 0 {
+				return cmp
+			}
+		}
+		return 0
+	default:
+		return compareJSONNumbers(a, b)
+	}
+}
+
+func compareJSONNumbers(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return compareInt64s(a, b)
+		case uint64:
+			if a < 0 {
+				return -1
+			}
+			return compareUint64s(uint64(a), b)
+		}
+	case uint64:
+		switch b := b.(type) {
+		case int64:
+			if b < 0 {
+				return 1
+			}
+			return compareUint64s(a, uint64(b))
+		case uint64:
+			return compareUint64s(a, b)
+		}
+	}
+
+	fa, fb := jsonFloat(a), jsonFloat(b)
+	if fa < fb {
+		return -1
+	} else if fa > fb {
+		return 1
+	}
+	return 0
+}
+
+func jsonFloat(v interface{}) float64 {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	default:
+		return v.(float64)
+	}
+}
+
+func compareInts(a, b int) int {
+	return compareInt64s(int64(a), int64(b))
+}
+
+func compareInt64s(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareUint64s(a, b uint64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,100 @@
+package sql
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSON(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected interface{}
+	}{
+		{`null`, nil},
+		{`true`, true},
+		{`"a"`, "a"},
+		{`1`, int64(1)},
+		{`-1`, int64(-1)},
+		{`18446744073709551615`, uint64(math.MaxUint64)},
+		{`1.0`, float64(1)},
+		{`1e2`, float64(100)},
+		{`[1, "a", null]`, []interface{}{int64(1), "a", nil}},
+		{` {"a": {"b": [2.5]}} `, map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{2.5}}}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.text, func(t *testing.T) {
+			v, err := ParseJSON(tt.text)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+
+	for _, text := range []string{``, `a`, `{"a": 1`, `[1] [2]`, `'a'`} {
+		t.Run(text, func(t *testing.T) {
+			_, err := ParseJSON(text)
+			require.Error(t, err)
+			require.True(t, ErrInvalidJSONText.Is(err))
+		})
+	}
+}
+
+func TestJSONDocumentString(t *testing.T) {
+	testCases := []struct {
+		val      interface{}
+		expected string
+	}{
+		{nil, `null`},
+		{false, `false`},
+		{int64(-3), `-3`},
+		{uint64(math.MaxUint64), `18446744073709551615`},
+		{float64(2), `2.0`},
+		{1.5, `1.5`},
+		{1e21, `1e+21`},
+		{`a"<b>`, `"a\"<b>"`},
+		{[]interface{}{}, `[]`},
+		{map[string]interface{}{}, `{}`},
+		{
+			map[string]interface{}{"bb": int64(1), "c": []interface{}{"x", nil}, "a": map[string]interface{}{}},
+			`{"a": {}, "c": ["x", null], "bb": 1}`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.expected, func(t *testing.T) {
+			require.Equal(t, tt.expected, JSONDocument{Val: tt.val}.String())
+		})
+	}
+}
+
+func TestToJSONValue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		typ      Type
+		val      interface{}
+		expected interface{}
+	}{
+		{"null", Int64, nil, nil},
+		{"int", Int32, int32(1), int64(1)},
+		{"uint", Uint8, uint8(1), uint64(1)},
+		{"float", Float32, float32(1.5), 1.5},
+		{"boolean", Boolean, int8(1), true},
+		{"text", Text, `[1]`, `[1]`},
+		{"blob", Blob, []byte("a"), "a"},
+		{"json", JSON, `[1]`, []interface{}{int64(1)}},
+		{"json document", JSON, JSONDocument{Val: "a"}, "a"},
+		{"date", Date, time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC), "2019-01-02"},
+		{"array", Array(Int32), []interface{}{int32(1), int32(2)}, []interface{}{int64(1), int64(2)}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := ToJSONValue(tt.typ, tt.val)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+}
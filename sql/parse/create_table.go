@@ -0,0 +1,491 @@
+package parse
+
+import (
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/vitess/go/vt/sqlparser"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrInvalidDefaultValue is returned when the default value of a column
+	// is not valid for it.
+	ErrInvalidDefaultValue = errors.NewKind("invalid default value for '%s'")
+
+	// ErrInvalidAutoIncrement is returned when an AUTO_INCREMENT column is
+	// not an integer column.
+	ErrInvalidAutoIncrement = errors.NewKind("incorrect column specifier for column '%s'")
+
+	// ErrMultipleAutoIncrement is returned when a table has more than one
+	// AUTO_INCREMENT column.
+	ErrMultipleAutoIncrement = errors.NewKind("incorrect table definition; there can be only one auto column")
+
+	// ErrUnknownColumn is returned when an expression of a column definition
+	// refers to a column that is not in the table.
+	ErrUnknownColumn = errors.NewKind("unknown column '%s' in '%s'")
+
+	// ErrInvalidColumnReference is returned when an expression of a column
+	// definition refers to a column it can't refer to, because its value is
+	// not known yet when the expression is computed.
+	ErrInvalidColumnReference = errors.NewKind("%s of column '%s' cannot refer to column '%s'")
+)
+
+// columnOptions are the options of a column definition that are taken out
+// of a CREATE TABLE statement before parsing it, because the parser only
+// supports some of them or in a fixed order.
+type columnOptions struct {
+	// Default is the default value of the column, if HasDefault is true.
+	Default    string
+	HasDefault bool
+	// AutoIncrement is true for AUTO_INCREMENT columns.
+	AutoIncrement bool
+	// Generated is the expression of a generated column, if any.
+	Generated string
+	// Stored is true if the generated column is STORED instead of VIRTUAL.
+	Stored bool
+}
+
+// tableConstraintKeywords are the words the definitions in a CREATE TABLE
+// statement that are not column definitions start with.
+var tableConstraintKeywords = map[string]bool{
+	"check":      true,
+	"constraint": true,
+	"foreign":    true,
+	"fulltext":   true,
+	"index":      true,
+	"key":        true,
+	"primary":    true,
+	"spatial":    true,
+	"unique":     true,
+}
+
+func parseCreateTable(ctx *sql.Context, s string) (sql.Node, error) {
+	query, options := extractColumnOptions(s)
+
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.CreateStr {
+		return convert(ctx, stmt, query)
+	}
+
+	return convertCreateTable(ddl, options)
+}
+
+// extractColumnOptions removes the DEFAULT, AUTO_INCREMENT and generated
+// column options of the column definitions of a CREATE TABLE statement and
+// returns the statement without them, along with the options of each column
+// by its lowercase name.
+func extractColumnOptions(s string) (string, map[string]*columnOptions) {
+	var defs *sqlToken
+	tokens := tokenize(s)
+	for i := range tokens {
+		if tokens[i].isGroup() {
+			defs = &tokens[i]
+			break
+		}
+	}
+
+	if defs == nil {
+		return s, nil
+	}
+
+	offset := defs.start + 1
+	body := s[offset : defs.end-1]
+	options := make(map[string]*columnOptions)
+	var cuts [][2]int
+	cut := func(from, to sqlToken) {
+		cuts = append(cuts, [2]int{offset + from.start, offset + to.end})
+	}
+
+	for _, def := range splitTokens(tokenize(body), ",") {
+		if len(def) == 0 || tableConstraintKeywords[strings.ToLower(def[0].text)] {
+			continue
+		}
+
+		opts := new(columnOptions)
+		for j := 1; j < len(def); j++ {
+			switch strings.ToLower(def[j].text) {
+			case "default":
+				end := defaultValueEnd(def, j+1)
+				if end == j+1 {
+					continue
+				}
+
+				opts.Default = body[def[j+1].start:def[end-1].end]
+				opts.HasDefault = true
+				cut(def[j], def[end-1])
+				j = end - 1
+			case "auto_increment":
+				opts.AutoIncrement = true
+				cut(def[j], def[j])
+			case "generated", "as":
+				k := j
+				if strings.ToLower(def[k].text) == "generated" {
+					if k+2 >= len(def) ||
+						strings.ToLower(def[k+1].text) != "always" ||
+						strings.ToLower(def[k+2].text) != "as" {
+						continue
+					}
+					k += 2
+				}
+
+				if k+1 >= len(def) || !def[k+1].isGroup() {
+					continue
+				}
+
+				expr := def[k+1].text
+				opts.Generated = expr[1 : len(expr)-1]
+				end := k + 2
+				if end < len(def) {
+					switch strings.ToLower(def[end].text) {
+					case "stored":
+						opts.Stored = true
+						end++
+					case "virtual":
+						end++
+					}
+				}
+
+				cut(def[j], def[end-1])
+				j = end - 1
+			}
+		}
+
+		options[strings.ToLower(unquoteIdent(def[0].text))] = opts
+	}
+
+	if len(cuts) == 0 {
+		return s, options
+	}
+
+	var buf strings.Builder
+	var pos int
+	for _, c := range cuts {
+		buf.WriteString(s[pos:c[0]])
+		pos = c[1]
+	}
+	buf.WriteString(s[pos:])
+
+	return buf.String(), options
+}
+
+// defaultValueEnd returns the position after the last token of the default
+// value of a column starting at the given position, which can be a literal
+// with an optional sign, an expression in parentheses or a function call
+// such as CURRENT_TIMESTAMP(6).
+func defaultValueEnd(tokens []sqlToken, pos int) int {
+	if pos < len(tokens) && (tokens[pos].text == "-" || tokens[pos].text == "+") {
+		pos++
+	}
+
+	if pos >= len(tokens) {
+		return pos
+	}
+	pos++
+
+	if pos < len(tokens) && tokens[pos].isGroup() && isWordByte(tokens[pos-1].text[0]) {
+		pos++
+	}
+
+	return pos
+}
+
+// applyColumnOptions sets the default values, AUTO_INCREMENT and generated
+// column expressions of the options to the columns of the schema.
+func applyColumnOptions(schema sql.Schema, options map[string]*columnOptions) error {
+	var autoIncrement bool
+	for i, col := range schema {
+		opts, ok := options[strings.ToLower(col.Name)]
+		if !ok {
+			opts = new(columnOptions)
+		}
+
+		if opts.AutoIncrement || col.AutoIncrement {
+			if !sql.IsInteger(col.Type) {
+				return ErrInvalidAutoIncrement.New(col.Name)
+			}
+
+			if autoIncrement {
+				return ErrMultipleAutoIncrement.New()
+			}
+
+			autoIncrement = true
+			col.AutoIncrement = true
+		}
+
+		if opts.Generated != "" {
+			if opts.HasDefault || col.AutoIncrement {
+				return ErrInvalidDefaultValue.New(col.Name)
+			}
+
+			e, err := columnExpression(schema, opts.Generated, "generated column function")
+			if err != nil {
+				return err
+			}
+
+			col.Generated = e
+			col.Virtual = !opts.Stored
+		}
+
+		if opts.HasDefault {
+			if col.AutoIncrement {
+				return ErrInvalidDefaultValue.New(col.Name)
+			}
+
+			if err := setColumnDefault(schema, col, opts.Default); err != nil {
+				return err
+			}
+		}
+
+		schema[i] = col
+	}
+
+	for i, col := range schema {
+		if col.Generated != nil {
+			err := checkColumnReferences(schema, i, col.Generated, "generated column expression",
+				func(j int, ref *sql.Column) bool {
+					return ref.AutoIncrement || (ref.Generated != nil && j >= i)
+				})
+			if err != nil {
+				return err
+			}
+		}
+
+		if col.DefaultExpr != nil {
+			err := checkColumnReferences(schema, i, col.DefaultExpr, "default value expression",
+				func(j int, ref *sql.Column) bool {
+					return ref.AutoIncrement || ref.Generated != nil || (ref.DefaultExpr != nil && j >= i)
+				})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// setColumnDefault sets the default value of the column, which is the
+// value of the expression if it's a constant and the expression itself
+// otherwise, so it's computed every time a row is inserted.
+func setColumnDefault(schema sql.Schema, col *sql.Column, def string) error {
+	e, err := columnExpression(schema, def, "default value expression")
+	if err != nil {
+		return err
+	}
+
+	if !isConstant(e) {
+		col.DefaultExpr = e
+		return nil
+	}
+
+	v, err := e.Eval(sql.NewEmptyContext(), nil)
+	if err != nil {
+		return ErrInvalidDefaultValue.Wrap(err, col.Name)
+	}
+
+	if v == nil {
+		if !col.Nullable {
+			return ErrInvalidDefaultValue.New(col.Name)
+		}
+		col.Default = nil
+		return nil
+	}
+
+	v, err = col.Type.Convert(v)
+	if err != nil {
+		return ErrInvalidDefaultValue.Wrap(err, col.Name)
+	}
+
+	col.Default = v
+	return nil
+}
+
+// columnExpression parses an expression of a column definition, whose
+// columns are fields of the rows of the table with the given schema.
+func columnExpression(schema sql.Schema, s, where string) (sql.Expression, error) {
+	stmt, err := sqlparser.Parse("SELECT " + s)
+	if err != nil {
+		return nil, err
+	}
+
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return nil, ErrUnsupportedSyntax.New(s)
+	}
+
+	ae, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, ErrUnsupportedSyntax.New(s)
+	}
+
+	e, err := exprToExpression(ae.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+		uc, ok := e.(*expression.UnresolvedColumn)
+		if !ok {
+			return e, nil
+		}
+
+		for i, col := range schema {
+			if strings.EqualFold(col.Name, uc.Name()) {
+				return expression.NewGetField(i, col.Type, col.Name, col.Nullable), nil
+			}
+		}
+
+		return nil, ErrUnknownColumn.New(uc.Name(), where)
+	})
+}
+
+// checkColumnReferences returns an error if the expression of the column in
+// the given position refers to itself or to any column for which invalid
+// returns true.
+func checkColumnReferences(
+	schema sql.Schema,
+	pos int,
+	e sql.Expression,
+	what string,
+	invalid func(int, *sql.Column) bool,
+) error {
+	var err error
+	expression.Inspect(e, func(e sql.Expression) bool {
+		gf, ok := e.(*expression.GetField)
+		if !ok || err != nil {
+			return err == nil
+		}
+
+		i := gf.Index()
+		if i == pos || invalid(i, schema[i]) {
+			err = ErrInvalidColumnReference.New(what, schema[pos].Name, schema[i].Name)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// isConstant reports whether the expression has the same value every time
+// it's evaluated, because it has no columns nor functions.
+func isConstant(e sql.Expression) bool {
+	constant := true
+	expression.Inspect(e, func(e sql.Expression) bool {
+		switch e.(type) {
+		case *expression.GetField, *expression.UnresolvedFunction:
+			constant = false
+		}
+		return constant
+	})
+	return constant
+}
+
+// sqlToken is a word, a quoted string or identifier, an expression in
+// parentheses or any other character of a SQL statement, along with its
+// position in the statement.
+type sqlToken struct {
+	text       string
+	start, end int
+}
+
+func (t sqlToken) isGroup() bool {
+	return strings.HasPrefix(t.text, "(")
+}
+
+// tokenize splits a SQL statement in tokens.
+func tokenize(s string) []sqlToken {
+	var tokens []sqlToken
+	for i := 0; i < len(s); {
+		start := i
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(s, i)
+		case c == '(':
+			i = skipGroup(s, i)
+		case isWordByte(c):
+			for i < len(s) && isWordByte(s[i]) {
+				i++
+			}
+		default:
+			i++
+		}
+		tokens = append(tokens, sqlToken{s[start:i], start, i})
+	}
+	return tokens
+}
+
+// splitTokens splits the tokens by the given separator.
+func splitTokens(tokens []sqlToken, sep string) [][]sqlToken {
+	var result [][]sqlToken
+	var current []sqlToken
+	for _, t := range tokens {
+		if t.text == sep {
+			result = append(result, current)
+			current = nil
+			continue
+		}
+		current = append(current, t)
+	}
+	return append(result, current)
+}
+
+// skipQuoted returns the position after the quoted string or identifier
+// starting at the given position.
+func skipQuoted(s string, i int) int {
+	quote := s[i]
+	for i++; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote != '`':
+			i++
+		case s[i] == quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// skipGroup returns the position after the closing parenthesis of the
+// expression in parentheses starting at the given position.
+func skipGroup(s string, i int) int {
+	var depth int
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			i = skipQuoted(s, i) - 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || c == '.' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func unquoteIdent(s string) string {
+	if len(s) >= 2 && (s[0] == '`' || s[0] == '"') && s[len(s)-1] == s[0] {
+		q := string(s[0])
+		return strings.Replace(s[1:len(s)-1], q+q, q, -1)
+	}
+	return s
+}
@@ -0,0 +1,28 @@
+package parse
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var maxExecutionTimeHintRegex = regexp.MustCompile(
+	`(?i)^\s*select\s+/\*\+[^*]*\bmax_execution_time\s*\(\s*(\d+)\s*\)[^*]*\*/`,
+)
+
+// MaxExecutionTimeHint returns the number of milliseconds given in the
+// MAX_EXECUTION_TIME optimizer hint of the query and whether the query has
+// the hint at all. As in MySQL, the hint is only taken into account right
+// after the SELECT keyword of the top-level query.
+func MaxExecutionTimeHint(query string) (int64, bool) {
+	m := maxExecutionTimeHintRegex.FindStringSubmatch(query)
+	if m == nil {
+		return 0, false
+	}
+
+	ms, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return ms, true
+}
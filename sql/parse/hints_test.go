@@ -0,0 +1,33 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxExecutionTimeHint(t *testing.T) {
+	testCases := []struct {
+		query string
+		ms    int64
+		ok    bool
+	}{
+		{"SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM foo", 1000, true},
+		{"select /*+max_execution_time( 20 )*/ 1", 20, true},
+		{"  SELECT /*+ BKA(t1) MAX_EXECUTION_TIME(5) NO_ICP(t1) */ 1", 5, true},
+		{"SELECT 1 /*+ MAX_EXECUTION_TIME(1000) */", 0, false},
+		{"SELECT /* MAX_EXECUTION_TIME(1000) */ 1", 0, false},
+		{"SELECT * FROM (SELECT /*+ MAX_EXECUTION_TIME(1000) */ 1) t", 0, false},
+		{"DELETE /*+ MAX_EXECUTION_TIME(1000) */ FROM foo", 0, false},
+		{"SELECT 1", 0, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+			ms, ok := MaxExecutionTimeHint(tt.query)
+			require.Equal(tt.ok, ok)
+			require.Equal(tt.ms, ms)
+		})
+	}
+}
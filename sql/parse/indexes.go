@@ -45,10 +45,34 @@ func parseCreateIndex(s string) (sql.Node, error) {
 	var name, table, driver string
 	var exprs []string
 	var config = make(map[string]string)
+
+	var indexType parseFunc = expect("index")
+	var using parseFunc = parseFuncs{
+		expect("using"),
+		skipSpaces,
+		readIdent(&driver),
+		skipSpaces,
+	}.exec
+
+	// FULLTEXT indexes are created with the full-text driver unless another
+	// one is given.
+	fulltext := createFullTextIndexRegex.MatchString(strings.ToLower(s))
+	if fulltext {
+		indexType = parseFuncs{expect("fulltext"), skipSpaces, expect("index")}.exec
+		using = optional(using)
+	}
+
+	// SPATIAL indexes are created with the spatial driver unless another
+	// one is given.
+	spatial := createSpatialIndexRegex.MatchString(strings.ToLower(s))
+	if spatial {
+		indexType = parseFuncs{expect("spatial"), skipSpaces, expect("index")}.exec
+		using = optional(using)
+	}
 	err := parseFuncs{
 		expect("create"),
 		skipSpaces,
-		expect("index"),
+		indexType,
 		skipSpaces,
 		readIdent(&name),
 		skipSpaces,
@@ -56,10 +80,7 @@ func parseCreateIndex(s string) (sql.Node, error) {
 		skipSpaces,
 		readIdent(&table),
 		skipSpaces,
-		expect("using"),
-		skipSpaces,
-		readIdent(&driver),
-		skipSpaces,
+		using,
 		readExprs(&exprs),
 		skipSpaces,
 		optional(
@@ -75,6 +96,14 @@ func parseCreateIndex(s string) (sql.Node, error) {
 		return nil, err
 	}
 
+	if fulltext && driver == "" {
+		driver = sql.FullTextDriverID
+	}
+
+	if spatial && driver == "" {
+		driver = sql.SpatialDriverID
+	}
+
 	var indexExprs = make([]sql.Expression, len(exprs))
 	for i, e := range exprs {
 		var err error
@@ -219,6 +248,50 @@ func parseDropIndex(str string) (sql.Node, error) {
 	), nil
 }
 
+// parseRebuildIndex parses both ALTER INDEX name ON table REBUILD and its
+// shorter form REINDEX name ON table, which can be followed by a config.
+func parseRebuildIndex(str string) (sql.Node, error) {
+	r := bufio.NewReader(strings.NewReader(str))
+
+	var name, table string
+	var config = make(map[string]string)
+
+	var start, end parseFunc = expect("reindex"), skipSpaces
+	if !strings.HasPrefix(strings.ToLower(str), "reindex") {
+		start = parseFuncs{expect("alter"), skipSpaces, expect("index")}.exec
+		end = parseFuncs{expect("rebuild"), skipSpaces}.exec
+	}
+
+	err := parseFuncs{
+		start,
+		skipSpaces,
+		readIdent(&name),
+		skipSpaces,
+		expect("on"),
+		skipSpaces,
+		readIdent(&table),
+		skipSpaces,
+		end,
+		optional(
+			expect("with"),
+			skipSpaces,
+			readKeyValue(config),
+			skipSpaces,
+		),
+		checkEOF,
+	}.exec(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewRebuildIndex(
+		name,
+		plan.NewUnresolvedTable(table, ""),
+		config,
+	), nil
+}
+
 func readExprs(exprs *[]string) parseFunc {
 	return func(rd *bufio.Reader) error {
 		var buf bytes.Buffer
@@ -114,6 +114,46 @@ func TestParseCreateIndex(t *testing.T) {
 			nil,
 			errUnexpectedSyntax,
 		},
+		{
+			"CREATE FULLTEXT INDEX idx ON foo (bar, baz)",
+			plan.NewCreateIndex(
+				"idx",
+				plan.NewUnresolvedTable("foo", ""),
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("bar"),
+					expression.NewUnresolvedColumn("baz"),
+				},
+				sql.FullTextDriverID,
+				make(map[string]string),
+			),
+			nil,
+		},
+		{
+			"CREATE FULLTEXT INDEX idx ON foo USING bar (baz)",
+			plan.NewCreateIndex(
+				"idx",
+				plan.NewUnresolvedTable("foo", ""),
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("baz"),
+				},
+				"bar",
+				make(map[string]string),
+			),
+			nil,
+		},
+		{
+			"CREATE SPATIAL INDEX idx ON foo (bar)",
+			plan.NewCreateIndex(
+				"idx",
+				plan.NewUnresolvedTable("foo", ""),
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("bar"),
+				},
+				sql.SpatialDriverID,
+				make(map[string]string),
+			),
+			nil,
+		},
 		{
 			"CREATE INDEX idx ON foo USING bar (baz) WITH (foo = bar)",
 			plan.NewCreateIndex(
@@ -0,0 +1,399 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/mushiyu/vitess/go/vt/sqlparser"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrInvalidJSONTable is returned when a JSON_TABLE is not valid.
+	ErrInvalidJSONTable = errors.NewKind("invalid JSON_TABLE: %s")
+
+	// ErrJSONTableAlias is returned when a JSON_TABLE has no alias.
+	ErrJSONTableAlias = errors.NewKind("every table function must have an alias")
+)
+
+// jsonTablePrefix is the prefix of the names of the tables JSON_TABLEs are
+// replaced with before parsing a query, as the parser doesn't support them.
+const jsonTablePrefix = "__json_table_"
+
+// extractJSONTables replaces the JSON_TABLEs in a query with tables named
+// after their position in the returned JSON tables, which have no name nor
+// child yet.
+func extractJSONTables(s string) (string, []*plan.JSONTable, error) {
+	var tables []*plan.JSONTable
+	query, err := replaceJSONTables(s, &tables)
+	if err != nil {
+		return "", nil, err
+	}
+	return query, tables, nil
+}
+
+func replaceJSONTables(s string, tables *[]*plan.JSONTable) (string, error) {
+	var buf strings.Builder
+	var pos int
+	tokens := tokenize(s)
+	for i, t := range tokens {
+		if !t.isGroup() {
+			continue
+		}
+
+		if i > 0 && strings.ToLower(tokens[i-1].text) == "json_table" {
+			table, err := parseJSONTable(t.text[1 : len(t.text)-1])
+			if err != nil {
+				return "", err
+			}
+
+			buf.WriteString(s[pos:tokens[i-1].start])
+			buf.WriteString(fmt.Sprintf("%s%d", jsonTablePrefix, len(*tables)))
+			pos = t.end
+			*tables = append(*tables, table)
+			continue
+		}
+
+		// JSON_TABLEs can also be in subqueries.
+		inner, err := replaceJSONTables(t.text[1:len(t.text)-1], tables)
+		if err != nil {
+			return "", err
+		}
+
+		if inner != t.text[1:len(t.text)-1] {
+			buf.WriteString(s[pos:t.start])
+			buf.WriteString("(" + inner + ")")
+			pos = t.end
+		}
+	}
+	buf.WriteString(s[pos:])
+
+	return buf.String(), nil
+}
+
+// parseJSONTable parses the arguments of a JSON_TABLE, which are the
+// document and the path of its rows followed by their columns.
+func parseJSONTable(s string) (*plan.JSONTable, error) {
+	args := splitTokens(tokenize(s), ",")
+	if len(args) != 2 || len(args[0]) == 0 {
+		return nil, ErrInvalidJSONTable.New(s)
+	}
+
+	doc, err := parseExpr(s[args[0][0].start:args[0][len(args[0])-1].end])
+	if err != nil {
+		return nil, err
+	}
+
+	path, columns, err := parseJSONTableColumns(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewJSONTable("", doc, path, columns), nil
+}
+
+// parseJSONTableColumns parses a path followed by the COLUMNS clause with
+// the columns for the values it matches.
+func parseJSONTableColumns(tokens []sqlToken) (*jsonpath.Path, []*plan.JSONTableColumn, error) {
+	if len(tokens) != 3 ||
+		strings.ToLower(tokens[1].text) != "columns" ||
+		!tokens[2].isGroup() {
+		return nil, nil, ErrInvalidJSONTable.New(joinTokens(tokens))
+	}
+
+	path, err := parseJSONTablePath(tokens[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := tokens[2].text[1 : len(tokens[2].text)-1]
+	var columns []*plan.JSONTableColumn
+	for _, def := range splitTokens(tokenize(body), ",") {
+		col, err := parseJSONTableColumn(def)
+		if err != nil {
+			return nil, nil, err
+		}
+		columns = append(columns, col)
+	}
+
+	return path, columns, nil
+}
+
+// parseJSONTableColumn parses the definition of a column of a JSON_TABLE,
+// which is either `name FOR ORDINALITY`, `name type [EXISTS] PATH path`
+// followed by the ON EMPTY and ON ERROR clauses, or
+// `NESTED [PATH] path COLUMNS (...)`.
+func parseJSONTableColumn(def []sqlToken) (*plan.JSONTableColumn, error) {
+	if len(def) < 2 {
+		return nil, ErrInvalidJSONTable.New(joinTokens(def))
+	}
+
+	if strings.ToLower(def[0].text) == "nested" {
+		rest := def[1:]
+		if strings.ToLower(rest[0].text) == "path" {
+			rest = rest[1:]
+		}
+
+		path, columns, err := parseJSONTableColumns(rest)
+		if err != nil {
+			return nil, err
+		}
+
+		return &plan.JSONTableColumn{Path: path, Nested: columns}, nil
+	}
+
+	col := &plan.JSONTableColumn{Name: unquoteIdent(def[0].text)}
+	if len(def) == 3 &&
+		strings.ToLower(def[1].text) == "for" &&
+		strings.ToLower(def[2].text) == "ordinality" {
+		col.Ordinality = true
+		col.Type = sql.Uint32
+		return col, nil
+	}
+
+	i := 1
+	for i < len(def) && !strings.EqualFold(def[i].text, "path") && !strings.EqualFold(def[i].text, "exists") {
+		i++
+	}
+
+	if i == 1 || i >= len(def) {
+		return nil, ErrInvalidJSONTable.New(joinTokens(def))
+	}
+
+	typ, err := parseJSONTableColumnType(joinTokens(def[1:i]))
+	if err != nil {
+		return nil, err
+	}
+	col.Type = typ
+
+	if strings.EqualFold(def[i].text, "exists") {
+		col.Exists = true
+		i++
+	}
+
+	if i+1 >= len(def) || !strings.EqualFold(def[i].text, "path") {
+		return nil, ErrInvalidJSONTable.New(joinTokens(def))
+	}
+
+	col.Path, err = parseJSONTablePath(def[i+1])
+	if err != nil {
+		return nil, err
+	}
+
+	rest := def[i+2:]
+	if col.Exists && len(rest) > 0 {
+		return nil, ErrInvalidJSONTable.New(joinTokens(def))
+	}
+
+	var onEmpty, onError bool
+	for len(rest) > 0 {
+		var fallback plan.JSONTableFallback
+		var n int
+		switch strings.ToLower(rest[0].text) {
+		case "null":
+			n = 1
+		case "error":
+			fallback.Error = true
+			n = 1
+		case "default":
+			if len(rest) < 2 {
+				return nil, ErrInvalidJSONTable.New(joinTokens(def))
+			}
+
+			fallback.Default, err = parseJSONTableDefault(rest[1])
+			if err != nil {
+				return nil, err
+			}
+			n = 2
+		default:
+			return nil, ErrInvalidJSONTable.New(joinTokens(def))
+		}
+
+		if len(rest) < n+2 || !strings.EqualFold(rest[n].text, "on") {
+			return nil, ErrInvalidJSONTable.New(joinTokens(def))
+		}
+
+		switch strings.ToLower(rest[n+1].text) {
+		case "empty":
+			if onEmpty || onError {
+				return nil, ErrInvalidJSONTable.New(joinTokens(def))
+			}
+			onEmpty = true
+			col.OnEmpty = fallback
+		case "error":
+			if onError {
+				return nil, ErrInvalidJSONTable.New(joinTokens(def))
+			}
+			onError = true
+			col.OnError = fallback
+		default:
+			return nil, ErrInvalidJSONTable.New(joinTokens(def))
+		}
+
+		rest = rest[n+2:]
+	}
+
+	return col, nil
+}
+
+// parseJSONTableColumnType parses the type of a column of a JSON_TABLE as
+// the type of a column of a table.
+func parseJSONTableColumnType(s string) (sql.Type, error) {
+	stmt, err := sqlparser.Parse(fmt.Sprintf("CREATE TABLE t (c %s)", s))
+	if err != nil {
+		return nil, err
+	}
+
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.TableSpec == nil || len(ddl.TableSpec.Columns) != 1 {
+		return nil, ErrInvalidJSONTable.New(s)
+	}
+
+	return columnTypeToType(&ddl.TableSpec.Columns[0].Type)
+}
+
+// parseJSONTablePath parses a path, which must be a string literal.
+func parseJSONTablePath(t sqlToken) (*jsonpath.Path, error) {
+	s, err := parseJSONTableString(t)
+	if err != nil {
+		return nil, err
+	}
+	return jsonpath.Parse(s)
+}
+
+// parseJSONTableDefault parses the default value of a column, which is a
+// string literal with a JSON text. If it's not valid JSON, it's used as a
+// JSON string.
+func parseJSONTableDefault(t sqlToken) (interface{}, error) {
+	s, err := parseJSONTableString(t)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := sql.ParseJSON(s)
+	if err != nil {
+		return s, nil
+	}
+	return v, nil
+}
+
+func parseJSONTableString(t sqlToken) (string, error) {
+	if !strings.HasPrefix(t.text, "'") && !strings.HasPrefix(t.text, `"`) {
+		return "", ErrInvalidJSONTable.New(t.text)
+	}
+
+	e, err := parseExpr(t.text)
+	if err != nil {
+		return "", err
+	}
+
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return "", ErrInvalidJSONTable.New(t.text)
+	}
+
+	s, ok := lit.Value().(string)
+	if !ok {
+		return "", ErrInvalidJSONTable.New(t.text)
+	}
+	return s, nil
+}
+
+func joinTokens(tokens []sqlToken) string {
+	parts := make([]string, len(tokens))
+	for i, t := range tokens {
+		parts[i] = t.text
+	}
+	return strings.Join(parts, " ")
+}
+
+// resolveJSONTables replaces the tables the JSON_TABLEs were replaced with
+// in the node with the JSON tables, named after their aliases. JSON tables
+// joined with other tables get them as a child, so their documents can use
+// the columns of the tables before them.
+func resolveJSONTables(n sql.Node, tables []*plan.JSONTable) (sql.Node, error) {
+	jsonTable := func(n sql.Node) *plan.JSONTable {
+		t, ok := n.(*plan.JSONTable)
+		if ok && t.Child == nil {
+			return t
+		}
+		return nil
+	}
+
+	n, err := plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		switch n := n.(type) {
+		case *plan.SubqueryAlias:
+			// Subqueries are opaque, so they are not transformed by
+			// TransformUp.
+			child, err := resolveJSONTables(n.Child, tables)
+			if err != nil {
+				return nil, err
+			}
+			return plan.NewSubqueryAlias(n.Name(), child), nil
+		case *plan.TableAlias:
+			t, ok := n.Child.(*plan.UnresolvedTable)
+			if !ok {
+				return n, nil
+			}
+
+			idx := jsonTableIndex(t.Name(), tables)
+			if idx < 0 {
+				return n, nil
+			}
+
+			jt := tables[idx]
+			return plan.NewJSONTable(n.Name(), jt.Doc, jt.Path, jt.Columns), nil
+		case *plan.CrossJoin:
+			if t := jsonTable(n.Right); t != nil {
+				return t.WithChildren(n.Left)
+			}
+		case *plan.InnerJoin:
+			if t := jsonTable(n.Right); t != nil {
+				child, err := t.WithChildren(n.Left)
+				if err != nil {
+					return nil, err
+				}
+				return plan.NewFilter(n.Cond, child), nil
+			}
+		case *plan.LeftJoin, *plan.RightJoin:
+			children := n.Children()
+			if jsonTable(children[0]) != nil || jsonTable(children[1]) != nil {
+				return nil, ErrUnsupportedFeature.New("JSON_TABLE in outer joins")
+			}
+		}
+		return n, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Any table that is left is a JSON_TABLE without an alias.
+	plan.Inspect(n, func(n sql.Node) bool {
+		if t, ok := n.(*plan.UnresolvedTable); ok && jsonTableIndex(t.Name(), tables) >= 0 {
+			err = ErrJSONTableAlias.New()
+		}
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func jsonTableIndex(name string, tables []*plan.JSONTable) int {
+	if !strings.HasPrefix(name, jsonTablePrefix) {
+		return -1
+	}
+
+	for i := range tables {
+		if name == fmt.Sprintf("%s%d", jsonTablePrefix, i) {
+			return i
+		}
+	}
+	return -1
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
 	"github.com/mushiyu/go-mysql-server/sql"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
 	"github.com/mushiyu/go-mysql-server/sql/expression/function"
@@ -34,22 +35,61 @@ var (
 
 	// ErrInvalidSortOrder is returned when a sort order is not valid.
 	ErrInvalidSortOrder = errors.NewKind("invalid sort order: %s")
+
+	// ErrInvalidDecimal is returned when the precision or the scale of a
+	// DECIMAL type is not valid.
+	ErrInvalidDecimal = errors.NewKind("invalid DECIMAL(%d,%d): precision must be between 1 and %d, and scale between 0 and %d and not greater than the precision")
+
+	// ErrInvalidTimePrecision is returned when the fractional seconds
+	// precision of a TIME, DATETIME or TIMESTAMP type is not valid.
+	ErrInvalidTimePrecision = errors.NewKind("invalid %s(%d): fractional seconds precision must be between 0 and %d")
+
+	// ErrDuplicateMember is returned when an ENUM or SET type has the same
+	// member more than once.
+	ErrDuplicateMember = errors.NewKind("duplicated value %q in %s")
+
+	// ErrTooManySetMembers is returned when a SET type has more members than
+	// the allowed ones.
+	ErrTooManySetMembers = errors.NewKind("too many members in SET, the maximum is %d")
+
+	// ErrInvalidSetMember is returned when a member of a SET type contains a
+	// comma, which is the separator of the elements of its values.
+	ErrInvalidSetMember = errors.NewKind("invalid SET member %q: members can't contain commas")
+
+	// ErrInvalidStringLength is returned when the length of a CHAR, VARCHAR,
+	// BINARY or VARBINARY type is not valid.
+	ErrInvalidStringLength = errors.NewKind("invalid %s(%d): length must be between 0 and %d")
+)
+
+const (
+	// maxCharLength is the maximum length of CHAR and BINARY types.
+	maxCharLength = 255
+	// maxVarCharLength is the maximum length of VARCHAR and VARBINARY types.
+	maxVarCharLength = 65535
 )
 
 var (
-	describeTablesRegex  = regexp.MustCompile(`^(describe|desc)\s+table\s+(.*)`)
-	createIndexRegex     = regexp.MustCompile(`^create\s+index\s+`)
-	dropIndexRegex       = regexp.MustCompile(`^drop\s+index\s+`)
-	showIndexRegex       = regexp.MustCompile(`^show\s+(index|indexes|keys)\s+(from|in)\s+\S+\s*`)
-	showCreateRegex      = regexp.MustCompile(`^show create\s+\S+\s*`)
-	showVariablesRegex   = regexp.MustCompile(`^show\s+(.*)?variables\s*`)
-	showWarningsRegex    = regexp.MustCompile(`^show\s+warnings\s*`)
-	showCollationRegex   = regexp.MustCompile(`^show\s+collation\s*`)
-	describeRegex        = regexp.MustCompile(`^(describe|desc|explain)\s+(.*)\s+`)
-	fullProcessListRegex = regexp.MustCompile(`^show\s+(full\s+)?processlist$`)
-	unlockTablesRegex    = regexp.MustCompile(`^unlock\s+tables$`)
-	lockTablesRegex      = regexp.MustCompile(`^lock\s+tables\s`)
-	setRegex             = regexp.MustCompile(`^set\s+`)
+	describeTablesRegex      = regexp.MustCompile(`^(describe|desc)\s+table\s+(.*)`)
+	createIndexRegex         = regexp.MustCompile(`^create\s+((fulltext|spatial)\s+)?index\s+`)
+	createTableRegex         = regexp.MustCompile(`^create\s+table\s+`)
+	createFullTextIndexRegex = regexp.MustCompile(`^create\s+fulltext\s+`)
+	createSpatialIndexRegex  = regexp.MustCompile(`^create\s+spatial\s+`)
+	dropIndexRegex           = regexp.MustCompile(`^drop\s+index\s+`)
+	rebuildIndexRegex        = regexp.MustCompile(`^(alter\s+index|reindex)\s+`)
+	createPolicyRegex        = regexp.MustCompile(`^create\s+policy\s+`)
+	dropPolicyRegex          = regexp.MustCompile(`^drop\s+policy\s+`)
+	showIndexRegex           = regexp.MustCompile(`^show\s+(index|indexes|keys)\s+(from|in)\s+\S+\s*`)
+	showCreateRegex          = regexp.MustCompile(`^show create\s+\S+\s*`)
+	showVariablesRegex       = regexp.MustCompile(`^show\s+(.*)?variables\s*`)
+	showStatusRegex          = regexp.MustCompile(`^show\s+((global|session)\s+)?status\s*`)
+	showWarningsRegex        = regexp.MustCompile(`^show\s+warnings\s*`)
+	showCollationRegex       = regexp.MustCompile(`^show\s+collation\s*`)
+	showCharsetRegex         = regexp.MustCompile(`^show\s+(character\s+set|charset)\s*`)
+	describeRegex            = regexp.MustCompile(`^(describe|desc|explain)\s+(.*)\s+`)
+	fullProcessListRegex     = regexp.MustCompile(`^show\s+(full\s+)?processlist$`)
+	unlockTablesRegex        = regexp.MustCompile(`^unlock\s+tables$`)
+	lockTablesRegex          = regexp.MustCompile(`^lock\s+tables\s`)
+	setRegex                 = regexp.MustCompile(`^set\s+`)
 )
 
 // Parse parses the given SQL sentence and returns the corresponding node.
@@ -74,18 +114,30 @@ func Parse(ctx *sql.Context, query string) (sql.Node, error) {
 		return parseDescribeTables(lowerQuery)
 	case createIndexRegex.MatchString(lowerQuery):
 		return parseCreateIndex(s)
+	case createTableRegex.MatchString(lowerQuery):
+		return parseCreateTable(ctx, s)
 	case dropIndexRegex.MatchString(lowerQuery):
 		return parseDropIndex(s)
+	case rebuildIndexRegex.MatchString(lowerQuery):
+		return parseRebuildIndex(s)
+	case createPolicyRegex.MatchString(lowerQuery):
+		return parseCreatePolicy(s)
+	case dropPolicyRegex.MatchString(lowerQuery):
+		return parseDropPolicy(s)
 	case showIndexRegex.MatchString(lowerQuery):
 		return parseShowIndex(s)
 	case showCreateRegex.MatchString(lowerQuery):
 		return parseShowCreate(s)
 	case showVariablesRegex.MatchString(lowerQuery):
 		return parseShowVariables(ctx, s)
+	case showStatusRegex.MatchString(lowerQuery):
+		return parseShowStatus(s)
 	case showWarningsRegex.MatchString(lowerQuery):
 		return parseShowWarnings(ctx, s)
 	case showCollationRegex.MatchString(lowerQuery):
 		return parseShowCollation(s)
+	case showCharsetRegex.MatchString(lowerQuery):
+		return parseShowCharset(s, showCharsetRegex.FindStringIndex(lowerQuery)[1])
 	case describeRegex.MatchString(lowerQuery):
 		return parseDescribeQuery(ctx, s)
 	case fullProcessListRegex.MatchString(lowerQuery):
@@ -98,12 +150,26 @@ func Parse(ctx *sql.Context, query string) (sql.Node, error) {
 		s = fixSetQuery(s)
 	}
 
+	var jsonTables []*plan.JSONTable
+	if strings.Contains(lowerQuery, "json_table") {
+		var err error
+		s, jsonTables, err = extractJSONTables(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	stmt, err := sqlparser.Parse(s)
 	if err != nil {
 		return nil, err
 	}
 
-	return convert(ctx, stmt, s)
+	node, err := convert(ctx, stmt, s)
+	if err != nil || len(jsonTables) == 0 {
+		return node, err
+	}
+
+	return resolveJSONTables(node, jsonTables)
 }
 
 func parseDescribeTables(s string) (sql.Node, error) {
@@ -348,20 +414,38 @@ func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
 func convertDDL(c *sqlparser.DDL) (sql.Node, error) {
 	switch c.Action {
 	case sqlparser.CreateStr:
-		return convertCreateTable(c)
+		return convertCreateTable(c, nil)
 	default:
 		return nil, ErrUnsupportedSyntax.New(c)
 	}
 }
 
-func convertCreateTable(c *sqlparser.DDL) (sql.Node, error) {
+func convertCreateTable(c *sqlparser.DDL, options map[string]*columnOptions) (sql.Node, error) {
+	if c.TableSpec == nil {
+		return nil, ErrUnsupportedSyntax.New(c)
+	}
+
+	collation, err := applyTableCollation(c.TableSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	schema, err := columnDefinitionToSchema(c.TableSpec.Columns)
 	if err != nil {
 		return nil, err
 	}
 
-	return plan.NewCreateTable(
-		sql.UnresolvedDatabase(""), c.Table.Name.String(), schema), nil
+	if err := applyColumnOptions(schema, options); err != nil {
+		return nil, err
+	}
+
+	node := plan.NewCreateTable(
+		sql.UnresolvedDatabase(""), c.Table.Name.String(), schema)
+	if collation != nil {
+		node = node.WithCollation(collation)
+	}
+
+	return node, nil
 }
 
 func convertInsert(ctx *sql.Context, i *sqlparser.Insert) (sql.Node, error) {
@@ -430,23 +514,321 @@ func columnDefinitionToSchema(colDef []*sqlparser.ColumnDefinition) (sql.Schema,
 	var schema sql.Schema
 	for _, cd := range colDef {
 		typ := cd.Type
-		internalTyp, err := sql.MysqlTypeToType(typ.SQLType())
+		internalTyp, err := columnTypeToType(&typ)
 		if err != nil {
 			return nil, err
 		}
 
 		schema = append(schema, &sql.Column{
-			Nullable: !bool(typ.NotNull),
-			Type:     internalTyp,
-			Name:     cd.Name.String(),
-			// TODO
-			Default: nil,
+			Nullable:      !bool(typ.NotNull),
+			Type:          internalTyp,
+			Name:          cd.Name.String(),
+			AutoIncrement: bool(typ.Autoincrement),
 		})
 	}
 
 	return schema, nil
 }
 
+func columnTypeToType(ct *sqlparser.ColumnType) (sql.Type, error) {
+	switch strings.ToLower(ct.Type) {
+	case "decimal", "numeric":
+		return decimalColumnType(ct)
+	case "time", "datetime", "timestamp":
+		return timeColumnType(ct)
+	case "enum", "set":
+		return enumColumnType(ct)
+	case "char", "varchar", "binary", "varbinary":
+		return stringColumnType(ct)
+	case "text", "tinytext", "mediumtext", "longtext":
+		collation, err := sql.ResolveCollation(ct.Charset, ct.Collate)
+		if err != nil {
+			return nil, err
+		}
+
+		if collation == sql.BinaryCollation {
+			return sql.Blob, nil
+		}
+		return sql.WithCollation(sql.Text, collation), nil
+	case "geometry":
+		return sql.Geometry, nil
+	case "point":
+		return sql.Point, nil
+	case "linestring":
+		return sql.LineString, nil
+	case "polygon":
+		return sql.Polygon, nil
+	case "geometrycollection", "multipoint", "multilinestring", "multipolygon":
+		return nil, ErrUnsupportedFeature.New(strings.ToUpper(ct.Type) + " columns")
+	default:
+		if ct.Zerofill {
+			return zerofillColumnType(ct)
+		}
+		return sql.MysqlTypeToType(ct.SQLType())
+	}
+}
+
+// zerofillColumnType returns the type of an integer ZEROFILL column, which is
+// UNSIGNED and keeps the display width of the column.
+func zerofillColumnType(ct *sqlparser.ColumnType) (sql.Type, error) {
+	unsigned := *ct
+	unsigned.Unsigned = true
+	typ, err := sql.MysqlTypeToType(unsigned.SQLType())
+	if err != nil {
+		return nil, err
+	}
+
+	var width int
+	if ct.Length != nil {
+		width, err = strconv.Atoi(string(ct.Length.Val))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.Zerofill(typ, width), nil
+}
+
+// stringColumnType returns the type of a CHAR, VARCHAR, BINARY or VARBINARY
+// column with the length and collation of the column, whose length is 1 by
+// default for CHAR and BINARY columns. CHAR and VARCHAR columns with the
+// binary character set are BINARY and VARBINARY columns, as in MySQL.
+func stringColumnType(ct *sqlparser.ColumnType) (sql.Type, error) {
+	typ := strings.ToUpper(ct.Type)
+	length := 1
+	if ct.Length != nil {
+		var err error
+		length, err = strconv.Atoi(string(ct.Length.Val))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	collation, err := sql.ResolveCollation(ct.Charset, ct.Collate)
+	if err != nil {
+		return nil, err
+	}
+
+	if collation == sql.BinaryCollation {
+		switch typ {
+		case "CHAR":
+			typ = "BINARY"
+		case "VARCHAR":
+			typ = "VARBINARY"
+		}
+	}
+
+	max := maxCharLength
+	if strings.HasPrefix(typ, "VAR") {
+		max = maxVarCharLength
+	}
+
+	if length < 0 || length > max {
+		return nil, ErrInvalidStringLength.New(typ, length, max)
+	}
+
+	switch typ {
+	case "CHAR":
+		return sql.WithCollation(sql.Char(length), collation), nil
+	case "VARCHAR":
+		return sql.WithCollation(sql.VarChar(length), collation), nil
+	case "BINARY":
+		return sql.Binary(length), nil
+	default:
+		return sql.VarBinary(length), nil
+	}
+}
+
+var (
+	tableCharsetRegex   = regexp.MustCompile(`(?:charset|character set)\s*=?\s*([a-z0-9_]+)`)
+	tableCollationRegex = regexp.MustCompile(`collate\s*=?\s*([a-z0-9_]+)`)
+)
+
+// applyTableCollation sets the default character set and collation of a
+// table, given in its options, to the string columns of the table that
+// have neither a character set nor a collation, and returns the collation.
+// If the options have neither, the returned collation is nil.
+func applyTableCollation(spec *sqlparser.TableSpec) (*sql.Collation, error) {
+	var charset, collation string
+	options := strings.ToLower(spec.Options)
+	if m := tableCharsetRegex.FindStringSubmatch(options); m != nil {
+		charset = m[1]
+	}
+	if m := tableCollationRegex.FindStringSubmatch(options); m != nil {
+		collation = m[1]
+	}
+
+	if charset == "" && collation == "" {
+		return nil, nil
+	}
+
+	tableCollation, err := sql.ResolveCollation(charset, collation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cd := range spec.Columns {
+		if !isStringColumnType(cd.Type.Type) || cd.Type.Charset != "" || cd.Type.Collate != "" {
+			continue
+		}
+
+		cd.Type.Charset = charset
+		cd.Type.Collate = collation
+	}
+
+	return tableCollation, nil
+}
+
+func isStringColumnType(typ string) bool {
+	switch strings.ToLower(typ) {
+	case "char", "varchar", "text", "tinytext", "mediumtext", "longtext":
+		return true
+	default:
+		return false
+	}
+}
+
+// decimalColumnType returns the Decimal type of a DECIMAL or NUMERIC column.
+func decimalColumnType(ct *sqlparser.ColumnType) (sql.Type, error) {
+	precision, scale, err := decimalPrecision(ct.Length, ct.Scale)
+	if err != nil {
+		return nil, err
+	}
+	return sql.Decimal(precision, scale), nil
+}
+
+// decimalPrecision returns the precision and scale of a DECIMAL type with
+// the given length and scale, which are 10 and 0 by default, as in MySQL.
+func decimalPrecision(length, scale *sqlparser.SQLVal) (int, int, error) {
+	precision, s := 10, 0
+	if length != nil {
+		p, err := strconv.Atoi(string(length.Val))
+		if err != nil {
+			return 0, 0, err
+		}
+		precision = p
+	}
+
+	if scale != nil {
+		sc, err := strconv.Atoi(string(scale.Val))
+		if err != nil {
+			return 0, 0, err
+		}
+		s = sc
+	}
+
+	if precision < 1 || precision > sql.MaxDecimalPrecision ||
+		s > sql.MaxDecimalScale || s > precision {
+		return 0, 0, ErrInvalidDecimal.New(
+			precision, s, sql.MaxDecimalPrecision, sql.MaxDecimalScale,
+		)
+	}
+
+	return precision, s, nil
+}
+
+// decimalLiteral returns a literal of a Decimal type with the digits of the
+// given number, or false if it has an exponent or too many digits to be a
+// Decimal.
+func decimalLiteral(s string) (sql.Expression, bool) {
+	if strings.ContainsAny(s, "eE") {
+		return nil, false
+	}
+
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return nil, false
+	}
+
+	var intPart, fracPart = s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+
+	precision, scale := len(intPart)+len(fracPart), len(fracPart)
+	if precision > sql.MaxDecimalPrecision || scale > sql.MaxDecimalScale {
+		return nil, false
+	}
+
+	if precision == 0 {
+		precision = 1
+	}
+
+	return expression.NewLiteral(d, sql.Decimal(precision, scale)), true
+}
+
+// timeColumnType returns the type of a TIME, DATETIME or TIMESTAMP column
+// with the fractional seconds precision of its length, which is 0 by default.
+func timeColumnType(ct *sqlparser.ColumnType) (sql.Type, error) {
+	typ := strings.ToUpper(ct.Type)
+	fsp, err := timePrecision(typ, ct.Length)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case "TIME":
+		return sql.TimeWithPrecision(fsp), nil
+	case "DATETIME":
+		return sql.DatetimeWithPrecision(fsp), nil
+	default:
+		return sql.TimestampWithPrecision(fsp), nil
+	}
+}
+
+// timePrecision returns the fractional seconds precision of a time type
+// with the given name and length, which is 0 by default.
+func timePrecision(typ string, length *sqlparser.SQLVal) (int, error) {
+	var fsp int
+	if length != nil {
+		var err error
+		fsp, err = strconv.Atoi(string(length.Val))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if fsp < 0 || fsp > sql.MaxTimePrecision {
+		return 0, ErrInvalidTimePrecision.New(typ, fsp, sql.MaxTimePrecision)
+	}
+
+	return fsp, nil
+}
+
+// enumColumnType returns the Enum or Set type of an ENUM or SET column.
+// Members are compared ignoring case and trailing spaces, so they must be
+// unique ignoring them.
+func enumColumnType(ct *sqlparser.ColumnType) (sql.Type, error) {
+	typ := strings.ToUpper(ct.Type)
+	members := make([]string, len(ct.EnumValues))
+	for i, v := range ct.EnumValues {
+		// The parser returns the members quoted.
+		m := v[1 : len(v)-1]
+		for _, prev := range members[:i] {
+			if strings.EqualFold(strings.TrimRight(prev, " "), strings.TrimRight(m, " ")) {
+				return nil, ErrDuplicateMember.New(m, typ)
+			}
+		}
+
+		if typ == "SET" && strings.Contains(m, ",") {
+			return nil, ErrInvalidSetMember.New(m)
+		}
+
+		members[i] = m
+	}
+
+	if typ == "ENUM" {
+		return sql.Enum(members...), nil
+	}
+
+	if len(members) > sql.MaxSetMembers {
+		return nil, ErrTooManySetMembers.New(sql.MaxSetMembers)
+	}
+
+	return sql.Set(members...), nil
+}
+
 func columnsToStrings(cols sqlparser.Columns) []string {
 	res := make([]string, len(cols))
 	for i, c := range cols {
@@ -517,6 +899,27 @@ func tableExprsToTable(
 	return join, nil
 }
 
+func indexHintToIndexHint(h *sqlparser.IndexHints) (*plan.IndexHint, error) {
+	var typ plan.IndexHintType
+	switch h.Type {
+	case sqlparser.UseStr:
+		typ = plan.UseIndex
+	case sqlparser.ForceStr:
+		typ = plan.ForceIndex
+	case sqlparser.IgnoreStr:
+		typ = plan.IgnoreIndex
+	default:
+		return nil, ErrUnsupportedSyntax.New(h)
+	}
+
+	var indexes = make([]string, len(h.Indexes))
+	for i, idx := range h.Indexes {
+		indexes[i] = idx.String()
+	}
+
+	return plan.NewIndexHint(typ, indexes...), nil
+}
+
 func tableExprToTable(
 	ctx *sql.Context,
 	te sqlparser.TableExpr,
@@ -529,12 +932,24 @@ func tableExprToTable(
 		switch e := t.Expr.(type) {
 		case sqlparser.TableName:
 			node := plan.NewUnresolvedTable(e.Name.String(), e.Qualifier.String())
+			if t.Hints != nil {
+				hint, err := indexHintToIndexHint(t.Hints)
+				if err != nil {
+					return nil, err
+				}
+				node.IndexHint = hint
+			}
+
 			if !t.As.IsEmpty() {
 				return plan.NewTableAlias(t.As.String(), node), nil
 			}
 
 			return node, nil
 		case *sqlparser.Subquery:
+			if t.Hints != nil {
+				return nil, ErrUnsupportedSyntax.New(t.Hints)
+			}
+
 			node, err := convert(ctx, e.Select, "")
 			if err != nil {
 				return nil, err
@@ -771,6 +1186,30 @@ func selectExprsToExpressions(se sqlparser.SelectExprs) ([]sql.Expression, error
 	return exprs, nil
 }
 
+func matchExprToExpression(m *sqlparser.MatchExpr) (sql.Expression, error) {
+	var mode sql.FullTextSearchMode
+	switch m.Option {
+	case "", sqlparser.NaturalLanguageModeStr:
+		mode = sql.NaturalLanguageMode
+	case sqlparser.BooleanModeStr:
+		mode = sql.BooleanMode
+	default:
+		return nil, ErrUnsupportedFeature.New("MATCH" + strings.ToUpper(m.Option))
+	}
+
+	columns, err := selectExprsToExpressions(m.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := exprToExpression(m.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return expression.NewMatch(columns, query, mode), nil
+}
+
 func exprToExpression(e sqlparser.Expr) (sql.Expression, error) {
 	switch v := e.(type) {
 	default:
@@ -816,6 +1255,8 @@ func exprToExpression(e sqlparser.Expr) (sql.Expression, error) {
 		return expression.NewNot(c), nil
 	case *sqlparser.SQLVal:
 		return convertVal(v)
+	case *sqlparser.MatchExpr:
+		return matchExprToExpression(v)
 	case sqlparser.BoolVal:
 		return expression.NewLiteral(bool(v), sql.Boolean), nil
 	case *sqlparser.NullVal:
@@ -874,13 +1315,46 @@ func exprToExpression(e sqlparser.Expr) (sql.Expression, error) {
 		}
 
 		return expression.NewOr(lhs, rhs), nil
+	case *sqlparser.CollateExpr:
+		expr, err := exprToExpression(v.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		collation, err := sql.LookupCollation(strings.Trim(v.Charset, "'\""))
+		if err != nil {
+			return nil, err
+		}
+
+		return expression.NewCollate(expr, collation), nil
 	case *sqlparser.ConvertExpr:
 		expr, err := exprToExpression(v.Expr)
 		if err != nil {
 			return nil, err
 		}
 
-		return expression.NewConvert(expr, v.Type.Type), nil
+		switch strings.ToLower(v.Type.Type) {
+		case expression.ConvertToDecimal:
+			precision, scale, err := decimalPrecision(v.Type.Length, v.Type.Scale)
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewDecimalConvert(expr, precision, scale), nil
+		case expression.ConvertToDatetime:
+			fsp, err := timePrecision("DATETIME", v.Type.Length)
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewDatetimeConvert(expr, fsp), nil
+		case expression.ConvertToTime:
+			fsp, err := timePrecision("TIME", v.Type.Length)
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewTimeConvert(expr, fsp), nil
+		default:
+			return expression.NewConvert(expr, v.Type.Type), nil
+		}
 	case *sqlparser.RangeCond:
 		val, err := exprToExpression(v.Left)
 		if err != nil {
@@ -931,7 +1405,7 @@ func exprToExpression(e sqlparser.Expr) (sql.Expression, error) {
 
 func isAggregateFunc(v *sqlparser.FuncExpr) bool {
 	switch v.Name.Lowered() {
-	case "first", "last":
+	case "first", "last", "json_arrayagg", "json_objectagg", "array_agg":
 		return true
 	}
 
@@ -955,6 +1429,12 @@ func convertVal(v *sqlparser.SQLVal) (sql.Expression, error) {
 		}
 		return expression.NewLiteral(val, sql.Int64), nil
 	case sqlparser.FloatVal:
+		// As in MySQL, numbers with a decimal point are exact values, and
+		// only the ones with an exponent are approximate values.
+		if lit, ok := decimalLiteral(string(v.Val)); ok {
+			return lit, nil
+		}
+
 		val, err := strconv.ParseFloat(string(v.Val), 64)
 		if err != nil {
 			return nil, err
@@ -1145,6 +1625,31 @@ func binaryExprToExpression(be *sqlparser.BinaryExpr) (sql.Expression, error) {
 
 		return expression.NewArithmetic(l, r, be.Operator), nil
 
+	case
+		sqlparser.JSONExtractOp,
+		sqlparser.JSONUnquoteExtractOp:
+
+		l, err := exprToExpression(be.Left)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := exprToExpression(be.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		extract, err := function.NewJSONExtract(l, r)
+		if err != nil {
+			return nil, err
+		}
+
+		if be.Operator == sqlparser.JSONUnquoteExtractOp {
+			return function.NewJSONUnquote(extract), nil
+		}
+
+		return extract, nil
+
 	default:
 		return nil, ErrUnsupportedFeature.New(be.Operator)
 	}
@@ -1423,6 +1928,54 @@ func parseShowCollation(query string) (sql.Node, error) {
 	}
 }
 
+// parseShowCharset parses a SHOW CHARACTER SET query, whose optional LIKE
+// or WHERE clause starts at the given position.
+func parseShowCharset(query string, pos int) (sql.Node, error) {
+	buf := bufio.NewReader(strings.NewReader(query[pos:]))
+	if _, err := buf.Peek(1); err == io.EOF {
+		return plan.NewShowCharset(), nil
+	}
+
+	var clause string
+	if err := readIdent(&clause)(buf); err != nil {
+		return nil, err
+	}
+
+	if err := skipSpaces(buf); err != nil {
+		return nil, err
+	}
+
+	switch strings.ToUpper(clause) {
+	case "WHERE", "LIKE":
+		bs, err := ioutil.ReadAll(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		expr, err := parseExpr(string(bs))
+		if err != nil {
+			return nil, err
+		}
+
+		var filter sql.Expression
+		if strings.ToUpper(clause) == "LIKE" {
+			filter = expression.NewLike(
+				expression.NewUnresolvedColumn("charset"),
+				expr,
+			)
+		} else {
+			filter = expr
+		}
+
+		return plan.NewFilter(
+			filter,
+			plan.NewShowCharset(),
+		), nil
+	default:
+		return nil, errUnexpectedSyntax.New("one of: LIKE or WHERE", clause)
+	}
+}
+
 var fixSessionRegex = regexp.MustCompile(`(,\s*|(set|SET)\s+)(SESSION|session)\s+([a-zA-Z0-9_]+)\s*=`)
 var fixGlobalRegex = regexp.MustCompile(`(,\s*|(set|SET)\s+)(GLOBAL|global)\s+([a-zA-Z0-9_]+)\s*=`)
 
@@ -3,9 +3,12 @@ package parse
 import (
 	"testing"
 
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
 	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function"
 	"github.com/mushiyu/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/mushiyu/go-mysql-server/sql/plan"
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/stretchr/testify/require"
@@ -34,7 +37,7 @@ var fixtures = map[string]sql.Node{
 			Nullable: true,
 		}, {
 			Name:     "e",
-			Type:     sql.Text,
+			Type:     sql.VarChar(20),
 			Nullable: true,
 		}, {
 			Name:     "f",
@@ -46,7 +49,190 @@ var fixtures = map[string]sql.Node{
 			Nullable: true,
 		}, {
 			Name:     "h",
-			Type:     sql.Text,
+			Type:     sql.Char(40),
+			Nullable: true,
+		}},
+	),
+	`CREATE TABLE t2(a DECIMAL(10,2) NOT NULL, b NUMERIC, c DECIMAL(5))`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t2",
+		sql.Schema{{
+			Name:     "a",
+			Type:     sql.Decimal(10, 2),
+			Nullable: false,
+		}, {
+			Name:     "b",
+			Type:     sql.Decimal(10, 0),
+			Nullable: true,
+		}, {
+			Name:     "c",
+			Type:     sql.Decimal(5, 0),
+			Nullable: true,
+		}},
+	),
+	`CREATE TABLE t3(a TIME, b TIME(3), c YEAR, d DATETIME(6), e TIMESTAMP)`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t3",
+		sql.Schema{{
+			Name:     "a",
+			Type:     sql.Time,
+			Nullable: true,
+		}, {
+			Name:     "b",
+			Type:     sql.TimeWithPrecision(3),
+			Nullable: true,
+		}, {
+			Name:     "c",
+			Type:     sql.Year,
+			Nullable: true,
+		}, {
+			Name:     "d",
+			Type:     sql.DatetimeWithPrecision(6),
+			Nullable: true,
+		}, {
+			Name:     "e",
+			Type:     sql.Timestamp,
+			Nullable: true,
+		}},
+	),
+	`CREATE TABLE t4(a ENUM('x', 'y''s') NOT NULL, b SET('a', 'b'))`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t4",
+		sql.Schema{{
+			Name:     "a",
+			Type:     sql.Enum("x", "y's"),
+			Nullable: false,
+		}, {
+			Name:     "b",
+			Type:     sql.Set("a", "b"),
+			Nullable: true,
+		}},
+	),
+	`CREATE TABLE t8(a GEOMETRY, b POINT NOT NULL, c LINESTRING, d POLYGON)`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t8",
+		sql.Schema{{
+			Name:     "a",
+			Type:     sql.Geometry,
+			Nullable: true,
+		}, {
+			Name:     "b",
+			Type:     sql.Point,
+			Nullable: false,
+		}, {
+			Name:     "c",
+			Type:     sql.LineString,
+			Nullable: true,
+		}, {
+			Name:     "d",
+			Type:     sql.Polygon,
+			Nullable: true,
+		}},
+	),
+	`CREATE TABLE t6(a VARCHAR(10) COLLATE utf8mb4_bin, b TEXT CHARACTER SET latin1, c CHAR(2), d INT) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t6",
+		sql.Schema{{
+			Name:     "a",
+			Type:     sql.WithCollation(sql.VarChar(10), mustCollation("utf8mb4_bin")),
+			Nullable: true,
+		}, {
+			Name:     "b",
+			Type:     sql.WithCollation(sql.Text, mustCollation("latin1_swedish_ci")),
+			Nullable: true,
+		}, {
+			Name:     "c",
+			Type:     sql.WithCollation(sql.Char(2), mustCollation("utf8mb4_unicode_ci")),
+			Nullable: true,
+		}, {
+			Name:     "d",
+			Type:     sql.Int32,
+			Nullable: true,
+		}},
+	).WithCollation(mustCollation("utf8mb4_unicode_ci")),
+	`CREATE TABLE t7(id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY, a INT DEFAULT -1, b VARCHAR(5) NOT NULL DEFAULT 'x', c INT DEFAULT (a + 1), d INT AS (a * 2) STORED, e INT GENERATED ALWAYS AS (d + c) VIRTUAL)`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t7",
+		sql.Schema{{
+			Name:          "id",
+			Type:          sql.Int64,
+			Nullable:      false,
+			AutoIncrement: true,
+		}, {
+			Name:     "a",
+			Type:     sql.Int32,
+			Default:  int32(-1),
+			Nullable: true,
+		}, {
+			Name:     "b",
+			Type:     sql.VarChar(5),
+			Default:  "x",
+			Nullable: false,
+		}, {
+			Name:     "c",
+			Type:     sql.Int32,
+			Nullable: true,
+			DefaultExpr: expression.NewPlus(
+				expression.NewGetField(1, sql.Int32, "a", true),
+				expression.NewLiteral(int64(1), sql.Int64),
+			),
+		}, {
+			Name:     "d",
+			Type:     sql.Int32,
+			Nullable: true,
+			Generated: expression.NewMult(
+				expression.NewGetField(1, sql.Int32, "a", true),
+				expression.NewLiteral(int64(2), sql.Int64),
+			),
+		}, {
+			Name:     "e",
+			Type:     sql.Int32,
+			Nullable: true,
+			Generated: expression.NewPlus(
+				expression.NewGetField(4, sql.Int32, "d", true),
+				expression.NewGetField(3, sql.Int32, "c", true),
+			),
+			Virtual: true,
+		}},
+	),
+	`SELECT a COLLATE utf8mb4_bin FROM foo WHERE b = 'x' COLLATE 'utf8mb4_bin'`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewCollate(expression.NewUnresolvedColumn("a"), mustCollation("utf8mb4_bin")),
+		},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewUnresolvedColumn("b"),
+				expression.NewCollate(expression.NewLiteral("x", sql.Text), mustCollation("utf8mb4_bin")),
+			),
+			plan.NewUnresolvedTable("foo", ""),
+		),
+	),
+	`CREATE TABLE t5(a BINARY(4), b VARBINARY(10), c CHAR, d VARCHAR(5) CHARACTER SET binary, e TEXT CHARACTER SET binary, f INT(5) ZEROFILL)`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t5",
+		sql.Schema{{
+			Name:     "a",
+			Type:     sql.Binary(4),
+			Nullable: true,
+		}, {
+			Name:     "b",
+			Type:     sql.VarBinary(10),
+			Nullable: true,
+		}, {
+			Name:     "c",
+			Type:     sql.Char(1),
+			Nullable: true,
+		}, {
+			Name:     "d",
+			Type:     sql.VarBinary(5),
+			Nullable: true,
+		}, {
+			Name:     "e",
+			Type:     sql.Blob,
+			Nullable: true,
+		}, {
+			Name:     "f",
+			Type:     sql.Zerofill(sql.Uint32, 5),
 			Nullable: true,
 		}},
 	),
@@ -514,6 +700,28 @@ var fixtures = map[string]sql.Node{
 		},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT CAST(a AS DATETIME), CAST(a AS DATETIME(3)) FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewConvert(expression.NewUnresolvedColumn("a"), expression.ConvertToDatetime),
+			expression.NewDatetimeConvert(expression.NewUnresolvedColumn("a"), 3),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT CAST(a AS TIME), CAST(a AS TIME(3)) FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewConvert(expression.NewUnresolvedColumn("a"), expression.ConvertToTime),
+			expression.NewTimeConvert(expression.NewUnresolvedColumn("a"), 3),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT CAST(a AS DECIMAL), CAST(a AS DECIMAL(5)), CAST(a AS DECIMAL(5,2)) FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewConvert(expression.NewUnresolvedColumn("a"), expression.ConvertToDecimal),
+			expression.NewDecimalConvert(expression.NewUnresolvedColumn("a"), 5, 0),
+			expression.NewDecimalConvert(expression.NewUnresolvedColumn("a"), 5, 2),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`SELECT 2 = 2 FROM foo`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewEquals(expression.NewLiteral(int64(2), sql.Int64), expression.NewLiteral(int64(2), sql.Int64)),
@@ -636,12 +844,21 @@ var fixtures = map[string]sql.Node{
 	`SELECT 1.0 * a + 2.0 * b FROM t;`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewPlus(
-				expression.NewMult(expression.NewLiteral(float64(1.0), sql.Float64), expression.NewUnresolvedColumn("a")),
-				expression.NewMult(expression.NewLiteral(float64(2.0), sql.Float64), expression.NewUnresolvedColumn("b")),
+				expression.NewMult(expression.NewLiteral(decimal.RequireFromString("1.0"), sql.Decimal(2, 1)), expression.NewUnresolvedColumn("a")),
+				expression.NewMult(expression.NewLiteral(decimal.RequireFromString("2.0"), sql.Decimal(2, 1)), expression.NewUnresolvedColumn("b")),
 			),
 		},
 		plan.NewUnresolvedTable("t", ""),
 	),
+	`SELECT 0.05, .5, 1.5e2, 1.`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewLiteral(decimal.RequireFromString("0.05"), sql.Decimal(2, 2)),
+			expression.NewLiteral(decimal.RequireFromString(".5"), sql.Decimal(1, 1)),
+			expression.NewLiteral(float64(150), sql.Float64),
+			expression.NewLiteral(decimal.RequireFromString("1."), sql.Decimal(1, 0)),
+		},
+		plan.NewUnresolvedTable("dual", ""),
+	),
 	`SELECT '1.0' + 2;`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewPlus(
@@ -680,6 +897,67 @@ var fixtures = map[string]sql.Node{
 		"bar",
 		make(map[string]string),
 	),
+	`SELECT doc->'$.a', doc->>'$.b[0]' FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			mustJSONExtract(
+				expression.NewUnresolvedColumn("doc"),
+				expression.NewLiteral("$.a", sql.Text),
+			),
+			function.NewJSONUnquote(mustJSONExtract(
+				expression.NewUnresolvedColumn("doc"),
+				expression.NewLiteral("$.b[0]", sql.Text),
+			)),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT * FROM foo, JSON_TABLE(foo.doc, '$[*]' COLUMNS (
+		n FOR ORDINALITY,
+		v INT PATH '$.v' DEFAULT '0' ON EMPTY ERROR ON ERROR,
+		NESTED PATH '$.t[*]' COLUMNS (j JSON EXISTS PATH '$')
+	)) AS jt`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		mustJSONTable(
+			plan.NewUnresolvedTable("foo", ""),
+			plan.NewJSONTable(
+				"jt",
+				expression.NewUnresolvedQualifiedColumn("foo", "doc"),
+				mustJSONPath("$[*]"),
+				[]*plan.JSONTableColumn{
+					{Name: "n", Type: sql.Uint32, Ordinality: true},
+					{
+						Name:    "v",
+						Type:    sql.Int32,
+						Path:    mustJSONPath("$.v"),
+						OnEmpty: plan.JSONTableFallback{Default: int64(0)},
+						OnError: plan.JSONTableFallback{Error: true},
+					},
+					{
+						Path: mustJSONPath("$.t[*]"),
+						Nested: []*plan.JSONTableColumn{
+							{Name: "j", Type: sql.JSON, Exists: true, Path: mustJSONPath("$")},
+						},
+					},
+				},
+			),
+		),
+	),
+	`SELECT * FROM (SELECT * FROM JSON_TABLE('[]', '$' COLUMNS (v JSON PATH '$')) t) AS s`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewSubqueryAlias(
+			"s",
+			plan.NewProject(
+				[]sql.Expression{expression.NewStar()},
+				plan.NewJSONTable(
+					"t",
+					expression.NewLiteral("[]", sql.Text),
+					mustJSONPath("$"),
+					[]*plan.JSONTableColumn{
+						{Name: "v", Type: sql.JSON, Path: mustJSONPath("$")},
+					},
+				),
+			),
+		),
+	),
 	`SELECT * FROM foo NATURAL JOIN bar`: plan.NewProject(
 		[]sql.Expression{expression.NewStar()},
 		plan.NewNaturalJoin(
@@ -701,6 +979,33 @@ var fixtures = map[string]sql.Node{
 		"foo",
 		plan.NewUnresolvedTable("bar", ""),
 	),
+	`ALTER INDEX foo ON bar REBUILD`: plan.NewRebuildIndex(
+		"foo",
+		plan.NewUnresolvedTable("bar", ""),
+		map[string]string{},
+	),
+	`ALTER INDEX foo ON bar REBUILD WITH (async = false)`: plan.NewRebuildIndex(
+		"foo",
+		plan.NewUnresolvedTable("bar", ""),
+		map[string]string{"async": "false"},
+	),
+	`REINDEX foo ON bar`: plan.NewRebuildIndex(
+		"foo",
+		plan.NewUnresolvedTable("bar", ""),
+		map[string]string{},
+	),
+	`CREATE POLICY tenant ON foo USING (tenant_id = CURRENT_USER())`: plan.NewCreatePolicy(
+		"tenant",
+		plan.NewUnresolvedTable("foo", ""),
+		expression.NewEquals(
+			expression.NewUnresolvedColumn("tenant_id"),
+			expression.NewUnresolvedFunction("current_user", false),
+		),
+	),
+	`DROP POLICY tenant ON foo`: plan.NewDropPolicy(
+		"tenant",
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`DESCRIBE FORMAT=TREE SELECT * FROM foo`: plan.NewDescribeQuery(
 		"tree",
 		plan.NewProject(
@@ -916,6 +1221,9 @@ var fixtures = map[string]sql.Node{
 	`SHOW SESSION VARIABLES`:                   plan.NewShowVariables(sql.NewEmptyContext().GetAll(), ""),
 	`SHOW VARIABLES LIKE 'gtid_mode'`:          plan.NewShowVariables(sql.NewEmptyContext().GetAll(), "gtid_mode"),
 	`SHOW SESSION VARIABLES LIKE 'autocommit'`: plan.NewShowVariables(sql.NewEmptyContext().GetAll(), "autocommit"),
+	`SHOW STATUS`:                              plan.NewShowStatus(""),
+	`SHOW GLOBAL STATUS`:                       plan.NewShowStatus(""),
+	`SHOW SESSION STATUS LIKE 'threads%'`:      plan.NewShowStatus("threads%"),
 	`UNLOCK TABLES`:                            plan.NewUnlockTables(),
 	`LOCK TABLES foo READ`: plan.NewLockTables([]*plan.TableLock{
 		{Table: plan.NewUnresolvedTable("foo", "")},
@@ -1042,6 +1350,21 @@ var fixtures = map[string]sql.Node{
 		),
 		plan.NewShowCollation(),
 	),
+	"SHOW CHARACTER SET": plan.NewShowCharset(),
+	"SHOW CHARSET LIKE 'utf8%'": plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("charset"),
+			expression.NewLiteral("utf8%", sql.Text),
+		),
+		plan.NewShowCharset(),
+	),
+	"SHOW CHARACTER SET WHERE Maxlen > 1": plan.NewFilter(
+		expression.NewGreaterThan(
+			expression.NewUnresolvedColumn("Maxlen"),
+			expression.NewLiteral(int64(1), sql.Int64),
+		),
+		plan.NewShowCharset(),
+	),
 	`ROLLBACK`:                               plan.NewRollback(),
 	"SHOW CREATE TABLE `mytable`":            plan.NewShowCreateTable("", nil, "mytable"),
 	"SHOW CREATE TABLE `mydb`.`mytable`":     plan.NewShowCreateTable("mydb", nil, "mytable"),
@@ -1191,6 +1514,54 @@ var fixtures = map[string]sql.Node{
 		[]sql.Expression{},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT a FROM foo USE INDEX (idx_a, idx_b)`: plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("a")},
+		unresolvedTableWithHint("foo", plan.NewIndexHint(plan.UseIndex, "idx_a", "idx_b")),
+	),
+	`SELECT a FROM foo FORCE INDEX (idx_a)`: plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("a")},
+		unresolvedTableWithHint("foo", plan.NewIndexHint(plan.ForceIndex, "idx_a")),
+	),
+	`SELECT a FROM foo AS f IGNORE INDEX (idx_a)`: plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("a")},
+		plan.NewTableAlias(
+			"f",
+			unresolvedTableWithHint("foo", plan.NewIndexHint(plan.IgnoreIndex, "idx_a")),
+		),
+	),
+	`SELECT a FROM foo WHERE MATCH(a, b) AGAINST ('foo bar')`: plan.NewProject(
+		[]sql.Expression{expression.NewUnresolvedColumn("a")},
+		plan.NewFilter(
+			expression.NewMatch(
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("a"),
+					expression.NewUnresolvedColumn("b"),
+				},
+				expression.NewLiteral("foo bar", sql.Text),
+				sql.NaturalLanguageMode,
+			),
+			plan.NewUnresolvedTable("foo", ""),
+		),
+	),
+	`SELECT MATCH(a) AGAINST ('+foo -bar' IN BOOLEAN MODE) AS score FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias(
+				expression.NewMatch(
+					[]sql.Expression{expression.NewUnresolvedColumn("a")},
+					expression.NewLiteral("+foo -bar", sql.Text),
+					sql.BooleanMode,
+				),
+				"score",
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+}
+
+func unresolvedTableWithHint(name string, hint *plan.IndexHint) *plan.UnresolvedTable {
+	t := plan.NewUnresolvedTable(name, "")
+	t.IndexHint = hint
+	return t
 }
 
 func TestParse(t *testing.T) {
@@ -1218,13 +1589,76 @@ var fixturesErrors = map[string]*errors.Kind{
 		JOIN commit_files
 		JOIN refs
 	`: ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                    ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                    ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                    ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                    ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                  ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`: ErrUnsupportedSyntax,
-	`SELECT AVG(DISTINCT foo) FROM b`:                         ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                                  ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                                  ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                                  ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                                  ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                                ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`:               ErrUnsupportedSyntax,
+	`SELECT AVG(DISTINCT foo) FROM b`:                                       ErrUnsupportedSyntax,
+	`CREATE POLICY tenant ON foo USING tenant_id = 1`:                       errUnexpectedSyntax,
+	`ALTER INDEX foo ON bar`:                                                errUnexpectedSyntax,
+	`SELECT a FROM foo WHERE MATCH(a) AGAINST ('foo' WITH QUERY EXPANSION)`: ErrUnsupportedFeature,
+	`CREATE TABLE t(a DECIMAL(70,2))`:                                       ErrInvalidDecimal,
+	`CREATE TABLE t(a DECIMAL(5,6))`:                                        ErrInvalidDecimal,
+	`SELECT CAST(a AS DECIMAL(70,2)) FROM foo`:                              ErrInvalidDecimal,
+	`SELECT CAST(a AS DATETIME(7)) FROM foo`:                                ErrInvalidTimePrecision,
+	`CREATE TABLE t(a DATETIME(7))`:                                         ErrInvalidTimePrecision,
+	`CREATE TABLE t(a ENUM('a', 'A '))`:                                     ErrDuplicateMember,
+	`CREATE TABLE t(a SET('a,b'))`:                                          ErrInvalidSetMember,
+	`CREATE TABLE t(a MULTIPOINT)`:                                          ErrUnsupportedFeature,
+	`CREATE TABLE t(a CHAR(256))`:                                           ErrInvalidStringLength,
+	`CREATE TABLE t(a VARBINARY(65536))`:                                    ErrInvalidStringLength,
+	`CREATE TABLE t(a TEXT COLLATE foo)`:                                    sql.ErrUnknownCollation,
+	`CREATE TABLE t(a TEXT CHARACTER SET latin1 COLLATE utf8mb4_bin)`:       sql.ErrCollationCharacterSetMismatch,
+	`CREATE TABLE t(a TEXT) DEFAULT CHARSET=foo`:                            sql.ErrUnknownCharacterSet,
+	`SELECT 'a' COLLATE foo`:                                                sql.ErrUnknownCollation,
+	`SHOW CHARACTER SET FOO`:                                                errUnexpectedSyntax,
+	`CREATE TABLE t(a INT NOT NULL DEFAULT NULL)`:                           ErrInvalidDefaultValue,
+	`CREATE TABLE t(a INT DEFAULT 'foo')`:                                   ErrInvalidDefaultValue,
+	`CREATE TABLE t(a TEXT AUTO_INCREMENT)`:                                 ErrInvalidAutoIncrement,
+	`CREATE TABLE t(a INT AUTO_INCREMENT, b INT AUTO_INCREMENT)`:            ErrMultipleAutoIncrement,
+	`CREATE TABLE t(a INT AUTO_INCREMENT, b INT AS (a + 1))`:                ErrInvalidColumnReference,
+	`CREATE TABLE t(a INT AS (b), b INT AS (1))`:                            ErrInvalidColumnReference,
+	`CREATE TABLE t(a INT DEFAULT 1 AS (1))`:                                ErrInvalidDefaultValue,
+	`CREATE TABLE t(a INT DEFAULT (b))`:                                     ErrUnknownColumn,
+	`SELECT * FROM JSON_TABLE('[]', '$' COLUMNS (v INT PATH '$'))`:           ErrJSONTableAlias,
+	`SELECT * FROM JSON_TABLE('[]' COLUMNS (v INT PATH '$')) t`:              ErrInvalidJSONTable,
+	`SELECT * FROM JSON_TABLE('[]', '$' COLUMNS (v INT)) t`:                  ErrInvalidJSONTable,
+	`SELECT * FROM JSON_TABLE('[]', '$' COLUMNS (v INT PATH $)) t`:           ErrInvalidJSONTable,
+	`SELECT * FROM JSON_TABLE('[]', '$' COLUMNS (v INT PATH '$' NULL ON)) t`: ErrInvalidJSONTable,
+}
+
+func mustCollation(name string) *sql.Collation {
+	c, err := sql.LookupCollation(name)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func mustJSONExtract(args ...sql.Expression) sql.Expression {
+	e, err := function.NewJSONExtract(args...)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func mustJSONPath(s string) *jsonpath.Path {
+	p, err := jsonpath.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func mustJSONTable(child sql.Node, t *plan.JSONTable) sql.Node {
+	n, err := t.WithChildren(child)
+	if err != nil {
+		panic(err)
+	}
+	return n
 }
 
 func TestParseErrors(t *testing.T) {
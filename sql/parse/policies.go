@@ -0,0 +1,78 @@
+package parse
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+func parseCreatePolicy(s string) (sql.Node, error) {
+	r := bufio.NewReader(strings.NewReader(s))
+
+	var name, table, predicate string
+	err := parseFuncs{
+		expect("create"),
+		skipSpaces,
+		expect("policy"),
+		skipSpaces,
+		readIdent(&name),
+		skipSpaces,
+		expect("on"),
+		skipSpaces,
+		readIdent(&table),
+		skipSpaces,
+		expect("using"),
+		skipSpaces,
+		readRemaining(&predicate),
+	}.exec(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	predicate = strings.TrimSpace(predicate)
+	if !strings.HasPrefix(predicate, "(") || !strings.HasSuffix(predicate, ")") {
+		return nil, errUnexpectedSyntax.New("(expression)", predicate)
+	}
+
+	expr, err := parseExpr(predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewCreatePolicy(
+		name,
+		plan.NewUnresolvedTable(table, ""),
+		expr,
+	), nil
+}
+
+func parseDropPolicy(s string) (sql.Node, error) {
+	r := bufio.NewReader(strings.NewReader(s))
+
+	var name, table string
+	err := parseFuncs{
+		expect("drop"),
+		skipSpaces,
+		expect("policy"),
+		skipSpaces,
+		readIdent(&name),
+		skipSpaces,
+		expect("on"),
+		skipSpaces,
+		readIdent(&table),
+		skipSpaces,
+		checkEOF,
+	}.exec(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewDropPolicy(
+		name,
+		plan.NewUnresolvedTable(table, ""),
+	), nil
+}
@@ -0,0 +1,70 @@
+package parse
+
+import "strings"
+
+// SplitStatements splits a query string containing several statements
+// separated by semicolons into the individual statements. Semicolons inside
+// quoted strings, quoted identifiers and comments do not separate statements.
+// Comments are kept in the statement they appear in and empty statements are
+// discarded.
+func SplitStatements(query string) []string {
+	var (
+		stmts []string
+		start int
+		quote byte
+	)
+
+	add := func(end int) {
+		stmt := strings.TrimSpace(query[start:end])
+		if stmt != "" && strings.TrimSpace(removeComments(stmt)) != "" {
+			stmts = append(stmts, stmt)
+		}
+		start = end + 1
+	}
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+
+		if quote != 0 {
+			switch {
+			case c == '\\' && quote != '`':
+				i++
+			case c == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '#':
+			i = skipLine(query, i)
+		case c == '-' && strings.HasPrefix(query[i:], "-- "):
+			i = skipLine(query, i)
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				i = len(query)
+			} else {
+				i += end + 3
+			}
+		case c == ';':
+			add(i)
+		}
+	}
+
+	if start < len(query) {
+		add(len(query))
+	}
+
+	return stmts
+}
+
+func skipLine(query string, i int) int {
+	end := strings.IndexByte(query[i:], '\n')
+	if end < 0 {
+		return len(query)
+	}
+	return i + end
+}
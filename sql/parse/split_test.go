@@ -0,0 +1,31 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{"SELECT 1", []string{"SELECT 1"}},
+		{"SELECT 1;", []string{"SELECT 1"}},
+		{"SET @a=1; SELECT @a; SELECT 2", []string{"SET @a=1", "SELECT @a", "SELECT 2"}},
+		{"SELECT 'a;b'; SELECT \"c;d\"", []string{"SELECT 'a;b'", "SELECT \"c;d\""}},
+		{"SELECT 'it\\'s;'; SELECT 2", []string{"SELECT 'it\\'s;'", "SELECT 2"}},
+		{"SELECT `a;b` FROM t;;", []string{"SELECT `a;b` FROM t"}},
+		{"SELECT 1 /* ; */; SELECT 2", []string{"SELECT 1 /* ; */", "SELECT 2"}},
+		{"SELECT 1 -- ;\n; SELECT 2 # ;", []string{"SELECT 1 -- ;", "SELECT 2 # ;"}},
+		{"SELECT 1; /* nothing */", []string{"SELECT 1"}},
+		{"", nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, SplitStatements(tt.query))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package parse
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/plan"
+)
+
+func parseShowStatus(s string) (sql.Node, error) {
+	var pattern string
+
+	r := bufio.NewReader(strings.NewReader(s))
+	err := parseFuncs{
+		expect("show"),
+		skipSpaces,
+		func(in *bufio.Reader) error {
+			var s string
+			if err := readIdent(&s)(in); err != nil {
+				return err
+			}
+
+			switch s {
+			case "global", "session":
+				if err := skipSpaces(in); err != nil {
+					return err
+				}
+
+				return expect("status")(in)
+			case "status":
+				return nil
+			}
+			return errUnexpectedSyntax.New("show [global | session] status", s)
+		},
+		skipSpaces,
+		func(in *bufio.Reader) error {
+			if expect("like")(in) == nil {
+				if err := skipSpaces(in); err != nil {
+					return err
+				}
+
+				return readValue(&pattern)(in)
+			}
+			return nil
+		},
+		skipSpaces,
+		checkEOF,
+	}.exec(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewShowStatus(pattern), nil
+}
@@ -2,6 +2,15 @@ package plan // import "github.com/mushiyu/go-mysql-server/sql/plan"
 
 import "github.com/mushiyu/go-mysql-server/sql"
 
+// OkResultSchema is the schema of the result of the statements that change
+// rows, whose only row has the number of changed rows.
+var OkResultSchema = sql.Schema{{
+	Name:     "updated",
+	Type:     sql.Int64,
+	Default:  int64(0),
+	Nullable: false,
+}}
+
 // IsUnary returns whether the node is unary or not.
 func IsUnary(node sql.Node) bool {
 	return len(node.Children()) == 1
@@ -0,0 +1,88 @@
+package plan
+
+import (
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// CoveringTable is a table that returns the values of a covering index
+// lookup instead of reading the rows of the underlying table. Only the
+// columns of the table that are indexed have values, the rest of them are
+// always NULL.
+type CoveringTable struct {
+	sql.Table
+	Index  sql.Index
+	Lookup sql.CoveringIndexLookup
+}
+
+var _ sql.TableWrapper = (*CoveringTable)(nil)
+
+// NewCoveringTable returns a new CoveringTable that reads the rows of the
+// table from the given lookup, which was created from the given index.
+func NewCoveringTable(
+	table sql.Table,
+	index sql.Index,
+	lookup sql.CoveringIndexLookup,
+) *CoveringTable {
+	return &CoveringTable{table, index, lookup}
+}
+
+// Underlying implements sql.TableWrapper interface.
+func (t *CoveringTable) Underlying() sql.Table {
+	return t.Table
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *CoveringTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Lookup.KeyValues(p)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := t.Schema()
+	var columns = make([]int, len(schema))
+	for i, col := range schema {
+		columns[i] = -1
+		for j, e := range t.Index.Expressions() {
+			if isColumnExpression(e, col) {
+				columns[i] = j
+				break
+			}
+		}
+	}
+
+	return &coveringIter{iter, columns}, nil
+}
+
+func (t *CoveringTable) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("CoveringTable(%s)", t.Index.ID())
+	_ = pr.WriteChildren(t.Table.String())
+	return pr.String()
+}
+
+type coveringIter struct {
+	iter sql.IndexKeyValueIter
+	// columns contains the position of the key value of each column in the
+	// schema, or -1 if the column is not indexed.
+	columns []int
+}
+
+func (i *coveringIter) Next() (sql.Row, error) {
+	values, _, err := i.iter.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	var row = make(sql.Row, len(i.columns))
+	for j, pos := range i.columns {
+		if pos >= 0 {
+			row[j] = values[pos]
+		}
+	}
+
+	return row, nil
+}
+
+func (i *coveringIter) Close() error {
+	return i.iter.Close()
+}
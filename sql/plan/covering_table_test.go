@@ -0,0 +1,70 @@
+package plan
+
+import (
+	"io"
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoveringTable(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "foo"},
+		{Name: "b", Type: sql.Text, Source: "foo"},
+	}
+	table := memory.NewPartitionedTable("foo", schema, 1)
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(1), "z")))
+
+	index := &mockIndex{
+		db:    "db",
+		table: "foo",
+		id:    "idx",
+		exprs: []sql.Expression{expression.NewGetFieldWithTable(1, sql.Text, "foo", "b", false)},
+	}
+	lookup := &coveringLookup{[][]interface{}{{"x"}, {"y"}}}
+	covering := NewCoveringTable(table, index, lookup)
+	require.Equal(table, covering.Underlying())
+
+	iter, err := NewResolvedTable(covering).RowIter(ctx)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{nil, "x"}, {nil, "y"}}, rows)
+}
+
+type coveringLookup struct {
+	keys [][]interface{}
+}
+
+func (l *coveringLookup) Values(sql.Partition) (sql.IndexValueIter, error) {
+	panic("not implemented")
+}
+
+func (l *coveringLookup) Indexes() []string { return []string{"idx"} }
+
+func (l *coveringLookup) KeyValues(sql.Partition) (sql.IndexKeyValueIter, error) {
+	return &keyValuesIter{l.keys, 0}, nil
+}
+
+type keyValuesIter struct {
+	keys [][]interface{}
+	pos  int
+}
+
+func (i *keyValuesIter) Next() ([]interface{}, []byte, error) {
+	if i.pos >= len(i.keys) {
+		return nil, nil, io.EOF
+	}
+
+	i.pos++
+	return i.keys[i.pos-1], nil, nil
+}
+
+func (i *keyValuesIter) Close() error { return nil }
@@ -10,9 +10,10 @@ var ErrCreateTable = errors.NewKind("tables cannot be created on database %s")
 
 // CreateTable is a node describing the creation of some table.
 type CreateTable struct {
-	db     sql.Database
-	name   string
-	schema sql.Schema
+	db        sql.Database
+	name      string
+	schema    sql.Schema
+	collation *sql.Collation
 }
 
 // NewCreateTable creates a new CreateTable node
@@ -44,8 +45,56 @@ func (c *CreateTable) WithDatabase(db sql.Database) (sql.Node, error) {
 
 // Resolved implements the Resolvable interface.
 func (c *CreateTable) Resolved() bool {
-	_, ok := c.db.(sql.UnresolvedDatabase)
-	return !ok
+	if _, ok := c.db.(sql.UnresolvedDatabase); ok {
+		return false
+	}
+
+	for _, e := range c.Expressions() {
+		if !e.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+var _ sql.Expressioner = (*CreateTable)(nil)
+
+// Expressions implements the sql.Expressioner interface. The expressions
+// are the default values and generated column expressions of the columns.
+func (c *CreateTable) Expressions() []sql.Expression {
+	var exprs []sql.Expression
+	for _, col := range c.schema {
+		if col.DefaultExpr != nil {
+			exprs = append(exprs, col.DefaultExpr)
+		}
+		if col.Generated != nil {
+			exprs = append(exprs, col.Generated)
+		}
+	}
+	return exprs
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (c *CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(c.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), len(c.Expressions()))
+	}
+
+	schema := make(sql.Schema, len(c.schema))
+	for i, col := range c.schema {
+		nc := *col
+		if nc.DefaultExpr != nil {
+			nc.DefaultExpr, exprs = exprs[0], exprs[1:]
+		}
+		if nc.Generated != nil {
+			nc.Generated, exprs = exprs[0], exprs[1:]
+		}
+		schema[i] = &nc
+	}
+
+	nc := *c
+	nc.schema = schema
+	return &nc, nil
 }
 
 // RowIter implements the Node interface.
@@ -55,9 +104,21 @@ func (c *CreateTable) RowIter(s *sql.Context) (sql.RowIter, error) {
 		return nil, ErrCreateTable.New(c.db.Name())
 	}
 
+	if cd, ok := d.(sql.CollatedAlterable); ok && c.collation != nil {
+		return sql.RowsToRowIter(), cd.CreateWithCollation(c.name, c.schema, c.collation)
+	}
+
 	return sql.RowsToRowIter(), d.Create(c.name, c.schema)
 }
 
+// WithCollation returns a copy of the node that creates the table with the
+// given default collation, if the database supports it.
+func (c *CreateTable) WithCollation(collation *sql.Collation) *CreateTable {
+	nc := *c
+	nc.collation = collation
+	return &nc
+}
+
 // Schema implements the Node interface.
 func (c *CreateTable) Schema() sql.Schema { return nil }
 
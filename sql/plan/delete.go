@@ -1,9 +1,10 @@
 package plan
 
 import (
+	"io"
+
 	"github.com/mushiyu/go-mysql-server/sql"
 	"gopkg.in/src-d/go-errors.v1"
-	"io"
 )
 
 var ErrDeleteFromNotSupported = errors.NewKind("table doesn't support DELETE FROM")
@@ -11,21 +12,19 @@ var ErrDeleteFromNotSupported = errors.NewKind("table doesn't support DELETE FRO
 // DeleteFrom is a node describing a deletion from some table.
 type DeleteFrom struct {
 	sql.Node
+	// Registry is used to keep the indexes of the table up to date.
+	Registry        *sql.IndexRegistry
+	CurrentDatabase string
 }
 
 // NewDeleteFrom creates a DeleteFrom node.
 func NewDeleteFrom(n sql.Node) *DeleteFrom {
-	return &DeleteFrom{n}
+	return &DeleteFrom{Node: n}
 }
 
 // Schema implements the Node interface.
 func (p *DeleteFrom) Schema() sql.Schema {
-	return sql.Schema{{
-		Name:     "updated",
-		Type:     sql.Int64,
-		Default:  int64(0),
-		Nullable: false,
-	}}
+	return OkResultSchema
 }
 
 // Resolved implements the Resolvable interface.
@@ -71,12 +70,52 @@ func (p *DeleteFrom) Execute(ctx *sql.Context) (int, error) {
 		return 0, err
 	}
 
+	located, isLocated := deletable.(sql.LocatedDeleter)
+	indexes := newIndexUpdater(p.Registry, p.CurrentDatabase, p.Node, isLocated)
+	defer indexes.release()
+
 	iter, err := p.Node.RowIter(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	i := 0
+	if !indexes.hasIndexes() {
+		return deleteRows(ctx, deletable, iter)
+	}
+
+	// Rows of tables with indexes are read before deleting any of them, as
+	// deleting rows may change the location of others, which would affect
+	// the iteration if it's using an index, and the updates of the indexes.
+	rows, err := sql.RowIterToRows(iter)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, row := range rows {
+		if !indexes.enabled() {
+			if err := deletable.Delete(ctx, row); err != nil {
+				return i, err
+			}
+			continue
+		}
+
+		changes, err := located.DeleteLocated(ctx, row)
+		if err != nil {
+			return i, err
+		}
+
+		if err := indexes.update(ctx, changes); err != nil {
+			return i, err
+		}
+	}
+
+	return len(rows), nil
+}
+
+// deleteRows deletes the rows of the iterator as they are read, and returns
+// the number of deleted rows.
+func deleteRows(ctx *sql.Context, deletable sql.Deleter, iter sql.RowIter) (int, error) {
+	var n int
 	for {
 		row, err := iter.Next()
 		if err == io.EOF {
@@ -85,18 +124,17 @@ func (p *DeleteFrom) Execute(ctx *sql.Context) (int, error) {
 
 		if err != nil {
 			_ = iter.Close()
-			return i, err
+			return n, err
 		}
 
 		if err := deletable.Delete(ctx, row); err != nil {
 			_ = iter.Close()
-			return i, err
+			return n, err
 		}
-
-		i++
+		n++
 	}
 
-	return i, nil
+	return n, iter.Close()
 }
 
 // RowIter implements the Node interface.
@@ -114,7 +152,9 @@ func (p *DeleteFrom) WithChildren(children ...sql.Node) (sql.Node, error) {
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
 	}
-	return NewDeleteFrom(children[0]), nil
+	np := *p
+	np.Node = children[0]
+	return &np, nil
 }
 
 func (p DeleteFrom) String() string {
@@ -0,0 +1,65 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+func TestDeleteFrom(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var events []string
+	table := &loggedTable{
+		Table: memory.NewTable("t", sql.Schema{{Name: "i", Type: sql.Int64, Source: "t"}}),
+		log:   &events,
+	}
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i)))
+	}
+
+	n, err := NewDeleteFrom(NewResolvedTable(table)).Execute(ctx)
+	require.NoError(err)
+	require.Equal(3, n)
+
+	// Tables without indexes are not read before deleting their rows.
+	require.Equal([]string{"read", "delete", "read", "delete", "read", "delete"}, events)
+
+	rows, err := sql.NodeToRows(ctx, NewResolvedTable(table))
+	require.NoError(err)
+	require.Len(rows, 0)
+}
+
+type loggedTable struct {
+	*memory.Table
+	log *[]string
+}
+
+func (t *loggedTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Table.PartitionRows(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return &loggedIter{iter, t.log}, nil
+}
+
+func (t *loggedTable) Delete(ctx *sql.Context, row sql.Row) error {
+	*t.log = append(*t.log, "delete")
+	return t.Table.Delete(ctx, row)
+}
+
+type loggedIter struct {
+	sql.RowIter
+	log *[]string
+}
+
+func (i *loggedIter) Next() (sql.Row, error) {
+	row, err := i.RowIter.Next()
+	if err == nil {
+		*i.log = append(*i.log, "read")
+	}
+	return row, err
+}
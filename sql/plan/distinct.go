@@ -33,7 +33,7 @@ func (d *Distinct) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, newDistinctIter(ctx, it)), nil
+	return sql.NewSpanIter(span, newDistinctIter(ctx, it, d.Child.Schema())), nil
 }
 
 // WithChildren implements the Node interface.
@@ -59,14 +59,16 @@ func (d Distinct) String() string {
 // result sets.
 type distinctIter struct {
 	childIter sql.RowIter
+	schema    sql.Schema
 	seen      sql.KeyValueCache
 	dispose   sql.DisposeFunc
 }
 
-func newDistinctIter(ctx *sql.Context, child sql.RowIter) *distinctIter {
+func newDistinctIter(ctx *sql.Context, child sql.RowIter, schema sql.Schema) *distinctIter {
 	cache, dispose := ctx.Memory.NewHistoryCache()
 	return &distinctIter{
 		childIter: child,
+		schema:    schema,
 		seen:      cache,
 		dispose:   dispose,
 	}
@@ -82,7 +84,7 @@ func (di *distinctIter) Next() (sql.Row, error) {
 			return nil, err
 		}
 
-		hash := sql.CacheKey(row)
+		hash := sql.CacheKey(collationKeys(di.schema, row))
 		if _, err := di.seen.Get(hash); err == nil {
 			continue
 		}
@@ -95,6 +97,21 @@ func (di *distinctIter) Next() (sql.Row, error) {
 	}
 }
 
+// collationKeys returns the row with its strings replaced by their keys in
+// the collation of their columns, so rows that are equal in their collations
+// have the same hash.
+func collationKeys(schema sql.Schema, row sql.Row) sql.Row {
+	if len(schema) != len(row) {
+		return row
+	}
+
+	keys := make(sql.Row, len(row))
+	for i, v := range row {
+		keys[i] = sql.CollationKey(schema[i].Type, v)
+	}
+	return keys
+}
+
 func (di *distinctIter) Close() error {
 	di.Dispose()
 	return di.childIter.Close()
@@ -44,8 +44,14 @@ func (p *GroupBy) Resolved() bool {
 
 // Schema implements the Node interface.
 func (p *GroupBy) Schema() sql.Schema {
-	var s = make(sql.Schema, len(p.Aggregate))
-	for i, e := range p.Aggregate {
+	return aggregateSchema(p.Aggregate)
+}
+
+// aggregateSchema returns the schema of the rows with the results of the
+// given aggregations.
+func aggregateSchema(aggregate []sql.Expression) sql.Schema {
+	var s = make(sql.Schema, len(aggregate))
+	for i, e := range aggregate {
 		var name string
 		if n, ok := e.(sql.Nameable); ok {
 			name = n.Name()
@@ -307,7 +313,8 @@ func groupingKey(
 		if err != nil {
 			return 0, err
 		}
-		vals = append(vals, fmt.Sprintf("%#v", v))
+		// Strings that are equal in their collation are in the same group.
+		vals = append(vals, fmt.Sprintf("%#v", sql.CollationKey(expr.Type(), v)))
 	}
 
 	return crc64.Checksum([]byte(strings.Join(vals, ",")), table), nil
@@ -0,0 +1,164 @@
+package plan
+
+import (
+	"io"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// IndexAggregate is an aggregation of an IndexAggregation node. COUNT(*) is
+// answered by the number of values of the lookup of the node, and MIN and MAX
+// by the bounds of an index on their column.
+type IndexAggregate struct {
+	// Expression is the aggregation that is answered.
+	Expression sql.Expression
+	// Index is the index whose bounds answer a MIN or MAX aggregation, or
+	// nil for COUNT(*).
+	Index sql.BoundedIndex
+	// Max is true if the last key of the index is the answer instead of
+	// the first one.
+	Max bool
+}
+
+// IndexAggregation is a node that returns the only row of an aggregation
+// without grouping of a table computing the aggregations with indexes, so
+// the rows of the table are never read.
+type IndexAggregation struct {
+	Aggregates []IndexAggregate
+	Table      sql.Table
+	Lookup     sql.CountableIndexLookup
+	Indexes    []sql.Index
+	Registry   *sql.IndexRegistry
+}
+
+// NewIndexAggregation returns a new IndexAggregation node for the given
+// aggregations of the table. The lookup, which is only needed to answer
+// COUNT(*), and the bounded indexes of the aggregations are created from the
+// given indexes, whose usage is recorded in the registry.
+func NewIndexAggregation(
+	aggregates []IndexAggregate,
+	table sql.Table,
+	lookup sql.CountableIndexLookup,
+	indexes []sql.Index,
+	registry *sql.IndexRegistry,
+) *IndexAggregation {
+	return &IndexAggregation{aggregates, table, lookup, indexes, registry}
+}
+
+// Resolved implements the Resolvable interface.
+func (*IndexAggregation) Resolved() bool { return true }
+
+// Children implements the Node interface.
+func (*IndexAggregation) Children() []sql.Node { return nil }
+
+// Schema implements the Node interface.
+func (n *IndexAggregation) Schema() sql.Schema {
+	var exprs = make([]sql.Expression, len(n.Aggregates))
+	for i, a := range n.Aggregates {
+		exprs[i] = a.Expression
+	}
+	return aggregateSchema(exprs)
+}
+
+// RowIter implements the Node interface.
+func (n *IndexAggregation) RowIter(ctx *sql.Context) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.IndexAggregation")
+
+	for _, idx := range n.Indexes {
+		n.Registry.RecordIndexLookup(idx)
+	}
+
+	var row = make(sql.Row, len(n.Aggregates))
+	var count *int64
+	for i, a := range n.Aggregates {
+		if a.Index == nil {
+			if count == nil {
+				c, err := n.count(ctx)
+				if err != nil {
+					span.Finish()
+					return nil, err
+				}
+				count = &c
+			}
+			row[i] = *count
+			continue
+		}
+
+		first, last, err := a.Index.Bounds()
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+
+		key := first
+		if a.Max {
+			key = last
+		}
+
+		if key != nil {
+			row[i] = key[0]
+		}
+	}
+
+	return sql.NewSpanIter(span, sql.RowsToRowIter(row)), nil
+}
+
+// count returns the number of values of the lookup in all the partitions of
+// the table.
+func (n *IndexAggregation) count(ctx *sql.Context) (int64, error) {
+	iter, err := n.Table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for {
+		p, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			_ = iter.Close()
+			return 0, err
+		}
+
+		c, err := n.Lookup.Count(p)
+		if err != nil {
+			_ = iter.Close()
+			return 0, err
+		}
+		count += c
+	}
+
+	return count, iter.Close()
+}
+
+// WithChildren implements the Node interface.
+func (n *IndexAggregation) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+
+	return n, nil
+}
+
+func (n *IndexAggregation) String() string {
+	var aggregates = make([]string, len(n.Aggregates))
+	for i, a := range n.Aggregates {
+		aggregates[i] = a.Expression.String()
+	}
+
+	var indexes = make([]string, len(n.Indexes))
+	for i, idx := range n.Indexes {
+		indexes[i] = idx.ID()
+	}
+
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("IndexAggregation(%s)", strings.Join(aggregates, ", "))
+	_ = pr.WriteChildren(
+		"Indexes("+strings.Join(indexes, ", ")+")",
+		n.Table.String(),
+	)
+	return pr.String()
+}
@@ -0,0 +1,73 @@
+package plan
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndexHintType is the type of an index hint.
+type IndexHintType byte
+
+const (
+	// UseIndex only allows the given indexes to be used.
+	UseIndex IndexHintType = iota
+	// ForceIndex only allows the given indexes to be used and requires one
+	// of them to be used.
+	ForceIndex
+	// IgnoreIndex does not allow the given indexes to be used.
+	IgnoreIndex
+)
+
+func (t IndexHintType) String() string {
+	switch t {
+	case UseIndex:
+		return "USE INDEX"
+	case ForceIndex:
+		return "FORCE INDEX"
+	case IgnoreIndex:
+		return "IGNORE INDEX"
+	default:
+		return "UNKNOWN INDEX HINT"
+	}
+}
+
+// IndexHint is a hint given in a table reference about the indexes that can
+// be used to read the rows of the table.
+type IndexHint struct {
+	Type    IndexHintType
+	Indexes []string
+}
+
+// NewIndexHint creates a new index hint of the given type for the indexes
+// with the given IDs.
+func NewIndexHint(typ IndexHintType, indexes ...string) *IndexHint {
+	return &IndexHint{typ, indexes}
+}
+
+// Allows reports whether the index with the given ID can be used according
+// to the hint.
+func (h *IndexHint) Allows(id string) bool {
+	if h == nil {
+		return true
+	}
+
+	if h.Type == IgnoreIndex {
+		return !h.Contains(id)
+	}
+
+	return h.Contains(id)
+}
+
+// Contains reports whether the index with the given ID is in the hint.
+func (h *IndexHint) Contains(id string) bool {
+	for _, idx := range h.Indexes {
+		if strings.EqualFold(idx, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *IndexHint) String() string {
+	return fmt.Sprintf("%s (%s)", h.Type, strings.Join(h.Indexes, ", "))
+}
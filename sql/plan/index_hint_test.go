@@ -0,0 +1,30 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexHintAllows(t *testing.T) {
+	require := require.New(t)
+
+	var hint *IndexHint
+	require.True(hint.Allows("idx_a"))
+
+	hint = NewIndexHint(UseIndex, "idx_a", "idx_b")
+	require.True(hint.Allows("IDX_A"))
+	require.True(hint.Allows("idx_b"))
+	require.False(hint.Allows("idx_c"))
+	require.Equal("USE INDEX (idx_a, idx_b)", hint.String())
+
+	hint = NewIndexHint(ForceIndex, "idx_a")
+	require.True(hint.Allows("idx_a"))
+	require.False(hint.Allows("idx_b"))
+
+	hint = NewIndexHint(IgnoreIndex, "idx_a")
+	require.False(hint.Allows("idx_a"))
+	require.True(hint.Allows("idx_b"))
+
+	require.False(NewIndexHint(UseIndex).Allows("idx_a"))
+}
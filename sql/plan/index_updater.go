@@ -0,0 +1,182 @@
+package plan
+
+import (
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// indexUpdater keeps the indexes of a table up to date while rows are
+// inserted into or deleted from it. The indexes that can't be updated
+// incrementally, because of their driver or the table, are marked as
+// outdated instead.
+type indexUpdater struct {
+	registry *sql.IndexRegistry
+	table    sql.Table
+	retained []sql.Index
+	indexes  []updatableIndex
+}
+
+type updatableIndex struct {
+	index   sql.Index
+	driver  sql.IncrementalIndexDriver
+	columns []int
+}
+
+// newIndexUpdater returns an indexUpdater for the indexes of the table in
+// the given node. located reports whether the table can tell the locations
+// of the rows it changes.
+func newIndexUpdater(
+	registry *sql.IndexRegistry,
+	db string,
+	node sql.Node,
+	located bool,
+) *indexUpdater {
+	u := &indexUpdater{registry: registry}
+	if registry == nil {
+		return u
+	}
+
+	table := findResolvedTable(node)
+	if table == nil {
+		return u
+	}
+
+	u.table = table.Table
+	u.retained = registry.IndexesByTable(db, table.Name())
+	for _, idx := range u.retained {
+		if !registry.CanUseIndex(idx) {
+			continue
+		}
+
+		driver, ok := registry.IndexDriver(idx.Driver()).(sql.IncrementalIndexDriver)
+		columns, found := indexColumns(idx, table.Schema())
+		if !located || !ok || !found {
+			registry.MarkOutdated(idx)
+			continue
+		}
+
+		u.indexes = append(u.indexes, updatableIndex{idx, driver, columns})
+	}
+
+	return u
+}
+
+// enabled reports whether there are indexes to update.
+func (u *indexUpdater) enabled() bool {
+	return len(u.indexes) > 0
+}
+
+// hasIndexes reports whether the table has indexes, even if they can't be
+// updated.
+func (u *indexUpdater) hasIndexes() bool {
+	return len(u.retained) > 0
+}
+
+// update applies to the indexes the given changes in the locations of the
+// rows of the table.
+// An index that could not be updated is marked as outdated.
+func (u *indexUpdater) update(ctx *sql.Context, changes sql.RowLocationChanges) error {
+	for _, idx := range u.indexes {
+		if err := idx.update(ctx, changes); err != nil {
+			u.registry.MarkOutdated(idx.index)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// release releases the indexes retained by the updater. Since the updated
+// indexes are still up to date with the table, the new checksum of the
+// table is recorded for them, so they are not found outdated when it's
+// checked.
+func (u *indexUpdater) release() {
+	if ch := getChecksumable(u.table); ch != nil && len(u.indexes) > 0 {
+		checksum, err := ch.Checksum()
+		for _, idx := range u.indexes {
+			if err != nil {
+				u.registry.MarkOutdated(idx.index)
+			} else if u.registry.CanUseIndex(idx.index) {
+				u.registry.UpdateIndexChecksum(idx.index, checksum)
+			}
+		}
+	}
+
+	for _, idx := range u.retained {
+		u.registry.ReleaseIndex(idx)
+	}
+	u.retained = nil
+}
+
+func (idx updatableIndex) update(ctx *sql.Context, changes sql.RowLocationChanges) error {
+	for _, l := range changes.Removed {
+		err := idx.driver.DeleteKey(ctx, idx.index, l.Partition, idx.keyValues(l.Row), l.Location)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, l := range changes.Added {
+		err := idx.driver.InsertKey(ctx, idx.index, l.Partition, idx.keyValues(l.Row), l.Location)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (idx updatableIndex) keyValues(row sql.Row) []interface{} {
+	var values = make([]interface{}, len(idx.columns))
+	for i, c := range idx.columns {
+		values[i] = row[c]
+	}
+	return values
+}
+
+// indexColumns returns the position in the schema of the columns of the
+// index. It returns false if some of the indexed expressions is not a column
+// of the schema.
+func indexColumns(idx sql.Index, schema sql.Schema) ([]int, bool) {
+	var columns []int
+	for _, e := range idx.Expressions() {
+		pos := -1
+		for i, col := range schema {
+			if isColumnExpression(e, col) {
+				pos = i
+				break
+			}
+		}
+
+		if pos < 0 {
+			return nil, false
+		}
+		columns = append(columns, pos)
+	}
+
+	return columns, true
+}
+
+// isColumnExpression reports whether the given indexed expression is the
+// given column.
+func isColumnExpression(expr string, col *sql.Column) bool {
+	return strings.EqualFold(expr, col.Source+"."+col.Name) ||
+		strings.EqualFold(expr, col.Name)
+}
+
+func findResolvedTable(node sql.Node) *ResolvedTable {
+	var table *ResolvedTable
+	Inspect(node, func(node sql.Node) bool {
+		if table != nil {
+			return false
+		}
+
+		if t, ok := node.(*ResolvedTable); ok {
+			table = t
+			return false
+		}
+		return true
+	})
+	return table
+}
@@ -0,0 +1,97 @@
+package plan
+
+import (
+	"io"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// IndexUsageTable is a table that uses an index lookup and records the
+// usage of the indexes of the lookup in the registry as it's read. Each
+// time its partitions are requested counts as a lookup for every index.
+type IndexUsageTable struct {
+	sql.Table
+	Registry *sql.IndexRegistry
+	Indexes  []sql.Index
+}
+
+var _ sql.TableWrapper = (*IndexUsageTable)(nil)
+
+// NewIndexUsageTable returns a new IndexUsageTable for the given table, which
+// uses a lookup of the given indexes.
+func NewIndexUsageTable(
+	table sql.Table,
+	registry *sql.IndexRegistry,
+	indexes []sql.Index,
+) *IndexUsageTable {
+	return &IndexUsageTable{table, registry, indexes}
+}
+
+// Underlying implements sql.TableWrapper interface.
+func (t *IndexUsageTable) Underlying() sql.Table {
+	return t.Table
+}
+
+// Partitions implements the sql.Table interface.
+func (t *IndexUsageTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	iter, err := t.Table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, idx := range t.Indexes {
+		t.Registry.RecordIndexLookup(idx)
+	}
+
+	return iter, nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *IndexUsageTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Table.PartitionRows(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexUsageIter{iter: iter, table: t}, nil
+}
+
+func (t *IndexUsageTable) String() string {
+	return t.Table.String()
+}
+
+type indexUsageIter struct {
+	iter  sql.RowIter
+	table *IndexUsageTable
+	rows  uint64
+	done  bool
+}
+
+func (i *indexUsageIter) record() {
+	if i.done {
+		return
+	}
+
+	i.done = true
+	for _, idx := range i.table.Indexes {
+		i.table.Registry.RecordIndexRows(idx, i.rows)
+	}
+}
+
+func (i *indexUsageIter) Next() (sql.Row, error) {
+	row, err := i.iter.Next()
+	if err != nil {
+		if err == io.EOF {
+			i.record()
+		}
+		return nil, err
+	}
+
+	i.rows++
+	return row, nil
+}
+
+func (i *indexUsageIter) Close() error {
+	i.record()
+	return i.iter.Close()
+}
@@ -0,0 +1,48 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexUsageTable(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "foo"},
+	}
+	table := memory.NewPartitionedTable("foo", schema, 2)
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i)))
+	}
+
+	index := &mockIndex{
+		db:    "db",
+		table: "foo",
+		id:    "idx",
+		exprs: []sql.Expression{expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false)},
+	}
+	registry := sql.NewIndexRegistry()
+
+	usage := NewIndexUsageTable(table, registry, []sql.Index{index})
+	require.Equal(table, usage.Underlying())
+	require.Equal(table.String(), usage.String())
+
+	for i := 0; i < 2; i++ {
+		iter, err := NewResolvedTable(usage).RowIter(ctx)
+		require.NoError(err)
+
+		rows, err := sql.RowIterToRows(iter)
+		require.NoError(err)
+		require.Len(rows, 3)
+	}
+
+	stats := registry.IndexUsage(index)
+	require.Equal(uint64(2), stats.Lookups)
+	require.Equal(uint64(6), stats.Rows)
+}
@@ -17,12 +17,21 @@ var ErrInsertIntoDuplicateColumn = errors.NewKind("duplicate column name %v")
 var ErrInsertIntoNonexistentColumn = errors.NewKind("invalid column name %v")
 var ErrInsertIntoNonNullableDefaultNullColumn = errors.NewKind("column name '%v' is non-nullable but attempted to set default value of null")
 var ErrInsertIntoNonNullableProvidedNull = errors.NewKind("column name '%v' is non-nullable but attempted to set a value of null")
+var ErrInsertIntoDataTruncated = errors.NewKind("data truncated for column '%v' at row %d")
+var ErrInsertIntoOutOfRange = errors.NewKind("out of range value for column '%v' at row %d")
+var ErrInsertIntoIncorrectValue = errors.NewKind("incorrect %v value: '%v' for column '%v' at row %d")
+var ErrInsertIntoInvalidJSON = errors.NewKind("invalid JSON text in value for column '%v' at row %d")
+var ErrInsertIntoGeneratedColumn = errors.NewKind("the value specified for generated column '%v' in table '%v' is not allowed")
+var ErrAutoIncrementNotSupported = errors.NewKind("table %s doesn't support AUTO_INCREMENT columns")
 
 // InsertInto is a node describing the insertion into some table.
 type InsertInto struct {
 	BinaryNode
 	Columns   []string
 	IsReplace bool
+	// Registry is used to keep the indexes of the table up to date.
+	Registry        *sql.IndexRegistry
+	CurrentDatabase string
 }
 
 // NewInsertInto creates an InsertInto node.
@@ -36,12 +45,7 @@ func NewInsertInto(dst, src sql.Node, isReplace bool, cols []string) *InsertInto
 
 // Schema implements the Node interface.
 func (p *InsertInto) Schema() sql.Schema {
-	return sql.Schema{{
-		Name:     "updated",
-		Type:     sql.Int64,
-		Default:  int64(0),
-		Nullable: false,
-	}}
+	return OkResultSchema
 }
 
 func getInsertable(node sql.Node) (sql.Inserter, error) {
@@ -114,13 +118,18 @@ func (p *InsertInto) Execute(ctx *sql.Context) (int, error) {
 		}
 
 		if !found {
-			if !f.Nullable && f.Default == nil {
+			if !f.Nullable && f.Default == nil && !hasComputedDefault(f) {
 				return 0, ErrInsertIntoNonNullableDefaultNullColumn.New(f.Name)
 			}
-			projExprs[i] = expression.NewLiteral(f.Default, f.Type)
+			projExprs[i] = expression.NewColumnDefault(f)
 		}
 	}
 
+	autoIncrement, err := getAutoIncrementer(p.Left, dstSchema)
+	if err != nil {
+		return 0, err
+	}
+
 	proj := NewProject(projExprs, p.Right)
 
 	iter, err := proj.RowIter(ctx)
@@ -128,7 +137,42 @@ func (p *InsertInto) Execute(ctx *sql.Context) (int, error) {
 		return 0, err
 	}
 
+	located, isLocated := insertable.(sql.LocatedInserter)
+	if replaceable != nil {
+		_, ok := insertable.(sql.LocatedDeleter)
+		isLocated = isLocated && ok
+	}
+
+	indexes := newIndexUpdater(p.Registry, p.CurrentDatabase, p.Left, isLocated)
+	defer indexes.release()
+
+	insert := func(row sql.Row) error {
+		if !indexes.enabled() {
+			return insertable.Insert(ctx, row)
+		}
+
+		changes, err := located.InsertLocated(ctx, row)
+		if err != nil {
+			return err
+		}
+		return indexes.update(ctx, changes)
+	}
+
+	delete := func(row sql.Row) error {
+		if !indexes.enabled() {
+			return replaceable.Delete(ctx, row)
+		}
+
+		changes, err := replaceable.(sql.LocatedDeleter).DeleteLocated(ctx, row)
+		if err != nil {
+			return err
+		}
+		return indexes.update(ctx, changes)
+	}
+
 	i := 0
+	rowNum := 0
+	var insertID interface{}
 	for {
 		row, err := iter.Next()
 		if err == io.EOF {
@@ -139,14 +183,27 @@ func (p *InsertInto) Execute(ctx *sql.Context) (int, error) {
 			return i, err
 		}
 
+		err = p.computeValues(ctx, dstSchema, row, autoIncrement, &insertID)
+		if err != nil {
+			_ = iter.Close()
+			return i, err
+		}
+
 		err = p.validateNullability(ctx, dstSchema, row)
 		if err != nil {
 			_ = iter.Close()
 			return i, err
 		}
 
+		rowNum++
+		err = p.convertValues(ctx, dstSchema, row, rowNum)
+		if err != nil {
+			_ = iter.Close()
+			return i, err
+		}
+
 		if replaceable != nil {
-			if err = replaceable.Delete(ctx, row); err != nil {
+			if err = delete(row); err != nil {
 				if err != sql.ErrDeleteRowNotFound {
 					_ = iter.Close()
 					return i, err
@@ -154,23 +211,184 @@ func (p *InsertInto) Execute(ctx *sql.Context) (int, error) {
 			} else {
 				i++
 			}
+		}
 
-			if err = replaceable.Insert(ctx, row); err != nil {
-				_ = iter.Close()
-				return i, err
-			}
-		} else {
-			if err := insertable.Insert(ctx, row); err != nil {
-				_ = iter.Close()
-				return i, err
-			}
+		if err := insert(row); err != nil {
+			_ = iter.Close()
+			return i, err
 		}
 		i++
 	}
 
+	if insertID != nil {
+		id, err := sql.Uint64.Convert(insertID)
+		if err != nil {
+			return i, err
+		}
+		ctx.Set(sql.LastInsertIDVar, sql.Uint64, id)
+		ctx.SetInsertID(id.(uint64))
+	}
+
 	return i, nil
 }
 
+// hasComputedDefault reports whether the value of the column is computed
+// when no value is given for it.
+func hasComputedDefault(c *sql.Column) bool {
+	return c.DefaultExpr != nil || c.AutoIncrement || c.Generated != nil
+}
+
+func getAutoIncrementer(node sql.Node, schema sql.Schema) (sql.AutoIncrementer, error) {
+	var found bool
+	for _, col := range schema {
+		if col.AutoIncrement {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	var t sql.Table
+	switch node := node.(type) {
+	case *ResolvedTable:
+		t = node.Table
+	case sql.Table:
+		t = node
+	}
+
+	for t != nil {
+		if a, ok := t.(sql.AutoIncrementer); ok {
+			return a, nil
+		}
+
+		w, ok := t.(sql.TableWrapper)
+		if !ok {
+			break
+		}
+		t = w.Underlying()
+	}
+
+	return nil, ErrAutoIncrementNotSupported.New(node)
+}
+
+// computeValues replaces the DEFAULT values of the row with the default
+// values of their columns, gives the AUTO_INCREMENT column its next value if
+// no value was given for it, and computes the generated columns. The first
+// generated AUTO_INCREMENT value is kept in insertID.
+//
+// Constant default values are set first, so the default value expressions
+// can use them, and generated columns are computed last, so they can use
+// any other column.
+func (p *InsertInto) computeValues(
+	ctx *sql.Context,
+	dstSchema sql.Schema,
+	row sql.Row,
+	autoIncrement sql.AutoIncrementer,
+	insertID *interface{},
+) error {
+	var computed []int
+	for i, col := range dstSchema {
+		isDefault := row[i] == expression.DefaultValue
+		switch {
+		case col.Generated != nil:
+			if !isDefault {
+				return ErrInsertIntoGeneratedColumn.New(col.Name, col.Source)
+			}
+		case col.AutoIncrement:
+			if isDefault {
+				row[i] = nil
+			}
+
+			v, generated, err := p.autoIncrementValue(ctx, col, row[i], autoIncrement)
+			if err != nil {
+				return err
+			}
+
+			if generated && *insertID == nil {
+				*insertID = v
+			}
+			row[i] = v
+		case isDefault && col.DefaultExpr != nil:
+			computed = append(computed, i)
+		case isDefault:
+			row[i] = col.Default
+		}
+	}
+
+	for _, i := range computed {
+		if err := computeColumn(ctx, dstSchema[i], dstSchema[i].DefaultExpr, row, i); err != nil {
+			return err
+		}
+	}
+
+	for i, col := range dstSchema {
+		if col.Generated == nil {
+			continue
+		}
+
+		if err := computeColumn(ctx, col, col.Generated, row, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// computeColumn sets the value of the column in the given position of the
+// row to the value of the expression.
+func computeColumn(ctx *sql.Context, col *sql.Column, e sql.Expression, row sql.Row, i int) error {
+	v, err := e.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if v != nil {
+		if v, err = col.Type.Convert(v); err != nil {
+			return err
+		}
+	}
+
+	row[i] = v
+	return nil
+}
+
+// autoIncrementValue returns the value of the AUTO_INCREMENT column for the
+// given value, which is the next value of the counter of the table if no
+// value is given or it's 0, as in MySQL, and whether it was generated.
+func (p *InsertInto) autoIncrementValue(
+	ctx *sql.Context,
+	col *sql.Column,
+	v interface{},
+	autoIncrement sql.AutoIncrementer,
+) (interface{}, bool, error) {
+	if v != nil {
+		n, err := sql.Int64.Convert(v)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if n.(int64) != 0 {
+			if err := autoIncrement.UpdateAutoIncrement(ctx, n.(int64)); err != nil {
+				return nil, false, err
+			}
+
+			v, err = col.Type.Convert(v)
+			return v, false, err
+		}
+	}
+
+	n, err := autoIncrement.NextAutoIncrement(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	v, err = col.Type.Convert(n)
+	return v, true, err
+}
+
 // RowIter implements the Node interface.
 func (p *InsertInto) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 	n, err := p.Execute(ctx)
@@ -187,7 +405,10 @@ func (p *InsertInto) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 2)
 	}
 
-	return NewInsertInto(children[0], children[1], p.IsReplace, p.Columns), nil
+	np := *p
+	np.Left = children[0]
+	np.Right = children[1]
+	return &np, nil
 }
 
 func (p InsertInto) String() string {
@@ -234,6 +455,125 @@ func (p *InsertInto) validateColumns(ctx *sql.Context, dstSchema sql.Schema) err
 	return nil
 }
 
+const (
+	// dataTruncatedCode is the MySQL error code of the data truncated
+	// warning.
+	dataTruncatedCode = 1265
+	// outOfRangeCode is the MySQL error code of the out of range warning.
+	outOfRangeCode = 1264
+)
+
+// convertValues converts the values of the row in columns of enums, sets,
+// sized strings, decimals and time types to their types. Values that don't
+// fit in enums, sets, sized strings and decimals are an error in strict SQL
+// mode, and are stored truncated with a warning otherwise, as MySQL does.
+// Values that can't be converted to the rest of the types, and strings that
+// are not valid JSON texts in JSON columns, are always an error.
+func (p *InsertInto) convertValues(ctx *sql.Context, dstSchema sql.Schema, row sql.Row, rowNum int) error {
+	for i, col := range dstSchema {
+		if row[i] == nil {
+			continue
+		}
+
+		if col.Type == sql.JSON {
+			if err := validateJSON(row[i]); err != nil {
+				return ErrInsertIntoInvalidJSON.Wrap(err, col.Name, rowNum)
+			}
+		}
+
+		if !isConvertedType(col.Type) {
+			continue
+		}
+
+		v, err := col.Type.Convert(row[i])
+		if err == nil && isInvalidEmptyMember(col.Type, row[i]) {
+			err = sql.ErrInvalidEnumValue.New(row[i], col.Type)
+		}
+
+		if err != nil {
+			if !isTruncatedType(col.Type) || isStrictMode(ctx) {
+				return convertError(err, col, row[i], rowNum)
+			}
+
+			if sql.ErrDecimalOutOfRange.Is(err) {
+				ctx.Warn(outOfRangeCode, "Out of range value for column '%s' at row %d", col.Name, rowNum)
+			} else {
+				ctx.Warn(dataTruncatedCode, "Data truncated for column '%s' at row %d", col.Name, rowNum)
+			}
+			v, err = sql.TruncateValue(col.Type, row[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		row[i] = v
+	}
+	return nil
+}
+
+// isTruncatedType reports whether the values of the type are truncated when
+// they don't fit in it.
+func isTruncatedType(t sql.Type) bool {
+	return sql.IsEnum(t) || sql.IsSet(t) || sql.IsChar(t) || sql.IsVarChar(t) ||
+		sql.IsBinary(t) || sql.IsFixedPoint(t)
+}
+
+// isInvalidEmptyMember reports whether the value is an empty string inserted
+// in an enum that doesn't have it as a member. The empty string is the value
+// enums store for invalid members, but inserting it is invalid like any other
+// value that is not a member.
+func isInvalidEmptyMember(t sql.Type, v interface{}) bool {
+	if s, ok := v.(string); !ok || s != "" || !sql.IsEnum(t) {
+		return false
+	}
+
+	n, err := sql.MemberNumber(t, v)
+	return err == nil && n == uint64(0)
+}
+
+// isConvertedType reports whether the values inserted in columns of the type
+// are converted to it.
+func isConvertedType(t sql.Type) bool {
+	return isTruncatedType(t) || sql.IsTime(t) || sql.IsDuration(t) || sql.IsYear(t)
+}
+
+// validateJSON returns an error if the value is a string that is not a valid
+// JSON text. Values of other types are already JSON values.
+func validateJSON(v interface{}) error {
+	var err error
+	switch v := v.(type) {
+	case string:
+		_, err = sql.ParseJSON(v)
+	case []byte:
+		_, err = sql.ParseJSON(string(v))
+	}
+	return err
+}
+
+// convertError returns the error of a value that can't be converted to the
+// type of its column.
+func convertError(err error, col *sql.Column, v interface{}, rowNum int) error {
+	switch {
+	case sql.ErrDecimalOutOfRange.Is(err), sql.ErrTimeOutOfRange.Is(err), sql.ErrYearOutOfRange.Is(err):
+		return ErrInsertIntoOutOfRange.Wrap(err, col.Name, rowNum)
+	case isTruncatedType(col.Type) && !sql.IsFixedPoint(col.Type):
+		return ErrInsertIntoDataTruncated.Wrap(err, col.Name, rowNum)
+	default:
+		typ := strings.ToLower(sql.MySQLTypeName(col.Type))
+		return ErrInsertIntoIncorrectValue.Wrap(err, typ, v, col.Name, rowNum)
+	}
+}
+
+// isStrictMode reports whether the SQL mode of the session is strict, so
+// invalid values are errors instead of warnings.
+func isStrictMode(ctx *sql.Context) bool {
+	_, v := ctx.Session.Get("sql_mode")
+	mode, _ := v.(string)
+	mode = strings.ToUpper(mode)
+	return strings.Contains(mode, "STRICT_TRANS_TABLES") ||
+		strings.Contains(mode, "STRICT_ALL_TABLES")
+}
+
 func (p *InsertInto) validateNullability(ctx *sql.Context, dstSchema sql.Schema, row sql.Row) error {
 	for i, col := range dstSchema {
 		if !col.Nullable && row[i] == nil {
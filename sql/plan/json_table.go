@@ -0,0 +1,409 @@
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrJSONTableMissingValue is returned when a column of a JSON_TABLE
+	// has no value and it's defined with ERROR ON EMPTY.
+	ErrJSONTableMissingValue = errors.NewKind("missing value for JSON_TABLE column '%s'")
+
+	// ErrJSONTableInvalidValue is returned when the value of a column of a
+	// JSON_TABLE can't be converted to its type or the path of the column
+	// matches more than one value, and it's defined with ERROR ON ERROR.
+	ErrJSONTableInvalidValue = errors.NewKind("invalid value for JSON_TABLE column '%s': %s")
+)
+
+// JSONTableFallback is what a JSON_TABLE column evaluates to when its path
+// doesn't match any value (ON EMPTY) or the value can't be used (ON ERROR).
+// By default, it's NULL.
+type JSONTableFallback struct {
+	// Error is true if an error must be returned.
+	Error bool
+	// Default is the JSON value to use, if Error is false.
+	Default interface{}
+}
+
+// JSONTableColumn is a column of a JSON_TABLE, or a NESTED PATH with more
+// columns in it.
+type JSONTableColumn struct {
+	// Name of the column. It's empty for NESTED PATH columns.
+	Name string
+	// Type of the column.
+	Type sql.Type
+	// Ordinality is true if the column is a counter of the rows, starting
+	// at 1, for the path of the columns it's in.
+	Ordinality bool
+	// Exists is true if the column tells whether its path matches a value.
+	Exists bool
+	// Path of the value of the column, or of the values the rows of the
+	// nested columns are for. It's relative to the value of the row.
+	Path *jsonpath.Path
+	// OnEmpty and OnError are the values of the column when its path
+	// doesn't match any value and when the value can't be used.
+	OnEmpty, OnError JSONTableFallback
+	// Nested are the columns in a NESTED PATH.
+	Nested []*JSONTableColumn
+}
+
+// IsNested returns whether the column is a NESTED PATH.
+func (c *JSONTableColumn) IsNested() bool {
+	return c.Nested != nil
+}
+
+func (c *JSONTableColumn) String() string {
+	switch {
+	case c.IsNested():
+		cols := make([]string, len(c.Nested))
+		for i, n := range c.Nested {
+			cols[i] = n.String()
+		}
+		return fmt.Sprintf("NESTED PATH '%s' COLUMNS(%s)", c.Path, strings.Join(cols, ", "))
+	case c.Ordinality:
+		return fmt.Sprintf("%s FOR ORDINALITY", c.Name)
+	case c.Exists:
+		return fmt.Sprintf("%s %s EXISTS PATH '%s'", c.Name, c.Type, c.Path)
+	default:
+		return fmt.Sprintf("%s %s PATH '%s'", c.Name, c.Type, c.Path)
+	}
+}
+
+// JSONTable is a table with the rows and columns extracted from a JSON
+// document using JSON paths. If it has a child, the table is computed for
+// each one of the rows of the child, which are prepended to its rows, so
+// the document can use the columns of the child.
+type JSONTable struct {
+	Child   sql.Node
+	Doc     sql.Expression
+	Path    *jsonpath.Path
+	Columns []*JSONTableColumn
+	name    string
+}
+
+// NewJSONTable creates a new JSONTable node with the given name.
+func NewJSONTable(
+	name string,
+	doc sql.Expression,
+	path *jsonpath.Path,
+	columns []*JSONTableColumn,
+) *JSONTable {
+	return &JSONTable{Doc: doc, Path: path, Columns: columns, name: name}
+}
+
+// Name implements the Nameable interface.
+func (t *JSONTable) Name() string { return t.name }
+
+// Resolved implements the Resolvable interface.
+func (t *JSONTable) Resolved() bool {
+	return t.Doc.Resolved() && (t.Child == nil || t.Child.Resolved())
+}
+
+// Children implements the Node interface.
+func (t *JSONTable) Children() []sql.Node {
+	if t.Child == nil {
+		return nil
+	}
+	return []sql.Node{t.Child}
+}
+
+// WithChildren implements the Node interface. The JSONTable can have one
+// child or none.
+func (t *JSONTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) > 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 1)
+	}
+
+	nt := *t
+	nt.Child = nil
+	if len(children) == 1 {
+		nt.Child = children[0]
+	}
+	return &nt, nil
+}
+
+// Expressions implements the Expressioner interface.
+func (t *JSONTable) Expressions() []sql.Expression {
+	return []sql.Expression{t.Doc}
+}
+
+// WithExpressions implements the Expressioner interface.
+func (t *JSONTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(exprs), 1)
+	}
+
+	nt := *t
+	nt.Doc = exprs[0]
+	return &nt, nil
+}
+
+// Schema implements the Node interface.
+func (t *JSONTable) Schema() sql.Schema {
+	var schema sql.Schema
+	if t.Child != nil {
+		schema = append(schema, t.Child.Schema()...)
+	}
+
+	var add func(cols []*JSONTableColumn)
+	add = func(cols []*JSONTableColumn) {
+		for _, c := range cols {
+			if c.IsNested() {
+				add(c.Nested)
+				continue
+			}
+
+			schema = append(schema, &sql.Column{
+				Name:     c.Name,
+				Type:     c.Type,
+				Source:   t.name,
+				Nullable: true,
+			})
+		}
+	}
+	add(t.Columns)
+
+	return schema
+}
+
+// RowIter implements the Node interface.
+func (t *JSONTable) RowIter(ctx *sql.Context) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.JSONTable")
+
+	if t.Child == nil {
+		rows, err := t.rows(ctx, nil)
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+		return sql.NewSpanIter(span, sql.RowsToRowIter(rows...)), nil
+	}
+
+	childIter, err := t.Child.RowIter(ctx)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, &jsonTableIter{ctx: ctx, table: t, child: childIter}), nil
+}
+
+// rows returns the rows of the table for the given row of the child.
+func (t *JSONTable) rows(ctx *sql.Context, row sql.Row) ([]sql.Row, error) {
+	v, err := t.Doc.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	doc, err := sql.JSON.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	base := make(sql.Row, jsonTableWidth(t.Columns))
+	for i, v := range t.Path.Find(doc.(sql.JSONDocument).Val) {
+		rs, err := jsonTableRows(t.Columns, v, i+1, base, 0)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, rs...)
+	}
+
+	return rows, nil
+}
+
+func (t *JSONTable) String() string {
+	cols := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		cols[i] = c.String()
+	}
+
+	tp := sql.NewTreePrinter()
+	_ = tp.WriteNode(
+		"JSONTable(%s, '%s' COLUMNS(%s)) AS %s",
+		t.Doc, t.Path, strings.Join(cols, ", "), t.name,
+	)
+	if t.Child != nil {
+		_ = tp.WriteChildren(t.Child.String())
+	}
+	return tp.String()
+}
+
+// jsonTableWidth returns the number of columns of the row of the given
+// JSON_TABLE columns, including the nested ones.
+func jsonTableWidth(cols []*JSONTableColumn) int {
+	var n int
+	for _, c := range cols {
+		if c.IsNested() {
+			n += jsonTableWidth(c.Nested)
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// jsonTableRows returns the rows of the columns for the n-th value matched
+// by their path. The values of the columns are set in a copy of the given
+// row starting at the offset. Each row of the nested columns is a row, with
+// the columns of the other nested columns at the same level set to NULL, and
+// if there are none, a single row is returned.
+func jsonTableRows(
+	cols []*JSONTableColumn,
+	v interface{},
+	n int,
+	row sql.Row,
+	offset int,
+) ([]sql.Row, error) {
+	row = row.Copy()
+
+	idx := offset
+	for _, c := range cols {
+		if c.IsNested() {
+			idx += jsonTableWidth(c.Nested)
+			continue
+		}
+
+		val, err := c.eval(v, n)
+		if err != nil {
+			return nil, err
+		}
+		row[idx] = val
+		idx++
+	}
+
+	var rows []sql.Row
+	idx = offset
+	for _, c := range cols {
+		if !c.IsNested() {
+			idx++
+			continue
+		}
+
+		for i, nv := range c.Path.Find(v) {
+			rs, err := jsonTableRows(c.Nested, nv, i+1, row, idx)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, rs...)
+		}
+		idx += jsonTableWidth(c.Nested)
+	}
+
+	if len(rows) == 0 {
+		return []sql.Row{row}, nil
+	}
+	return rows, nil
+}
+
+// eval returns the value of the column for the n-th value matched by the
+// path of the columns it's in.
+func (c *JSONTableColumn) eval(v interface{}, n int) (interface{}, error) {
+	if c.Ordinality {
+		return uint32(n), nil
+	}
+
+	values := c.Path.Find(v)
+	if c.Exists {
+		var exists int64
+		if len(values) > 0 {
+			exists = 1
+		}
+		return c.Type.Convert(exists)
+	}
+
+	if len(values) == 0 {
+		if c.OnEmpty.Error {
+			return nil, ErrJSONTableMissingValue.New(c.Name)
+		}
+		return c.fallback(c.OnEmpty)
+	}
+
+	if len(values) > 1 {
+		return c.onError(fmt.Errorf("more than one value matched by '%s'", c.Path))
+	}
+
+	val, err := jsonTableValue(c.Type, values[0])
+	if err != nil {
+		return c.onError(err)
+	}
+	return val, nil
+}
+
+func (c *JSONTableColumn) onError(err error) (interface{}, error) {
+	if c.OnError.Error {
+		return nil, ErrJSONTableInvalidValue.New(c.Name, err)
+	}
+	return c.fallback(c.OnError)
+}
+
+func (c *JSONTableColumn) fallback(f JSONTableFallback) (interface{}, error) {
+	val, err := jsonTableValue(c.Type, f.Default)
+	if err != nil {
+		return nil, ErrJSONTableInvalidValue.New(c.Name, err)
+	}
+	return val, nil
+}
+
+// jsonTableValue converts a JSON value to the type of a column. Objects and
+// arrays can only be values of JSON columns.
+func jsonTableValue(typ sql.Type, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if typ == sql.JSON {
+		return sql.JSONDocument{Val: v}, nil
+	}
+
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return nil, fmt.Errorf("%s can't be converted to %s", sql.JSONDocument{Val: v}, typ)
+	case string:
+	default:
+		if sql.IsText(typ) {
+			v = sql.JSONDocument{Val: v}.String()
+		}
+	}
+
+	return typ.Convert(v)
+}
+
+type jsonTableIter struct {
+	ctx   *sql.Context
+	table *JSONTable
+	child sql.RowIter
+	row   sql.Row
+	rows  []sql.Row
+}
+
+func (i *jsonTableIter) Next() (sql.Row, error) {
+	for len(i.rows) == 0 {
+		row, err := i.child.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := i.table.rows(i.ctx, row)
+		if err != nil {
+			return nil, err
+		}
+
+		i.row = row
+		i.rows = rows
+	}
+
+	row := i.rows[0]
+	i.rows = i.rows[1:]
+	return append(i.row.Copy(), row...), nil
+}
+
+func (i *jsonTableIter) Close() error {
+	return i.child.Close()
+}
@@ -0,0 +1,179 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/mushiyu/go-mysql-server/internal/jsonpath"
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+func mustJSONPath(s string) *jsonpath.Path {
+	p, err := jsonpath.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func TestJSONTable(t *testing.T) {
+	require := require.New(t)
+
+	doc := `[
+		{"a": 1, "b": [{"c": "x"}, {"c": "y"}], "d": [true]},
+		{"a": "foo", "b": [{}], "d": [false, null]},
+		{"a": [1], "b": []}
+	]`
+
+	table := NewJSONTable(
+		"t",
+		expression.NewLiteral(doc, sql.Text),
+		mustJSONPath("$[*]"),
+		[]*JSONTableColumn{
+			{Name: "n", Type: sql.Uint32, Ordinality: true},
+			{
+				Name:    "a",
+				Type:    sql.Int64,
+				Path:    mustJSONPath("$.a"),
+				OnError: JSONTableFallback{Default: int64(-1)},
+			},
+			{Name: "j", Type: sql.JSON, Path: mustJSONPath("$.a")},
+			{Name: "e", Type: sql.Int8, Exists: true, Path: mustJSONPath("$.d")},
+			{
+				Path: mustJSONPath("$.b[*]"),
+				Nested: []*JSONTableColumn{
+					{Name: "bn", Type: sql.Uint32, Ordinality: true},
+					{
+						Name:    "c",
+						Type:    sql.Text,
+						Path:    mustJSONPath("$.c"),
+						OnEmpty: JSONTableFallback{Default: "none"},
+					},
+				},
+			},
+			{
+				Path: mustJSONPath("$.d[*]"),
+				Nested: []*JSONTableColumn{
+					{Name: "d", Type: sql.Text, Path: mustJSONPath("$")},
+				},
+			},
+		},
+	)
+
+	require.Equal(sql.Schema{
+		{Name: "n", Type: sql.Uint32, Source: "t", Nullable: true},
+		{Name: "a", Type: sql.Int64, Source: "t", Nullable: true},
+		{Name: "j", Type: sql.JSON, Source: "t", Nullable: true},
+		{Name: "e", Type: sql.Int8, Source: "t", Nullable: true},
+		{Name: "bn", Type: sql.Uint32, Source: "t", Nullable: true},
+		{Name: "c", Type: sql.Text, Source: "t", Nullable: true},
+		{Name: "d", Type: sql.Text, Source: "t", Nullable: true},
+	}, table.Schema())
+
+	iter, err := table.RowIter(sql.NewEmptyContext())
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(iter)
+	require.NoError(err)
+
+	one := sql.JSONDocument{Val: int64(1)}
+	require.Equal([]sql.Row{
+		{uint32(1), int64(1), one, int8(1), uint32(1), "x", nil},
+		{uint32(1), int64(1), one, int8(1), uint32(2), "y", nil},
+		{uint32(1), int64(1), one, int8(1), nil, nil, "true"},
+		{uint32(2), int64(-1), sql.JSONDocument{Val: "foo"}, int8(1), uint32(1), "none", nil},
+		{uint32(2), int64(-1), sql.JSONDocument{Val: "foo"}, int8(1), nil, nil, "false"},
+		{uint32(2), int64(-1), sql.JSONDocument{Val: "foo"}, int8(1), nil, nil, nil},
+		{uint32(3), int64(-1), sql.JSONDocument{Val: []interface{}{int64(1)}}, int8(0), nil, nil, nil},
+	}, rows)
+}
+
+func TestJSONTableErrors(t *testing.T) {
+	doc := expression.NewLiteral(`[{"a": "foo"}, {"b": 1}, {"a": [1, 2]}]`, sql.Text)
+
+	testCases := []struct {
+		name string
+		col  *JSONTableColumn
+		err  *errors.Kind
+	}{
+		{
+			"invalid value",
+			&JSONTableColumn{Path: mustJSONPath("$.a"), OnError: JSONTableFallback{Error: true}},
+			ErrJSONTableInvalidValue,
+		},
+		{
+			"many values",
+			&JSONTableColumn{Path: mustJSONPath("$.a[*]"), OnError: JSONTableFallback{Error: true}},
+			ErrJSONTableInvalidValue,
+		},
+		{
+			"missing value",
+			&JSONTableColumn{Path: mustJSONPath("$.a"), OnEmpty: JSONTableFallback{Error: true}},
+			ErrJSONTableMissingValue,
+		},
+		{
+			"invalid default",
+			&JSONTableColumn{Path: mustJSONPath("$.b"), OnEmpty: JSONTableFallback{Default: "bar"}},
+			ErrJSONTableInvalidValue,
+		},
+		{
+			"null on error",
+			&JSONTableColumn{Path: mustJSONPath("$.a")},
+			nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.col.Name = "v"
+			tt.col.Type = sql.Int64
+
+			table := NewJSONTable("t", doc, mustJSONPath("$[*]"), []*JSONTableColumn{tt.col})
+			_, err := table.RowIter(sql.NewEmptyContext())
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, tt.err.Is(err))
+			}
+		})
+	}
+}
+
+func TestJSONTableLateral(t *testing.T) {
+	require := require.New(t)
+
+	child := memory.NewTable("docs", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "docs"},
+		{Name: "doc", Type: sql.JSON, Source: "docs", Nullable: true},
+	})
+	for _, r := range []sql.Row{
+		sql.NewRow(int64(1), sql.JSONDocument{Val: []interface{}{int64(1), int64(2)}}),
+		sql.NewRow(int64(2), nil),
+		sql.NewRow(int64(3), sql.JSONDocument{Val: []interface{}{int64(3)}}),
+	} {
+		require.NoError(child.Insert(sql.NewEmptyContext(), r))
+	}
+
+	table, err := NewJSONTable(
+		"t",
+		expression.NewGetField(1, sql.JSON, "doc", true),
+		mustJSONPath("$[*]"),
+		[]*JSONTableColumn{{Name: "v", Type: sql.Int64, Path: mustJSONPath("$")}},
+	).WithChildren(NewResolvedTable(child))
+	require.NoError(err)
+
+	iter, err := table.RowIter(sql.NewEmptyContext())
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(iter)
+	require.NoError(err)
+
+	doc1 := sql.JSONDocument{Val: []interface{}{int64(1), int64(2)}}
+	doc3 := sql.JSONDocument{Val: []interface{}{int64(3)}}
+	require.Equal([]sql.Row{
+		{int64(1), doc1, int64(1)},
+		{int64(1), doc1, int64(2)},
+		{int64(3), doc3, int64(3)},
+	}, rows)
+}
@@ -0,0 +1,150 @@
+package plan
+
+import (
+	"fmt"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrPolicyColumnNotFound is returned when the predicate of a policy
+// references a column that does not exist in the table.
+var ErrPolicyColumnNotFound = errors.NewKind("policy %q references unknown column %q of table %q")
+
+// CreatePolicy is a node to create a row-level security policy on a table.
+type CreatePolicy struct {
+	Name            string
+	Table           sql.Node
+	Predicate       sql.Expression
+	Catalog         *sql.Catalog
+	CurrentDatabase string
+}
+
+// NewCreatePolicy creates a new CreatePolicy node. The predicate is kept
+// unresolved, since it will be resolved in every query the policy applies to.
+func NewCreatePolicy(name string, table sql.Node, predicate sql.Expression) *CreatePolicy {
+	return &CreatePolicy{Name: name, Table: table, Predicate: predicate}
+}
+
+// Resolved implements the sql.Node interface.
+func (c *CreatePolicy) Resolved() bool { return c.Table.Resolved() }
+
+// Schema implements the sql.Node interface.
+func (c *CreatePolicy) Schema() sql.Schema { return nil }
+
+// Children implements the sql.Node interface.
+func (c *CreatePolicy) Children() []sql.Node { return []sql.Node{c.Table} }
+
+// RowIter implements the sql.Node interface.
+func (c *CreatePolicy) RowIter(ctx *sql.Context) (sql.RowIter, error) {
+	table, ok := c.Table.(*ResolvedTable)
+	if !ok {
+		return nil, ErrTableNotValid.New()
+	}
+
+	var err error
+	expression.Inspect(c.Predicate, func(e sql.Expression) bool {
+		if err != nil {
+			return false
+		}
+
+		if col, ok := e.(*expression.UnresolvedColumn); ok {
+			if !table.Schema().Contains(col.Name(), table.Name()) {
+				err = ErrPolicyColumnNotFound.New(c.Name, col.Name(), table.Name())
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.Catalog.AddPolicy(&sql.Policy{
+		Name:      c.Name,
+		Database:  c.CurrentDatabase,
+		Table:     table.Name(),
+		Predicate: c.Predicate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(), nil
+}
+
+func (c *CreatePolicy) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("CreatePolicy(%s)", c.Name)
+	_ = pr.WriteChildren(
+		fmt.Sprintf("Using(%s)", c.Predicate),
+		c.Table.String(),
+	)
+	return pr.String()
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CreatePolicy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+
+	nc := *c
+	nc.Table = children[0]
+	return &nc, nil
+}
+
+// DropPolicy is a node to drop a row-level security policy from a table.
+type DropPolicy struct {
+	Name            string
+	Table           sql.Node
+	Catalog         *sql.Catalog
+	CurrentDatabase string
+}
+
+// NewDropPolicy creates a new DropPolicy node.
+func NewDropPolicy(name string, table sql.Node) *DropPolicy {
+	return &DropPolicy{Name: name, Table: table}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DropPolicy) Resolved() bool { return d.Table.Resolved() }
+
+// Schema implements the sql.Node interface.
+func (d *DropPolicy) Schema() sql.Schema { return nil }
+
+// Children implements the sql.Node interface.
+func (d *DropPolicy) Children() []sql.Node { return []sql.Node{d.Table} }
+
+// RowIter implements the sql.Node interface.
+func (d *DropPolicy) RowIter(ctx *sql.Context) (sql.RowIter, error) {
+	n, ok := d.Table.(sql.Nameable)
+	if !ok {
+		return nil, ErrTableNotNameable.New()
+	}
+
+	if err := d.Catalog.DropPolicy(d.CurrentDatabase, n.Name(), d.Name); err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(), nil
+}
+
+func (d *DropPolicy) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("DropPolicy(%s)", d.Name)
+	_ = pr.WriteChildren(d.Table.String())
+	return pr.String()
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DropPolicy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+
+	nd := *d
+	nd.Table = children[0]
+	return &nd, nil
+}
@@ -0,0 +1,422 @@
+package plan
+
+import (
+	"context"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/sirupsen/logrus"
+	"github.com/mushiyu/go-mysql-server/sql"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrIndexNotRebuildable is returned when the driver of the index can't
+	// rebuild its indexes.
+	ErrIndexNotRebuildable = errors.NewKind("index %q can't be rebuilt because driver %q does not support it, drop and create it again")
+
+	// ErrIndexExpressionsNotRebuildable is returned when the index has
+	// expressions that are not columns of the table.
+	ErrIndexExpressionsNotRebuildable = errors.NewKind("index %q can't be rebuilt because it does not only index columns, drop and create it again")
+
+	// ErrIndexRebuildTableChanged is returned when the table of an index
+	// kept changing while its new version was being built.
+	ErrIndexRebuildTableChanged = errors.NewKind("index %q could not be rebuilt because its table changed during the last %d attempts")
+)
+
+// maxRebuildAttempts is the number of times a new version of an index is
+// built before giving up if its table keeps changing while it's built.
+const maxRebuildAttempts = 3
+
+// RebuildIndex is a node to rebuild an index. A new version of the index is
+// built while the current one keeps being used, and once it's saved it
+// replaces the current one.
+//
+// Rows written to the table while the new version is built may be missing
+// from it, since only the current version is updated with them. If the
+// table is Checksumable, its checksum is compared with the one the new
+// version was built from once it's saved: if it changed, the new version is
+// built again, up to maxRebuildAttempts times, and then the current version
+// is kept. If the table changes right as the versions are swapped, the new
+// version is marked as outdated. Changes to tables that are not
+// Checksumable can't be detected.
+type RebuildIndex struct {
+	Name            string
+	Table           sql.Node
+	Catalog         *sql.Catalog
+	CurrentDatabase string
+	Async           bool
+}
+
+// NewRebuildIndex creates a new RebuildIndex node. As with CreateIndex, the
+// index is rebuilt asynchronously unless the async config is false.
+func NewRebuildIndex(name string, table sql.Node, config map[string]string) *RebuildIndex {
+	async, ok := config["async"]
+	return &RebuildIndex{
+		Name:  name,
+		Table: table,
+		Async: async != "false" || !ok,
+	}
+}
+
+// Resolved implements the Node interface.
+func (r *RebuildIndex) Resolved() bool { return r.Table.Resolved() }
+
+// Schema implements the Node interface.
+func (r *RebuildIndex) Schema() sql.Schema { return nil }
+
+// Children implements the Node interface.
+func (r *RebuildIndex) Children() []sql.Node { return []sql.Node{r.Table} }
+
+// StartIndexRebuild starts rebuilding the given index of the table in the
+// background, as a RebuildIndex node would do, but outside of any process.
+func StartIndexRebuild(ctx *sql.Context, catalog *sql.Catalog, index sql.Index, table sql.Table) error {
+	r := &RebuildIndex{
+		Name:            index.ID(),
+		Table:           NewResolvedTable(table),
+		Catalog:         catalog,
+		CurrentDatabase: index.Database(),
+		Async:           true,
+	}
+	return r.start(ctx, func() {})
+}
+
+// RowIter implements the Node interface. If the index is already being
+// rebuilt, it's rebuilt again once the current rebuild finishes, along with
+// any other rebuild requested in the meantime.
+func (r *RebuildIndex) RowIter(ctx *sql.Context) (sql.RowIter, error) {
+	done := func() {
+		r.Catalog.ProcessList.Done(ctx.Pid())
+	}
+
+	err := r.start(ctx, done)
+	if sql.ErrIndexRebuildInvalidStatus.Is(err) {
+		err = r.queue(ctx, done)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(), nil
+}
+
+// queue queues a new rebuild of the index, which is already being rebuilt,
+// and calls done when it has finished. If the index is not being rebuilt
+// anymore, it tries to start rebuilding it once more instead.
+func (r *RebuildIndex) queue(ctx *sql.Context, done func()) error {
+	index := r.Catalog.Index(r.CurrentDatabase, r.Name)
+	if index == nil {
+		return r.start(ctx, done)
+	}
+	r.Catalog.ReleaseIndex(index)
+
+	queued, ok := r.Catalog.QueueIndexRebuild(index)
+	if !ok {
+		return r.start(ctx, done)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":     index.ID(),
+		"driver": index.Driver(),
+		"async":  r.Async,
+	}).Info("index is already being rebuilt, it will be rebuilt again once it's done")
+
+	wait := func() {
+		<-queued
+		done()
+	}
+
+	if r.Async {
+		go wait()
+	} else {
+		wait()
+	}
+
+	return nil
+}
+
+// start starts rebuilding the index and calls done when it has finished.
+func (r *RebuildIndex) start(ctx *sql.Context, done func()) error {
+	table, ok := r.Table.(*ResolvedTable)
+	if !ok {
+		return ErrNotIndexable.New()
+	}
+
+	indexable, err := getIndexableTable(table.Table)
+	if err != nil {
+		return err
+	}
+
+	index := r.Catalog.Index(r.CurrentDatabase, r.Name)
+	if index == nil {
+		return ErrIndexNotFound.New(r.Name, table.Name(), r.CurrentDatabase)
+	}
+	r.Catalog.ReleaseIndex(index)
+
+	if index.Table() != table.Name() {
+		return ErrIndexNotFound.New(r.Name, table.Name(), r.CurrentDatabase)
+	}
+
+	driver, ok := r.Catalog.IndexDriver(index.Driver()).(sql.RebuildableIndexDriver)
+	if !ok {
+		return ErrIndexNotRebuildable.New(r.Name, index.Driver())
+	}
+
+	// Only the values of the columns are available to build the new version,
+	// since the indexed expressions are only known by their names.
+	positions, ok := indexColumns(index, table.Schema())
+	if !ok {
+		return ErrIndexExpressionsNotRebuildable.New(r.Name)
+	}
+
+	schema := table.Schema()
+	columns := make([]string, len(positions))
+	for i, pos := range positions {
+		columns[i] = schema[pos].Name
+	}
+
+	if err := r.Catalog.StartIndexRebuild(index); err != nil {
+		return err
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"id":     index.ID(),
+		"driver": index.Driver(),
+	})
+
+	return r.rebuild(ctx, log, driver, index, indexable, columns, done)
+}
+
+// rebuild rebuilds the index, which must be marked as being rebuilt, and
+// calls done when it has finished. Then, the rebuild queued in the meantime,
+// if any, is started.
+func (r *RebuildIndex) rebuild(
+	ctx *sql.Context,
+	log *logrus.Entry,
+	driver sql.RebuildableIndexDriver,
+	index sql.Index,
+	indexable sql.IndexableTable,
+	columns []string,
+	done func(),
+) error {
+	table := r.Table.(*ResolvedTable).Table
+	build := func() (indexVersion, error) {
+		return newIndexVersion(ctx, driver, index, table, indexable, columns)
+	}
+
+	version, err := build()
+	if err != nil {
+		r.Catalog.CancelIndexRebuild(index)
+		r.rebuildQueued(ctx, log, driver, indexable, columns)
+		return err
+	}
+
+	rebuildIndex := func() {
+		r.rebuildIndex(ctx, log, driver, index, version, build)
+		done()
+		r.rebuildQueued(ctx, log, driver, indexable, columns)
+	}
+
+	log.WithField("async", r.Async).Info("starting to rebuild the index")
+
+	if r.Async {
+		go rebuildIndex()
+	} else {
+		rebuildIndex()
+	}
+
+	return nil
+}
+
+// rebuildQueued rebuilds the index in the background if a rebuild of it was
+// queued while it was being rebuilt.
+func (r *RebuildIndex) rebuildQueued(
+	ctx *sql.Context,
+	log *logrus.Entry,
+	driver sql.RebuildableIndexDriver,
+	indexable sql.IndexableTable,
+	columns []string,
+) {
+	index := r.Catalog.StartQueuedIndexRebuild(r.CurrentDatabase, r.Name)
+	if index == nil {
+		return
+	}
+
+	// The queued rebuild outlives the query that started the previous one,
+	// so it can't use its context.
+	rctx := sql.NewContext(context.Background(), sql.WithSession(ctx.Session))
+
+	queued := *r
+	queued.Async = true
+	if err := queued.rebuild(rctx, log, driver, index, indexable, columns, func() {}); err != nil {
+		logrus.WithField("err", err).Error("unable to rebuild the index")
+	}
+}
+
+// indexVersion is a new version of an index that is being built, along
+// with the checksum of the table it's built from and the key values to
+// build it with.
+type indexVersion struct {
+	index    sql.Index
+	checksum string
+	iter     sql.PartitionIndexKeyValueIter
+}
+
+// newIndexVersion creates a new version of the index with the current
+// checksum of the table, whose indexable table gives the key values.
+func newIndexVersion(
+	ctx *sql.Context,
+	driver sql.RebuildableIndexDriver,
+	index sql.Index,
+	table sql.Table,
+	indexable sql.IndexableTable,
+	columns []string,
+) (indexVersion, error) {
+	checksum, err := tableChecksum(table)
+	if err != nil {
+		return indexVersion{}, err
+	}
+
+	newIndex, err := driver.NewVersion(index, checksum)
+	if err != nil {
+		return indexVersion{}, err
+	}
+
+	iter, err := indexable.IndexKeyValues(ctx, columns)
+	if err != nil {
+		_ = driver.DeleteVersion(newIndex)
+		return indexVersion{}, err
+	}
+
+	return indexVersion{newIndex, checksum, iter}, nil
+}
+
+// tableChecksum returns the checksum of the table, or an empty string if
+// it's not Checksumable.
+func tableChecksum(table sql.Table) (string, error) {
+	if ch := getChecksumable(table); ch != nil {
+		return ch.Checksum()
+	}
+	return "", nil
+}
+
+func (r *RebuildIndex) rebuildIndex(
+	ctx *sql.Context,
+	log *logrus.Entry,
+	driver sql.RebuildableIndexDriver,
+	index sql.Index,
+	version indexVersion,
+	build func() (indexVersion, error),
+) {
+	span, ctx := ctx.Span("plan.rebuildIndex",
+		opentracing.Tags{
+			"index":  index.ID(),
+			"table":  index.Table(),
+			"driver": index.Driver(),
+		})
+
+	table := r.Table.(*ResolvedTable).Table
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = driver.Save(ctx, version.index, newLoggingPartitionKeyValueIter(ctx, log, version.iter))
+		if err != nil {
+			break
+		}
+
+		var checksum string
+		checksum, err = tableChecksum(table)
+		if err != nil || checksum == version.checksum {
+			break
+		}
+
+		// Rows were written to the table while the new version was being
+		// built, so they may be missing from it.
+		r.deleteVersion(ctx, driver, version.index)
+		version = indexVersion{}
+
+		if attempt == maxRebuildAttempts {
+			err = ErrIndexRebuildTableChanged.New(index.ID(), maxRebuildAttempts)
+			break
+		}
+
+		log.WithField("attempt", attempt).Info("table changed while rebuilding the index, building it again")
+		if version, err = build(); err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		var unused <-chan struct{}
+		unused, err = r.Catalog.ReplaceIndex(index, version.index)
+		if err == nil {
+			span.Finish()
+			log.Info("index successfully rebuilt")
+
+			// Writes that started before the swap only update the previous
+			// version, so the new one is outdated if any of them changed
+			// the table since it was checked.
+			if checksum, err := tableChecksum(table); err != nil || checksum != version.checksum {
+				r.Catalog.MarkOutdated(version.index)
+				log.Warn("table changed while the index was replaced, it's outdated")
+			}
+
+			// Queries that were already using the previous version may keep
+			// doing so, it's deleted once they are done.
+			go func() {
+				<-unused
+				if err := driver.DeleteVersion(index); err != nil {
+					logrus.WithField("err", err).Error("unable to delete the previous version of the index")
+				}
+			}()
+			return
+		}
+	} else {
+		r.Catalog.CancelIndexRebuild(index)
+	}
+
+	span.FinishWithOptions(opentracing.FinishOptions{
+		LogRecords: []opentracing.LogRecord{
+			{
+				Timestamp: time.Now(),
+				Fields: []otlog.Field{
+					otlog.String("error", err.Error()),
+				},
+			},
+		},
+	})
+
+	ctx.Error(0, "unable to rebuild the index: %s", err)
+	logrus.WithField("err", err).Error("unable to rebuild the index")
+
+	if version.index != nil {
+		r.deleteVersion(ctx, driver, version.index)
+	}
+}
+
+func (r *RebuildIndex) deleteVersion(ctx *sql.Context, driver sql.RebuildableIndexDriver, newIndex sql.Index) {
+	if err := driver.DeleteVersion(newIndex); err != nil {
+		ctx.Error(0, "unable to delete the new version of the index: %s", err)
+		logrus.WithField("err", err).Error("unable to delete the new version of the index")
+	}
+}
+
+func (r *RebuildIndex) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("RebuildIndex(%s)", r.Name)
+	_ = pr.WriteChildren(r.Table.String())
+	return pr.String()
+}
+
+// WithChildren implements the Node interface.
+func (r *RebuildIndex) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 1)
+	}
+
+	nr := *r
+	nr.Table = children[0]
+	return &nr, nil
+}
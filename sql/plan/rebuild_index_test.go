@@ -0,0 +1,347 @@
+package plan
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildIndex(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewPartitionedTable("foo", sql.Schema{
+		{Name: "a", Source: "foo", Type: sql.Int64},
+		{Name: "b", Source: "foo", Type: sql.Int64},
+	}, 2)
+	ctx := sql.NewEmptyContext()
+	for i := int64(0); i < 4; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i, i*2)))
+	}
+
+	driver := &rebuildableDriver{versionDeleted: make(chan sql.Index, 2)}
+	catalog := sql.NewCatalog()
+	catalog.RegisterIndexDriver(driver)
+	db := memory.NewDatabase("foo")
+	db.AddTable("foo", table)
+	catalog.AddDatabase(db)
+
+	idx := &mockIndex{"foo", "foo", "idx", []sql.Expression{
+		expression.NewGetFieldWithTable(1, sql.Int64, "foo", "b", true),
+	}}
+	created, ready, err := catalog.AddIndex(idx)
+	require.NoError(err)
+	close(created)
+	<-ready
+	catalog.MarkOutdated(idx)
+
+	ri := NewRebuildIndex("idx", NewResolvedTable(&checksumTable{table, "2"}), map[string]string{
+		"async": "false",
+	})
+	ri.Catalog = catalog
+	ri.CurrentDatabase = "foo"
+
+	_, err = ri.RowIter(ctx)
+	require.NoError(err)
+
+	newIdx := catalog.Index("foo", "idx")
+	require.NotNil(newIdx)
+	catalog.ReleaseIndex(newIdx)
+	require.True(newIdx != sql.Index(idx))
+	require.Equal(sql.IndexReady, catalog.IndexStatus(newIdx))
+	require.Equal("2", driver.checksums[newIdx])
+	require.ElementsMatch([]interface{}{int64(0), int64(2), int64(4), int64(6)}, driver.values)
+	require.Equal(sql.Index(idx), <-driver.versionDeleted)
+
+	ri = NewRebuildIndex("idx2", NewResolvedTable(table), nil)
+	ri.Catalog = catalog
+	ri.CurrentDatabase = "foo"
+	_, err = ri.RowIter(ctx)
+	require.Error(err)
+	require.True(ErrIndexNotFound.Is(err))
+}
+
+func TestRebuildIndexTableChanged(t *testing.T) {
+	testCases := []struct {
+		name    string
+		writes  int
+		rebuilt bool
+		values  []interface{}
+	}{
+		{
+			"rows inserted during the first attempt",
+			1,
+			true,
+			[]interface{}{int64(0), int64(2), int64(4), int64(6), int64(8)},
+		},
+		{
+			"rows inserted during every attempt",
+			maxRebuildAttempts,
+			false,
+			nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			table := memory.NewPartitionedTable("foo", sql.Schema{
+				{Name: "a", Source: "foo", Type: sql.Int64},
+				{Name: "b", Source: "foo", Type: sql.Int64},
+			}, 2)
+			ctx := sql.NewEmptyContext()
+			for i := int64(0); i < 4; i++ {
+				require.NoError(table.Insert(ctx, sql.NewRow(i, i*2)))
+			}
+			checksumTable := &checksumTable{table, "4"}
+
+			driver := &rebuildableDriver{versionDeleted: make(chan sql.Index, maxRebuildAttempts+1)}
+			var saves int
+			driver.onSave = func() {
+				saves++
+				if saves > tt.writes {
+					return
+				}
+
+				// A row is inserted while the version is being built.
+				i := int64(3 + saves)
+				require.NoError(table.Insert(ctx, sql.NewRow(i, i*2)))
+				checksumTable.checksum = fmt.Sprint(i + 1)
+			}
+
+			catalog := sql.NewCatalog()
+			catalog.RegisterIndexDriver(driver)
+			db := memory.NewDatabase("foo")
+			db.AddTable("foo", table)
+			catalog.AddDatabase(db)
+
+			idx := &mockIndex{"foo", "foo", "idx", []sql.Expression{
+				expression.NewGetFieldWithTable(1, sql.Int64, "foo", "b", true),
+			}}
+			created, ready, err := catalog.AddIndex(idx)
+			require.NoError(err)
+			close(created)
+			<-ready
+			catalog.MarkOutdated(idx)
+
+			ri := NewRebuildIndex("idx", NewResolvedTable(checksumTable), map[string]string{
+				"async": "false",
+			})
+			ri.Catalog = catalog
+			ri.CurrentDatabase = "foo"
+
+			_, err = ri.RowIter(ctx)
+			require.NoError(err)
+
+			newIdx := catalog.Index("foo", "idx")
+			require.NotNil(newIdx)
+			catalog.ReleaseIndex(newIdx)
+
+			if !tt.rebuilt {
+				require.Equal(maxRebuildAttempts, saves)
+				require.True(newIdx == sql.Index(idx))
+				require.Equal(sql.IndexOutdated, catalog.IndexStatus(idx))
+				require.Len(driver.versionDeleted, maxRebuildAttempts)
+
+				// The index is no longer being rebuilt, so it can be rebuilt
+				// again.
+				require.NoError(catalog.StartIndexRebuild(idx))
+				return
+			}
+
+			require.Equal(tt.writes+1, saves)
+			require.True(newIdx != sql.Index(idx))
+			require.Equal(sql.IndexReady, catalog.IndexStatus(newIdx))
+			require.Equal(checksumTable.checksum, driver.checksums[newIdx])
+			require.ElementsMatch(tt.values, driver.values)
+
+			deleted := []sql.Index{<-driver.versionDeleted, <-driver.versionDeleted}
+			require.Contains(deleted, sql.Index(idx))
+		})
+	}
+}
+
+func TestRebuildIndexQueued(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewPartitionedTable("foo", sql.Schema{
+		{Name: "a", Source: "foo", Type: sql.Int64},
+		{Name: "b", Source: "foo", Type: sql.Int64},
+	}, 2)
+	ctx := sql.NewEmptyContext()
+	for i := int64(0); i < 4; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i, i*2)))
+	}
+
+	saving, resume := make(chan struct{}), make(chan struct{})
+	driver := &rebuildableDriver{versionDeleted: make(chan sql.Index, 2)}
+	var saves int
+	driver.onSave = func() {
+		saves++
+		if saves == 1 {
+			close(saving)
+			<-resume
+		}
+	}
+
+	catalog := sql.NewCatalog()
+	catalog.RegisterIndexDriver(driver)
+	db := memory.NewDatabase("foo")
+	db.AddTable("foo", table)
+	catalog.AddDatabase(db)
+
+	idx := &mockIndex{"foo", "foo", "idx", []sql.Expression{
+		expression.NewGetFieldWithTable(1, sql.Int64, "foo", "b", true),
+	}}
+	created, ready, err := catalog.AddIndex(idx)
+	require.NoError(err)
+	close(created)
+	<-ready
+
+	rebuild := func(async string) error {
+		ri := NewRebuildIndex("idx", NewResolvedTable(table), map[string]string{
+			"async": async,
+		})
+		ri.Catalog = catalog
+		ri.CurrentDatabase = "foo"
+		_, err := ri.RowIter(ctx)
+		return err
+	}
+
+	require.NoError(rebuild("true"))
+	<-saving
+
+	// The row inserted during the first rebuild is missing from it, so the
+	// rebuilds requested in the meantime are coalesced into a new one.
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(4), int64(8))))
+	require.NoError(rebuild("true"))
+
+	rebuilt := make(chan error)
+	go func() {
+		rebuilt <- rebuild("false")
+	}()
+
+	// Wait for the synchronous rebuild to be queued.
+	time.Sleep(50 * time.Millisecond)
+	close(resume)
+	require.NoError(<-rebuilt)
+
+	require.Equal(2, saves)
+	require.ElementsMatch([]interface{}{int64(0), int64(2), int64(4), int64(6), int64(8)}, driver.values)
+
+	newIdx := catalog.Index("foo", "idx")
+	require.NotNil(newIdx)
+	catalog.ReleaseIndex(newIdx)
+	require.Equal(sql.IndexReady, catalog.IndexStatus(newIdx))
+	require.Contains(driver.checksums, newIdx)
+
+	// The index is no longer being rebuilt, so it can be rebuilt again.
+	require.NoError(catalog.StartIndexRebuild(newIdx))
+}
+
+func TestRebuildIndexErrors(t *testing.T) {
+	table := memory.NewTable("foo", sql.Schema{
+		{Name: "a", Source: "foo", Type: sql.Int64},
+	})
+
+	testCases := []struct {
+		name   string
+		driver sql.IndexDriver
+		expr   sql.Expression
+		err    interface{ Is(error) bool }
+	}{
+		{
+			"driver can't rebuild",
+			new(mockDriver),
+			expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", true),
+			ErrIndexNotRebuildable,
+		},
+		{
+			"expressions are not columns",
+			new(rebuildableDriver),
+			expression.NewArithmetic(
+				expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", true),
+				expression.NewLiteral(int64(1), sql.Int64),
+				"+",
+			),
+			ErrIndexExpressionsNotRebuildable,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := sql.NewCatalog()
+			catalog.RegisterIndexDriver(tt.driver)
+			db := memory.NewDatabase("foo")
+			db.AddTable("foo", table)
+			catalog.AddDatabase(db)
+
+			created, ready, err := catalog.AddIndex(&mockIndex{"foo", "foo", "idx", []sql.Expression{tt.expr}})
+			require.NoError(t, err)
+			close(created)
+			<-ready
+
+			ri := NewRebuildIndex("idx", NewResolvedTable(table), nil)
+			ri.Catalog = catalog
+			ri.CurrentDatabase = "foo"
+			_, err = ri.RowIter(sql.NewEmptyContext())
+			require.Error(t, err)
+			require.True(t, tt.err.Is(err))
+		})
+	}
+}
+
+type rebuildableDriver struct {
+	mockDriver
+	checksums      map[sql.Index]string
+	values         []interface{}
+	versionDeleted chan sql.Index
+	// onSave is called after the values of a version are read in Save.
+	onSave func()
+}
+
+var _ sql.RebuildableIndexDriver = (*rebuildableDriver)(nil)
+
+func (d *rebuildableDriver) NewVersion(idx sql.Index, checksum string) (sql.Index, error) {
+	i := *idx.(*mockIndex)
+	if d.checksums == nil {
+		d.checksums = make(map[sql.Index]string)
+	}
+	d.checksums[&i] = checksum
+	return &i, nil
+}
+
+func (d *rebuildableDriver) DeleteVersion(idx sql.Index) error {
+	d.versionDeleted <- idx
+	return nil
+}
+
+func (d *rebuildableDriver) Save(ctx *sql.Context, index sql.Index, iter sql.PartitionIndexKeyValueIter) error {
+	d.values = nil
+	for {
+		_, kviter, err := iter.Next()
+		if err != nil {
+			break
+		}
+
+		for {
+			values, _, err := kviter.Next()
+			if err != nil {
+				break
+			}
+			d.values = append(d.values, values...)
+		}
+	}
+
+	if d.onSave != nil {
+		d.onSave()
+	}
+
+	return d.mockDriver.Save(ctx, index, iter)
+}
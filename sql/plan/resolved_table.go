@@ -10,13 +10,15 @@ import (
 // ResolvedTable represents a resolved SQL Table.
 type ResolvedTable struct {
 	sql.Table
+	// IndexHint is the index hint given in the table reference, if any.
+	IndexHint *IndexHint
 }
 
 var _ sql.Node = (*ResolvedTable)(nil)
 
 // NewResolvedTable creates a new instance of ResolvedTable.
 func NewResolvedTable(table sql.Table) *ResolvedTable {
-	return &ResolvedTable{table}
+	return &ResolvedTable{Table: table}
 }
 
 // Resolved implements the Resolvable interface.
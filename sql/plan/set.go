@@ -93,10 +93,15 @@ func (s *Set) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 			err   error
 		)
 
-		name := strings.TrimPrefix(
-			strings.TrimPrefix(strings.TrimLeft(v.Name, "@"), sessionPrefix),
-			globalPrefix,
-		)
+		// User variables keep their @ prefix, so they are not mistaken for
+		// system variables with the same name.
+		name := strings.ToLower(v.Name)
+		if !isUserVariable(v.Name) {
+			name = strings.TrimPrefix(
+				strings.TrimPrefix(strings.TrimLeft(v.Name, "@"), sessionPrefix),
+				globalPrefix,
+			)
+		}
 
 		if _, ok := v.Value.(*expression.DefaultColumn); ok {
 			valtyp, ok := sql.DefaultSessionConfig()[name]
@@ -118,6 +123,12 @@ func (s *Set) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 	return sql.RowsToRowIter(), nil
 }
 
+// isUserVariable reports whether the variable with the given name is a user
+// variable, whose name starts with a single @.
+func isUserVariable(name string) bool {
+	return strings.HasPrefix(name, "@") && !strings.HasPrefix(name, "@@")
+}
+
 // Schema implements the sql.Node interface.
 func (s *Set) Schema() sql.Schema { return nil }
 
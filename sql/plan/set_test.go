@@ -17,6 +17,7 @@ func TestSet(t *testing.T) {
 	s := NewSet(
 		SetVariable{"foo", expression.NewLiteral("bar", sql.Text)},
 		SetVariable{"@@baz", expression.NewLiteral(int64(1), sql.Int64)},
+		SetVariable{"@Qux", expression.NewLiteral(int64(2), sql.Int64)},
 	)
 
 	_, err := s.RowIter(ctx)
@@ -29,6 +30,13 @@ func TestSet(t *testing.T) {
 	typ, v = ctx.Get("baz")
 	require.Equal(sql.Int64, typ)
 	require.Equal(int64(1), v)
+
+	typ, v = ctx.Get("@qux")
+	require.Equal(sql.Int64, typ)
+	require.Equal(int64(2), v)
+
+	_, v = ctx.Get("qux")
+	require.Nil(v)
 }
 
 func TestSetDesfault(t *testing.T) {
@@ -0,0 +1,52 @@
+package plan
+
+import "github.com/mushiyu/go-mysql-server/sql"
+
+// ShowCharset shows all available character sets.
+type ShowCharset struct{}
+
+var charsetSchema = sql.Schema{
+	{Name: "Charset", Type: sql.Text},
+	{Name: "Description", Type: sql.Text},
+	{Name: "Default collation", Type: sql.Text},
+	{Name: "Maxlen", Type: sql.Int64},
+}
+
+// NewShowCharset creates a new ShowCharset node.
+func NewShowCharset() ShowCharset {
+	return ShowCharset{}
+}
+
+// Children implements the sql.Node interface.
+func (ShowCharset) Children() []sql.Node { return nil }
+
+func (ShowCharset) String() string { return "SHOW CHARACTER SET" }
+
+// Resolved implements the sql.Node interface.
+func (ShowCharset) Resolved() bool { return true }
+
+// RowIter implements the sql.Node interface.
+func (ShowCharset) RowIter(ctx *sql.Context) (sql.RowIter, error) {
+	var rows = make([]sql.Row, len(sql.CharacterSets))
+	for i, cs := range sql.CharacterSets {
+		rows[i] = sql.Row{
+			cs.Name,
+			cs.Description,
+			cs.DefaultCollation,
+			cs.MaxLength,
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Schema implements the sql.Node interface.
+func (ShowCharset) Schema() sql.Schema { return charsetSchema }
+
+// WithChildren implements the Node interface.
+func (s ShowCharset) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+
+	return s, nil
+}
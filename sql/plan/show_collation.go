@@ -12,6 +12,7 @@ var collationSchema = sql.Schema{
 	{Name: "Default", Type: sql.Text},
 	{Name: "Compiled", Type: sql.Text},
 	{Name: "Sortlen", Type: sql.Int64},
+	{Name: "Pad_attribute", Type: sql.Text},
 }
 
 // NewShowCollation creates a new ShowCollation node.
@@ -29,14 +30,29 @@ func (ShowCollation) Resolved() bool { return true }
 
 // RowIter implements the sql.Node interface.
 func (ShowCollation) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	return sql.RowsToRowIter(sql.Row{
-		defaultCollation,
-		defaultCharacterSet,
-		int64(1),
-		"Yes",
-		"Yes",
-		int64(1),
-	}), nil
+	var rows = make([]sql.Row, len(sql.Collations))
+	for i, c := range sql.Collations {
+		var isDefault string
+		if c.IsDefault() {
+			isDefault = "Yes"
+		}
+
+		pad := "NO PAD"
+		if c.PadSpace {
+			pad = "PAD SPACE"
+		}
+
+		rows[i] = sql.Row{
+			c.Name,
+			c.CharacterSet,
+			c.ID,
+			isDefault,
+			"Yes",
+			c.Sortlen,
+			pad,
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // Schema implements the sql.Node interface.
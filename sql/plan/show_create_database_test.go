@@ -18,7 +18,7 @@ func TestShowCreateDatabase(t *testing.T) {
 	require.NoError(err)
 
 	require.Equal([]sql.Row{
-		{"foo", "CREATE DATABASE /*!32312 IF NOT EXISTS*/ `foo` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8_bin */"},
+		{"foo", "CREATE DATABASE /*!32312 IF NOT EXISTS*/ `foo` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci */"},
 	}, rows)
 
 	node = NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false)
@@ -29,6 +29,6 @@ func TestShowCreateDatabase(t *testing.T) {
 	require.NoError(err)
 
 	require.Equal([]sql.Row{
-		{"foo", "CREATE DATABASE `foo` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8_bin */"},
+		{"foo", "CREATE DATABASE `foo` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci */"},
 	}, rows)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/mushiyu/go-mysql-server/internal/similartext"
 
 	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function"
 )
 
 // ShowCreateTable is a node that shows the CREATE TABLE statement for a table.
@@ -87,37 +88,105 @@ func (i *showCreateTablesIter) Next() (sql.Row, error) {
 
 func produceCreateStatement(table sql.Table) string {
 	schema := table.Schema()
+	collation := sql.TableCollation(table)
 	colStmts := make([]string, len(schema))
 
 	// Statement creation parts for each column
 	for i, col := range schema {
-		stmt := fmt.Sprintf("  `%s` %s", col.Name, strings.ToLower(sql.MySQLTypeName(col.Type)))
+		stmt := fmt.Sprintf("  `%s` %s", col.Name, sql.MySQLColumnType(col.Type))
+
+		if c, ok := sql.CollationOf(col.Type); ok && c != collation && c != sql.BinaryCollation {
+			stmt = fmt.Sprintf("%s%s", stmt, columnCollation(c, collation))
+		}
+
+		if col.Generated != nil {
+			kind := "STORED"
+			if col.Virtual {
+				kind = "VIRTUAL"
+			}
+			stmt = fmt.Sprintf("%s GENERATED ALWAYS AS (%s) %s", stmt, col.Generated, kind)
+		}
 
 		if !col.Nullable {
 			stmt = fmt.Sprintf("%s NOT NULL", stmt)
 		}
 
-		switch def := col.Default.(type) {
-		case string:
-			if def != "" {
-				stmt = fmt.Sprintf("%s DEFAULT %q", stmt, def)
-			}
-		default:
-			if def != nil {
-				stmt = fmt.Sprintf("%s DEFAULT %v", stmt, col.Default)
-			}
+		if col.AutoIncrement {
+			stmt = fmt.Sprintf("%s AUTO_INCREMENT", stmt)
+		}
+
+		if def, ok := columnDefault(col); ok {
+			stmt = fmt.Sprintf("%s DEFAULT %s", stmt, def)
 		}
 
 		colStmts[i] = stmt
 	}
 
+	tableOptions := "ENGINE=InnoDB DEFAULT CHARSET=" + collation.CharacterSet
+	if !collation.IsDefault() {
+		tableOptions += " COLLATE=" + collation.Name
+	}
+
 	return fmt.Sprintf(
-		"CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE `%s` (\n%s\n) %s",
 		table.Name(),
 		strings.Join(colStmts, ",\n"),
+		tableOptions,
 	)
 }
 
+// columnCollation returns the character set and collation of a column
+// whose collation is not the default one of its table, as they are written
+// in a CREATE TABLE statement.
+func columnCollation(c, tableCollation *sql.Collation) string {
+	if c.CharacterSet == tableCollation.CharacterSet {
+		return " COLLATE " + c.Name
+	}
+
+	if c.IsDefault() {
+		return " CHARACTER SET " + c.CharacterSet
+	}
+	return fmt.Sprintf(" CHARACTER SET %s COLLATE %s", c.CharacterSet, c.Name)
+}
+
+// columnDefault returns the default value of a column as it's written in a
+// CREATE TABLE statement, or false if the column has no default value. As in
+// MySQL, values are quoted as strings, the current time is written as
+// CURRENT_TIMESTAMP and other expressions are written in parentheses.
+func columnDefault(col *sql.Column) (string, bool) {
+	switch def := col.Default.(type) {
+	case nil:
+	case string:
+		if def != "" {
+			return quoteDefault(def), true
+		}
+		return "", false
+	default:
+		v, err := col.Type.SQL(def)
+		if err != nil {
+			return quoteDefault(fmt.Sprint(def)), true
+		}
+		return quoteDefault(v.ToString()), true
+	}
+
+	if col.DefaultExpr == nil {
+		return "", false
+	}
+
+	if _, ok := col.DefaultExpr.(*function.Now); ok {
+		if fsp := sql.TimePrecision(col.Type); fsp > 0 {
+			return fmt.Sprintf("CURRENT_TIMESTAMP(%d)", fsp), true
+		}
+		return "CURRENT_TIMESTAMP", true
+	}
+
+	return fmt.Sprintf("(%s)", col.DefaultExpr), true
+}
+
+func quoteDefault(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
 func (i *showCreateTablesIter) Close() error {
 	return nil
 }
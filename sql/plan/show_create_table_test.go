@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/mushiyu/go-mysql-server/memory"
 	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/mushiyu/go-mysql-server/sql/expression/function"
 )
 
 func TestShowCreateTable(t *testing.T) {
@@ -19,6 +21,15 @@ func TestShowCreateTable(t *testing.T) {
 			&sql.Column{Name: "baz", Type: sql.Text, Default: "", Nullable: false},
 			&sql.Column{Name: "zab", Type: sql.Int32, Default: int32(0), Nullable: true},
 			&sql.Column{Name: "bza", Type: sql.Uint64, Default: uint64(0), Nullable: true},
+			&sql.Column{Name: "size", Type: sql.Enum("s", "m"), Nullable: true},
+			&sql.Column{Name: "opts", Type: sql.Set("a", "b"), Nullable: false},
+			&sql.Column{Name: "quote", Type: sql.VarChar(10), Default: "it's", Nullable: true},
+			&sql.Column{Name: "created", Type: sql.DatetimeWithPrecision(3), DefaultExpr: function.NewNow(), Nullable: true},
+			&sql.Column{Name: "twice", Type: sql.Int32, DefaultExpr: expression.NewArithmetic(
+				expression.NewGetField(1, sql.Int32, "zab", true),
+				expression.NewLiteral(int32(2), sql.Int32),
+				"*",
+			), Nullable: true},
 		})
 
 	db.AddTable(table.Name(), table)
@@ -38,9 +49,54 @@ func TestShowCreateTable(t *testing.T) {
 	expected := sql.NewRow(
 		table.Name(),
 		"CREATE TABLE `test-table` (\n  `baz` text NOT NULL,\n"+
-			"  `zab` integer DEFAULT 0,\n"+
-			"  `bza` bigint unsigned DEFAULT 0\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			"  `zab` int DEFAULT '0',\n"+
+			"  `bza` bigint unsigned DEFAULT '0',\n"+
+			"  `size` enum('s','m'),\n"+
+			"  `opts` set('a','b') NOT NULL,\n"+
+			"  `quote` varchar(10) DEFAULT 'it''s',\n"+
+			"  `created` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),\n"+
+			"  `twice` int DEFAULT (zab * 2)\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
 	)
 
 	require.Equal(expected, row)
 }
+
+func TestShowCreateTableCollations(t *testing.T) {
+	var require = require.New(t)
+
+	collation := func(name string) *sql.Collation {
+		c, err := sql.LookupCollation(name)
+		require.NoError(err)
+		return c
+	}
+
+	db := memory.NewDatabase("testdb")
+	table := memory.NewTable(
+		"t",
+		sql.Schema{
+			&sql.Column{Name: "a", Type: sql.Text, Nullable: true},
+			&sql.Column{Name: "b", Type: sql.WithCollation(sql.VarChar(5), collation("utf8mb4_bin")), Nullable: true},
+			&sql.Column{Name: "c", Type: sql.WithCollation(sql.Char(2), collation("latin1_swedish_ci")), Nullable: true},
+			&sql.Column{Name: "d", Type: sql.WithCollation(sql.Text, collation("latin1_bin")), Nullable: true},
+		})
+	db.AddTable(table.Name(), table)
+
+	cat := sql.NewCatalog()
+	cat.AddDatabase(db)
+
+	rowIter, err := NewShowCreateTable(db.Name(), cat, table.Name()).RowIter(sql.NewEmptyContext())
+	require.NoError(err)
+
+	row, err := rowIter.Next()
+	require.NoError(err)
+	require.Equal(sql.NewRow(
+		"t",
+		"CREATE TABLE `t` (\n"+
+			"  `a` text,\n"+
+			"  `b` varchar(5) COLLATE utf8mb4_bin,\n"+
+			"  `c` char(2) CHARACTER SET latin1,\n"+
+			"  `d` text CHARACTER SET latin1 COLLATE latin1_bin\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+	), row)
+}
@@ -0,0 +1,104 @@
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+)
+
+// ShowStatus is a node that shows the status variables of the server.
+type ShowStatus struct {
+	Registry *sql.StatusRegistry
+	pattern  string
+}
+
+// NewShowStatus returns a new ShowStatus reference.
+// like is a "like pattern". If like is an empty string it will return all
+// status variables.
+func NewShowStatus(like string) *ShowStatus {
+	return &ShowStatus{pattern: like}
+}
+
+// Resolved implements sql.Node interface. The function always returns true.
+func (s *ShowStatus) Resolved() bool {
+	return true
+}
+
+// WithChildren implements the Node interface.
+func (s *ShowStatus) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+
+	return s, nil
+}
+
+// String implements the Stringer interface.
+func (s *ShowStatus) String() string {
+	var like string
+	if s.pattern != "" {
+		like = fmt.Sprintf(" LIKE '%s'", s.pattern)
+	}
+	return fmt.Sprintf("SHOW STATUS%s", like)
+}
+
+// Schema returns a new Schema reference for "SHOW STATUS" query.
+func (*ShowStatus) Schema() sql.Schema {
+	return sql.Schema{
+		&sql.Column{Name: "Variable_name", Type: sql.Text, Nullable: false},
+		&sql.Column{Name: "Value", Type: sql.Text, Nullable: true},
+	}
+}
+
+// Children implements sql.Node interface. The function always returns nil.
+func (*ShowStatus) Children() []sql.Node { return nil }
+
+// RowIter implements the sql.Node interface.
+// The function returns an iterator for the status variables sorted by name
+// and filtered by the like pattern.
+func (s *ShowStatus) RowIter(ctx *sql.Context) (sql.RowIter, error) {
+	if s.Registry == nil {
+		return sql.RowsToRowIter(), nil
+	}
+
+	var like sql.Expression
+	if s.pattern != "" {
+		like = expression.NewLike(
+			expression.NewGetField(0, sql.Text, "", false),
+			expression.NewGetField(1, sql.Text, s.pattern, false),
+		)
+	}
+
+	vars := s.Registry.StatusVariables()
+	var names = make([]string, 0, len(vars))
+	for k := range vars {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var rows []sql.Row
+	for _, k := range names {
+		if like != nil {
+			// Status variable names are matched case insensitively.
+			b, err := like.Eval(ctx, sql.NewRow(strings.ToLower(k), strings.ToLower(s.pattern)))
+			if err != nil {
+				return nil, err
+			}
+			if !b.(bool) {
+				continue
+			}
+		}
+
+		value, err := sql.Text.Convert(vars[k])
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, sql.NewRow(k, value))
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
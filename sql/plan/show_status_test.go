@@ -0,0 +1,39 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowStatus(t *testing.T) {
+	require := require.New(t)
+
+	registry := sql.NewStatusRegistry()
+	registry.RegisterStatusVariable("Threads_running", func() interface{} { return 3 })
+	registry.RegisterStatusVariable("Threads_connected", func() interface{} { return 5 })
+	registry.RegisterStatusVariable("Uptime", func() interface{} { return int64(10) })
+
+	s := NewShowStatus("")
+	s.Registry = registry
+	require.True(s.Resolved())
+
+	rows, err := sql.NodeToRows(sql.NewEmptyContext(), s)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"Threads_connected", "5"},
+		{"Threads_running", "3"},
+		{"Uptime", "10"},
+	}, rows)
+
+	s = NewShowStatus("threads%")
+	s.Registry = registry
+
+	rows, err = sql.NodeToRows(sql.NewEmptyContext(), s)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"Threads_connected", "5"},
+		{"Threads_running", "3"},
+	}, rows)
+}
@@ -1,8 +1,6 @@
 package plan
 
 import (
-	"fmt"
-
 	"github.com/mushiyu/go-mysql-server/sql"
 )
 
@@ -12,8 +10,6 @@ type ShowColumns struct {
 	Full bool
 }
 
-const defaultCollation = "utf8_bin"
-
 var (
 	showColumnsSchema = sql.Schema{
 		{Name: "Field", Type: sql.Text},
@@ -61,8 +57,8 @@ func (s *ShowColumns) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 	for i, col := range schema {
 		var row sql.Row
 		var collation interface{}
-		if col.Type == sql.Text {
-			collation = defaultCollation
+		if c, ok := sql.CollationOf(col.Type); ok && c != sql.BinaryCollation {
+			collation = c.Name
 		}
 
 		var null = "NO"
@@ -70,10 +66,7 @@ func (s *ShowColumns) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 			null = "YES"
 		}
 
-		var defaultVal string
-		if col.Default != nil {
-			defaultVal = fmt.Sprint(col.Default)
-		}
+		defaultVal, _ := col.DefaultString()
 
 		if s.Full {
 			row = sql.Row{
@@ -83,7 +76,7 @@ func (s *ShowColumns) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 				null,
 				"", // Key
 				defaultVal,
-				"", // Extra
+				col.Extra(),
 				"", // Privileges
 				"", // Comment
 			}
@@ -94,7 +87,7 @@ func (s *ShowColumns) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 				null,
 				"", // Key
 				defaultVal,
-				"", // Extra
+				col.Extra(),
 			}
 		}
 
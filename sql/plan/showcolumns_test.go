@@ -47,7 +47,7 @@ func TestShowColumnsFull(t *testing.T) {
 	require.NoError(err)
 
 	expected := []sql.Row{
-		sql.Row{"a", "TEXT", "utf8_bin", "NO", "", "", "", "", ""},
+		sql.Row{"a", "TEXT", "utf8mb4_general_ci", "NO", "", "", "", "", ""},
 		sql.Row{"b", "INT64", nil, "YES", "", "", "", "", ""},
 		sql.Row{"c", "INT64", nil, "NO", "", "1", "", "", ""},
 	}
@@ -200,6 +200,7 @@ func (i *sortIter) computeSortedRows() error {
 		sortFields: i.s.SortFields,
 		rows:       rows,
 		lastError:  nil,
+		ctx:        i.ctx,
 	}
 	sort.Stable(sorter)
 	if sorter.lastError != nil {
@@ -229,28 +230,35 @@ func (s *sorter) Less(i, j int) bool {
 		return false
 	}
 
-	a := s.rows[i]
-	b := s.rows[j]
-	for _, sf := range s.sortFields {
+	less, err := lessRow(s.ctx, s.sortFields, s.rows[i], s.rows[j])
+	if err != nil {
+		s.lastError = err
+		return false
+	}
+	return less
+}
+
+// lessRow reports whether the row a goes before the row b when they are
+// sorted by the given fields.
+func lessRow(ctx *sql.Context, sortFields []SortField, a, b sql.Row) (bool, error) {
+	for _, sf := range sortFields {
 		typ := sf.Column.Type()
-		av, err := sf.Column.Eval(s.ctx, a)
+		av, err := sf.Column.Eval(ctx, a)
 		if err != nil {
-			s.lastError = ErrUnableSort.Wrap(err)
-			return false
+			return false, ErrUnableSort.Wrap(err)
 		}
 
-		bv, err := sf.Column.Eval(s.ctx, b)
+		bv, err := sf.Column.Eval(ctx, b)
 		if err != nil {
-			s.lastError = ErrUnableSort.Wrap(err)
-			return false
+			return false, ErrUnableSort.Wrap(err)
 		}
 
 		if av == nil {
-			return sf.NullOrdering == NullsFirst
+			return sf.NullOrdering == NullsFirst, nil
 		}
 
 		if bv == nil {
-			return sf.NullOrdering != NullsFirst
+			return sf.NullOrdering != NullsFirst, nil
 		}
 
 		if sf.Order == Descending {
@@ -259,17 +267,16 @@ func (s *sorter) Less(i, j int) bool {
 
 		cmp, err := typ.Compare(av, bv)
 		if err != nil {
-			s.lastError = err
-			return false
+			return false, err
 		}
 
 		switch cmp {
 		case -1:
-			return true
+			return true, nil
 		case 1:
-			return false
+			return false, nil
 		}
 	}
 
-	return false
+	return false, nil
 }
@@ -0,0 +1,211 @@
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mushiyu/go-mysql-server/sql"
+)
+
+// SortedTable is a table whose partitions return their rows already sorted,
+// such as a table using a sorted index lookup. It has a single partition
+// that merges the rows of all the partitions of the underlying table, so
+// they are returned sorted by the given fields without having to sort them.
+type SortedTable struct {
+	sql.Table
+	SortFields []SortField
+}
+
+var _ sql.TableWrapper = (*SortedTable)(nil)
+
+// NewSortedTable returns a new SortedTable. The rows of each partition of
+// the given table must be sorted by the given fields.
+func NewSortedTable(table sql.Table, sortFields []SortField) *SortedTable {
+	return &SortedTable{table, sortFields}
+}
+
+// Underlying implements sql.TableWrapper interface.
+func (t *SortedTable) Underlying() sql.Table {
+	return t.Table
+}
+
+// Partitions implements the sql.Table interface.
+func (t *SortedTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return &sortedTablePartitionIter{}, nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *SortedTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	partitions, err := t.Table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var iters []sql.RowIter
+	closeAll := func() {
+		for _, iter := range iters {
+			_ = iter.Close()
+		}
+		_ = partitions.Close()
+	}
+
+	for {
+		p, err := partitions.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			closeAll()
+			return nil, err
+		}
+
+		iter, err := t.Table.PartitionRows(ctx, p)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		iters = append(iters, iter)
+	}
+
+	if err := partitions.Close(); err != nil {
+		for _, iter := range iters {
+			_ = iter.Close()
+		}
+		return nil, err
+	}
+
+	return &sortedMergeIter{
+		ctx:        ctx,
+		sortFields: t.SortFields,
+		iters:      iters,
+		rows:       make([]sql.Row, len(iters)),
+	}, nil
+}
+
+func (t *SortedTable) String() string {
+	pr := sql.NewTreePrinter()
+	var fields = make([]string, len(t.SortFields))
+	for i, f := range t.SortFields {
+		fields[i] = fmt.Sprintf("%s %s", f.Column, f.Order)
+	}
+	_ = pr.WriteNode("SortedTable(%s)", strings.Join(fields, ", "))
+	_ = pr.WriteChildren(t.Table.String())
+	return pr.String()
+}
+
+type sortedTablePartition struct{}
+
+func (sortedTablePartition) Key() []byte { return []byte("sorted") }
+
+type sortedTablePartitionIter struct {
+	done bool
+}
+
+func (i *sortedTablePartitionIter) Next() (sql.Partition, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+
+	i.done = true
+	return sortedTablePartition{}, nil
+}
+
+func (i *sortedTablePartitionIter) Close() error { return nil }
+
+// sortedMergeIter merges the rows of several sorted iterators.
+type sortedMergeIter struct {
+	ctx        *sql.Context
+	sortFields []SortField
+	iters      []sql.RowIter
+	// rows contains the next row of each iterator, which is nil if it has
+	// not been read yet or if the iterator is exhausted.
+	rows    []sql.Row
+	started bool
+}
+
+func (i *sortedMergeIter) Next() (sql.Row, error) {
+	if !i.started {
+		i.started = true
+		for n := range i.iters {
+			if err := i.advance(n); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	next := -1
+	for n, row := range i.rows {
+		if row == nil {
+			continue
+		}
+
+		if next < 0 {
+			next = n
+			continue
+		}
+
+		less, err := lessRow(i.ctx, i.sortFields, row, i.rows[next])
+		if err != nil {
+			return nil, err
+		}
+
+		if less {
+			next = n
+		}
+	}
+
+	if next < 0 {
+		return nil, io.EOF
+	}
+
+	row := i.rows[next]
+	if err := i.advance(next); err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// advance reads the next row of the iterator n.
+func (i *sortedMergeIter) advance(n int) error {
+	select {
+	case <-i.ctx.Done():
+		return i.ctx.Err()
+	default:
+	}
+
+	i.rows[n] = nil
+	if i.iters[n] == nil {
+		return nil
+	}
+
+	row, err := i.iters[n].Next()
+	if err != nil {
+		if err != io.EOF {
+			return err
+		}
+
+		err = i.iters[n].Close()
+		i.iters[n] = nil
+		return err
+	}
+
+	i.rows[n] = row
+	return nil
+}
+
+func (i *sortedMergeIter) Close() error {
+	var err error
+	for n, iter := range i.iters {
+		if iter == nil {
+			continue
+		}
+
+		if e := iter.Close(); err == nil {
+			err = e
+		}
+		i.iters[n] = nil
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/mushiyu/go-mysql-server/memory"
+	"github.com/mushiyu/go-mysql-server/sql"
+	"github.com/mushiyu/go-mysql-server/sql/expression"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedTable(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "foo", Nullable: true},
+	}
+	table := memory.NewPartitionedTable("foo", schema, 3)
+
+	// Rows are inserted in partitions in turn, so all of them are sorted.
+	values := []interface{}{nil, int64(1), int64(2), int64(2), int64(5), int64(6), int64(7)}
+	for _, v := range values {
+		require.NoError(table.Insert(ctx, sql.NewRow(v)))
+	}
+
+	sorted := NewSortedTable(table, []SortField{
+		{Column: expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", true), Order: Ascending},
+	})
+	require.Equal(table, sorted.Underlying())
+
+	iter, err := NewResolvedTable(sorted).RowIter(ctx)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(iter)
+	require.NoError(err)
+
+	var expected []sql.Row
+	for _, v := range values {
+		expected = append(expected, sql.NewRow(v))
+	}
+	require.Equal(expected, rows)
+}
@@ -14,11 +14,13 @@ var ErrUnresolvedTable = errors.NewKind("unresolved table")
 type UnresolvedTable struct {
 	name     string
 	Database string
+	// IndexHint is the index hint given in the table reference, if any.
+	IndexHint *IndexHint
 }
 
 // NewUnresolvedTable creates a new Unresolved table.
 func NewUnresolvedTable(name, db string) *UnresolvedTable {
-	return &UnresolvedTable{name, db}
+	return &UnresolvedTable{name: name, Database: db}
 }
 
 // Name implements the Nameable interface.
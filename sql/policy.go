@@ -0,0 +1,106 @@
+package sql
+
+import (
+	"strings"
+	"sync"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrPolicyAlreadyExists is returned when a policy with the same name
+	// already exists on the table.
+	ErrPolicyAlreadyExists = errors.NewKind("policy %q already exists on table %q")
+
+	// ErrPolicyNotFound is returned when the policy could not be found.
+	ErrPolicyNotFound = errors.NewKind("policy %q was not found on table %q")
+)
+
+// Policy is a row-level security policy. Only the rows of the table for
+// which the predicate evaluates to true are visible to queries.
+type Policy struct {
+	// Name of the policy.
+	Name string
+	// Database the table of the policy belongs to.
+	Database string
+	// Table on which the policy is enforced.
+	Table string
+	// Predicate is the condition every visible row must satisfy. It is
+	// stored unresolved and is resolved again in each query using it, so it
+	// can reference session values such as CURRENT_USER().
+	Predicate Expression
+}
+
+type policyKey struct {
+	db, table string
+}
+
+func newPolicyKey(db, table string) policyKey {
+	return policyKey{strings.ToLower(db), strings.ToLower(table)}
+}
+
+// PolicyRegistry keeps track of all row-level security policies.
+type PolicyRegistry struct {
+	mu       sync.RWMutex
+	policies map[policyKey][]*Policy
+}
+
+// NewPolicyRegistry returns a new PolicyRegistry.
+func NewPolicyRegistry() *PolicyRegistry {
+	return &PolicyRegistry{policies: make(map[policyKey][]*Policy)}
+}
+
+// AddPolicy registers the given policy. It will fail if there is already a
+// policy with the same name on the same table.
+func (r *PolicyRegistry) AddPolicy(p *Policy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := newPolicyKey(p.Database, p.Table)
+	for _, existing := range r.policies[key] {
+		if strings.ToLower(existing.Name) == strings.ToLower(p.Name) {
+			return ErrPolicyAlreadyExists.New(p.Name, p.Table)
+		}
+	}
+
+	r.policies[key] = append(r.policies[key], p)
+	return nil
+}
+
+// DropPolicy removes the policy with the given name on the given table.
+func (r *PolicyRegistry) DropPolicy(db, table, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := newPolicyKey(db, table)
+	policies := r.policies[key]
+	for i, p := range policies {
+		if strings.ToLower(p.Name) == strings.ToLower(name) {
+			r.policies[key] = append(policies[:i:i], policies[i+1:]...)
+			if len(r.policies[key]) == 0 {
+				delete(r.policies, key)
+			}
+			return nil
+		}
+	}
+
+	return ErrPolicyNotFound.New(name, table)
+}
+
+// PoliciesByTable returns all the policies enforced on the given table.
+func (r *PolicyRegistry) PoliciesByTable(db, table string) []*Policy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policies := r.policies[newPolicyKey(db, table)]
+	var result = make([]*Policy, len(policies))
+	copy(result, policies)
+	return result
+}
+
+// HasPolicies returns whether there is any policy registered.
+func (r *PolicyRegistry) HasPolicies() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.policies) > 0
+}
@@ -0,0 +1,35 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyRegistry(t *testing.T) {
+	require := require.New(t)
+
+	r := NewPolicyRegistry()
+	require.False(r.HasPolicies())
+
+	p1 := &Policy{Name: "p1", Database: "db", Table: "t"}
+	p2 := &Policy{Name: "p2", Database: "db", Table: "T"}
+	require.NoError(r.AddPolicy(p1))
+	require.NoError(r.AddPolicy(p2))
+	require.True(r.HasPolicies())
+
+	err := r.AddPolicy(&Policy{Name: "P1", Database: "db", Table: "t"})
+	require.True(ErrPolicyAlreadyExists.Is(err))
+
+	require.Equal([]*Policy{p1, p2}, r.PoliciesByTable("DB", "t"))
+	require.Len(r.PoliciesByTable("db", "other"), 0)
+
+	require.NoError(r.DropPolicy("db", "t", "p1"))
+	require.Equal([]*Policy{p2}, r.PoliciesByTable("db", "t"))
+
+	err = r.DropPolicy("db", "t", "p1")
+	require.True(ErrPolicyNotFound.Is(err))
+
+	require.NoError(r.DropPolicy("db", "t", "p2"))
+	require.False(r.HasPolicies())
+}
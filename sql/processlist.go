@@ -33,6 +33,8 @@ const (
 	QueryProcess ProcessType = iota
 	// CreateIndexProcess is a process to create an index.
 	CreateIndexProcess
+	// RebuildIndexProcess is a process to rebuild an index.
+	RebuildIndexProcess
 )
 
 func (p ProcessType) String() string {
@@ -41,6 +43,8 @@ func (p ProcessType) String() string {
 		return "query"
 	case CreateIndexProcess:
 		return "create_index"
+	case RebuildIndexProcess:
+		return "rebuild_index"
 	default:
 		return "invalid"
 	}
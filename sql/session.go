@@ -162,6 +162,11 @@ type (
 	}
 )
 
+// LastInsertIDVar is the session variable with the first value generated
+// for an AUTO_INCREMENT column by the last INSERT, which is the value of
+// LAST_INSERT_ID().
+const LastInsertIDVar = "last_insert_id"
+
 // DefaultSessionConfig returns default values for session variables
 func DefaultSessionConfig() map[string]TypedValue {
 	return map[string]TypedValue{
@@ -169,9 +174,17 @@ func DefaultSessionConfig() map[string]TypedValue {
 		"time_zone":                TypedValue{Text, time.Local.String()},
 		"system_time_zone":         TypedValue{Text, time.Local.String()},
 		"max_allowed_packet":       TypedValue{Int32, math.MaxInt32},
+		"max_execution_time":       TypedValue{Int64, int64(0)},
 		"sql_mode":                 TypedValue{Text, ""},
 		"gtid_mode":                TypedValue{Int32, int32(0)},
-		"collation_database":       TypedValue{Text, "utf8_bin"},
+		LastInsertIDVar:            TypedValue{Uint64, uint64(0)},
+		"character_set_client":     TypedValue{Text, DefaultCollation.CharacterSet},
+		"character_set_connection": TypedValue{Text, DefaultCollation.CharacterSet},
+		"character_set_results":    TypedValue{Text, DefaultCollation.CharacterSet},
+		"character_set_server":     TypedValue{Text, DefaultCollation.CharacterSet},
+		"collation_connection":     TypedValue{Text, DefaultCollation.Name},
+		"collation_database":       TypedValue{Text, DefaultCollation.Name},
+		"collation_server":         TypedValue{Text, DefaultCollation.Name},
 		"ndbinfo_version":          TypedValue{Text, ""},
 		"sql_select_limit":         TypedValue{Int32, math.MaxInt32},
 		"transaction_isolation":    TypedValue{Text, "READ UNCOMMITTED"},
@@ -215,6 +228,9 @@ type Context struct {
 	pid    uint64
 	query  string
 	tracer opentracing.Tracer
+	// insertID is shared with the contexts derived from this one, so the
+	// value set while executing the query can be read by its caller.
+	insertID *uint64
 }
 
 // ContextOption is a function to configure the context.
@@ -264,7 +280,7 @@ func NewContext(
 	ctx context.Context,
 	opts ...ContextOption,
 ) *Context {
-	c := &Context{ctx, NewBaseSession(), nil, 0, "", opentracing.NoopTracer{}}
+	c := &Context{ctx, NewBaseSession(), nil, 0, "", opentracing.NoopTracer{}, new(uint64)}
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -298,12 +314,30 @@ func (c *Context) Span(
 	span := c.tracer.StartSpan(opName, opts...)
 	ctx := opentracing.ContextWithSpan(c.Context, span)
 
-	return span, &Context{ctx, c.Session, c.Memory, c.Pid(), c.Query(), c.tracer}
+	return span, &Context{ctx, c.Session, c.Memory, c.Pid(), c.Query(), c.tracer, c.insertID}
 }
 
 // WithContext returns a new context with the given underlying context.
 func (c *Context) WithContext(ctx context.Context) *Context {
-	return &Context{ctx, c.Session, c.Memory, c.Pid(), c.Query(), c.tracer}
+	return &Context{ctx, c.Session, c.Memory, c.Pid(), c.Query(), c.tracer, c.insertID}
+}
+
+// SetInsertID sets the first value generated for an AUTO_INCREMENT column by
+// the query of the context.
+func (c *Context) SetInsertID(id uint64) {
+	if c.insertID != nil {
+		*c.insertID = id
+	}
+}
+
+// InsertID returns the first value generated for an AUTO_INCREMENT column by
+// the query of the context, or 0 if it didn't generate any. Unlike
+// LAST_INSERT_ID(), it is not kept between queries.
+func (c *Context) InsertID() uint64 {
+	if c.insertID == nil {
+		return 0
+	}
+	return *c.insertID
 }
 
 // Error adds an error as warning to the session.
@@ -49,6 +49,20 @@ func TestHasDefaultValue(t *testing.T) {
 	require.False(HasDefaultValue(sess, "non_existing_key"))
 }
 
+func TestContextInsertID(t *testing.T) {
+	require := require.New(t)
+
+	ctx := NewEmptyContext()
+	require.Equal(uint64(0), ctx.InsertID())
+
+	span, spanCtx := ctx.Span("foo")
+	defer span.Finish()
+	spanCtx.WithContext(context.Background()).SetInsertID(5)
+	require.Equal(uint64(5), ctx.InsertID())
+
+	require.Equal(uint64(0), NewEmptyContext().InsertID())
+}
+
 type testNode struct{}
 
 func (*testNode) Resolved() bool {
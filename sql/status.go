@@ -0,0 +1,53 @@
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// StatusVariable returns the current value of a status variable.
+type StatusVariable func() interface{}
+
+type statusVariable struct {
+	name string
+	fn   StatusVariable
+}
+
+// StatusRegistry holds the status variables shown by SHOW STATUS.
+type StatusRegistry struct {
+	mu   sync.RWMutex
+	vars map[string]statusVariable
+}
+
+// NewStatusRegistry returns a new empty StatusRegistry.
+func NewStatusRegistry() *StatusRegistry {
+	return &StatusRegistry{vars: make(map[string]statusVariable)}
+}
+
+// RegisterStatusVariable registers a status variable with the given name,
+// replacing the previous one with the same name, if any.
+func (r *StatusRegistry) RegisterStatusVariable(name string, fn StatusVariable) {
+	r.mu.Lock()
+	r.vars[strings.ToLower(name)] = statusVariable{name, fn}
+	r.mu.Unlock()
+}
+
+// UnregisterStatusVariable removes the status variable with the given name.
+func (r *StatusRegistry) UnregisterStatusVariable(name string) {
+	r.mu.Lock()
+	delete(r.vars, strings.ToLower(name))
+	r.mu.Unlock()
+}
+
+// StatusVariables returns the current values of all the status variables
+// by name.
+func (r *StatusRegistry) StatusVariables() map[string]interface{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result = make(map[string]interface{}, len(r.vars))
+	for _, v := range r.vars {
+		result[v.name] = v.fn()
+	}
+	return result
+}
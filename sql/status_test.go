@@ -0,0 +1,35 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusRegistry(t *testing.T) {
+	require := require.New(t)
+
+	r := NewStatusRegistry()
+	require.Len(r.StatusVariables(), 0)
+
+	var n int
+	r.RegisterStatusVariable("Threads_running", func() interface{} { return n })
+	r.RegisterStatusVariable("Foo", func() interface{} { return "bar" })
+
+	n = 2
+	require.Equal(map[string]interface{}{
+		"Threads_running": 2,
+		"Foo":             "bar",
+	}, r.StatusVariables())
+
+	r.RegisterStatusVariable("foo", func() interface{} { return "baz" })
+	require.Equal(map[string]interface{}{
+		"Threads_running": 2,
+		"foo":             "baz",
+	}, r.StatusVariables())
+
+	r.UnregisterStatusVariable("FOO")
+	require.Equal(map[string]interface{}{
+		"Threads_running": 2,
+	}, r.StatusVariables())
+}
@@ -0,0 +1,39 @@
+package sql // import "github.com/mushiyu/go-mysql-server/sql"
+
+const (
+	// SysDatabaseName is the name of the sys database.
+	SysDatabaseName = "sys"
+	// SchemaIndexStatisticsTableName is the name of the index statistics view
+	// of the sys database.
+	SchemaIndexStatisticsTableName = "schema_index_statistics"
+)
+
+// schemaIndexStatisticsSchema has the same columns as the index_statistics
+// table of the information schema. Unlike in MySQL, there are no columns for
+// the rows written through the index nor for latencies, which are not tracked.
+var schemaIndexStatisticsSchema = Schema{
+	{Name: "table_schema", Type: Text, Default: "", Nullable: false, Source: SchemaIndexStatisticsTableName},
+	{Name: "table_name", Type: Text, Default: "", Nullable: false, Source: SchemaIndexStatisticsTableName},
+	{Name: "index_name", Type: Text, Default: "", Nullable: false, Source: SchemaIndexStatisticsTableName},
+	{Name: "driver", Type: Text, Default: "", Nullable: false, Source: SchemaIndexStatisticsTableName},
+	{Name: "lookups", Type: Uint64, Default: 0, Nullable: false, Source: SchemaIndexStatisticsTableName},
+	{Name: "rows_selected", Type: Uint64, Default: 0, Nullable: false, Source: SchemaIndexStatisticsTableName},
+	{Name: "last_used", Type: Timestamp, Default: nil, Nullable: true, Source: SchemaIndexStatisticsTableName},
+}
+
+// NewSysDatabase creates a new sys Database with the views of the catalog
+// that MySQL has in its sys schema, which for now is only the
+// schema_index_statistics view with the usage of the indexes.
+func NewSysDatabase(cat *Catalog) Database {
+	return &informationSchemaDatabase{
+		name: SysDatabaseName,
+		tables: map[string]Table{
+			SchemaIndexStatisticsTableName: &informationSchemaTable{
+				name:    SchemaIndexStatisticsTableName,
+				schema:  schemaIndexStatisticsSchema,
+				catalog: cat,
+				rowIter: indexStatisticsRowIter,
+			},
+		},
+	}
+}
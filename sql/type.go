@@ -2,15 +2,18 @@ package sql
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"io"
 	"math"
+	"math/big"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/shopspring/decimal"
 	"github.com/spf13/cast"
 	"github.com/mushiyu/vitess/go/sqltypes"
 	"github.com/mushiyu/vitess/go/vt/proto/query"
@@ -33,6 +36,12 @@ var (
 	// ErrVarCharTruncation is thrown when a VarChar value is textually longer than the destination capacity
 	ErrVarCharTruncation = errors.NewKind("string value of %q is longer than destination capacity %d")
 
+	// ErrBinaryTruncation is thrown when a Binary value is longer than the destination capacity
+	ErrBinaryTruncation = errors.NewKind("binary value of %q is longer than destination capacity %d")
+
+	// ErrVarBinaryTruncation is thrown when a VarBinary value is longer than the destination capacity
+	ErrVarBinaryTruncation = errors.NewKind("binary value of %q is longer than destination capacity %d")
+
 	// ErrValueNotNil is thrown when a value that was expected to be nil, is not
 	ErrValueNotNil = errors.NewKind("value not nil: %#v")
 
@@ -49,6 +58,30 @@ var (
 	// ErrConvertToSQL is returned when Convert failed.
 	// It makes an error less verbose comparingto what spf13/cast returns.
 	ErrConvertToSQL = errors.NewKind("incompatible conversion to SQL type: %s")
+
+	// ErrDecimalOutOfRange is returned when a value has more digits in its
+	// integer part than the ones allowed by a Decimal type.
+	ErrDecimalOutOfRange = errors.NewKind("value %v is out of range for %s")
+
+	// ErrTimeOutOfRange is returned when a value is out of the range of the
+	// Time type.
+	ErrTimeOutOfRange = errors.NewKind("value %v is out of range for %s")
+
+	// ErrYearOutOfRange is returned when a value is out of the range of the
+	// Year type.
+	ErrYearOutOfRange = errors.NewKind("value %v is out of range for %s")
+
+	// ErrConvertingToDuration is thrown when a value cannot be converted to a
+	// time.Duration.
+	ErrConvertingToDuration = errors.NewKind("value %q can't be converted to time.Duration")
+
+	// ErrInvalidEnumValue is returned when a value is not a member of an
+	// Enum type.
+	ErrInvalidEnumValue = errors.NewKind("value %v is not a member of %s")
+
+	// ErrInvalidSetValue is returned when a value has elements that are not
+	// members of a Set type.
+	ErrInvalidSetValue = errors.NewKind("value %v is not a member of %s")
 )
 
 // Schema is the definition of a table.
@@ -119,6 +152,20 @@ type Column struct {
 	Type Type
 	// Default contains the default value of the column or nil if it is NULL.
 	Default interface{}
+	// DefaultExpr is the expression the default value of the column is
+	// computed with every time a row is inserted, such as CURRENT_TIMESTAMP,
+	// or nil if the default value is Default.
+	DefaultExpr Expression
+	// AutoIncrement is true if the column takes the next value of the
+	// AUTO_INCREMENT counter of its table when no value is given for it.
+	AutoIncrement bool
+	// Generated is the expression the value of a generated column is
+	// computed with from the other columns of the row, or nil if the column
+	// is not generated.
+	Generated Expression
+	// Virtual is true if the value of the generated column is computed when
+	// the row is read instead of being stored.
+	Virtual bool
 	// Nullable is true if the column can contain NULL values, or false
 	// otherwise.
 	Nullable bool
@@ -126,6 +173,37 @@ type Column struct {
 	Source string
 }
 
+// Extra returns the additional information of the column that MySQL shows
+// along with its definition, such as whether it's an AUTO_INCREMENT or a
+// generated column.
+func (c *Column) Extra() string {
+	switch {
+	case c.AutoIncrement:
+		return "auto_increment"
+	case c.Generated != nil && c.Virtual:
+		return "VIRTUAL GENERATED"
+	case c.Generated != nil:
+		return "STORED GENERATED"
+	case c.DefaultExpr != nil:
+		return "DEFAULT_GENERATED"
+	default:
+		return ""
+	}
+}
+
+// DefaultString returns the default value of the column as shown by MySQL,
+// and false if it is NULL.
+func (c *Column) DefaultString() (string, bool) {
+	switch {
+	case c.DefaultExpr != nil:
+		return c.DefaultExpr.String(), true
+	case c.Default != nil:
+		return fmt.Sprint(c.Default), true
+	default:
+		return "", false
+	}
+}
+
 // Check ensures the value is correct for this column.
 func (c *Column) Check(v interface{}) bool {
 	if v == nil {
@@ -141,7 +219,11 @@ func (c *Column) Equals(c2 *Column) bool {
 	return c.Name == c2.Name &&
 		c.Source == c2.Source &&
 		c.Nullable == c2.Nullable &&
+		c.AutoIncrement == c2.AutoIncrement &&
+		c.Virtual == c2.Virtual &&
 		reflect.DeepEqual(c.Default, c2.Default) &&
+		reflect.DeepEqual(c.DefaultExpr, c2.DefaultExpr) &&
+		reflect.DeepEqual(c.Generated, c2.Generated) &&
 		reflect.DeepEqual(c.Type, c2.Type)
 }
 
@@ -170,6 +252,18 @@ func ValidateTime(t time.Time) interface{} {
 	return t
 }
 
+// maxDuration is the maximum absolute value of the Time type, 838:59:59.
+const maxDuration = 838*time.Hour + 59*time.Minute + 59*time.Second
+
+// ValidateDuration receives a duration and returns either that duration or
+// nil if it's out of the range of the Time type.
+func ValidateDuration(d time.Duration) interface{} {
+	if d > maxDuration || d < -maxDuration {
+		return nil
+	}
+	return d
+}
+
 var (
 	// Null represents the null type.
 	Null nullT
@@ -203,6 +297,11 @@ var (
 	Date dateT
 	// Datetime is a date and a time
 	Datetime datetimeT
+	// Time is an amount of time with hours, minutes and seconds, which can
+	// be a time of the day or the time elapsed between two events.
+	Time timeT
+	// Year is a year between 1901 and 2155, or the year 0.
+	Year yearT
 	// Text is a string type.
 	Text textT
 	// Boolean is a boolean type.
@@ -211,6 +310,15 @@ var (
 	JSON jsonT
 	// Blob is a type that holds a chunk of binary data.
 	Blob blobT
+
+	// Geometry is a type that holds any geometry.
+	Geometry = geometryT{kind: GeometryTypeGeometry}
+	// Point is a type that holds points.
+	Point = geometryT{kind: GeometryTypePoint}
+	// LineString is a type that holds line strings.
+	LineString = geometryT{kind: GeometryTypeLineString}
+	// Polygon is a type that holds polygons.
+	Polygon = geometryT{kind: GeometryTypePolygon}
 )
 
 // Tuple returns a new tuple type with the given element types.
@@ -233,6 +341,96 @@ func VarChar(length int) Type {
 	return varCharT{length: length}
 }
 
+// Binary returns a new Binary type of the given length, whose values are
+// padded with zero bytes to that length.
+func Binary(length int) Type {
+	return binaryT{length: length}
+}
+
+// VarBinary returns a new VarBinary type of the given length.
+func VarBinary(length int) Type {
+	return varBinaryT{length: length}
+}
+
+const (
+	// MaxDecimalPrecision is the maximum precision of a Decimal type.
+	MaxDecimalPrecision = 65
+	// MaxDecimalScale is the maximum scale of a Decimal type.
+	MaxDecimalScale = 30
+)
+
+// MaxSetMembers is the maximum number of members of a Set type.
+const MaxSetMembers = 64
+
+// Enum returns a new Enum type with the given members, whose values are
+// stored as the ordinal of the member, starting at 1, and 0 for the empty
+// string that is used for invalid values.
+func Enum(members ...string) Type {
+	return &enumT{members}
+}
+
+// Set returns a new Set type with the given members, whose values are
+// stored as a bitmask where the bit i is set if the member i is one of
+// the elements of the value.
+func Set(members ...string) Type {
+	return &setT{members}
+}
+
+// MaxTimePrecision is the maximum number of digits of the fractional
+// seconds of the time types.
+const MaxTimePrecision = 6
+
+// TimestampWithPrecision returns a new Timestamp type whose values have the
+// given number of digits of fractional seconds.
+func TimestampWithPrecision(fsp int) Type {
+	return timestampT{fsp: timePrecision(fsp)}
+}
+
+// DatetimeWithPrecision returns a new Datetime type whose values have the
+// given number of digits of fractional seconds.
+func DatetimeWithPrecision(fsp int) Type {
+	return datetimeT{fsp: timePrecision(fsp)}
+}
+
+// TimeWithPrecision returns a new Time type whose values have the given
+// number of digits of fractional seconds.
+func TimeWithPrecision(fsp int) Type {
+	return timeT{fsp: timePrecision(fsp)}
+}
+
+func timePrecision(fsp int) int {
+	if fsp < 0 {
+		return 0
+	}
+
+	if fsp > MaxTimePrecision {
+		return MaxTimePrecision
+	}
+
+	return fsp
+}
+
+// Decimal returns a new Decimal type, which holds exact numbers with the
+// given precision, which is the number of digits, and scale, which is the
+// number of those digits after the decimal point. A precision or scale
+// greater than the maximum one is reduced to it, and a precision lower than
+// the scale is increased to it.
+func Decimal(precision, scale int) Type {
+	if scale > MaxDecimalScale {
+		scale = MaxDecimalScale
+	}
+
+	if precision > MaxDecimalPrecision {
+		precision = MaxDecimalPrecision
+	}
+
+	if precision < scale {
+		precision = scale
+	}
+
+	return decimalT{precision: precision, scale: scale}
+}
+
 // MysqlTypeToType gets the column type using the mysql type
 func MysqlTypeToType(sql query.Type) (Type, error) {
 	switch sql {
@@ -258,10 +456,18 @@ func MysqlTypeToType(sql query.Type) (Type, error) {
 		return Float32, nil
 	case sqltypes.Float64:
 		return Float64, nil
+	case sqltypes.Decimal:
+		// Since we can't get the precision and scale of the sqltypes.Decimal
+		// we return a Decimal with the default ones of MySQL here
+		return Decimal(10, 0), nil
 	case sqltypes.Timestamp:
 		return Timestamp, nil
 	case sqltypes.Date:
 		return Date, nil
+	case sqltypes.Time:
+		return Time, nil
+	case sqltypes.Year:
+		return Year, nil
 	case sqltypes.Text:
 		return Text, nil
 	case sqltypes.Char:
@@ -272,6 +478,11 @@ func MysqlTypeToType(sql query.Type) (Type, error) {
 		// Since we can't get the size of the sqltypes.VarChar to instantiate a
 		// specific VarChar(length) type we return a Text here
 		return Text, nil
+	case sqltypes.Binary, sqltypes.VarBinary:
+		// Since we can't get the size of the sqltypes.Binary and
+		// sqltypes.VarBinary to instantiate a specific Binary(length) or
+		// VarBinary(length) type we return a Blob here
+		return Blob, nil
 	case sqltypes.Datetime:
 		return Datetime, nil
 	case sqltypes.Bit:
@@ -280,6 +491,8 @@ func MysqlTypeToType(sql query.Type) (Type, error) {
 		return JSON, nil
 	case sqltypes.Blob:
 		return Blob, nil
+	case sqltypes.Geometry:
+		return Geometry, nil
 	default:
 		return nil, ErrTypeNotSupported.New(sql)
 	}
@@ -321,6 +534,10 @@ func IsNull(ex Expression) bool {
 
 type numberT struct {
 	t query.Type
+	// width is the display width of the values of ZEROFILL integer types,
+	// which are padded with zeros up to it.
+	width    int
+	zerofill bool
 }
 
 // Type implements Type interface.
@@ -335,14 +552,14 @@ func (t numberT) SQL(v interface{}) (sqltypes.Value, error) {
 	}
 
 	switch t.t {
-	case sqltypes.Int32:
+	case sqltypes.Int8, sqltypes.Int16, sqltypes.Int32, sqltypes.Int64:
 		return sqltypes.MakeTrusted(t.t, strconv.AppendInt(nil, cast.ToInt64(v), 10)), nil
-	case sqltypes.Int64:
-		return sqltypes.MakeTrusted(t.t, strconv.AppendInt(nil, cast.ToInt64(v), 10)), nil
-	case sqltypes.Uint32:
-		return sqltypes.MakeTrusted(t.t, strconv.AppendUint(nil, cast.ToUint64(v), 10)), nil
-	case sqltypes.Uint64:
-		return sqltypes.MakeTrusted(t.t, strconv.AppendUint(nil, cast.ToUint64(v), 10)), nil
+	case sqltypes.Uint8, sqltypes.Uint16, sqltypes.Uint32, sqltypes.Uint64:
+		digits := strconv.AppendUint(nil, cast.ToUint64(v), 10)
+		if t.zerofill && len(digits) < t.width {
+			digits = append(bytes.Repeat([]byte{'0'}, t.width-len(digits)), digits...)
+		}
+		return sqltypes.MakeTrusted(t.t, digits), nil
 	case sqltypes.Float32:
 		return sqltypes.MakeTrusted(t.t, strconv.AppendFloat(nil, cast.ToFloat64(v), 'f', -1, 64)), nil
 	case sqltypes.Float64:
@@ -354,8 +571,15 @@ func (t numberT) SQL(v interface{}) (sqltypes.Value, error) {
 
 // Convert implements Type interface.
 func (t numberT) Convert(v interface{}) (interface{}, error) {
-	if ti, ok := v.(time.Time); ok {
-		v = ti.Unix()
+	switch x := v.(type) {
+	case time.Time:
+		v = x.Unix()
+	case decimal.Decimal:
+		if IsDecimal(t) {
+			v, _ = x.Float64()
+		} else {
+			v = x.Round(0).IntPart()
+		}
 	}
 
 	switch t.t {
@@ -476,9 +700,117 @@ func compareUnsignedInts(a interface{}, b interface{}) (int, error) {
 	return +1, nil
 }
 
-type timestampT struct{}
+type decimalT struct {
+	precision int
+	scale     int
+}
+
+func (t decimalT) String() string {
+	return fmt.Sprintf("DECIMAL(%d,%d)", t.precision, t.scale)
+}
+
+// Type implements Type interface.
+func (t decimalT) Type() query.Type {
+	return sqltypes.Decimal
+}
+
+// SQL implements Type interface.
+func (t decimalT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	d, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	s := d.(decimal.Decimal).StringFixed(int32(t.scale))
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(s)), nil
+}
+
+// Convert implements Type interface. Values are rounded to the scale of the
+// type, and it's an error if they have more digits than the ones allowed by
+// its precision before the decimal point.
+func (t decimalT) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	d, err := toDecimal(v)
+	if err != nil {
+		return nil, ErrConvertToSQL.Wrap(err, t)
+	}
+
+	d = d.Round(int32(t.scale))
+	max := decimal.New(1, int32(t.precision-t.scale))
+	if d.Abs().Cmp(max) >= 0 {
+		return nil, ErrDecimalOutOfRange.New(v, t)
+	}
+
+	return d, nil
+}
+
+// Compare implements Type interface. Values are compared exactly, without
+// rounding them to the scale of the type.
+func (t decimalT) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	da, err := toDecimal(a)
+	if err != nil {
+		return 0, err
+	}
+
+	db, err := toDecimal(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return da.Cmp(db), nil
+}
+
+// toDecimal returns the exact decimal value of a number or a string.
+func toDecimal(v interface{}) (decimal.Decimal, error) {
+	switch v := v.(type) {
+	case decimal.Decimal:
+		return v, nil
+	case bool:
+		if v {
+			return decimal.New(1, 0), nil
+		}
+		return decimal.Zero, nil
+	case int, int8, int16, int32, int64:
+		return decimal.New(cast.ToInt64(v), 0), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(cast.ToUint64(v)), 0), nil
+	case float32:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return decimal.Zero, ErrInvalidType.New(fmt.Sprint(v))
+		}
+		return decimal.NewFromFloat32(v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return decimal.Zero, ErrInvalidType.New(fmt.Sprint(v))
+		}
+		return decimal.NewFromFloat(v), nil
+	case string:
+		return decimal.NewFromString(strings.TrimSpace(v))
+	case []byte:
+		return decimal.NewFromString(strings.TrimSpace(string(v)))
+	case time.Time:
+		return decimal.New(v.Unix(), 0), nil
+	default:
+		return decimal.Zero, ErrInvalidType.New(reflect.TypeOf(v))
+	}
+}
+
+type timestampT struct {
+	fsp int
+}
 
-func (t timestampT) String() string { return "TIMESTAMP" }
+func (t timestampT) String() string { return withPrecision("TIMESTAMP", t.fsp) }
 
 // Type implements Type interface.
 func (t timestampT) Type() query.Type {
@@ -501,6 +833,40 @@ var TimestampLayouts = []string{
 	"20060102",
 }
 
+// withPrecision returns the name of a time type with the given fractional
+// seconds precision.
+func withPrecision(name string, fsp int) string {
+	if fsp == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", name, fsp)
+}
+
+// roundTime rounds the fractional seconds of the time to the given
+// precision, rounding halfway values up as MySQL does.
+func roundTime(t time.Time, fsp int) time.Time {
+	return t.Round(fractionalUnit(fsp))
+}
+
+// fractionalUnit returns the duration of the last digit of the fractional
+// seconds with the given precision.
+func fractionalUnit(fsp int) time.Duration {
+	unit := time.Second
+	for i := 0; i < fsp; i++ {
+		unit /= 10
+	}
+	return unit
+}
+
+// fractionalLayout returns the layout of the fractional seconds with the
+// given precision that follows the seconds of a layout.
+func fractionalLayout(fsp int) string {
+	if fsp == 0 {
+		return ""
+	}
+	return "." + strings.Repeat("0", fsp)
+}
+
 // SQL implements Type interface.
 func (t timestampT) SQL(v interface{}) (sqltypes.Value, error) {
 	if v == nil {
@@ -514,22 +880,23 @@ func (t timestampT) SQL(v interface{}) (sqltypes.Value, error) {
 
 	return sqltypes.MakeTrusted(
 		sqltypes.Timestamp,
-		[]byte(v.(time.Time).Format(TimestampLayout)),
+		[]byte(v.(time.Time).Format(TimestampLayout+fractionalLayout(t.fsp))),
 	), nil
 }
 
-// Convert implements Type interface.
+// Convert implements Type interface. The fractional seconds are rounded to
+// the precision of the type.
 func (t timestampT) Convert(v interface{}) (interface{}, error) {
 	switch value := v.(type) {
 	case time.Time:
-		return value.UTC(), nil
+		return roundTime(value.UTC(), t.fsp), nil
 	case string:
-		t, err := time.Parse(TimestampLayout, value)
+		ti, err := time.Parse(TimestampLayout, value)
 		if err != nil {
 			failed := true
 			for _, fmt := range TimestampLayouts {
 				if t2, err2 := time.Parse(fmt, value); err2 == nil {
-					t = t2
+					ti = t2
 					failed = false
 					break
 				}
@@ -539,7 +906,7 @@ func (t timestampT) Convert(v interface{}) (interface{}, error) {
 				return nil, ErrConvertingToTime.Wrap(err, v)
 			}
 		}
-		return t.UTC(), nil
+		return roundTime(ti.UTC(), t.fsp), nil
 	default:
 		ts, err := Int64.Convert(v)
 		if err != nil {
@@ -550,20 +917,36 @@ func (t timestampT) Convert(v interface{}) (interface{}, error) {
 	}
 }
 
-// Compare implements Type interface.
+// Compare implements Type interface. Values are converted to times with
+// all their fractional seconds before comparing them.
 func (t timestampT) Compare(a interface{}, b interface{}) (int, error) {
 	if hasNulls, res := compareNulls(a, b); hasNulls {
 		return res, nil
 	}
 
-	av := a.(time.Time)
-	bv := b.(time.Time)
-	if av.Before(bv) {
+	return compareTimes(TimestampWithPrecision(MaxTimePrecision), a, b)
+}
+
+// compareTimes compares two values converted to times with the given type.
+func compareTimes(t Type, a, b interface{}) (int, error) {
+	av, err := t.Convert(a)
+	if err != nil {
+		return 0, err
+	}
+
+	bv, err := t.Convert(b)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case av.(time.Time).Before(bv.(time.Time)):
 		return -1, nil
-	} else if av.After(bv) {
+	case av.(time.Time).After(bv.(time.Time)):
 		return 1, nil
+	default:
+		return 0, nil
 	}
-	return 0, nil
 }
 
 type dateT struct{}
@@ -623,23 +1006,18 @@ func (t dateT) Compare(a, b interface{}) (int, error) {
 		return res, nil
 	}
 
-	av := truncateDate(a.(time.Time))
-	bv := truncateDate(b.(time.Time))
-	if av.Before(bv) {
-		return -1, nil
-	} else if av.After(bv) {
-		return 1, nil
-	}
-	return 0, nil
+	return compareTimes(t, a, b)
 }
 
-type datetimeT struct{}
+type datetimeT struct {
+	fsp int
+}
 
 // DatetimeLayout is the layout of the MySQL date format in the representation
 // Go understands.
 const DatetimeLayout = "2006-01-02 15:04:05"
 
-func (t datetimeT) String() string { return "DATETIME" }
+func (t datetimeT) String() string { return withPrecision("DATETIME", t.fsp) }
 
 func (t datetimeT) Type() query.Type {
 	return sqltypes.Datetime
@@ -657,20 +1035,33 @@ func (t datetimeT) SQL(v interface{}) (sqltypes.Value, error) {
 
 	return sqltypes.MakeTrusted(
 		sqltypes.Datetime,
-		[]byte(v.(time.Time).Format(DatetimeLayout)),
+		[]byte(v.(time.Time).Format(DatetimeLayout+fractionalLayout(t.fsp))),
 	), nil
 }
 
+// Convert implements Type interface. The fractional seconds are rounded to
+// the precision of the type.
 func (t datetimeT) Convert(v interface{}) (interface{}, error) {
 	switch value := v.(type) {
 	case time.Time:
-		return value.UTC(), nil
+		return roundTime(value.UTC(), t.fsp), nil
 	case string:
-		t, err := time.Parse(DatetimeLayout, value)
+		ti, err := time.Parse(DatetimeLayout, value)
 		if err != nil {
-			return nil, ErrConvertingToTime.Wrap(err, v)
+			failed := true
+			for _, fmt := range TimestampLayouts {
+				if t2, err2 := time.Parse(fmt, value); err2 == nil {
+					ti = t2
+					failed = false
+					break
+				}
+			}
+
+			if failed {
+				return nil, ErrConvertingToTime.Wrap(err, v)
+			}
 		}
-		return t.UTC(), nil
+		return roundTime(ti.UTC(), t.fsp), nil
 	default:
 		ts, err := Int64.Convert(v)
 		if err != nil {
@@ -681,32 +1072,41 @@ func (t datetimeT) Convert(v interface{}) (interface{}, error) {
 	}
 }
 
+// Compare implements Type interface. Values are converted to times with
+// all their fractional seconds before comparing them.
 func (t datetimeT) Compare(a, b interface{}) (int, error) {
-	av := a.(time.Time)
-	bv := b.(time.Time)
-	if av.Before(bv) {
-		return -1, nil
-	} else if av.After(bv) {
-		return 1, nil
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
 	}
-	return 0, nil
+
+	return compareTimes(DatetimeWithPrecision(MaxTimePrecision), a, b)
 }
 
-type charT struct {
-	length int
+type timeT struct {
+	fsp int
 }
 
-func (t charT) Capacity() int { return t.length }
+// TimeLayout is the layout of the MySQL time format, which can't be
+// represented in the layouts Go understands, because hours can be greater
+// than 24 and negative.
+const TimeLayout = "HH:MM:SS"
 
-func (t charT) String() string { return fmt.Sprintf("CHAR(%d)", t.length) }
+var (
+	timeRegex        = regexp.MustCompile(`^(-)?(?:(\d+)\s+)?(\d+):(\d+)(?::(\d+))?(?:\.(\d*))?$`)
+	numericTimeRegex = regexp.MustCompile(`^(-)?(\d+)(?:\.(\d*))?$`)
+)
 
-func (t charT) Type() query.Type {
-	return sqltypes.Char
+func (t timeT) String() string { return withPrecision("TIME", t.fsp) }
+
+// Type implements Type interface.
+func (t timeT) Type() query.Type {
+	return sqltypes.Time
 }
 
-func (t charT) SQL(v interface{}) (sqltypes.Value, error) {
+// SQL implements Type interface.
+func (t timeT) SQL(v interface{}) (sqltypes.Value, error) {
 	if v == nil {
-		return sqltypes.MakeTrusted(sqltypes.Char, nil), nil
+		return sqltypes.NULL, nil
 	}
 
 	v, err := t.Convert(v)
@@ -714,83 +1114,792 @@ func (t charT) SQL(v interface{}) (sqltypes.Value, error) {
 		return sqltypes.Value{}, err
 	}
 
-	return sqltypes.MakeTrusted(sqltypes.Char, []byte(v.(string))), nil
-}
-
-// Converts any value that can be casted to a string
-func (t charT) Convert(v interface{}) (interface{}, error) {
-	val, err := cast.ToStringE(v)
-	if err != nil {
-		return nil, ErrConvertToSQL.New(t)
+	d := v.(time.Duration)
+	var sign string
+	if d < 0 {
+		sign = "-"
+		d = -d
 	}
 
-	if len(val) > t.length {
-		return nil, ErrCharTruncation.New(val, t.length)
+	s := fmt.Sprintf(
+		"%s%02d:%02d:%02d",
+		sign,
+		d/time.Hour,
+		d%time.Hour/time.Minute,
+		d%time.Minute/time.Second,
+	)
+	if t.fsp > 0 {
+		s += fmt.Sprintf(".%09d", d%time.Second)[:t.fsp+1]
 	}
-	return val, nil
-}
 
-// Compares two strings lexicographically
-func (t charT) Compare(a interface{}, b interface{}) (int, error) {
-	return strings.Compare(a.(string), b.(string)), nil
+	return sqltypes.MakeTrusted(sqltypes.Time, []byte(s)), nil
 }
 
-type varCharT struct {
-	length int
-}
+// Convert implements Type interface. Strings can have the formats
+// "D HH:MM:SS", "HH:MM:SS", "HH:MM" and "HHMMSS", and numbers the format
+// HHMMSS, all of them with fractional seconds, which are rounded to the
+// precision of the type. Of times, only the time of the day is taken.
+func (t timeT) Convert(v interface{}) (interface{}, error) {
+	var d time.Duration
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case time.Duration:
+		d = value
+	case time.Time:
+		d = value.Sub(truncateDate(value))
+	case string:
+		var err error
+		d, err = parseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+	case []byte:
+		var err error
+		d, err = parseDuration(strings.TrimSpace(string(value)))
+		if err != nil {
+			return nil, err
+		}
+	case float32, float64, decimal.Decimal:
+		var err error
+		d, err = parseDuration(fmt.Sprint(value))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		n, err := Int64.Convert(v)
+		if err != nil {
+			return nil, ErrInvalidType.New(reflect.TypeOf(v))
+		}
 
-func (t varCharT) Capacity() int { return t.length }
+		d, err = parseDuration(strconv.FormatInt(n.(int64), 10))
+		if err != nil {
+			return nil, err
+		}
+	}
 
-func (t varCharT) String() string { return fmt.Sprintf("VARCHAR(%d)", t.length) }
+	d = d.Round(fractionalUnit(t.fsp))
+	if ValidateDuration(d) == nil {
+		return nil, ErrTimeOutOfRange.New(v, t)
+	}
 
-// Type implements Type interface
-func (t varCharT) Type() query.Type {
-	return sqltypes.VarChar
+	return d, nil
 }
 
-// SQL implements Type interface
-func (t varCharT) SQL(v interface{}) (sqltypes.Value, error) {
-	if v == nil {
-		return sqltypes.MakeTrusted(sqltypes.VarChar, nil), nil
+// parseDuration parses the value of a time in one of the formats allowed by
+// the Time type.
+func parseDuration(s string) (time.Duration, error) {
+	var neg bool
+	var days, hours, minutes, seconds int64
+	var fraction string
+	if m := timeRegex.FindStringSubmatch(s); m != nil {
+		neg = m[1] != ""
+		days, _ = strconv.ParseInt(m[2], 10, 64)
+		hours, _ = strconv.ParseInt(m[3], 10, 64)
+		minutes, _ = strconv.ParseInt(m[4], 10, 64)
+		seconds, _ = strconv.ParseInt(m[5], 10, 64)
+		fraction = m[6]
+	} else if m := numericTimeRegex.FindStringSubmatch(s); m != nil {
+		neg = m[1] != ""
+		n, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			return 0, ErrConvertingToDuration.Wrap(err, s)
+		}
+		hours, minutes, seconds = n/10000, n/100%100, n%100
+		fraction = m[3]
+	} else {
+		return 0, ErrConvertingToDuration.New(s)
 	}
 
-	v, err := t.Convert(v)
-	if err != nil {
-		return sqltypes.Value{}, err
+	if minutes > 59 || seconds > 59 || days > 34 || hours > 838+24 {
+		return 0, ErrConvertingToDuration.New(s)
 	}
 
-	return sqltypes.MakeTrusted(sqltypes.VarChar, []byte(v.(string))), nil
-}
-
-// Convert implements Type interface
-func (t varCharT) Convert(v interface{}) (interface{}, error) {
-	val, err := cast.ToStringE(v)
-	if err != nil {
-		return nil, ErrConvertToSQL.New(t)
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
 	}
+	nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
 
-	if len(val) > t.length {
-		return nil, ErrVarCharTruncation.New(val, t.length)
+	d := time.Duration(days*24+hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second +
+		time.Duration(nanos)
+	if neg {
+		d = -d
 	}
-	return val, nil
+
+	return d, nil
 }
 
 // Compare implements Type interface.
-func (t varCharT) Compare(a interface{}, b interface{}) (int, error) {
+func (t timeT) Compare(a interface{}, b interface{}) (int, error) {
 	if hasNulls, res := compareNulls(a, b); hasNulls {
 		return res, nil
 	}
-	return strings.Compare(a.(string), b.(string)), nil
-}
 
-type textT struct{}
+	av, err := TimeWithPrecision(MaxTimePrecision).Convert(a)
+	if err != nil {
+		return 0, err
+	}
 
-func (t textT) String() string { return "TEXT" }
+	bv, err := TimeWithPrecision(MaxTimePrecision).Convert(b)
+	if err != nil {
+		return 0, err
+	}
 
-// Type implements Type interface.
-func (t textT) Type() query.Type {
-	return sqltypes.Text
-}
+	switch {
+	case av.(time.Duration) < bv.(time.Duration):
+		return -1, nil
+	case av.(time.Duration) > bv.(time.Duration):
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+type yearT struct{}
+
+func (t yearT) String() string { return "YEAR" }
+
+// Type implements Type interface.
+func (t yearT) Type() query.Type {
+	return sqltypes.Year
+}
+
+// SQL implements Type interface.
+func (t yearT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(
+		sqltypes.Year,
+		[]byte(fmt.Sprintf("%04d", v.(int16))),
+	), nil
+}
+
+// Convert implements Type interface. As in MySQL, years of one or two digits
+// between 1 and 69 are the ones between 2001 and 2069, and the ones between
+// 70 and 99 are the ones between 1970 and 1999. The number 0 is the year 0,
+// but the strings "0" and "00" are the year 2000.
+func (t yearT) Convert(v interface{}) (interface{}, error) {
+	var y int64
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		y = int64(value.Year())
+	case string, []byte:
+		s := strings.TrimSpace(fmt.Sprintf("%s", value))
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, ErrConvertToSQL.Wrap(err, t)
+		}
+
+		y = n
+		if n == 0 && len(s) < 4 {
+			y = 2000
+		}
+	default:
+		n, err := Int64.Convert(v)
+		if err != nil {
+			return nil, ErrInvalidType.New(reflect.TypeOf(v))
+		}
+		y = n.(int64)
+	}
+
+	switch {
+	case y >= 1 && y <= 69:
+		y += 2000
+	case y >= 70 && y <= 99:
+		y += 1900
+	case y != 0 && (y < 1901 || y > 2155):
+		return nil, ErrYearOutOfRange.New(v, t)
+	}
+
+	return int16(y), nil
+}
+
+// Compare implements Type interface.
+func (t yearT) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	av, err := t.Convert(a)
+	if err != nil {
+		return 0, err
+	}
+
+	bv, err := t.Convert(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return compareSignedInts(av, bv)
+}
+
+type charT struct {
+	length    int
+	collation *Collation
+}
+
+func (t charT) Capacity() int { return t.length }
+
+func (t charT) String() string { return fmt.Sprintf("CHAR(%d)", t.length) }
+
+func (t charT) Type() query.Type {
+	return sqltypes.Char
+}
+
+func (t charT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.MakeTrusted(sqltypes.Char, nil), nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(sqltypes.Char, []byte(v.(string))), nil
+}
+
+// Converts any value that can be casted to a string. The length of the
+// string is the number of characters.
+func (t charT) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	val, err := cast.ToStringE(v)
+	if err != nil {
+		return nil, ErrConvertToSQL.New(t)
+	}
+
+	if utf8.RuneCountInString(val) > t.length {
+		return nil, ErrCharTruncation.New(val, t.length)
+	}
+	return val, nil
+}
+
+// Compares two strings with the collation of the type.
+func (t charT) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+	return collationOrDefault(t.collation).Compare(a.(string), b.(string)), nil
+}
+
+type varCharT struct {
+	length    int
+	collation *Collation
+}
+
+func (t varCharT) Capacity() int { return t.length }
+
+func (t varCharT) String() string { return fmt.Sprintf("VARCHAR(%d)", t.length) }
+
+// Type implements Type interface
+func (t varCharT) Type() query.Type {
+	return sqltypes.VarChar
+}
+
+// SQL implements Type interface
+func (t varCharT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.MakeTrusted(sqltypes.VarChar, nil), nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(sqltypes.VarChar, []byte(v.(string))), nil
+}
+
+// Convert implements Type interface. The length of the string is the number
+// of characters.
+func (t varCharT) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	val, err := cast.ToStringE(v)
+	if err != nil {
+		return nil, ErrConvertToSQL.New(t)
+	}
+
+	if utf8.RuneCountInString(val) > t.length {
+		return nil, ErrVarCharTruncation.New(val, t.length)
+	}
+	return val, nil
+}
+
+// Compare implements Type interface.
+func (t varCharT) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+	return collationOrDefault(t.collation).Compare(a.(string), b.(string)), nil
+}
+
+type binaryT struct {
+	length int
+}
+
+func (t binaryT) Capacity() int { return t.length }
+
+func (t binaryT) String() string { return fmt.Sprintf("BINARY(%d)", t.length) }
+
+// Type implements Type interface.
+func (t binaryT) Type() query.Type {
+	return sqltypes.Binary
+}
+
+// SQL implements Type interface.
+func (t binaryT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(sqltypes.Binary, v.([]byte)), nil
+}
+
+// Convert implements Type interface. Values shorter than the length of the
+// type are padded with zero bytes.
+func (t binaryT) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	val, err := Blob.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	b := val.([]byte)
+	if len(b) > t.length {
+		return nil, ErrBinaryTruncation.New(b, t.length)
+	}
+
+	if len(b) < t.length {
+		padded := make([]byte, t.length)
+		copy(padded, b)
+		b = padded
+	}
+
+	return b, nil
+}
+
+// Compare implements Type interface.
+func (t binaryT) Compare(a interface{}, b interface{}) (int, error) {
+	return compareBytes(t, a, b)
+}
+
+type varBinaryT struct {
+	length int
+}
+
+func (t varBinaryT) Capacity() int { return t.length }
+
+func (t varBinaryT) String() string { return fmt.Sprintf("VARBINARY(%d)", t.length) }
+
+// Type implements Type interface.
+func (t varBinaryT) Type() query.Type {
+	return sqltypes.VarBinary
+}
+
+// SQL implements Type interface.
+func (t varBinaryT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(sqltypes.VarBinary, v.([]byte)), nil
+}
+
+// Convert implements Type interface.
+func (t varBinaryT) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	val, err := Blob.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(val.([]byte)) > t.length {
+		return nil, ErrVarBinaryTruncation.New(val, t.length)
+	}
+
+	return val, nil
+}
+
+// Compare implements Type interface.
+func (t varBinaryT) Compare(a interface{}, b interface{}) (int, error) {
+	return compareBytes(t, a, b)
+}
+
+// compareBytes compares two values of a binary type byte by byte.
+func compareBytes(t Type, a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	av, err := t.Convert(a)
+	if err != nil {
+		return 0, err
+	}
+
+	bv, err := t.Convert(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return bytes.Compare(av.([]byte), bv.([]byte)), nil
+}
+
+// TruncateValue returns the value converted to the given type. Strings that
+// are too long for sized string types are truncated to their length, values
+// that are not members of enums and sets are their empty value, numbers out
+// of the range of decimals are their maximum or minimum value and other
+// values are 0. MySQL stores these values, with a warning, when the SQL mode
+// is not strict.
+func TruncateValue(t Type, v interface{}) (interface{}, error) {
+	val, err := t.Convert(v)
+	if err == nil {
+		return val, nil
+	}
+
+	switch t := t.(type) {
+	case decimalT:
+		if !ErrDecimalOutOfRange.Is(err) {
+			return t.Convert(0)
+		}
+
+		max := decimal.New(1, int32(t.precision-t.scale)).Sub(decimal.New(1, -int32(t.scale)))
+		f, err := Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.(float64) < 0 {
+			return max.Neg(), nil
+		}
+		return max, nil
+	case *enumT, *setT:
+		return t.Convert(0)
+	case charT, varCharT:
+		s, err := cast.ToStringE(v)
+		if err != nil {
+			return nil, ErrConvertToSQL.New(t)
+		}
+
+		length, _ := CharacterLength(t)
+		if utf8.RuneCountInString(s) > length {
+			s = string([]rune(s)[:length])
+		}
+		return s, nil
+	case binaryT, varBinaryT:
+		b, err := Blob.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+
+		length, _ := CharacterLength(t)
+		if len(b.([]byte)) > length {
+			b = b.([]byte)[:length]
+		}
+		return t.Convert(b)
+	default:
+		return nil, err
+	}
+}
+
+type enumT struct {
+	members []string
+}
+
+func (t *enumT) String() string { return "ENUM(" + quoteMembers(t.members) + ")" }
+
+// Type implements Type interface.
+func (t *enumT) Type() query.Type {
+	return sqltypes.Enum
+}
+
+// SQL implements Type interface.
+func (t *enumT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(sqltypes.Enum, []byte(v.(string))), nil
+}
+
+// member returns the member with the given ordinal.
+func (t *enumT) member(ordinal uint16) string {
+	if ordinal == 0 {
+		return ""
+	}
+	return t.members[ordinal-1]
+}
+
+// Convert implements Type interface. Values are converted to the member
+// they are equal to ignoring case and trailing spaces, or whose ordinal they
+// represent if they are not a member. The ordinal 0 and the empty string are
+// the empty value, which is the value of invalid members.
+func (t *enumT) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	ordinal, err := t.ordinal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.member(ordinal), nil
+}
+
+// ordinal returns the ordinal of the member the value is equal to ignoring
+// case and trailing spaces, or the ordinal it represents if it's not a
+// member.
+func (t *enumT) ordinal(v interface{}) (uint16, error) {
+	switch value := v.(type) {
+	case string:
+		if i := memberIndex(t.members, value); i >= 0 {
+			return uint16(i + 1), nil
+		}
+
+		if value == "" {
+			return 0, nil
+		}
+
+		n, err := strconv.ParseUint(strings.TrimSpace(value), 10, 16)
+		if err != nil {
+			return 0, ErrInvalidEnumValue.New(v, t)
+		}
+		return t.ordinal(n)
+	case []byte:
+		return t.ordinal(string(value))
+	}
+
+	n, err := Uint64.Convert(v)
+	if err != nil || n.(uint64) > uint64(len(t.members)) {
+		return 0, ErrInvalidEnumValue.New(v, t)
+	}
+
+	return uint16(n.(uint64)), nil
+}
+
+// Compare implements Type interface. Values are compared by their ordinal.
+func (t *enumT) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	av, err := t.ordinal(a)
+	if err != nil {
+		return 0, err
+	}
+
+	bv, err := t.ordinal(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return compareUnsignedInts(uint64(av), uint64(bv))
+}
+
+type setT struct {
+	members []string
+}
+
+func (t *setT) String() string { return "SET(" + quoteMembers(t.members) + ")" }
+
+// Type implements Type interface.
+func (t *setT) Type() query.Type {
+	return sqltypes.Set
+}
+
+// SQL implements Type interface.
+func (t *setT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(sqltypes.Set, []byte(v.(string))), nil
+}
+
+// Convert implements Type interface. Values are converted to the members
+// of the set, written in the order in which they were defined and separated
+// by commas. Strings are lists of members separated by commas, which are
+// equal to them ignoring case and trailing spaces, or the bitmask they
+// represent if they are not. Numbers are bitmasks.
+func (t *setT) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	bitmask, err := t.bitmask(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []string
+	for i, m := range t.members {
+		if bitmask&(1<<uint(i)) != 0 {
+			members = append(members, m)
+		}
+	}
+
+	return strings.Join(members, ","), nil
+}
+
+// bitmask returns the bitmask of the members of the set in the value, or the
+// bitmask it represents if they are not members.
+func (t *setT) bitmask(v interface{}) (uint64, error) {
+	switch value := v.(type) {
+	case string:
+		if value == "" {
+			return 0, nil
+		}
+
+		var bitmask uint64
+		for _, e := range strings.Split(value, ",") {
+			i := memberIndex(t.members, e)
+			if i < 0 {
+				n, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+				if err != nil {
+					return 0, ErrInvalidSetValue.New(v, t)
+				}
+				return t.bitmask(n)
+			}
+			bitmask |= 1 << uint(i)
+		}
+		return bitmask, nil
+	case []byte:
+		return t.bitmask(string(value))
+	}
+
+	n, err := Uint64.Convert(v)
+	if err != nil {
+		return 0, ErrInvalidSetValue.New(v, t)
+	}
+
+	if len(t.members) < MaxSetMembers && n.(uint64) >= 1<<uint(len(t.members)) {
+		return 0, ErrInvalidSetValue.New(v, t)
+	}
+
+	return n.(uint64), nil
+}
+
+// Compare implements Type interface. Values are compared by their bitmask.
+func (t *setT) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	av, err := t.bitmask(a)
+	if err != nil {
+		return 0, err
+	}
+
+	bv, err := t.bitmask(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return compareUnsignedInts(av, bv)
+}
+
+// MemberNumber returns the number a value of an Enum or Set type is used as
+// in numeric contexts, which is the ordinal of the member of an enum and the
+// bitmask of the members of a set. Values of other types are returned as
+// they are.
+func MemberNumber(t Type, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch t := t.(type) {
+	case *enumT:
+		n, err := t.ordinal(v)
+		if err != nil {
+			return nil, err
+		}
+		return uint64(n), nil
+	case *setT:
+		n, err := t.bitmask(v)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	default:
+		return v, nil
+	}
+}
+
+// memberIndex returns the index of the member equal to the given value
+// ignoring case and trailing spaces, or -1 if there is none.
+func memberIndex(members []string, value string) int {
+	value = strings.TrimRight(value, " ")
+	for i, m := range members {
+		if strings.EqualFold(strings.TrimRight(m, " "), value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// quoteMembers returns the members of an Enum or Set type quoted as strings
+// and separated by commas.
+func quoteMembers(members []string) string {
+	quoted := make([]string, len(members))
+	for i, m := range members {
+		quoted[i] = "'" + strings.Replace(m, "'", "''", -1) + "'"
+	}
+	return strings.Join(quoted, ",")
+}
+
+type textT struct {
+	collation *Collation
+}
+
+func (t textT) String() string { return "TEXT" }
+
+// Type implements Type interface.
+func (t textT) Type() query.Type {
+	return sqltypes.Text
+}
 
 // SQL implements Type interface.
 func (t textT) SQL(v interface{}) (sqltypes.Value, error) {
@@ -820,7 +1929,7 @@ func (t textT) Compare(a interface{}, b interface{}) (int, error) {
 	if hasNulls, res := compareNulls(a, b); hasNulls {
 		return res, nil
 	}
-	return strings.Compare(a.(string), b.(string)), nil
+	return collationOrDefault(t.collation).Compare(a.(string), b.(string)), nil
 }
 
 type booleanT struct{}
@@ -954,29 +2063,127 @@ func (t jsonT) SQL(v interface{}) (sqltypes.Value, error) {
 		return sqltypes.Value{}, err
 	}
 
-	return sqltypes.MakeTrusted(sqltypes.TypeJSON, v.([]byte)), nil
+	return sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(v.(JSONDocument).String())), nil
 }
 
-// Convert implements Type interface.
+// Convert implements Type interface. Strings are parsed as JSON texts, and
+// they are JSON strings if they are not valid JSON texts.
 func (t jsonT) Convert(v interface{}) (interface{}, error) {
+	var val interface{}
+	var err error
 	switch v := v.(type) {
+	case nil:
+		return nil, nil
 	case string:
-		var doc interface{}
-		if err := json.Unmarshal([]byte(v), &doc); err != nil {
-			return json.Marshal(v)
+		if val, err = ParseJSON(v); err != nil {
+			val = v
+		}
+	case []byte:
+		if val, err = ParseJSON(string(v)); err != nil {
+			val = string(v)
 		}
-		return json.Marshal(doc)
 	default:
-		return json.Marshal(v)
+		if val, err = normalizeJSON(v); err != nil {
+			return nil, err
+		}
 	}
+
+	return JSONDocument{val}, nil
 }
 
-// Compare implements Type interface.
+// Compare implements Type interface. JSON values are compared as MySQL does.
 func (t jsonT) Compare(a interface{}, b interface{}) (int, error) {
 	if hasNulls, res := compareNulls(a, b); hasNulls {
 		return res, nil
 	}
-	return bytes.Compare(a.([]byte), b.([]byte)), nil
+
+	a, err := t.Convert(a)
+	if err != nil {
+		return 0, err
+	}
+
+	b, err = t.Convert(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return compareJSON(a.(JSONDocument).Val, b.(JSONDocument).Val), nil
+}
+
+type geometryT struct {
+	// kind is the name of the type of the geometries it holds, or GEOMETRY
+	// if it holds any geometry.
+	kind string
+}
+
+func (t geometryT) String() string { return t.kind }
+
+// Type implements Type interface.
+func (t geometryT) Type() query.Type {
+	return sqltypes.Geometry
+}
+
+// SQL implements Type interface. Geometries are sent as MySQL stores them.
+func (t geometryT) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	v, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return sqltypes.MakeTrusted(sqltypes.Geometry, GeometryBytes(v.(GeometryValue))), nil
+}
+
+// Convert implements Type interface. Strings and byte slices must have a
+// geometry in the format MySQL stores them.
+func (t geometryT) Convert(v interface{}) (interface{}, error) {
+	var g GeometryValue
+	var err error
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case GeometryValue:
+		g = v
+	case []byte:
+		g, err = ParseGeometryBytes(v)
+	case string:
+		g, err = ParseGeometryBytes([]byte(v))
+	default:
+		return nil, ErrInvalidGeometry.New(fmt.Sprintf("value of type %T", v))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t.kind != GeometryTypeGeometry && g.GeometryType() != t.kind {
+		return nil, ErrGeometryType.New(g.GeometryType(), t.kind)
+	}
+
+	return g, nil
+}
+
+// Compare implements Type interface. Geometries are compared by the bytes
+// of their stored format.
+func (t geometryT) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	a, err := Geometry.Convert(a)
+	if err != nil {
+		return 0, err
+	}
+
+	b, err = Geometry.Convert(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return bytes.Compare(GeometryBytes(a.(GeometryValue)), GeometryBytes(b.(GeometryValue))), nil
 }
 
 type tupleT []Type
@@ -1059,17 +2266,14 @@ func (t arrayT) SQL(v interface{}) (sqltypes.Value, error) {
 		return sqltypes.NULL, nil
 	}
 
-	v, err := convertForJSON(t, v)
-	if err != nil {
-		return sqltypes.Value{}, err
-	}
-
-	val, err := json.Marshal(v)
+	// Arrays are sent to clients as JSON arrays, written the same way as
+	// the documents of the JSON type.
+	val, err := ToJSONValue(t, v)
 	if err != nil {
 		return sqltypes.Value{}, err
 	}
 
-	return sqltypes.MakeTrusted(sqltypes.TypeJSON, val), nil
+	return sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(JSONDocument{val}.String())), nil
 }
 
 func (t arrayT) Convert(v interface{}) (interface{}, error) {
@@ -1078,7 +2282,7 @@ func (t arrayT) Convert(v interface{}) (interface{}, error) {
 		var result = make([]interface{}, len(v))
 		for i, v := range v {
 			var err error
-			result[i], err = t.underlying.Convert(v)
+			result[i], err = t.convertElement(v)
 			if err != nil {
 				return nil, err
 			}
@@ -1095,7 +2299,7 @@ func (t arrayT) Convert(v interface{}) (interface{}, error) {
 				return nil, err
 			}
 
-			val, err = t.underlying.Convert(val)
+			val, err = t.convertElement(val)
 			if err != nil {
 				return nil, err
 			}
@@ -1113,6 +2317,15 @@ func (t arrayT) Convert(v interface{}) (interface{}, error) {
 	}
 }
 
+// convertElement converts an element of an array to the underlying type.
+// NULL elements are kept as they are.
+func (t arrayT) convertElement(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return t.underlying.Convert(v)
+}
+
 func (t arrayT) Compare(a, b interface{}) (int, error) {
 	a, err := t.Convert(a)
 	if err != nil {
@@ -1149,17 +2362,57 @@ func (t arrayT) Compare(a, b interface{}) (int, error) {
 
 // IsNumber checks if t is a number type
 func IsNumber(t Type) bool {
-	return IsInteger(t) || IsDecimal(t)
+	return IsInteger(t) || IsDecimal(t) || IsFixedPoint(t)
 }
 
 // IsSigned checks if t is a signed type.
 func IsSigned(t Type) bool {
-	return t == Int32 || t == Int64
+	n, ok := t.(numberT)
+	return ok && (n.t == sqltypes.Int32 || n.t == sqltypes.Int64)
 }
 
 // IsUnsigned checks if t is an unsigned type.
 func IsUnsigned(t Type) bool {
-	return t == Uint64 || t == Uint32
+	n, ok := t.(numberT)
+	return ok && (n.t == sqltypes.Uint64 || n.t == sqltypes.Uint32)
+}
+
+// Zerofill returns the given unsigned integer type with the ZEROFILL
+// attribute and the given display width, or the default one of the type if
+// it's not positive. Its values are padded with zeros up to the display width
+// when they are written. Any other type is returned as it is.
+func Zerofill(t Type, width int) Type {
+	n, ok := t.(numberT)
+	if !ok {
+		return t
+	}
+
+	defaultWidth, ok := zerofillWidths[n.t]
+	if !ok {
+		return t
+	}
+
+	if width <= 0 {
+		width = defaultWidth
+	}
+
+	n.width, n.zerofill = width, true
+	return n
+}
+
+// zerofillWidths are the default display widths of the unsigned integer
+// types, which are the number of digits of their maximum values.
+var zerofillWidths = map[query.Type]int{
+	sqltypes.Uint8:  3,
+	sqltypes.Uint16: 5,
+	sqltypes.Uint32: 10,
+	sqltypes.Uint64: 20,
+}
+
+// IsZerofill checks if t is an integer type with the ZEROFILL attribute.
+func IsZerofill(t Type) bool {
+	n, ok := t.(numberT)
+	return ok && n.zerofill
 }
 
 // IsInteger checks if t is a (U)Int32/64 type.
@@ -1169,17 +2422,109 @@ func IsInteger(t Type) bool {
 
 // IsTime checks if t is a timestamp, date or datetime
 func IsTime(t Type) bool {
-	return t == Timestamp || t == Date || t == Datetime
+	switch t.(type) {
+	case timestampT, dateT, datetimeT:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsEnum checks if t is an Enum type.
+func IsEnum(t Type) bool {
+	_, ok := t.(*enumT)
+	return ok
+}
+
+// IsSet checks if t is a Set type.
+func IsSet(t Type) bool {
+	_, ok := t.(*setT)
+	return ok
+}
+
+// IsTimestamp checks if t is a Timestamp type.
+func IsTimestamp(t Type) bool {
+	_, ok := t.(timestampT)
+	return ok
+}
+
+// IsDuration checks if t is a Time type, whose values are durations.
+func IsDuration(t Type) bool {
+	_, ok := t.(timeT)
+	return ok
+}
+
+// IsYear checks if t is the Year type.
+func IsYear(t Type) bool {
+	return t == Year
+}
+
+// TimePrecision returns the number of digits of the fractional seconds of a
+// timestamp, datetime or time type, which is 0 for any other type.
+func TimePrecision(t Type) int {
+	switch t := t.(type) {
+	case timestampT:
+		return t.fsp
+	case datetimeT:
+		return t.fsp
+	case timeT:
+		return t.fsp
+	default:
+		return 0
+	}
 }
 
-// IsDecimal checks if t is decimal type.
+// IsDecimal checks if t is decimal type, which is any of the floating point
+// types. Use IsFixedPoint to check if t is a Decimal type.
 func IsDecimal(t Type) bool {
 	return t == Float32 || t == Float64
 }
 
+// IsFixedPoint checks if t is a Decimal type, which holds fixed-point
+// numbers.
+func IsFixedPoint(t Type) bool {
+	_, ok := t.(decimalT)
+	return ok
+}
+
+// DecimalPrecision returns the precision and scale of a Decimal type. For
+// integer types, they are the ones of the Decimal type that holds all their
+// values. It returns false for any other type.
+func DecimalPrecision(t Type) (precision, scale int, ok bool) {
+	if d, ok := t.(decimalT); ok {
+		return d.precision, d.scale, true
+	}
+
+	switch t {
+	case Int8, Uint8:
+		return 3, 0, true
+	case Int16, Uint16:
+		return 5, 0, true
+	case Int32, Uint32:
+		return 10, 0, true
+	case Int64:
+		return 19, 0, true
+	case Uint64:
+		return 20, 0, true
+	default:
+		return 0, 0, false
+	}
+}
+
 // IsText checks if t is a text type.
 func IsText(t Type) bool {
-	return t == Text || t == Blob || t == JSON || IsVarChar(t) || IsChar(t)
+	_, ok := t.(textT)
+	return ok || t == Blob || t == JSON || IsVarChar(t) || IsChar(t) || IsBinary(t)
+}
+
+// IsBinary checks if t is a Binary or VarBinary type.
+func IsBinary(t Type) bool {
+	switch t.(type) {
+	case binaryT, varBinaryT:
+		return true
+	default:
+		return false
+	}
 }
 
 // IsChar checks if t is a Char type.
@@ -1194,6 +2539,12 @@ func IsVarChar(t Type) bool {
 	return ok
 }
 
+// IsGeometry checks if t is a geometry type.
+func IsGeometry(t Type) bool {
+	_, ok := t.(geometryT)
+	return ok
+}
+
 // IsTuple checks if t is a tuple type.
 // Note that tupleT instances with just 1 value are not considered
 // as a tuple, but a parenthesized value.
@@ -1241,16 +2592,30 @@ func MySQLTypeName(t Type) string {
 		return "FLOAT"
 	case sqltypes.Float64:
 		return "DOUBLE"
+	case sqltypes.Decimal:
+		return "DECIMAL"
 	case sqltypes.Timestamp:
 		return "TIMESTAMP"
 	case sqltypes.Datetime:
 		return "DATETIME"
 	case sqltypes.Date:
 		return "DATE"
+	case sqltypes.Time:
+		return "TIME"
+	case sqltypes.Year:
+		return "YEAR"
+	case sqltypes.Enum:
+		return "ENUM"
+	case sqltypes.Set:
+		return "SET"
 	case sqltypes.Char:
 		return "CHAR"
 	case sqltypes.VarChar:
 		return "VARCHAR"
+	case sqltypes.Binary:
+		return "BINARY"
+	case sqltypes.VarBinary:
+		return "VARBINARY"
 	case sqltypes.Text:
 		return "TEXT"
 	case sqltypes.Bit:
@@ -1259,83 +2624,143 @@ func MySQLTypeName(t Type) string {
 		return "JSON"
 	case sqltypes.Blob:
 		return "BLOB"
+	case sqltypes.Geometry:
+		if g, ok := t.(geometryT); ok {
+			return g.kind
+		}
+		return GeometryTypeGeometry
 	default:
 		return "UNKNOWN"
 	}
 }
 
-// UnderlyingType returns the underlying type of an array if the type is an
-// array, or the type itself in any other case.
-func UnderlyingType(t Type) Type {
-	a, ok := t.(arrayT)
-	if !ok {
-		return t
+// MySQLColumnType returns the MySQL definition of the given type as it's
+// written in the columns of a CREATE TABLE statement, such as
+// "decimal(10,2)" or "enum('a','b')".
+func MySQLColumnType(t Type) string {
+	name := strings.ToLower(MySQLTypeName(t))
+	switch t := t.(type) {
+	case numberT:
+		name = strings.Replace(name, "integer", "int", 1)
+		if t.zerofill {
+			return fmt.Sprintf("%s(%d) unsigned zerofill", strings.TrimSuffix(name, " unsigned"), t.width)
+		}
+	case decimalT:
+		return fmt.Sprintf("%s(%d,%d)", name, t.precision, t.scale)
+	case *enumT:
+		return name + "(" + quoteMembers(t.members) + ")"
+	case *setT:
+		return name + "(" + quoteMembers(t.members) + ")"
 	}
 
-	return a.underlying
+	if fsp := TimePrecision(t); fsp > 0 {
+		return fmt.Sprintf("%s(%d)", name, fsp)
+	}
+
+	if length, ok := CharacterLength(t); ok {
+		return fmt.Sprintf("%s(%d)", name, length)
+	}
+
+	return name
 }
 
-func convertForJSON(t Type, v interface{}) (interface{}, error) {
+// CharacterLength returns the maximum length of the values of a Char or
+// VarChar type in characters, or of a Binary or VarBinary type in bytes.
+func CharacterLength(t Type) (int, bool) {
 	switch t := t.(type) {
-	case jsonT:
-		val, err := t.Convert(v)
-		if err != nil {
-			return nil, err
-		}
+	case charT:
+		return t.length, true
+	case varCharT:
+		return t.length, true
+	case binaryT:
+		return t.length, true
+	case varBinaryT:
+		return t.length, true
+	default:
+		return 0, false
+	}
+}
 
-		var doc interface{}
-		err = json.Unmarshal(val.([]byte), &doc)
-		if err != nil {
-			return nil, err
-		}
+// WithCollation returns the given Text, Char or VarChar type with the given
+// collation. Any other type is returned as it is.
+func WithCollation(t Type, c *Collation) Type {
+	if c == DefaultCollation {
+		c = nil
+	}
 
-		return doc, nil
-	case arrayT:
-		return convertArrayForJSON(t, v)
+	switch t := t.(type) {
+	case textT:
+		t.collation = c
+		return t
+	case charT:
+		t.collation = c
+		return t
+	case varCharT:
+		t.collation = c
+		return t
 	default:
-		return t.Convert(v)
+		return t
 	}
 }
 
-func convertArrayForJSON(t arrayT, v interface{}) (interface{}, error) {
-	switch v := v.(type) {
-	case []interface{}:
-		var result = make([]interface{}, len(v))
-		for i, v := range v {
-			var err error
-			result[i], err = convertForJSON(t.underlying, v)
-			if err != nil {
-				return nil, err
-			}
-		}
-		return result, nil
-	case Generator:
-		var values []interface{}
-		for {
-			val, err := v.Next()
-			if err != nil {
-				if err == io.EOF {
-					break
-				}
-				return nil, err
-			}
+// CollationOf returns the collation of a Text, Char or VarChar type, or the
+// binary collation for the types of binary strings. It returns false for any
+// other type.
+func CollationOf(t Type) (*Collation, bool) {
+	switch t := t.(type) {
+	case textT:
+		return collationOrDefault(t.collation), true
+	case charT:
+		return collationOrDefault(t.collation), true
+	case varCharT:
+		return collationOrDefault(t.collation), true
+	case blobT, binaryT, varBinaryT:
+		return BinaryCollation, true
+	default:
+		return nil, false
+	}
+}
 
-			val, err = convertForJSON(t.underlying, val)
-			if err != nil {
-				return nil, err
-			}
+// HasCollation reports whether the given type is a Text, Char or VarChar
+// type with a collation other than the default one.
+func HasCollation(t Type) bool {
+	c, ok := CollationOf(t)
+	return ok && c != DefaultCollation && c != BinaryCollation
+}
 
-			values = append(values, val)
-		}
+// CollationKey returns the value that is compared instead of the given
+// value of the given type, which is the key of the string in the collation
+// of the type for strings, so values that are equal have the same key. Any
+// other value is returned as it is.
+func CollationKey(t Type, v interface{}) interface{} {
+	s, ok := v.(string)
+	if !ok {
+		return v
+	}
 
-		if err := v.Close(); err != nil {
-			return nil, err
-		}
+	c, ok := CollationOf(t)
+	if !ok {
+		return v
+	}
+	return c.Key(s)
+}
 
-		return values, nil
-	default:
-		return nil, ErrNotArray.New(v)
+func collationOrDefault(c *Collation) *Collation {
+	if c == nil {
+		return DefaultCollation
+	}
+	return c
+}
+
+// UnderlyingType returns the underlying type of an array if the type is an
+// array, or the type itself in any other case.
+func UnderlyingType(t Type) Type {
+	a, ok := t.(arrayT)
+	if !ok {
+		return t
 	}
+
+	return a.underlying
 }
 
 // compareNulls compares two values, and returns true if either is null.
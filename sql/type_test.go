@@ -1,9 +1,12 @@
 package sql
 
 import (
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/mushiyu/vitess/go/sqltypes"
 	"github.com/mushiyu/vitess/go/vt/proto/query"
@@ -12,7 +15,7 @@ import (
 func TestIsNull(t *testing.T) {
 	require.True(t, IsNull(nil))
 
-	n := numberT{sqltypes.Uint64}
+	n := numberT{t: sqltypes.Uint64}
 	require.Equal(t, sqltypes.NULL, mustSQL(n.SQL(nil)))
 	require.Equal(t, sqltypes.NewUint64(0), mustSQL(n.SQL(uint64(0))))
 }
@@ -152,6 +155,22 @@ func TestInt64(t *testing.T) {
 	gt(t, Int64, int64(3), int64(2))
 }
 
+func TestZerofill(t *testing.T) {
+	require := require.New(t)
+
+	typ := Zerofill(Uint32, 5)
+	require.True(IsUnsigned(typ))
+	require.True(IsZerofill(typ))
+	require.False(IsZerofill(Uint32))
+	require.Equal(Int64, Zerofill(Int64, 5))
+
+	convert(t, typ, "3", uint32(3))
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Uint32, []byte("00003")), mustSQL(typ.SQL(uint32(3))))
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Uint32, []byte("123456")), mustSQL(typ.SQL(uint32(123456))))
+	require.Equal(sqltypes.NewUint64(3), mustSQL(Uint64.SQL(uint64(3))))
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Int8, []byte("-3")), mustSQL(Int8.SQL(int8(-3))))
+}
+
 func TestFloat64(t *testing.T) {
 	require := require.New(t)
 
@@ -164,14 +183,91 @@ func TestFloat64(t *testing.T) {
 	require.Equal(sqltypes.NewFloat64(23.222), val)
 }
 
+func TestDecimal(t *testing.T) {
+	require := require.New(t)
+
+	typ := Decimal(5, 2)
+	require.Equal("DECIMAL(5,2)", typ.String())
+	require.Equal(query.Type_DECIMAL, typ.Type())
+	require.True(IsFixedPoint(typ))
+	require.True(IsNumber(typ))
+	require.False(IsDecimal(typ))
+	require.False(IsFixedPoint(Float64))
+
+	convertible := []struct {
+		value    interface{}
+		expected string
+	}{
+		{int64(-12), "-12"},
+		{uint32(999), "999"},
+		{0.1, "0.1"},
+		{float32(2.5), "2.5"},
+		{"1.005", "1.01"},
+		{"-1.005", "-1.01"},
+		{[]byte(" 42.1 "), "42.1"},
+		{"999.994", "999.99"},
+	}
+
+	for _, c := range convertible {
+		v, err := typ.Convert(c.value)
+		require.NoError(err)
+		require.Equal(c.expected, v.(decimal.Decimal).String())
+	}
+
+	for _, v := range []interface{}{"999.995", int64(1000), -1000.5} {
+		_, err := typ.Convert(v)
+		require.True(ErrDecimalOutOfRange.Is(err), "%v", v)
+	}
+
+	_, err := typ.Convert("foo")
+	require.True(ErrConvertToSQL.Is(err))
+
+	v, err := typ.Convert(nil)
+	require.NoError(err)
+	require.Nil(v)
+
+	val, err := typ.SQL(1.5)
+	require.NoError(err)
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.50")), val)
+
+	eq(t, typ, decimal.New(15, -1), "1.50")
+	eq(t, typ, int64(3), decimal.New(300, -2))
+	lt(t, typ, "1.004", decimal.New(1005, -3))
+	gt(t, typ, uint64(18446744073709551615), int64(math.MaxInt64))
+	lt(t, typ, nil, "0")
+
+	require.Equal(Decimal(65, 30), Decimal(70, 40))
+	require.Equal(Decimal(3, 3), Decimal(1, 3))
+
+	precision, scale, ok := DecimalPrecision(typ)
+	require.True(ok)
+	require.Equal([]int{5, 2}, []int{precision, scale})
+
+	precision, scale, ok = DecimalPrecision(Uint64)
+	require.True(ok)
+	require.Equal([]int{20, 0}, []int{precision, scale})
+
+	_, _, ok = DecimalPrecision(Float64)
+	require.False(ok)
+
+	i, err := Int64.Convert(decimal.New(25, -1))
+	require.NoError(err)
+	require.Equal(int64(3), i)
+
+	f, err := Float64.Convert(decimal.New(25, -1))
+	require.NoError(err)
+	require.Equal(2.5, f)
+}
+
 func TestTimestamp(t *testing.T) {
 	require := require.New(t)
 
 	now := time.Now().UTC()
 	v, err := Timestamp.Convert(now)
 	require.NoError(err)
-	require.Equal(now, v)
+	require.Equal(now.Round(time.Second), v)
 
+	now = now.Truncate(time.Second)
 	v, err = Timestamp.Convert(now.Format(TimestampLayout))
 	require.NoError(err)
 	require.Equal(
@@ -240,7 +336,7 @@ func TestExtraTimestamps(t *testing.T) {
 // typ should be Date or Datetime
 func commonTestsDatesTypes(typ Type, layout string, t *testing.T) {
 	require := require.New(t)
-	now := time.Now().UTC()
+	now := time.Now().UTC().Truncate(time.Second)
 	v, err := typ.Convert(now)
 	require.NoError(err)
 	require.Equal(now.Format(layout), v.(time.Time).Format(layout))
@@ -287,6 +383,277 @@ func TestDatetime(t *testing.T) {
 	lt(t, Datetime, now, after)
 	eq(t, Datetime, now, now)
 	gt(t, Datetime, after, now)
+
+	lt(t, DatetimeWithPrecision(3), "2019-01-01 10:00:00.1234", "2019-01-01 10:00:00.1236")
+	gt(t, Datetime, "2019-01-02", now.AddDate(-100, 0, 0))
+	lt(t, Datetime, nil, now)
+	_, err := Datetime.Compare("foo", now)
+	require.Error(t, err)
+}
+
+func TestTimePrecision(t *testing.T) {
+	require := require.New(t)
+
+	ts := time.Date(2019, time.March, 4, 5, 6, 7, 123456789, time.UTC)
+	testCases := []struct {
+		typ      Type
+		name     string
+		expected string
+	}{
+		{Timestamp, "TIMESTAMP", "2019-03-04 05:06:07"},
+		{TimestampWithPrecision(3), "TIMESTAMP(3)", "2019-03-04 05:06:07.123"},
+		{DatetimeWithPrecision(6), "DATETIME(6)", "2019-03-04 05:06:07.123457"},
+		{DatetimeWithPrecision(9), "DATETIME(6)", "2019-03-04 05:06:07.123457"},
+		{TimeWithPrecision(2), "TIME(2)", "05:06:07.12"},
+	}
+
+	for _, tt := range testCases {
+		require.Equal(tt.name, tt.typ.String())
+		v, err := tt.typ.SQL(ts)
+		require.NoError(err)
+		require.Equal(tt.expected, v.ToString())
+	}
+
+	require.Equal(Timestamp, TimestampWithPrecision(0))
+	require.Equal(Datetime, DatetimeWithPrecision(-1))
+	require.True(IsTime(DatetimeWithPrecision(3)))
+	require.False(IsTime(Time))
+	require.Equal(3, TimePrecision(TimestampWithPrecision(3)))
+	require.Equal(0, TimePrecision(Int64))
+
+	v, err := DatetimeWithPrecision(6).Convert("2019-03-04 05:06:07.5")
+	require.NoError(err)
+	require.Equal(time.Date(2019, time.March, 4, 5, 6, 7, 500000000, time.UTC), v)
+}
+
+func TestTimeRounding(t *testing.T) {
+	date := func(s, ns int) time.Time {
+		return time.Date(2019, time.March, 4, 5, 6, s, ns, time.UTC)
+	}
+
+	testCases := []struct {
+		fsp      int
+		value    interface{}
+		expected time.Time
+	}{
+		{0, date(7, 499999999), date(7, 0)},
+		{0, date(7, 500000000), date(8, 0)},
+		{0, "2019-03-04 05:06:59.5", date(60, 0)},
+		{3, date(7, 123456789), date(7, 123000000)},
+		{3, date(7, 123500000), date(7, 124000000)},
+		{3, "2019-03-04 05:06:07.9996", date(8, 0)},
+		{6, date(7, 123456789), date(7, 123457000)},
+		{6, date(7, 123456499), date(7, 123456000)},
+		{6, "2019-03-04 05:06:07.1234565", date(7, 123457000)},
+	}
+
+	for _, tt := range testCases {
+		for _, typ := range []Type{TimestampWithPrecision(tt.fsp), DatetimeWithPrecision(tt.fsp)} {
+			t.Run(fmt.Sprintf("%s %v", typ, tt.value), func(t *testing.T) {
+				convert(t, typ, tt.value, tt.expected)
+			})
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("TIME", Time.String())
+	require.Equal(query.Type_TIME, Time.Type())
+	require.True(IsDuration(Time))
+	require.True(IsDuration(TimeWithPrecision(6)))
+	require.False(IsDuration(Datetime))
+
+	duration := func(h, m, s, ns int) time.Duration {
+		return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+			time.Duration(s)*time.Second + time.Duration(ns)
+	}
+
+	convert(t, Time, "10:11:12", duration(10, 11, 12, 0))
+	convert(t, Time, "-838:59:59", -duration(838, 59, 59, 0))
+	convert(t, TimeWithPrecision(1), "2 03:04:05.5", duration(51, 4, 5, 500000000))
+	convert(t, Time, "10:11", duration(10, 11, 0, 0))
+	convert(t, Time, "101112", duration(10, 11, 12, 0))
+	convert(t, Time, int64(-1112), -duration(0, 11, 12, 0))
+	convert(t, TimeWithPrecision(2), 1112.25, duration(0, 11, 12, 250000000))
+	convert(t, Time, time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC), duration(3, 4, 5, 0))
+	convert(t, Time, nil, nil)
+
+	convert(t, Time, "10:11:12.5", duration(10, 11, 13, 0))
+	convert(t, Time, "-00:00:01.5", -duration(0, 0, 2, 0))
+	convert(t, TimeWithPrecision(1), "10:11:12.56", duration(10, 11, 12, 600000000))
+	convert(t, TimeWithPrecision(6), "10:11:12.1234565", duration(10, 11, 12, 123457000))
+
+	_, err := Time.Convert("839:00:00")
+	require.True(ErrTimeOutOfRange.Is(err))
+
+	_, err = Time.Convert("10:61:00")
+	require.True(ErrConvertingToDuration.Is(err))
+
+	_, err = Time.Convert("foo")
+	require.True(ErrConvertingToDuration.Is(err))
+
+	v, err := Time.SQL(-duration(100, 2, 3, 0))
+	require.NoError(err)
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Time, []byte("-100:02:03")), v)
+
+	v, err = TimeWithPrecision(6).SQL("00:00:01.000002")
+	require.NoError(err)
+	require.Equal("00:00:01.000002", v.ToString())
+
+	lt(t, Time, "-01:00:00", "00:30:00")
+	eq(t, Time, duration(1, 0, 0, 0), "01:00:00")
+	gt(t, Time, "100:00:00", "99:59:59.999")
+	lt(t, Time, nil, "00:00:00")
+}
+
+func TestYear(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("YEAR", Year.String())
+	require.Equal(query.Type_YEAR, Year.Type())
+	require.True(IsYear(Year))
+
+	convert(t, Year, int64(0), int16(0))
+	convert(t, Year, int64(1), int16(2001))
+	convert(t, Year, int8(69), int16(2069))
+	convert(t, Year, 70, int16(1970))
+	convert(t, Year, "99", int16(1999))
+	convert(t, Year, "0", int16(2000))
+	convert(t, Year, "00", int16(2000))
+	convert(t, Year, "0000", int16(0))
+	convert(t, Year, uint16(2155), int16(2155))
+	convert(t, Year, time.Date(1999, 1, 2, 0, 0, 0, 0, time.UTC), int16(1999))
+	convert(t, Year, nil, nil)
+
+	for _, v := range []interface{}{int64(1900), "2156", int64(-1)} {
+		_, err := Year.Convert(v)
+		require.True(ErrYearOutOfRange.Is(err), "%v", v)
+	}
+
+	convertErr(t, Year, "foo")
+
+	v, err := Year.SQL(int64(5))
+	require.NoError(err)
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Year, []byte("2005")), v)
+
+	v, err = Year.SQL(0)
+	require.NoError(err)
+	require.Equal("0000", v.ToString())
+
+	lt(t, Year, int16(1999), "2000")
+	eq(t, Year, int64(5), int16(2005))
+	gt(t, Year, 2155, 1901)
+}
+
+func TestEnum(t *testing.T) {
+	require := require.New(t)
+
+	typ := Enum("small", "medium", "it's large")
+	require.Equal("ENUM('small','medium','it''s large')", typ.String())
+	require.Equal(query.Type_ENUM, typ.Type())
+	require.True(IsEnum(typ))
+	require.False(IsSet(typ))
+	require.False(IsText(typ))
+	require.Equal(Enum("small", "medium", "it's large"), typ)
+
+	convert(t, typ, "small", "small")
+	convert(t, typ, "MEDIUM  ", "medium")
+	convert(t, typ, []byte("it's large"), "it's large")
+	convert(t, typ, "2", "medium")
+	convert(t, typ, int64(3), "it's large")
+	convert(t, typ, uint16(0), "")
+	convert(t, typ, "", "")
+	convert(t, typ, nil, nil)
+
+	for _, v := range []interface{}{"huge", int64(4), int64(-1)} {
+		_, err := typ.Convert(v)
+		require.True(ErrInvalidEnumValue.Is(err), "%v", v)
+	}
+
+	v, err := typ.SQL(int64(2))
+	require.NoError(err)
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Enum, []byte("medium")), v)
+
+	v, err = typ.SQL(uint16(0))
+	require.NoError(err)
+	require.Equal("", v.ToString())
+
+	lt(t, typ, "small", "medium")
+	gt(t, typ, "it's large", uint16(2))
+	eq(t, typ, "Small", int64(1))
+	lt(t, typ, nil, "small")
+	lt(t, typ, "", "small")
+
+	for v, expected := range map[interface{}]interface{}{
+		"medium": uint64(2),
+		"":       uint64(0),
+		int64(3): uint64(3),
+		nil:      nil,
+	} {
+		n, err := MemberNumber(typ, v)
+		require.NoError(err)
+		require.Equal(expected, n, "%v", v)
+	}
+}
+
+func TestSet(t *testing.T) {
+	require := require.New(t)
+
+	typ := Set("a", "b", "c")
+	require.Equal("SET('a','b','c')", typ.String())
+	require.Equal(query.Type_SET, typ.Type())
+	require.True(IsSet(typ))
+	require.False(IsEnum(typ))
+
+	convert(t, typ, "a", "a")
+	convert(t, typ, "c,A", "a,c")
+	convert(t, typ, "b,b", "b")
+	convert(t, typ, "", "")
+	convert(t, typ, "7", "a,b,c")
+	convert(t, typ, int64(6), "b,c")
+	convert(t, typ, nil, nil)
+
+	for _, v := range []interface{}{"d", "a,d", int64(8), int64(-1)} {
+		_, err := typ.Convert(v)
+		require.True(ErrInvalidSetValue.Is(err), "%v", v)
+	}
+
+	v, err := typ.SQL("c,a")
+	require.NoError(err)
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Set, []byte("a,c")), v)
+
+	lt(t, typ, "b", "a,b")
+	eq(t, typ, "b,a", uint64(3))
+	gt(t, typ, "c", "a,b")
+
+	n, err := MemberNumber(typ, "c,a")
+	require.NoError(err)
+	require.Equal(uint64(5), n)
+}
+
+func TestMySQLColumnType(t *testing.T) {
+	testCases := []struct {
+		typ      Type
+		expected string
+	}{
+		{Int64, "bigint"},
+		{Uint8, "tinyint unsigned"},
+		{Int32, "int"},
+		{Zerofill(Uint32, 5), "int(5) unsigned zerofill"},
+		{Zerofill(Uint8, 0), "tinyint(3) unsigned zerofill"},
+		{Decimal(10, 2), "decimal(10,2)"},
+		{DatetimeWithPrecision(3), "datetime(3)"},
+		{Enum("a", "B"), "enum('a','B')"},
+		{Set("x"), "set('x')"},
+		{VarChar(20), "varchar(20)"},
+		{Char(1), "char(1)"},
+	}
+
+	for _, tt := range testCases {
+		require.Equal(t, tt.expected, MySQLColumnType(tt.typ))
+	}
 }
 
 func TestBlob(t *testing.T) {
@@ -305,13 +672,67 @@ func TestBlob(t *testing.T) {
 }
 
 func TestJSON(t *testing.T) {
-	convert(t, JSON, "", []byte(`""`))
-	convert(t, JSON, []int{1, 2}, []byte("[1,2]"))
-	convert(t, JSON, `{"a": true, "b": 3}`, []byte(`{"a":true,"b":3}`))
+	convert(t, JSON, nil, nil)
+	convert(t, JSON, "", JSONDocument{Val: ""})
+	convert(t, JSON, "null", JSONDocument{Val: nil})
+	convert(t, JSON, []int{1, 2}, JSONDocument{Val: []interface{}{int64(1), int64(2)}})
+	convert(t, JSON, `{"a": true, "b": 3.5}`, JSONDocument{Val: map[string]interface{}{"a": true, "b": 3.5}})
+	convert(t, JSON, uint8(1), JSONDocument{Val: uint64(1)})
+
+	lt(t, JSON, []byte(`"A"`), []byte(`"B"`))
+	eq(t, JSON, []byte(`"A"`), []byte(`"A"`))
+	gt(t, JSON, []byte(`"C"`), []byte(`"B"`))
+	lt(t, JSON, "null", "1")
+	lt(t, JSON, "2", "10.5")
+	eq(t, JSON, "1", "1.0")
+	lt(t, JSON, "1", `"1"`)
+	lt(t, JSON, `"a"`, `{}`)
+	lt(t, JSON, `{}`, `[]`)
+	lt(t, JSON, `[]`, `false`)
+	lt(t, JSON, `false`, `true`)
+	lt(t, JSON, `[1, 2]`, `[1, 3]`)
+	lt(t, JSON, `[1, 2]`, `[1, 2, 0]`)
+	eq(t, JSON, `{"a": 1, "b": [2]}`, `{"b": [2.0], "a": 1}`)
+
+	val, err := JSON.SQL(`{"b": [1, 2.0], "aa": "x<", "a": null}`)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": null, "b": [1, 2.0], "aa": "x<"}`, string(val.Raw()))
+}
+
+func TestGeometry(t *testing.T) {
+	require := require.New(t)
+
+	p := PointValue{SRID: 4326, X: 1, Y: 2}
+	line := LineStringValue{Points: []PointValue{{X: 0, Y: 0}, {X: 1, Y: 1}}}
+
+	convert(t, Geometry, nil, nil)
+	convert(t, Geometry, p, p)
+	convert(t, Geometry, GeometryBytes(p), p)
+	convert(t, Geometry, string(GeometryBytes(line)), line)
+	convert(t, Point, p, p)
+	convertErr(t, Geometry, "POINT(1 2)")
+	convertErr(t, Geometry, 1)
+
+	_, err := Point.Convert(line)
+	require.Error(err)
+	require.True(ErrGeometryType.Is(err))
+
+	eq(t, Geometry, p, GeometryBytes(p))
+	lt(t, Geometry, PointValue{SRID: 1}, PointValue{SRID: 2})
+
+	val, err := Geometry.SQL(p)
+	require.NoError(err)
+	require.Equal(sqltypes.Geometry, val.Type())
+	require.Equal(GeometryBytes(p), val.Raw())
+
+	require.Equal("POINT", Point.String())
+	require.True(IsGeometry(Polygon))
+	require.False(IsGeometry(Blob))
 
-	lt(t, JSON, []byte("A"), []byte("B"))
-	eq(t, JSON, []byte("A"), []byte("A"))
-	gt(t, JSON, []byte("C"), []byte("B"))
+	typ, err := MysqlTypeToType(sqltypes.Geometry)
+	require.NoError(err)
+	require.Equal(Geometry, typ)
+	require.Equal("LINESTRING", MySQLTypeName(LineString))
 }
 
 func TestTuple(t *testing.T) {
@@ -374,6 +795,15 @@ func testCharTypes(genType func(int) Type, checkType func(Type) bool, t *testing
 	convert(t, typ, text, "abc")
 	typ1 := genType(1)
 	convertErr(t, typ1, text)
+
+	// Lengths are in characters, not bytes.
+	convert(t, genType(3), "ñañ", "ñañ")
+	convertErr(t, genType(3), "ñañu")
+	convert(t, typ, nil, nil)
+
+	length, ok := CharacterLength(typ)
+	require.True(t, ok)
+	require.Equal(t, 10, length)
 }
 
 func TestChar(t *testing.T) {
@@ -384,6 +814,138 @@ func TestVarChar(t *testing.T) {
 	testCharTypes(VarChar, IsVarChar, t)
 }
 
+func TestBinary(t *testing.T) {
+	require := require.New(t)
+
+	typ := Binary(3)
+	require.Equal("BINARY(3)", typ.String())
+	require.Equal(query.Type_BINARY, typ.Type())
+	require.True(IsBinary(typ))
+	require.True(IsText(typ))
+
+	convert(t, typ, "ab", []byte{'a', 'b', 0})
+	convert(t, typ, []byte("abc"), []byte("abc"))
+	convert(t, typ, nil, nil)
+
+	_, err := typ.Convert("abcd")
+	require.True(ErrBinaryTruncation.Is(err))
+
+	_, err = typ.Convert("ñañ")
+	require.True(ErrBinaryTruncation.Is(err))
+
+	v, err := typ.SQL("a")
+	require.NoError(err)
+	require.Equal(sqltypes.MakeTrusted(sqltypes.Binary, []byte{'a', 0, 0}), v)
+
+	eq(t, typ, "a", []byte{'a', 0, 0})
+	lt(t, typ, "a", "b")
+	gt(t, typ, "ab", "a")
+}
+
+func TestVarBinary(t *testing.T) {
+	require := require.New(t)
+
+	typ := VarBinary(3)
+	require.Equal("VARBINARY(3)", typ.String())
+	require.Equal(query.Type_VARBINARY, typ.Type())
+	require.True(IsBinary(typ))
+	require.Equal("varbinary(3)", MySQLColumnType(typ))
+
+	convert(t, typ, "ab", []byte("ab"))
+	convert(t, typ, nil, nil)
+
+	_, err := typ.Convert("abcd")
+	require.True(ErrVarBinaryTruncation.Is(err))
+
+	v, err := typ.SQL("a")
+	require.NoError(err)
+	require.Equal(sqltypes.MakeTrusted(sqltypes.VarBinary, []byte("a")), v)
+
+	lt(t, typ, "a", "ab")
+	eq(t, typ, []byte("ab"), "ab")
+}
+
+func TestCollations(t *testing.T) {
+	require := require.New(t)
+
+	bin, err := LookupCollation("utf8mb4_bin")
+	require.NoError(err)
+
+	for _, typ := range []Type{Text, Char(3), VarChar(3)} {
+		eq(t, typ, "a", "A")
+		lt(t, typ, "a", "B")
+		eq(t, typ, nil, nil)
+
+		c, ok := CollationOf(typ)
+		require.True(ok)
+		require.Equal(DefaultCollation, c)
+		require.False(HasCollation(typ))
+		require.Equal(typ, WithCollation(typ, DefaultCollation))
+
+		collated := WithCollation(typ, bin)
+		require.NotEqual(typ, collated)
+		gt(t, collated, "a", "A")
+		lt(t, collated, "B", "a")
+
+		c, ok = CollationOf(collated)
+		require.True(ok)
+		require.Equal(bin, c)
+		require.True(HasCollation(collated))
+		require.True(IsText(collated))
+		require.Equal(typ, WithCollation(collated, DefaultCollation))
+	}
+
+	c, ok := CollationOf(Blob)
+	require.True(ok)
+	require.Equal(BinaryCollation, c)
+	require.False(HasCollation(Blob))
+
+	_, ok = CollationOf(Int64)
+	require.False(ok)
+	require.Equal(Int64, WithCollation(Int64, bin))
+
+	require.Equal("ABC", CollationKey(Text, "abc "))
+	require.Equal("abc", CollationKey(WithCollation(Text, bin), "abc "))
+	require.Equal(int64(1), CollationKey(Int64, int64(1)))
+	require.Nil(CollationKey(Text, nil))
+}
+
+func TestTruncateValue(t *testing.T) {
+	testCases := []struct {
+		typ      Type
+		value    interface{}
+		expected interface{}
+	}{
+		{Char(3), "abcd", "abc"},
+		{VarChar(2), "ñañ", "ña"},
+		{VarChar(2), "ab", "ab"},
+		{Binary(2), "abc", []byte("ab")},
+		{VarBinary(4), "abcdef", []byte("abcd")},
+		{Enum("a"), "b", ""},
+		{Set("a"), "a,b", ""},
+	}
+
+	for _, tt := range testCases {
+		v, err := TruncateValue(tt.typ, tt.value)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, v)
+	}
+
+	_, err := TruncateValue(Int64, "foo")
+	require.Error(t, err)
+
+	for value, expected := range map[interface{}]string{
+		int64(1000): "99.99",
+		"-1e10":      "-99.99",
+		"foo":        "0",
+		1.005:        "1.01",
+	} {
+		v, err := TruncateValue(Decimal(4, 2), value)
+		require.NoError(t, err)
+		require.Equal(t, expected, v.(decimal.Decimal).String())
+	}
+}
+
 func TestArray(t *testing.T) {
 	require := require.New(t)
 
@@ -412,7 +974,7 @@ func TestArray(t *testing.T) {
 	gt(t, typ, []interface{}{1, 2, 4}, []interface{}{1, 2, 3})
 	gt(t, typ, []interface{}{1, 2, 4}, []interface{}{5, 6})
 
-	expected := []byte("[1,2,3]")
+	expected := []byte("[1, 2, 3]")
 
 	v, err := Array(Int64).SQL([]interface{}{1, 2, 3})
 	require.NoError(err)
@@ -440,10 +1002,38 @@ func TestJSONArraySQL(t *testing.T) {
 		testJSONStruct{2, "bar"},
 	})
 	require.NoError(err)
-	expected := `[{"A":1,"B":"foo"},{"A":2,"B":"bar"}]`
+	expected := `[{"A": 1, "B": "foo"}, {"A": 2, "B": "bar"}]`
 	require.Equal(expected, string(val.Raw()))
 }
 
+func TestArraySQLElements(t *testing.T) {
+	testCases := []struct {
+		typ      Type
+		val      interface{}
+		expected string
+	}{
+		{Array(Text), []interface{}{[]byte("a<b"), nil, "c"}, `["a<b", null, "c"]`},
+		{Array(Boolean), []interface{}{true, false}, `[true, false]`},
+		{Array(Float64), []interface{}{1.5, 2}, `[1.5, 2.0]`},
+		{
+			Array(Datetime),
+			[]interface{}{time.Date(2019, 12, 31, 10, 20, 30, 0, time.UTC)},
+			`["2019-12-31 10:20:30"]`,
+		},
+		{Array(Array(Int64)), []interface{}{[]interface{}{1}, []interface{}{}}, `[[1], []]`},
+		{Array(Int64), []interface{}{}, `[]`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.expected, func(t *testing.T) {
+			val, err := tt.typ.SQL(tt.val)
+			require.NoError(t, err)
+			require.Equal(t, sqltypes.TypeJSON, val.Type())
+			require.Equal(t, tt.expected, string(val.Raw()))
+		})
+	}
+}
+
 func TestComparesWithNulls(t *testing.T) {
 	timeParse := func(layout string, value string) time.Time {
 		t, err := time.Parse(layout, value)
@@ -0,0 +1,10 @@
+module github.com/mushiyu/vitess
+
+require (
+	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b
+	github.com/golang/protobuf v1.3.0
+	golang.org/x/net v0.0.0-20181023162649-9b4f9f5ad519
+	google.golang.org/grpc v1.19.0
+)
+
+go 1.13